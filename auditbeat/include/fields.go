@@ -32,5 +32,5 @@ func init() {
 // AssetFieldsYml returns asset data.
 // This is the base64 encoded gzipped contents of fields.yml.
 func AssetFieldsYml() string {
-	return "eJzsvWtzGzmyKPi9fwVWE7Gy51LUw/KjdePcsxrL3a0dP3Qs9ek7c/uGCVaBJNpVhWoAJZq9sf99A5mJRz0oUbboce/RzMRYJKuARCKRyHf+hf1y+v7t+dsf/w92plilLBO5tMwupGEzWQiWSy0yW6xGTFq25IbNRSU0tyJn0xWzC8FevbxktVa/icyOvvsLm3IjcqYq+P5aaCNVxQ7Hh+OD8Xd/YReF4Eawa2mkZQtra3Oyvz+XdtFMx5kq90XBjZXZvsgMs4qZZj4XxrJswau5gK/csDMpityMv/tuj30UqxMmMvMdY1baQpy4B75jLBcm07K2UlXwFfuB3mH09sl3jO2xipfihO3+X1aWwlhe1rvfMcZYIa5FccIypQV81uL3RmqRnzCrG/zKrmpxwnJu8WNrvt0zbsW+G5MtF6ICNIlrUVmmtJzLyqFv/B28x9iVw7U08FAe3hOfrOaZQ/NMqzKOMHITy4wXxYppUWthRGVlNYeJaMQ43eCGGdXoTIT5z2fJC/gbW3DDKuWhLVhAzwhJ45oXjQCgAzC1qpvCTUPD0mQzqY2F9ztgaZEJeR2hqmUtCllFuN4TznG/2ExpxosCRzBj3CfxiZe12/Tdo4PDZ3sHT/eOnlwdvDg5eHry5Hj84umTf+4m21zwqSjM4Abjbqqpo2L4Av/8gN9/FKul0vnARr9sjFWle2AfcVJzqU1Yw0tesalgjTsSVjGe56wUljNZzZQuuRvEfU9rYpcL1RQ5HMNMVZbLilXCuK1DcIB83X9OiwL3wDCuBTNWOURx4yENALzyCJrkKvso9ITxKmeTjy/MhNDRwSS9x+u6kBnHVc6U2ptyTT+J6vrEHfi8ydzPCX5LYQyfixsQbMUnO4DFH5RmhZoTHoAcaCzafMIG/uSepJ9HTNVWlvKPQHaOTK6lWLojISvG4Wn3hdABKW46Y3WT2cahrVBzw5bSLlRjGa8i1bdgGDFlF0IT92AZ7mymqoxbUSWEb5UDomScLZqSV3ta8JxPC8FMU5Zcr5hKDlx6CsumsLIuwtoNE5+kcSd+IVZxwnIqK5EzWVnFVBWe7p6In0RRKPaL0kWebJHl85sOQErocl4pLT7wqboWJ+zw4Oi4v3OvpbFuPfSeCZRu+ZwJni38KtuH9X/tRPrZGbEdUV0f7fzv9KjyuaiQUoirn4Yv5lo19Qk7GqCjq4XAN8Mu0Ski3soZn7pNRi44s0t3eBz/tO5+m3nar1YO59wdwqJwx27EcmHxD6WZmhqhr932ILkqR2YL5XZKaWb5R2FYKbhptCjdAzRseKx7OA2TVVY0uWB/E9yxAVirYSVfMV4YxXRTubdpXm3GcKHBQsd/paXSkGbheORURHYMlO3g57IwnvYQSbqpKndOFCLIwZasz5/35ULolHkveF0LR4FusXBSw1KBsTsEVESNM6Vspazbc7/YE3aO02VOEFAzXDScW3cQRxG+sSMFRoLIVHA7Ts7v6cUbEEno4mwviHac1/W+W4rMxJhF2kiZb66ERx1wXZAzmJwhtUjD3PXK7EKrZr5gvzeiceOblbGiNKyQHwX7O5995CP2XuQS6aPWKhPGyGruN4UeN022cEz6tZoby82C4TrYJaCbUIYHEYgcURiklXg6RL0QpdC8+CA916HzLD5ZUeWRF/VO9dpz3T1Lr/wcTObuiMyk0Eg+0hAiH8kZcCBgU+ZxoGsv07ibTJcgHXgBjmdaGXf5G8u1O0/TxrIJbrfMJ7AfbicIGQnTeMGPZ08PDmYtRHSXH9jZFy3950r+7sSbu687XLeORJGw4b0l3OtTwYCMZb52eXlree7/t7FAklrgfKUcobeDhnF8CtkhXkFzeS1AbOEVvYZP088LUdSzpnCHyB1qWmEY2C4V+4EONJOVsbzKSIzp8CPjJgam5IiErlMWr1NRc81JBKHlG1YJkaP+sVzIbNGfKpzsTJVuMideJ+s+nznB13MeWCqyJP+VmllRsULMLBNlbVf9rZwp1dpFt1Hb2MWrVX3D9nlu5yZgxvKVYbxYun8Cbp0oaBaeNHFbSRrHd91tPo6oqQLPDliNzyKJ0xRTER+BK0zOWhsfd6xLAK3NL3m2cCpBH8XpOB7PpGxuAdX/SWpsG9kdmJ6ND8YHezo7SsUY05JhGqsqVarGsEu4Em6RZ04rxuMreIuwR6eXj/FgknRCgGWqqgQojOeVFboSll1oZVWmCoL00fnFY6ZVA+pircVMfhKGNVUu8CJ3wpJWhRvMcTelWam0YJWwS6U/MlU7NVJpJ/B4HU8seDFzL3Dm7rtCMJ6XspLGupN57YUrN1auSpTEuGWktuIiylJVI5YVgutiFbA/AyE3QKsKma1AsFwIJ/rCAscbX5hVU06DQHPTVVmocGu3toKuBBzH6aEqA+GKIOptE8kb4etA8LSLNNCj08u3j1kDgxereOMYFJ4D6vFMnLfWnZDe4dPDZ9+3Fqz0nFfyD2CP4/41cm9iwrtkHpi6B9uPSjm6eP36ZXIuskJ25PuX8ZsbBPxTetMdAE8j3BBRSCsdfSI5etTRsXDgzVRQYVFw12LOdQ4CnZPXVGVGyfMozE0lWsCkchrhrFBLpkXmdJ2WOnn18oJGxdsigtmDzX3hHk8gg0NhRBXEePfM5T/esppnH4V9ZB6PYRbUQGs61r2p0NLjxK3WpF7/0GDGEsbBQRKyx5LVvDIcgBmzS1WKILM2BmV/K3TJdrz5SumdqO1qMfMchECpOgs0eBzoZ9LNcGenIugmoJslCKCj4sCq5n6b4xQp/KhlEhH5CdyN0pjGIYRGjUqRrBx4vzUVbgDoSKj1eOPiwGARv5WyvSGdsIP7tQenzFt1gi0Ix9v38wTrHRweFJ94njMjSl5ZmQE/Fp8sSVriE8rQIxRs/Ck1Qd6yil1Lt1z5h4gKr1uo0KAEG2kbTttxPmMr1egwx4wXhSc+z6Udh5srvRq5R72gYKwsCiYqp/IR3aLJ0AkTuTDWkYdDqUPYTBZFYDK8rrWqteRWFKs7KDs8z7UwZlt6DlA7arZEWzQhySSBzZRTOW9UY4oVUjO8E/j60qHFqFKAqZQV0oAt6fxixLi/+5Rm3DH7T8woRydjxv4RMUuiE9jyorS8EEzzpYfJ0/1kTF9MEGVtya9yinEU7PIGbXl4XU3Gsp44UCZjBGsyYrmoRZWT6I1ys6oiEKBm045FyWb8X+5S5Wb8jd6rEcbpygpziwic7AdaQtqvtQD5m/sBrSDBEUHnhLYJ2VkffS+OW4AhsW1BOCe+iuOPW3POhRpn0q4+bEmRfulk28HdeeNkacGLPjiqsrISld0WTG8TpT5M1oPvrdJ2wU5LoWXGB4BsKqtXH6RRHzKVbwV1OAU7v3zH3BQ9CF+ergVrW7tJIA1u6Ete8byPKWBZtyudc6E+1EqG+6JtRFfVXNomxzu04BY+9CDY/X/YTqGqnRO29/zJ+Nnh8YsnByO2U3C7c8KOn46fHjz9/vAF+393e0BukU/t/myE3vN3ZPITSuEePSNGtgKUjNSMzTWvmoJraVfpZbdimbt0QRRMLrWX/i4LlhikcKlRysmE4+IkEM8KpTRdBiOwPCxkFDfjrYHgFaxerIx0f3hPQOaPtUlAeKts4u0EP4dE/byES2sulF9t314xVcaqai/PenujxVyqapsn7T3McNNB2/uPl+vg2tJRI5gGT9p/NGIq2oiS9S0whAfaxHl+EQQnzxHhskgpC42W3uDhXXDnF9fH7ovzi+tnUSDsyEAlz7aAmzenL9dBzVq2YTvu4mXwWK/BzZVT+VBzOb9wE5Ecj/Ebb0+vglLMHonxfExWF16kyjtDDdAbZFougHBWEj3QKZpgpqvmrFA8Z1Ne8CqDozuTWiydGgJ6t1aNO9EdjLtF10rbuwmdXsgxVsthSTTFhhv/z4IP1DfvIO+1Vn2Bb3+WdHfUhqO3J5sInev344L2YB3xN0bo8ZBEeX8XWypHoQlIaTSsuMnRAlsKUDjULNnnH6LPY+Q0wNdnpxfg6MvAIHoWhiKlEHjgbn91ouSy2NLi3KXNYALPaQbQO2uKYoD/3ysQu4a5aWBauKr5NZcFnxb9a+G0mApt2StZGSto21vwghVhvDWHaN8pOCMHOEwc/Bagiu7XBbeOzAfwinBuEbEp5eJkfSAW3Cy2JhIipuCYuHkcK8mU1sLx15b3fYYWEThPFeOVqlZpLA9yiuRs/WwEeRYnsAqZoyUDPrjVTULER6aqGe4VL1pzOhk741W04DEfoTV0CrfiYH7XETaaLmmFix9g6EO1JanscuHYLorXEI0hqz4gyZHkcCRbZn3V4JTBqu+/WG/Ux8BMhuQRjD8wFANL9UzzEK0V41DQOodOXH+vgCuXrY07mbE3wmqZoT/YpP5mXrFXL4/Q2+woZCZsthAGtItkdCatoVCfCKSjrnaEWivUSJrgx2yDQOPqpqIYIi1KZYPXk6nGGpmLZKYuZAgTZxTk4hfkN72Kr5Jm1A6mw0HjQBDNQ5P7u98NK00ElRB2F/ttBnr79jjz7lVEEM4FUUypBU3mITKNTtmK5XI2EzqV3ED/kxCP5S53dzz3rKh4ZZmorqVWVdlWHiJtnf5yGSaX+chb54D+2bv3P7LzHGPHwIPTO/B9jfHZs2fPnz9/8eLF9993jJB4Q8pC2tWHP6KZ9r6xeprMw9w8DitoGwaahqMSD1GPOTRmT3Bj9w47qhw5/LdHDuc+0OP8zHMvgNUfwi6gcu/w6Mnx02fPX3x/wKdZLmYHwxBv8coOMKchOX2oE8UTvuxHltwbRG88H0iCTG5Eoz0alyKXTdlWDLS6lvlGXoIvNnbCWfMTjv3hTOOk+dKMGP+j0WLE5lk9CgdZaZbLubS8UJngVf+mW5rWstA6sqVFkXHkM49beh0joyfs+yu59eUNvvbwYNufSp7OXhh7Ellbi0zOpLeNBCjQXUgucdKu1SwdJMmJEEb4eReiqBMBEu4r1MrD0IZuwmrlEGRlUKk2uaC2IuOREBwXL/P2GZYln2+Vp6RnAyYLLgEEaMkNmzaysO46HwDN8vmWIIuURXDxeRuAJFHj5tmThI0bUja6zBYmpeyH1rxb3I245mj0DNwESXZb7ARHZyWv+NxJb8BPAh30OAkmiiRsJPHqp4zkrPP1DawkefTm6A+UnpOnwYuAVq79dsLEwJhJwMdtoR7IfSjU41uMRWiFUmwUkBDFWMyxuqeAhDAsBCY8BCQ8BCR8ewEJ6WHxdmtKcuzi8GtFJaTs6SE04SE04X5AeghN2BxnD6EJD6EJf6bQhOQS+7PFJ7RAZ9sJUpC1my296W/xzIuWS77W8ppbwc7e/PPxkFMeTg3oBt9UXAI4whN7Ca0UrCgRN1ax6QowcSYg2/X+V7iNSIM7iG1fL9xgLS0/xBw8xBw8xBw8xBw8xBx8UzEHedXKsT17ewkfb7BG/tCyQMpq7l5ivzdCS2Fgr3hlliIp4+N+p6ADsmIJCY7ckMMVE2D9WCsncrjTqthcWExhw2Fp0EeTvDLgwjuB5yePqaLGyk+Sjg4sy+eAIUHF2iY0Ik4bDKqGLUVRuH95UYTcZYQBfTFLoYX3mOXEW6TBcfpQ4quTx3exl7ZWfO+W/N3TinGt+cojA7FM72P5AZ4tCAxmKN1SC9voKjnyvjAWxTpG4QkCImTlYCCURSum3xvcAiN8jaaWkXa6Yq9eXsYc+veYO4pjLfi1wBzrlFmUcTn4o5+8Ykv31quXlzR8Vwd02+zID/ROlKSwhAH80ja0u+c8mbNTy0pZybIpR/RlGNcvqmyMbZXTmbhZJg44CGvpLcMJK/5iHbGS11G5daNlC/D9WV/SjRtWK2PkFEWYHFIhebVy/0qffYsH11tjhwHlhmVY3qJl3e9Q5Dgr+Nbs+BiPwlE/ChviPS45UoyEKigo1WNGcY/Xnb8dBD2JSdpKKA1Am3BHMPmLTtU4OhyCY0CQt2Tgq7WocuOlE4ggAIblUZIO6Nfes0scHoz9/waxsE3LEWAhisqO4hJXfAd0VmN+rWlXEeEsW3C8zF6+PX3zyh2IqXDIcu8X1yIfpcxpd9ewCYoTkcXYxKujKl+FxYk1plYOxaDOxcMAg8C5HLPzwKsqZZmRZV2semP6SmcTyAv3LoSJu3kEFCnsbctyuRzPwdI/zlQ5uDPWbqJDrFMVHe7BXwla/DVIUo5zw3oBAYOb4LjmVLCMZ4uUsYsZ8KWW90majOtc5GP2T6GVjw9xpOzHpzOQ4G8akYZTDHgWhul0izE6V4sYn/OZLAZIswX3QvBc6A+zwleK28L5OoU7W83YESuEtUIDl8SZGczcCrKrsa5JDOQ5YaenI3b1csTen43Y+9MROz0bsZdnI3b2rkey9HGPvT+Lf7Yt+FtT4NwOuaWh9SRV5Lgxcl4l5S+1mmteIgWGkp0BCe4REMvQ5ZgMBL78WkYvJTIH09dmnx0dHh621q3qAcvuvS8eC8c4mcBNRmIUxggJDAb6KKvckQMKsC2ZloX6hlhkKlQeNcJ63MWqFGjax2FQRgbMQK3EdMy1OPqPn1+9/0cLR4EzfjWJQc18iRG6MFA1uVU+aPHwbV6NcCd2QEuvvuAJ6cQbV6raq7WsLNTvyhYcKtxqwx5NRaGW7MkRRCQ4CNjh0bPHo4T8lWm9Edl5UJKwFIwwGa/dseJGsMMDuEXmMMevZ2dnj6Mk/jeefWSm4GZBSt/vjQLPchiZhhqzKz41I5ZxrSWfC1IfDIqphUziEmZC5OkImaquhSYL7a92xH7V+NavFZCgAPtcMVBD7IZrNmyzFnNprNAi/7Bds6Tb84WcL4SxLE5KEtII7Kq1wzmJdqaZeo93wEzLQolcqjMOaGs7M6WSde+4g76TfO4WqENuQIXncmGFLuH6q7XIpBHFCiUkjuEvUK4RmG0zLWTGTDObyU9hRHjm0cLa+mR/Hx/BJ8ZKzx+P2ZVegTissJTJJ1lyK/Cana68hGX5x2hkRr5dcGOh+hmGnGFkjhMqIOoDdHS39qvXZ7FE5E6mxs3HnT5h3EYUX0ncIKnrZv50enravme95PvhS3xCpz2FvyjY+YW7EQREzU5SRWnS0Vj8jxNvOCDakbOZzJoC9NHGiBGbiow3Jhg1r7mWwq68qBWPPCi8xomYbigCa8xeYf3uCF8SxeUBtVhZVTGwsSTImcTLD6rJShuUY0wvzcUn93bpSCUdGrkLvgS/C26ckGBVGDHWCEKm567KmepnIARZqauItb877G4w3KtfQ6zwcw27jt++e/X+/bv3Lei2eDZ208MRzIUs4zXUmB4Rot31BvTXvjChFFOMiE7MjaoqVmDCMVCEKTFUtqoywWOZFr4aPcBXxQrFM4Sta3HcFIoIgDcfknGxBURnfqiQCliohab1P1I12nKKlRvCKFX5wl4k++HpeDxmp1UOmU1O8QtjElbbZ3+92dNbB51USDyhx1CDGSkU181aBmVsJ3CTQfmNsHwvNX35AHiybW1epvC2CpYDbQi+rMZv0qIB7rGAX7cYw6was4nIzJgemqAL0oMRmSDIecB6GmOxLi54V4peFTTGflmICvcMNhALAge/hKxymQnD9vbI5ELmUCipbhUzhZwvbDGUvpWsBt6nJhYOtEI4Fu1EQU3V1nj+mwPVu52zhSh5B/+sVal9gHQOxwfjg5RytFatXItX4Yubi5bHXIcMKtx60zIMaJB8V6AlBTz+jHX5SjRy43NkVK5rAUGzhcBkQYdmzwjA6ZVxdwuFut7fpWdLWiOKWZTZeYWj38Hov6VgIUAmqpAd4yQCeKNGf585HQPu2AEI0mYI68EIDREGF+tV35TGrjuFM19db1gYH/d3KJfHFwEcTucplBdnMe1Hy6xFK4EkT6HXQrsypyOfLs9OatFDfPoC95HH0vL+Nn8de0IAY/F18q23EXIbDLMgiVfzOEYsr69mySJoPD8U9+XaGVQk9+nAlOQb64iSBQcF3hDGQmN6bwvoBmkEyQhD7AeKkU6FXToxkIeqg3TfJZX3cTKq44kF97NCGbe2U78Tt6MbQ8doSKzo22BwbQEjYpVH+Jh2LQCAhhGdPEbDxrr/Layn1BJRXopSgXtUGKgiScPlCeIjwV03RSU05qHK2FiBHjYZr9zSoa3CXVKSNwiM/WwxEEcPsp+3UrXTV0iBDaGfVLI18Z8lbYPAmi8N7l6ULha8YhN8wNfqnEQDR9gId9YngJA9nueTEZsQye8ByQv4aiYLsYcSXD5BI6M3tYURQzX/xLuJ2WV1AdQwlMjcGKH3am6MQ+Ye+q/b1wWBvo3teEVSOM7QRX645BZyvqCircM8EDikl6Q7uxJ1NeVrxHY2BwliMvJ7akRlyA4aw3Z5ADPAFUf20hH35XR/4dodbmimMWugMkIQfdTMiUIjthSsLniF0Y/g42e8bexwgkWWiRoNcmRfD2EA1PamxpZdThcGY0rGm+FIYthpyDKLrGG9THB/qtc53cdZYmQOi6CmWa2ODQkdJBlX3mHuFuqZaI49x0LNgNCZp6mS9KsRVZIuYmoYQ/aH/YMKXs0b94fSzC0P5F6QP5HTqmunostSOK3H4zP4VBMKc8Tzi6xytTR477Pzs/4+HD87ftFGPh7rWw5YHpW3Nn6Jw+AgvUIXw33O3IUArb8C7FpwYBi+aQRW116h1tlr/kUnFKV3xyelu1MzCh6N7dpCseLkK5tW2rLRLsridTbQXS34Qrt8+rxipTI2KZ88ooAPu1SxMxrZ9aZiQEVBfuo/ZqkvsdUfLONFBlmLFIlagFMTBYVUOyf/EEW7IImHMVv3NmwLvOr7ImljvcgjciY7zTs8JKWqZCwdzpIhdndBjfA75j76KhFWsY9C1KypkVPAS+nhamMVmkkApG08uvsKT1zGi1G6s9GyPhA7l3PLjbgtLvjL43Jxmo6zv2r3zwPrMXgWSkya5BU68MlC5ARlpb1ghFHrjhMn/KNQ8xHqFe7Px6N0cnci/E6hOLCKWZLJKcxUmSSVdDudwFZqkamyBE4MbVYqZYN+D8M7EaE1N3h2QuBBqfIm6e6CQbUzVRRqiQICZ7nCcjlVb5gBa0zNs4UYJ7gI29voTdKZBuK+O2/Kqm7sB/9jxStF0QVe6Gxs+gA3b2RRyMFn0M0ANHI4SDhnNHVLbmAQVhKmbVMSch/EujvJ+Fk45UAL9rFSyyptwdiKFRniMJ59wOwVGmloT2UvCFxUmzjC110UEdTeHdG9HpDe3HXov3eSzXWaceVuEPCcUDuyTvmELQYT/8TNgj2qhV7w2kBTMmjWNZPVXGjwXz4GFwhf0v1kldsAjtb5aH8VpaqgEQq2LETzk7SrgewGX39m6K/Tv708+2q2jfMzt5qQnJ/oLR2YB/tVfZQbEdBna1Y+TmCtOoWG6r4MvyRZu1twpMUrkWbjRepbQpLOnxh1b1AJOmoXfDuJY06M5VY4hYsXXJeTb1OSByDb1qyUzW/tbsVZklDCm9p0gXRBcgpIQiDgmKaulaYmopmqHE5AFoehUXQpmjkwJ+UFoTBs9Jdw6odFFzpe0adwOwFLeDzy2h2OHML2hmTOmBYKSrx7ft3V18K6l0m3gff3fAnWx6ClqBlkmepAyj+ThHEDI1sjrTshApyUAi+cXGUfkjJMuTSOTHNQoDEvAuRmwXW2EHk8LU4gkaHvnBZWS3HthfbJB9ybSR+Vl6Jmh9+zgxcnR89ODg+weNLLVz+cHPyffzk8Ov7vlyJr3ALwE7MLp9ug5qrxu8MxPXp4QH9EtqB0yUwDEsqscWqGsaquRe5fwH+Nzv7t8GDs/nvIcmP/7Wh8OD4aH5na/tvh0ZN2QptqrJPVtsk7aYp17LPVBTpapZy2lqElM3IS077gWyMnvd18P6FoEcQHiTUSCqkj8YzLotFikCGGETdijJszxDDu5oyx6QumWy5xtnsZPLJD+4ZmAMgFRb7nI0guV4a0jL7V4LWaJ1py6Y69anOs6Ab2qo0/rAOp7rGX7g3Ri0hZyEcvVwaavi2srfPHWBgResg1U6qcQgNT6GBouRpGfPRR6EoUI/ZGZlq5+fdoiXv+cO+dNrl07z7u7yO+3dpGLc3HDybhreu47axQfNBn816ajwxGwC6wUmlp2+2eaf2GQGRGFUBpJglM+9kIUvZhyaBuk2kCZf6F0N0CUgH2D5XS5QaUuHYRu2/ByCv/EDkMe8uCRsEODxarsIgDdyQPDw4GOoqWXFaYjkx5dSvVwNFrq8pECEBRGCxrEoBM297hhlhyrFpuhGMCVVwGYo0czbwofG+zjvJjxO9NojrdXw73JQ3sywGtFWBFgME/Cu52aixPJgVQqk3PbDkCqw3/2A7wF594ZpnSudCUpkESTmK/JOtlkeTzR4tL0HB7yLoWSYGMe8nCvqQxO06RQP1+zo7E/QvF7EfFy5vd4htpjL/jYdcQMRKe80qyo0JwjjtmtxvFwqYOrSejqyMgHJxYNJUUvi5xZaSx4NhDwvNxEB1OtPu8g1inm3+xEo4a/q1qOPl/UkW8dXs7hTyactdo4o5YtliHdjcRLZPku9jevLWk3V2TUG/S3ZuRUEoOZIK5rSoWWvB8RTw6FzPeFNbfo9E+mbBqNKH5mCYs7ruUJrVznkYhJEzqYwYhk4E7glQV+F/Pz2jynVeNVrXYPy2NFTrn5U4SDM2nUy2u0SXsH7+82nmM0WXsp59OyjISt+SFf2rv4OnJwcHO485Z7ofF3ZOGIZBcQOwi1bbBeIawlguUvPi1ghLNoTwh7rd7ETK1nTIIUHuYZ5K0UYqC+MF/vrF5qHur6zGHRIKeVQCCEQybOq7Qdp+QU9/9Ct4k74p2Y1NVuNAY1E3n8xJJdOLGqEzGxvygmvjOoa12lhjMue9wJ4tWkA5ZjEcUFl9rlTcZXgww5blX0NibqB7/rx/O3/xvehYigWhEKvINLUYhZAglfC9O98sz8tkM83EAm531eKoJLCbEjNytYjh4J76ADe6+hqBrWaKwCqA6RuaHbmfOkuBaUQ5t3EqDDg2refbRqxTGDJlOB31sdwMZ0A/jAA26OTaFMtZmbL/fgXHDKqN3QSq3VstpY9G0UgrLMRMN/PzDaMbfQh4vDEPWNPShNTVcVpPSTTUhB5W7ed3tOoFVTBIrHXrd0KHqDjUVzIHETVmKETPSiVQ0HMhUVYTbSxMOjK5HCYrpbOlew0o9a2oiB4B6Cmin0FuoErMtKEPpmBAuGLgoVcfswbi/UKXY54XHXfAuOKD68a33BiucnzBJD6yapM5QSmxrWX8XWpZcr6hIi7vUfzw/e3zjvu4eHhwcdsrjBR65bQhTVX4Quv5eLrhZjMv86Zbge3P2FKfoT2oW/HBLs17+dHp4w7RHT59tb+Kjp89umPopFcDaytRPD48GppbV9kJ2zt3YMc7Zx/EiY6nC316c6p6Vo6fPnrx40ql1tz1o3zhgk+PhQFSZ5UWnhXcf0INnxwcdML/wCh64gcPVycG3IGeyq6F9pbpPhBunYYXIbM+NR8Gb1qpt1kMZ/THuMmu1rLZmYUUx3U2wC2EVerD2Y58H1txuywX9Q1MUMH4qJN100e6vQ5yRf9zRojUglLpBHNVDUeZEpntXFSumRSGuuSNAp4lDICnkGIGkteM+DqQxHj570qnEbLmeC/thi0i9ghkQrU6zNKuykNXHTh26LSaJAS7BC/3IoWXkzgEokwTJ494OB80vlOLaaqkC0LWdvPIzyCs6GqqTnIdHlx1hBs/OepEmqd2KKiCq7D/Sxxs09h+FShNjMq71Km2uxaNX3he4TfuIcS9ptk2tGCkQa+K2VP+QS6xl8DRakS0gPCJ6Vxxk5xdJnDrGpOk909ROT8nvki/z7ZQB/+ZLgH+D5b+/sdLf33zZ74eS399mye9vsdz3N1Dqu6+O+/srfLH+BrsKpVqTvLtSkKcyJnrCM5TA6R7xMpVfoupG4m1yr3zTZWm/di3aXtwo7eJP/vMt2ZMLDAGlVqV+36ILEX7nxVxpaRdlyJ6TmnyPiVNAFDmeZ0q+LEtVwfvCh4K/OXs6AmvEY6CGWgviaWN2mucejFmw4WNXSRpiumKFWgqdcePVsDZwyLIcgOhwaapcaHTzG1Fzza0KJTu5wWIntZbcCvbIVPwj+khHDEMZFvzJh6eHR3epCvq17UZf32T0r7EWfU1DUThPyrTSkX/yn290xPluhi1HHMYNFe5E1I3F1FdqvekPz6uXl5jr+Vd/CAZdwtIuBhxXMKmKXRXbie8+bxgUMhD7BxNe01RXt1bAaMhtpREXXOdLrsWIXUttG174rplmxM6gvVrSuhBrtvy9mULPAmFYpXJxp6ZkOltIK7IkVO5eK0d3YrBa8/XuzU8vnn141tbsH1odPbQ6ujtIm+o7D62OHvSeh1ZHX6PVkbs/twTJ7k80dtpqupWrGIsPhKi2pS/WO/GQTUCadueXajR6VaTVuXr3Ri3pftZDKhLKOWkYxKkJePSZEthnkzoyjCAIkeIVgz5IdbYhYJbyeW/sSE8VRRsNuknj8zsmU8EtlnjuYuHz2liBBCTr4Y4u22k/9RNt5fCc26LPtzfSZlL5D6kyociEEn+GTqsYskNMEvJHfm94AW67MGZSfNyXkHEA+Kq5ofIGtMigyGGnxbFcZDKH4k5OdgUyiowdKht2Nl6Z8YyXsthWAMm7S4bjs0fedq5FvuB2xHIxlbwasZkWYmryEVtiBH/fDYJP9uBuim01K+rJvLgTbeemr5zmq1INi6A8czh4o37j16K7giQN4SusAWcLYIPOpfmSIrJ7kB+Pj8cHe4eHR3tU06QL/RYFmjX4T33ItIx1CP+fXWi9GeprQeznI7p3spEyI9ZMm8o2N9E610vZo/XByoDbA35TGjk8GB8ej9s1QLcVTnxF6bsd9vuD0uxloZo8JGIZ6nAec5Xo5kffK1QBntijcSly2ZQTaF9yXabFpiHtNJF1g7I+wnJ7vpCx0mR6a/VvCXd1GHHozu40fqo3DAxZ56i/DB0SSOoI4cu+F1e6bU+Onranf+ht99Db7qG33UNvu4fedt9Sb7uFtS2X409XVxfweb1x/QfvogpRMO6lkM019oVj2aTRxcTnVQnMnLTJqh2QuojtmqDC/ObOR//CVOWrcdrM/455lemrbeSmMWkdMBnM2kXvixfP14NIUZRbOsNXpOvhZtwI5U+iKBRbKl3kw9BuAZdXyvKiHeXXxegjBywcdmzTMyC5Hh4/GUZwKexCbese2W2hFKfqZNUikWMaLdSRnYo0P9iq4DDFwoG+OPWYXQoqrKSypvRxvmFs309w59xnhToR+tXLy6G+DcKOWA1FZevGDqJJi5nQemthru9p+FgFIcVcbzcd7zEn+/vTQs3HPro0U+V+B3ZqpPO1zznV/t/woKdAft2TfhOc64+6h/drn3WC9vMOOwFtLLeN2bQDxJ0yxNs4xYmGzenHB20f5Hb1Z4BrnUHiEPTjGJ43T2/01/Tx1gsdDXq8Vf9XQTmeNLF8k5sZFr8FaWf3nU/Ud1AFFxOVEO+VO8DKq61iWUuuq8mITaDqoftDDtT2EVq3l6Pm8y7PvJf1XHWKnOBETFYGysxUjNd1QUVnx6G6RWMa8FKkqfTpKNjlC3cTK3vTJRRmGGFVbswW9r1MB22LSs/HouDGygxrJ42nSlljNa/Hf/N/tZC1zYJSHgOtmg1u532BKd6tDAh8MnkiUSBmWOm6kNg5WVrWQK3XIOPXXLcK+Z6jBV7z2NRhQsN6KReRntrqeZVUgnUjpiVMPOHSKGkBpE79I1rsqLcgXzMnjAkdf32dASjigRk7me9KgcHjaKMSVabA2Kw0q8QSWo05yb5U12ltHcWyQvAKilS0Qf7S+lzMKCq/tbsLQhM1eor75G2xaRuuzy/TBY5gMF69WRGjDH4dzIxPWefb5Ktbgvd8Xn074ggte2XZVL50NKSGQDVmYrcxvInhLiT5+RQxZJK8gjDTZ8Un+dE7pTC7FQNCQaY7RAhFTrUtKfwUuRwWzYPci3RWug5qrazKVNGuOcz1VFrNdXRCsdgek4TVam7wUJRQ74lqFoyAAnlhoCVbscKTHx82H1e1iIZdmf0+YjOeialSH0fMLqW16D+Thi3T0sLQczbUe05yn69FlSdlkSFFBmCJiSNOHslDokioL42nYD93Wsr5BebMGKcSaGtGLBlzKbUvEfIN6jFcthvPDYiom1QBWiue7qJ8inIplDUDrQV2ZKrcuQGDL1TBb1Wvm1CJaXiTisolnTjC976K7ohN/GGln/DuknEnTFP2EfDkWae4OnIQu/qwNVPp7ina/aBhCpQIAKYdFwft79x3RE1JD6xUDvHHL8bNtPlflGI4s0oVe3xeKSddOFG7yrnO02L4YdhZoZbpZrwWXFPrem6DHjmXdtFMQYN0BAI10vcD8vZkvucE24FMwZPFu/9m3h7/9N/e/Pj0zT/2XyzO9f+8+D07/ud//HHwb62tCKSxBfFm58wP7iU5z66t5rOZzMa/Vu+TWtpJs+JfK/ZrQM6v7K9MVlPVVPmvFWN/ZaqxySdoel3xAj85CoqfmgoI99fq1+qXhajSMUte10mbJ2A6eHntTbnb7KROKnX7GYULKRFs0jED53LD7BoGkXNu8ddSLMcIw5qJPWqUZrXQshRWaASkBfRmMEVAWhC4f8GpRpOlI4dJxztdciLct+hmpvQSOoL3+lLeJQwm9mGMNanouCY/kYBca/VpoBD090fjw/HhuF0cVPKKf8BAui0xmPPTt6fswnOHt1h77pE/ucvlcuxgGCs938eLGfpW7Ht+sofA9b8Yf1rYskgKZl0SH4H7ytfp9G8Z4j+8gGJ/wMFA4nkr7A+FWmLtcviLzNth3ELNvVLVkH17aE39ltgtRG/bh4TC0XRFZS2hZZvyt6+JwZT+XupC+yOYOH+RM9kCG1tT3eESHrpwaZDPunLp3YFLN/4ycO36H6N8Rhfw8MV71LboeKrZhir7+rnXLuKdCRo4E5/GcKONWAEU9RvPnCTpy69GCffbk9yCMykEaniot4HCS8gxMoGWEyaGUjv4nXks+ibY33Ge9BiGFowRwwVfOebU5PWI2aweMVlfP9uTWVmPmLDZ+PG3h3mbdRC/pQiZc7x03l2eQ82SAi/RZRrJ4sn6tcPi2OHuGDGYaEm1EdmI1bIEhH576HRAJ6YBqkrZarz5Lv3upkykKrzerwtYi0zywlPwKBRDwIjMnkqN1cJCX5ZcWJHZkR8frXpQJO72Effa9xsJV9BfFWrpmXYtgxCrFMyFPgEJB+VVJjCKlJbaqW+oqpmcN7Ghq1VMN9XmCAj1n5Na3+2EqJnUYsmLwoychKsbCC5DDElV7dcalghD+fBYL0MmUqIRlVE6lP5dimkLimQSSEcolDFsaGiHyNOLN4QNEDs8oJ4aUgMOxwJza+w3vgQ2DI4xN9VqlFZCx3WaQArG13VEcjBRYL4Bxb6aIo1JNRXZG7Kt/t6IBgdmr65eQwqdqrDSL+l61Oqg3QyWyMlbmrQA0yAUr82hN7/Hh9tQ6GW8udHpIe3rIe3r7iA9pH1tjrOHtK+HtK8/ddpXN+sr3L5t+8fnGWUSo8uNw28nTenN6ct107dmf8i/GYT6If/mIf/myyD+L5t/Y4SWvNiuwdjr1zQZ3fe3FVK8vxbrlnKBUrbqu1zc0DXuCvy4EACRFNUJhug40qoWZjwUouRdBTrt6ecVTwhZyg38UxtqtP5pBX+oohAQ04RKrPsrqqADsRF+zBZKW97n+0RqWDnOkAb4jzsQDJyD+wnijyAExhLDlua8kn9EYd+bebrf3xIHko7j9XtRaZktkHBAsV/XAb6seeVvaaVJXm0RXSdSIw0MMaGxwkIUNfQr4lrzCpuCz2RhqcsFBuGjeFthkA54DNopDgGMuJ67VIz5FyT1pKB+tUpgKX0E8SBy9RYpBRZ8GTuN3VzYzYlWrXZ4a0in28Rs81DNP6Vk+CcXC//EMuGfSCD8E0uD37womHhIQ7NK4nIXyVc335XxwlrP3LifYvimy3gVb7uYsEg259Z4GNjoh2My309omYJKWnG1wIAnfvoaEhdnVlTMWL4yvokATsWkNaKYMR6aU4OAWEt01EBaZ6GmvEi6Tnlwo0Fps0ps803SNT4vBkxrvqJwCUAS13NwpKV2sjd8xaaC5AlcXq2VFZkF54mElOlUuOvKnfRxj5mQz7rH9orwZ2OCTrHHfHvbdhSF+CSyBjqebQkVp1NomylaBfI9VuLs/XL5jdH7U1nt+7U9NDPZ0sTfXDOTbRrhiaeSnBGOolMeoWkgy3hRCChPMde8DPnARpay4HqgyXCHPOvNOhXdKZPqPEroaWZ7wl+EaZ2rqXDjG2ZVG7P1rRndd4LrItwAfdnn6LgdF1f35v5yvFxwqJhFq951yxsCpGNx+cKenVfUcrWFcOrNOdD95uDw2d7B072jJ1cHL04Onp48OR6/ePrkn52mjgsteL5Z+YY7YegKBmbnZ7dvEMGwxcNHwAyK+Dj73kEbJCcHbZsTwCSdCDC3rfD9CPN+kDWERnXchI3HQLOXvMLEhqmIVaZPwpBJGRrG2VSrpQFrnE+XIiD87bgUU1bzeSgJV0AMYtWv0XCfZWj8gu5UiWap9EdZzT9su7Gd2xOaKylHQ7wwiLUdaNud7WLmawzWITn7ffLVjXJ2bG0roCByqA0/45kspHUCcy2vFWwr16qpcicnS5El7bahO6onNzBawgOm29aUUlSM2wtZsZJXK6cYZRCuwzgEePiuylcpCDQ0JhmCXRWtOuWIOk86YvXyKXTYdlP4IoaKnMUgU5taVXlkLZSSVrEJYXE8CSs5dapHpoUNRliHoejWE2aU5PRNBRYyB39liLXRI4rBHkUi8NGpI5YVEnqY+0d5lYeAxTQoHEpEgc2uroXbgaJg5xde1LcqQi/ryQj1HQ4qSEVIo9IsGAF8fsGslteSF8VqxCrFSm4tJJ2JcHdKC5NxLfIRm65CIF061QkfT8fZOJ/cxfS3SUvBYYfqaRESes8vDO6x8pWtfGuCxAvRicm73Cwij54byNUj4qHiNiFALFNVRdGDsSI+hThBZ/McY8eMU6PNKHke8q7YVIb4ZqcCYnh5pnSe1OxXml29vAh9eYFtBzARtkzI6yhNUWovu/zHWwqtfmR80ySvK7+8SGAZwyRYTSwExHdnogrpmF7cwoffvnZeSmU4DQ5cwXeL5ZltfCAFRtcKXbKdMN4ONqeYBVUvhaLqAG58/Un4mVR/H+/Rz3L0rIRKiWfI2ExninQdxJAuWxNw6CUNq6ARY3geViv6ramyaFvAk05vDw0WURsrGcUh3enFbdzDIBqfdE9PvsTh9/0S2o0B0RTCc8flS15ZmfmEF8qUFJ+wJy7xs2ilWIiinjWFe+xauuXKP0TicqhYJjQYZ2KyoudVOswx40XheZWk5tYZt2Ku9AqZFSWpGiuLgokKGtrDY2vSzRzCZtJpNTRs0iOiWN3FYIKcfFsCGTrwsNU9bky4OjDR2TOYcirnjWpMsUJqhneCsAUthk3Q58BdyB0bHzHuy85h5S0o8KocnYwZ+0fELJX4TQss4anSfBlTg5DuJ2P6gvLW24Jk5W6GmFScNxgiiraeibt/oIIXFfObjFgu3JUFaeS+9UFs1g/3jDQdKZCb8cbe43WCIHmCcBx3YaoApVskb6yqVKka450igPf4dQDQ25spKen08u1jKvBVJG3pDBM8W8TEM0TlOWTTiX4E5uHTw2ffd9fcclF9ba9UC7wflZoXgr1+3Q4Nu+9c279Bki00solpyuQBV1StQg4FsB52ejcOVY68nwpqCA2O3zY8PIQXP4QX3x2kh/DizXH2EF78EF68/fDiz4zu3e2H9/rg3khZaBboxM6w84vrY/fF+cX1sygQdmSgrxYVPBSSXHE7/gJFfffKqX6kDIFNPxXesSDA29OroBNT1zlJ0lI8s4rVWl5zK9jZm3+miZXtswIaVqF4zqa84FUGpzXJxlKaadW4QzzutgK1434C6pfbqFMEQNLot4uCL0vevqCs7c+R4TrOlNvzgO/mSCG0ryPxh4rjDxXHHyqOP1Qcf6g4/k1VHKdqZvBcYrf3X90SX+1roXWtwDb9TemBDptO0ifgltywTBWFyMD9Td8Ox1DPZJVTXUlPnVAKBskyVEr1c7snfZji5kZKUS9EKTQvtljh65WfI2VPitQbD/4jOQNhVnySxprH3fKOMk+apIE92TCeaWUM0wLCCahg3oQGhNOXK2g5avuKzQt+PHt6cDBri+vbOE67fdbsSxI3VYXuG4SYnc9a1ISpHrWWJuE5aoa+TWikinpja8nRfBr870Aw7hqD3qt9xNIrXcPjKgWGyheV/KMwTFpWK2PkFJ3wgT7DyECnSUkHPBiV6FFt20PoDkzNtZWZ07AB3jCkKKW1VEu2W273rbJk05foyqwEWmMN1eVIK3i1wMC2uS20x9yXxHtASQyKPAzQAo1YuuPw8NFhnwq/9Oktf/JcPBXTmTjg4ll2/P3zo3wqvp8dHD4/5ofPnjyfTl8cHT+f3Vaz6f4bvnlii8lFxJ0G8otSC0ZKpeFkwl0JfuBQ7qpQSwO2jKUKLY9NSs2BTAMj0/FoeLHF/R4aHaG1pWpFj8hWySzqIBcOBuxU2qiwwOqvBJ6jzlw6eX/auJX7Epy42boBV3C4D91mm2G6R8+l99TRYkkjo6V0IumorA3UlFEz9iqteNw6f4B6LIbihQhUgBrjKDO1SKCU/zfBrekPIaGJei5mvCksFEmsQ2hIwJejLfLQhDHlzJ1VP0bo1jdQxDpdw15ahSOJKbNbMYRST0gYv0On/5r8vTudLnjRh3tQpR2U3gekgBZ3DXwtEWf8SoYaaJ7PcJBYJQVOXRu6NjGOOtQRBg0lmCatjR+qbp7+3tqO7WXe7f6nz5hpb0jwM7cksv6uRB4G5Z/UR8bdqcFsNmGZqopVVyK7jlPyQH79Wqvjo3Fa6gnd0S3hNH5zg2yKT90enOD93QAVWmb22xdpe6QkCuGW+IPU+kRBCN+kl5z8/Q9e8gcv+YOX/AYvOZ4T2qa04mUPh1/NVY4gPbjKH1zl9wPSg6t8c5w9uMofXOV/Klc5Fm7+s7nKCWq2TVc5Xe23uIh5QX7VeGpV8B4PuomTiGlmNQcFqJp/827ztegYfyE+vkG3+eZC3Vf0nQ/Q/IPv/MF3/uA7f/CdP/jOvynfudU88xydzJNXyVfr7ZNniV+FBhn2IvKKF6s/BKuFhi2twEqrVTNfqMbvKG/1SGOQsmlFZhunUBSOHEDMgy4+seFTlqmyLqRZiBxcQwngDF5r94M2bC9enD7ZbSmmoREzmelmWlV2T1R5x+6+55aD7QQNK3ke1hHpYsqzj+mbd2hx6qAX22OG693VOHHiRMNvEFwT10bOVmhSl+TpkTcNay0wq+bCLoSG1MAwZLxdkXV4hC94lRe4eWEaEMD2SPJMnHZ9zex4Ovv+aPbk6fPn0yfHOX/Gn2Ti+6Pv8wNxII6fP3nWRW/ILPzXIDlM30G1/96nZS7kfOGQE/RtbCkguGk0iZ+QSxpc7aYJ6XeMSi4Rft3x85GMPfQdHMwOnj3n/GDKvz84mj5PuEKji5Qj/Pz+9S3c4Of3r71/odbqWuaCmaYGeRwrE7kpLTApCATghXuF2hrQkyE1eSHYVAuOae5qWTmSUMxkC+FEDhTCRlBIh95XzMu7mxy07QqhZ+Q0ICasi1Foq7izXC5979txpnba7mKosJBxcGAAPku+woRWSrh0GjH2YAC8ooRbrGJBM95eGqOqDOCKhs6VRowoEzp2/QLrzFyF/rPkXSAHRY9o2kto4XWm+bzcXpPyXadhJB6/RheMzyzVUJ38ZZIg2qp6p+OEnfxl4rvIUtNc4vUIdEeS2GI9wPMZCtCO/sFVJUu3n1RCAZJgGyPibq0SnxDW2QzrkhWbNLoAuX8yYssFsN60DZ00kBdeGasb4KaOejDL1992bYdYqroNNNZvb//J8fGTfXT7/vvv/9ZyA//Fqk26ON8n58WuxLBGauQMJGJC7Yiw2r4pKYk0qga6uIzSor15OJ3QvcZv5ghLIXCTbg/PoJpIoeZk4HSvSkN1335rjI1p176Hj2Nsa7sgh1ob4bUwLAfRa8lNAHTUYryD8XKftbFutDU/dwwexiQ7ed97fkHDd8S8Tq0nbrelNl1A5+XW3AkPIgTtjG8xu9xD/afE9NKD4/j4Sb/o0fGTFlBQp2NbB9MxX5iAiDgY8wFe/AXXNriGVLDZ6RBbj8f/O/B48QnaOCVNONNZIBwTb9jQEb1S7l04oYkXH2tuJ7D7SE6sx81hvmljw1OjZDJcLEa9hhFDL+yythEeAB2fnNDbnWihVjgcmwq7FCJe8yBfLhUKD52LDKWmbe3tJYy+/gwAd9np8FmsYzQ5GbyPEd41fKqnVm/Z1pWGRSbMJYWgJSab20vFXHlrZDeuZ7gMMzyK95K7yQtxzcNlTRJbO9bnh6SMKb9G54gA12hqvnDfSGHoKHizD7Y/tguOyrbMfcisF+lDxSS6KeGYmUTTLu8QIPT/W1vwv9IM/CeyAP8JjL//arvvg8n3VpPvN2ft/VYNve6pD3zudb3kymLx2w0uLhzDX18xd0eVwhe99pUdw5VJwF05ZZYqXi/UkjW1I6ilmIboXQheTtqgwPpqrp0Y1ARQveB0h7tGhED5r3CSabbulsiLhQ/P/AolflOAIup6QF3yGdfya2rqP1e0odftCO5IXAMReX/IouD7T8cH7BGi8b+zlxc/E0rZu0t2ePThEE3TvnT/Y3Za14X4RUz/Lu3+s4On48Px4dPATh79/aerN69H+M6PIvuoHjOKKd8/PBofsDdqKguxf/j01eHxC8LT/rODbueih15og1A/9EJ76IX2ZRD/l+2Ftl1Q/7PPdddcDY4LfrfnJjlhUwGdoXmVLZTGj3uZKksAk2SJv+Ezrdn+Bwz60ttZ8BV4PaSjeOUBhMuCqlTCAkG3GcwtAXg7PT6HUNKCpdu4k1bdGtlBNrayFH/ETAocmBcymHZrbhcnpHh3Hi7lXHOcz+pGtEfHtbSGVdPfROaFXPzw4daV/I9wiwXMwj76puiATsrYaUMgtA5u2a7gtHaSV+6lTmcVqICa59JiBVonu0MOEeU7wjyhFnW6h2w4W2/dDt4AVgQtSYdrbWSPOvqbGBJ+N9k/GHSQ7PoDD9LojaNDChIEF4x9jummpH0lMc9WQlVsfNepRnR6s0I1eTyoL91Hb9SBTEFOpQwGMP2GfkV5PGu9ahwJUOzFAjKwPsADH/yQvii50ulRbq0aXhjXWjnSj+aAwIXol71PN9NoKu7SK44eKd0GVozUODC5LPlcDEzNS7nHp1l+ePRkkJXG2c/dCOz8LNgYEE9+K4g2/8JOHZlg0jwknwd2EMKSheXjgBJA8i10NvjwjXSWzOEBjEUibp4mLCg8f+eZNjg6nbk2PT/JbJRS/iFhMDdPRi+Mkxc2nYsuMFlIu/qwwbVx81ubzko0vunG9c7XpvNgLsFGc7QeHRzf86NcZR+BVokhnfnPA8cLf4PU725CL/3mzrVZKG0/4P13wma8MCIRV3C+vcCM1ogVASw2eDuuu8XoRkxDD4eRlSBs+JVBpK2ZynGcu88GnC45UHectfPmZpN+/nQFn4rCOMZ59e7snZPglswqVvLaMVkj/r0HS0ucYjeLVOxm0QJ5OoIw9pTr7vNItz/hp4FBzp08lFArXQtQlsTzmoRA3feD5En3xquXl2n6tgz52CIz41VZjOk5LDjENSVbqWovvtkxLSPoN1P6+q1p2X/9EFOlCsGrDdE7ixgBb0zc9v68yoynjSz6U/Z3NNzeO4cvzg4Pvt/ZDJx3lwxmaHcRHgIkU7kYPAc3wWKsFjZbbA6MnwUdLNUqUODHZgr5KZAMR3T49/S7gXHj70HYa0tucVCWUuHNXDW+dCtnbQF9M811MV6rfJjt3OkwJxioFfZe6m+um6oZ4OGfO9OFytnP52f9iSA/sebZ/S0qjtifTOU9lv+Fk3ljXX8yYpd//WLGnPz8oeR1Las5Pbvz1w1PUQIxXSQlr/sgg5eJ2lB8a3AnsA0DrwUUiTDC3u8Wx3HXbHQu6kKtIHLyXieO466ZGGoAzZri3pecDLxm6lvkoM+dOAx767TDQt+Xz4vj0gUTO/D2+u8OjOvbx4V7JSi1Q/dA2t33LpeA+LSp2Om7oPUaurIB0ZNW/Jsq1EfJ93hjVS5Npq5T5eT/xl/ZGf2yYulzLNG8b7WeDAyV3sIERxhynfmTnhujialtLr6D7dBbgqn+ipoFABJ78PCc8iY79DorIs8W5L9dgFk8eNXbDdGE9P2kHBJyljcQGwi1ILHZZzDegiCsdIkFC4L1EyIIaq55KaC4n2ZTAfZKt2/CYo1OCH2CL9xHjNyTOYBmxDVUtay5tgaj1c4vRt60RK0+RxA1AX6rFki8yrGVIdgkh1BI+Ri1VnmT2bsj8oqqg+DZpWGcmBjWdtO0n00urWl3TXBxPEpmfnzL1FXesT5vPDO+mxZHweUntGBCdcFuLRkPh09rufPsP79/zRZO+YS6YTAdUStAchPSs0Z3vDZtNWnNrL+EWH6/PixohiROKiVv7EJUVmLpCh/jHay+Hf/MS/95Aw/NHZ0z36U+DmK5Xe7emvUHWQjGLWUQkm46xOuMsI28CX+xdarj6AM4vRQWC4lA+y8BJ5yKIrqhJ2wqrZtmzN5RKVJoaLeUputTMMLOtwfL/E6wYJDG3amZsdOk7SV6JUMUKjDJmLwpPlGVBJws6VQIk2JeQiHG7aDJkOWAJJurZVUo7sOxx+xdVaySYSj5C0uylDx7dzli15KjWvrm7NyK8peF0OIHrUoTSWacDOFxJWce0jSUjIKMe0GKEN31oS1xBLGaL8P4w0LWWvSG5FQwv1DyK9n6QyRvgyFycIbncy3mJONjUGKqTuB6kmapkRgLWTWf1opSbf/QQrDLV6/dCxTFZkNxUNjCQfmrF+e8ITrcbGpZxeRHVIrGXSSrQtx5WBhp14TVuEG6A3ey4D536Fj+L97liaYrrkPkybo5Ynf0gzvMCyOPvxsMNL+Jp/6MEZqJ+Yp1bXlB521yud781xr11D3qoz87Y68jmMSGsgHykxlig+6BCLm7DlbFirxBj5jNBARyb7b2V/7xra6/M8uX46AzYBpW1Yps7ozZ/m7NiBg8PYCJ9crb3S1q3ekiStYg5TPHHaAQxw0xhmczEvkhPL9VGulO8+VE0h3xHqgkGfKrkElvvvuik97AA4Ri+HVHrl9LI5fu0a2SRzLDl1NGMtg9EAWO9lXoIZ3qvkghHROx8f8FAAD//6Zi/yA="
+	return "eJzsvftzHDeSJ/67/wp8NRHflmabxYcelnkxEdcjyjZjRYkj0uedWW+o0VXoboyqCmUARap9cf/7xQdIoFBdzZfM1si3jN0Yi9VViUQikchM5ONP7OfJ+7fHb3/4/9iRYrWyTBTSMruUhs1lKVghtchtuRozadklN2whaqG5FQWbrZhdCvb61RlrtPqnyO34mz+xGTeiYKp2zy+ENlLVbD/bz/ayb/7ETkvBjWAX0kjLltY25nB3dyHtsp1luap2RcmNlfmuyA2zipl2sRDGsnzJ64VwjwB2LkVZmOybb3bYR7E6ZCI33zBmpS3FIcb9hrFCmFzLxkpVu0fse/qG0deH3zC2w2peiUM2+p9WVsJYXjWjbxhjrBQXojxkudLC/a3Fr63UojhkVrf+kV014pAV3Po/e+ONjrgVu4DJLpeidmQSF6K2TGm5kDXIl33jvmPsHLSWxr1UxO/EJ6t5DjLPtao6CGNmV43MeVmumBaNFkbUVtYLNxBB7IbbuGBGtToXcfzjeYKf/40tuWG1CtiWLJJn7FnjgpetYNIkyDSqaUtMjMDSYHOpjXXfJ6MALS1yIS86rBrZiFLWHV7vieZ+vdhcacbL0kMwmV8n8YlXDRZ9dLC3/2Jn7/nOwdPzvZeHe88Pnz7LXj5/+o9Rsswln4nSbFxgv5pqBi52L/h/fvDPP4rVpdLFhoV+1RqrKnDhrqdJw6U2cQ6veM1mgrXYElYxXhSsEpYzWc+VrjiAgKdpTuxsqdqycNswV7Xlsma1MFg6j45jX8CdlCVz4xnGtWDGKhCKm4BpROB1INC0UPlHoaeM1wWbfnxppkSONUrSd7xpSpk7BA/ZXKmdGdf0k6gvDrHhizbHzwl9K2EMX4hrCGzFJ7uBit8rzUq1IDo4RiFYtPhEDb9J8Cb9PGaqsbKSv0W2A5tcSHGJLSFrxh1cPBA6EgXDGavb3LYgW6kWhl1Ku1StZbzuuL6Hw5gpuxSapAfL/crmqs65FXXC+FaBVyvG2bKteL2jBS/4rBTMtFXF9YqpZMNFnI7nrGpLK5syzt0w8Ukaiy0nVt2A1UzWomCytoqpOr69viN+FGWp2M9Kl0WyRJYvrtsAKaPLRa20+MBn6kIcsv29g2fDlXsjjcV86DsTOd3yBRM8X4ZZ9lAb/eejjn8ejdkjUV8cPPqvdKvyhag9p5BUn8QHC63a5pAdbOCj86XwX8ZVol1EspUzPsMi40+j5vYSmwfy0+J8m9NS8HoFmnPLclWWIrdmzAph/T+UZmpmhL4QJrCrApstFVZKaWb5R2FYJbhptaiwrwlsfG19cxom67xsC8H+KjjEgJurYRVfMV4axXRb40ClcbXJ3IHmJpr9maZKIM0SMnImOnHsOBv4c1mawHvuW8CtsU8ghJbC4ZbML+z3y6XQqfBe8qYR4EBMdinSqToFAQSoiRvnStlaWax5mOwhO/bD5VAE1NxPGlsGW9WMO/wysAIjRWQmOLGR37+T0xOnkkizYUK04rxpdjEVmYuMdbyRCt9CibA+Tuo6PYPJOQ52jrFxvDK71KpdLNmvrWhBMLMyVlSGlfKjYP/O5x/5mL0XhTSOAxqtcmGMrBcEObxu2nzJuGFv1MJYbpZ4eXJ6ws7ATppI5jeiY3L3d6etdLtDNEtRCc3LDzJIHdrP4pMVddHJosGuvnJfr++l12EMJgtskbkU2rOPNETIx3LuJJATU+ZJ5Oug0+Ak05XTDoICx3OtDA5/Y7nGfpq1lk0duEwWU7ceOP+IGInQeMmfzZ/v7c17hFiffhRnv2vqP9Xy11Z8zryJyQ8di3rGdvS6dOf6TDDHxrK4cnpFb3r4321MkLQWgO9JhMEKGsbd2U7i0B9BC3kBnVbhrPQr59+mE2opymbelthE2NQ0wwjYXir2PW1oJmtjeZ2TGrMmjwwGdkIJTELHKeuOU9FwzUkFoekbVgtRQDbV7HIp8+VwqLizc1VhMKjXybyP51B8g+RxU/UiKTxScytqVoq5ZaJq7Gq4lHOleqsITtzGKp6vmmuWj565AZixfGUYLy/xn0hbqIJmGVjTzTVo4w6eO82D0GWQ20FmR6p273oWpyFmonvFHWFy3lv4CHPAAL3Fr3i+hEkwJHEKJ9CZjM0tkPp/kRnbJ/YaTi+yvWxvR+cHqRpjejpMa1WtKtUaduaOhBv0mUnNePeJP0XY48nZE/AhD9oJIZaruhbOYDyurdC1sOxUK6tyVRKmj49PnzCtWmcuNlrM5SdhWFsXwh/kULK1KrG+kG5Ks0ppwWphL5X+yFQDu19pKDwEcSaWvJzjA85w3pWC8aKStTQWO/MiKFc46ApVwZ5xgoTMVj+JqlL1mOWl4LpcEeBCzJ2SG7FVpcxXkDlAVNIEs1sfmHVbzYTuc8bGo7JU9WITB9CR4OHADlVQ+4uA0WCZSN+Ijwlm0AUIISzm2yesdcDLVXfiGK88R9KDbiIu7ID19p/vv/iuN2GlF7yWvznxmA2PkXtTE94l47ihB7j9oNSiFOzNm1fJvshLuabfvyrlLRT8CX2JDRB4BCqnYwppJfjTs2MgHW0LoDdXgQNIcddiwXUB/jLQ11Rtxsn7XpmbSe8Bk6rmJZuX6pJpkcPWidIWZ/35q1OC6k+LDs0BbniA1xPM3KYwoo5qPN45+/tb1vD8o7CPzZPMaRTeAm1oWw+G8p4eqFu9QQmm0s6NJeAsCBpyoJLVvDbczTJjZ6oSxKfOoHNvWqEr9ohMY6v0o4CpYlrMhe6hUq9N0PjtQD+Tbeb5aCaibeJsswB2GVBgQKtehGXuhkjxd6TP2KveADhRWtNC/ySonVEka6D3z7Z2+HkbCaZCNPA3AevoWys7AAllx6/XjttlxA+RTQjebhgneu/c5vHqExxERlS8tjIHgvCXgMS8ZuKT16HHXrEhoNJEfcsquFVbXsrfRHAmwtPEcqGdEWykbTktx/GcrVSr4xhzXpJnjLEgpSHhFkqvxng1KArGSjjhatM6o5BHlyGUiUIYC/YASUGwuSzLKGR402jVaMmtKFd3MHZ4UWhhzJYE2Mhxu1uqwFs0IOkkUcxUM7loVWvKledm9w2BZOwSZDGqEnB1wjI0zpd0fDpmPJx98GBC2H9iBs44mzH2946ypDoZ22kszK2j5pcBp8D304weTD1/RiaD6SVqGMYEFfur9b4874OcZrKZQrJNM4/WFN6NRtQFqd6OvWDXRZDOzM5G/VUx2X+7Q5Wb7Cs9VzscZysrzA0qcLIe3hPS/6yHyF8Bz3tB4kUE7RNaJi/OhuR7+ayHmGe2GzD7HFKRXPXws96YC6GyXNrVh+FK3c/Q0q42r84JdGnByyE6Ctc1orbbwultYtTHwQb4vVXaLtmkElrmfAOSbW316oM06kOuim2g+coPwY7P3jEMMcDw1eRKtLa1moTSxgV9xWteDClVqjx1QVyFzkKoD42Std007htVL6SF/xdnaMmt+2OAweh/s0elqh8dsp1vn2Yv9p+9fLo3Zo9Kbh8dsmfPs+d7z7/bf8n+T19OA8ktyqnRT0bonXBGJj95LTyQZ8zIV+AIhN8WmtdtybW0QTlj4Z5DC++mTw61V+Esi54Yz+FSe3dOLmAakUI8L5XSdBjAre9dd0HdDFKOEXola5Yrg0vMeBOQh23d6fiMvVU2ue2EZwSHMc6oyh1aC6HCbLPR+trNlLGq3inywdposZCq3uZOe+9GuG6j7fzt1VV4bWmrEU4bd9rfWjETfULJ5gYcZLNplNHxaVScgkR0h0XKWd5pGRwe4Qru+PTiGZSk49OLFwGGCLfOAa2K5zfg9Tm0OZm8ugrrdPAajuTmFtv6Ctqca14bb7kcn2Ig0uN9/MbbyXk0itljkS0y8rrwkrAhoO6+MzhkelcAca8kdiCzmjs3Xb1gpeIFm/ES7j9txmwutbiEGeLsbnh+hF6nOCbdKG1vMe0NSo6xuruUuZIagP9HoYe3N02fHNfpe71Zn/qvP0u7O+jjMViT2yidV6/HKa3BVczfGqHJfOkPu5EVPmcXjlI9yruAlPaOFQwOzw5nlXCWi5on6/x9d+cxhgX45mhyigWc5M4hehRBkVGICa2tKgbIRMVluaXJ4dBmboAgaTaQd96W5QYl9V6RGBmGYdy83VHNL7gscb0z4LhJORPaste4MRCyHuLrvAjZ1i5EyViN1qSGWHH84AYO5qQ3RXebkluw+Qa6ute3qZOlnOsHGyKx5Ga5peFHRClMFoFkS5wQudJa4LTp3b6Dgpz2U814repVGsvjJUWyt34ygm4Wp/jI3RjDk+H+AEWnMeIjV/XcrxUve2NCx8553XnwWIjQ2rQLt3LB/G5N2WjXWSse/G5iQ6yGzHMveJ0tIXYBHOiVaiHrISLJluRuS/bc+qot+l798OBqp74PzGSePaLzJy9V60JMZD3XPEZrdXEo3jvnL3EJMRxh2TVxJ3N2IqyWOW4gIcCT+2aOeNUDHwIDDpkLmy+FcdZFAp1JayjUp0MSHB34zgxDjSQiefw9Zh8FgqvbmmKItKiUjbeeTLXWyEIk5FjHzOPEGQW5hAkRYPIVuk/JMuoH07lfEkB22Q0ezn6ZI86zQ5UIdhf/bZ7DsN6eZB6ddwTyY4FvUk8dQlFCZBrtshUr5HwudKq54QcLPyEMO28L7FhR89oyUV9Ireqqbzx0vDX5+SwOLotx8M69chR+9/4Hdlw4tdbf4Aw2fDZa31svXrz49ttvX758+d13a05If0LKEn6t3zo37X1TdZKMwzAOzF3vG3b2NHZBsokGwqE1O4Ibu7O/ZsrRhf/22OGYRmDHR0F6OVyJsweIyp39g6fPnr/49uV3e3yWF2K+txnjLR7ZEec0JGeIdUApPBxGltwbRidBDqyaaxBKyGgPskoUsq16mDZaXchC6C1hmao6XgKEAbMQi5XGSfNLM2b8t1aLMVvkzZhAMuzMQi6k5aXKBa8Hk+OXpjct7x3Z0qTIOfKZ2y09jr2gF7p3JPceXnPXHl/s36fSTecgjD2JrG1ELucy+EYiFv66kK7EybpW8xRIFK3nS2HouPIXnIkC6c4r76WIoA2dhPUKZxSu4O5wQMliC7oUKcHd5GXR38Oy4outypR0b7jB4pWARwixurNWlhbH+QbULF9sCbOOswgvvugjkCRqXD96krBxTcrG2vDHblDKfuiNu8XV6ObcOT3DsMSyWxr5vYfOKl7zBbQ3d3xHPhhIkgJ30zoRI8mtfipIjtYeXyNKklevj/5wLJpGEbhbBO/l2u0nTGyAmQR83BTq4aUPhXp8jbEIKRFuF5BAECm66d4CEiJYF5jwEJDwEJDw9QUkpJvFql6S478qKiEVTw+hCQ+hCQ+hCQ+hCQ+hCQ+hCVeHJiSH2B8tPqGH+paCFGSD0ZKRbrqZF0GiuSv5RssLXD8dnfzjyaZLebdrnG3wVcUluIvwxF9CM4UnyHa0sQp5W28n5+xI4CIgu/8ZbiPS4A5q25cLN7iSlx9iDh5iDh5iDh5iDh5iDr6qmIOi7uXYHr09u8kb+X3PAwmP6NHbM9R20Lj7haHDa3MpkjI++J2CDsiLJaRdpjlcXQJsgLVijZbYrYothPUpbB4sAX08LWqTOcq596dPqKLGKrjKUuiQjDEHzDMUcZ2Nrj8HpnOoGnYpyhL/RU0QIirh4O9iLoUW4casINkijSPFaoil/3T65C7+0t6Mr9v1n+XJHyFDWmu+CsTwVKbv3YRcyo/HnBlKt9TCtrpOtvxs1Yt1jM/PXUCErCESDWW7RS9mWBu/BMiLd6P2nbSzFWq1BC5GUSuXO+phLfmF8DnWqbCouun4H8Pg8IRyC3gEft0GxDKD/Zzd6RVGX8IAuVVrjna8R6uTsYllyNqu2mpMDyPcMKmqNV1RKoiJKUaZgjIum3AwDWm6g3XMKh4UfAaGrFAJBXd/NpR044Y1yhjp3gZ78wL7cAVTQ4bsW8dhQVW8AlFuWO7LW/S8+2scmeUl35ofH2zj4EOkxgUh4sGUAccgdEKQVu8zigey7vjtRtSTmKT7xtyF0gA+PZ5hQ4HYAdX1zSG4DwgKngz/KbIJTdBOgI0XWIEkKUDKps5G65Pf38vC/2+kwhaVGU+FTlUGxyVX8Wuos8bn16a78RjXVvkSANScvXo7OXkNk20mQCx8X16IYpwKp9HIsCkGmyYiphPtDAmZlJYLtcY0CiR25ly3GRwQLN80Y8dRVqGwkZFVU64GMEOls6nLCw9XCFOcawJW93BZLi8vs4Xz9KNe48aVsfY2NsRVpiJojysrH0R/4TQpSG43X0eAjYsAqTlDUal8GQeCljV3cimV24U0OdeFKDL2D6FViA+pBKe85xjBl9Bv1hHNDzHYrPsvN/PpFmN0zsPuUvPPFTGONXt4LwUvhP4wL0OluPvHezRxZ7aaswNWCmuFdlLSj8zcyMleev2p8XVNaKG4hmU2GbPzV2P2/mjM3k/GbHI0Zq+Oxuzo3YBl6c8d9v6o+2ffg781Aw4rhKl570lqyHFj5II0BDBco9VCc/hFuO1qrBJMlxrn1TJ/5ZgAcnf5jexuKb1wMENr9sXB/v5+b96q2eDZvffJ+8IxUG0wGKlRPkYIJV2Xgn2UdYGDwc2QFCqCyGJ9Q3beqzxqhA2066pSAAgnMO7I8ZRxtRJTmFfS6G8/vX7/9x6NomT8YhqDmtNuDQcG5iPFjfpBT4ZvCVF3NGK4ddTo5VhO1r2zVryzVvVOo2VtoROipq+rcKsNezwTKKzy9AAWkMOA7R+8eNLF59mlMr0vOnEejSRfAFWYnDfYVtwItr/nTpEFDJ7HvxwdHT0JNGTsrzz/yEzJzZKMvl9bZUUKmUBl7JzPUBmGay0ROeTNB0QSIl1XJnEJcyGKFEKu6guhyUP7ix2zX7T/6pcaBxjkmrzoqmHc7piNy4wgRGOFFsWH7bolseZLuViiEnM3KGlIY+dXbUBzUu1MOws33ps9lNizAzjOWns0VyqZ9yMoTY+SvxOIiTSgwnOo0akrVzeq0SKXBsEWTkPivh6HK9eIwZt2VsqcmXY+l58iRPfOYxSlPtzd9a/4NxBk8SRj53qF3YhyLChl8knids0fs7NV0LAs/9g5mcG4gqGktat+5kPOfGQOlDJXhsLZ6Jj7+ZujrkTko1xl7cdHQ8a4iSm+kLpBWtf18mkymfTP2aD5fvg9d0KTgcFfluz4FJcLqHxSs2lQvaDETXssI+KP0+A4IN6R87nM29LZo60RYzYTOUfxI2LqC66lQCWzeZoTEi4XDCxZsCGhhehjV7+7wy94vUWHKFxIAgM6T1BCnGkEX7lqstJG4xivy7oQn4BVBVZJQXvp4j9yvwtuoG1YFSF2NYLwKpZuhUkMOI3+3BkYYv1nfYUinKtfQq0IY22+On777vX79+/e97Db4t4YpZsjugtZzhtXY3pMhMbx5pgz4cpQiokU9fR72NDlyrlwDF5KHZW9qkzutVyLUI0e/1/UXYXiucdt3eN4Wyw6BGj3BOdiD4m18WGwuvFhINP8HytHL3cfyQ0zCs5x02m3kPfYxk8yNoEPiAy/CJOo2t/7V7s9g3dQzaM5NhCo0Y0UuETkPYfy61c3OZRPhOU7qesrBMCTbyu7tbv0pgqWG9oQ/C6mTVs0uHMs0heTQbuFjE1FbjJ6aYr14RENgklz8aIHbkJXFxeSOKnX33Haz4gHdWvmFtAXBI73ErIuJNyWOzvkciF3KBACPU0pF0tbbkrfSmbjvqcmFkCtxOWzUwW1WyLDePFPoEo2k8mXouLh6wiRZD9NYcA66Gmxl3KO1kr3eCc+uOY6opfrgDOkc/sLfO9sAnhCYSXFHfuTca6cCrI7vEdOZZTnBvFK4ZMFQeYgCDSWBVVcTVfXO0wLryDBUZTzsMWgGXvo2ejWXDyU/fdyU/QaaDhhv+6c9Ahea9HfCwZ0ybjhOnYDBmS23oBGbIiwcbLB9E15LFadCzwWH1zPY7S+m3J5QhHAzek8pQrqrMMI2as9XoksOUEB97XKnLzuZEqQ2UFSuxoblbBLPEx4t9Mk33Q9Idz9Q6iTb4OPkNvomMVTAIowQgKYG6ibBMELoHgo144aydqGdGBK8u3qiJIHxyu8MYyFYIbbFggonkaQuICajcVIZ8JeQg3kod4Gp/MuqbzvB6M6nrCvNDKscWvMJmElbiY3zmGSQowq+ra+7lfpIPoqjwgS6nUtcOJ8M6GT1whsV/e/R/WUWzqSV6JCLAqEHEYL4IqE8ARWaXbRlii66fJQpTBrLxtk8ovCfXQHCQXFXNVbkBBODfTQo+4XvFT99BUyYEmSkR8s3YB0zUaVTY9d4pxbvU67WPKaTf0LoVbnNBskJ7u9PnXCYYcXxXTMpsTyO47lhXuEHgs7XoMrpt7JGFxtEWKs5h84jmYGy9dxw6ZEZgQP7DTcGBBzx9cw7S1GQH0by/GatHA/wjrxaZMY59ygoq2bZSDejJr02qpEmG51nOt2bXE8Q0zHYU2NqA35QbuwXR7RjHh1kIN25CGZjP3MNWxfZDuzeQs+61QfNceN6phdCtaUuNRQ4Y6fdXZrSZ1beJ6LxjvkyL8ewwCo7U3jW3bBFnbOlJy3myOJ3Uq7LLNONFytE9yf6XVM53GeOJnjJKhpVq9TRcIHScZVuDDHRIMQLbAxk5oBdCCjanqSfjWmStJllxrGQFt6m5W8XrT4h9IM04NU9von6GSYQjlgiFlYPYGe8U414TAwz8+yLtSl8ec+Oz4arsOzF89e9onvt3Wf/oMNFluZrdOXJIwHMih0sbnPGQ4E1/qLIMJ2QVjtKjaNcEfjbAUDUg+bf9EOdSwIyVdInKk5BY927dpiseLkUbenCNcIMx5nG7qr0SUCGSwJIsc1q5D43pVPHlPAB3x6cVjy683EBhPFy9PwZx7IzIL3KdSmyHmZty5iDZgWonSXml5RSK1zJ2Q4xRRRX7gIs3duXy7Dp6EvEtqxkfzHzcha846ASaVq2ZUOZwkI3DGrbsXwZ6gSYRX7KETD2sYX1HYfpZurT1WYIaDkOh1xXvkdl/NynK4sORoIz2zU43K45YywN3D574/L9cOkU5nHVQgL5LzH7mbBHQruMFBJNQUoyopoE6LWIYkT+VGqxdibXlCrn4zTwbEjwkp5dWBF6pmi0i1BgFWig7je6cRi7eDIrSrnmXNtVuCXDva9Aw8VoTc2BHoXeFCpok26u+DHMZurslSXuIXBuVYoXy6nHoAZyi7e4HY9S2gRl7ftNXu5Q9z32peyblr7IfxY81pRdAH9rlqbvsDNiSxLufEdf83gpOT+RsY5oqF7egNkVjJsn5PcG5lTzJwG7v8WMA60YB9rdUmuGndaB9cbpR9tkDBBfLjRAQU9Xxz0pEpCoLGoiz55Nx7SVx0UHaqDM2L9ePD8pnT3HJrNRZpxhRPE3ZxQO7Ii66G6xWDiHxE//LgReskbg83nm3XNZb0Q2t1fPsF6oiy7P5+QfoGatSWc+QQRMCtVu0YozoYm95O0q2yd6bv6M5v+Nfnrq6Mv5ts4PsKmD1ZJt2LZrfpVwUPVx+3eFmV0nsQJJGj19QXvqB7q8Jeka68XHElYMvBsd+kcWkKSzZ84da8xCdbMLvd02sGcGsutmI7ZlJdcV9OvU5N3SPZWtifmt3a2+lGSUMLr2nQ57YL0FLzhFRzTNsgOomYhqoZ1gyXyoL3qUrYLp8CqoAhFsHQgg5rU5owOdH9ET9zphN1snoyDdechx7A94qMIMgZDBH3evz8kuj/6elQPOuk26P6eXzrvY7RS1NxlmerIyj+RhnGNIOvvvqitQ4lwl5TwSqEviMo/EE9iVxTSQFgWzoCGB0dhkzEjuEZoXbdboJDQxeoMIQNWS3ERlPbpB7820yEpz0TD9r9jey8PD14c7u859xB79fr7w73//0/7B8/+x5nIW6T3+r+YXcK28Zar9s/2M3p1f4/+EZG6xE2EaZ2Ggqj8FdrvIv4hfOD/a3T+l/093BBk+6ww9i8H2X52kB2Yxv5l/+BpP6FNtRa6Wn+d71d20hB9cdXbUPExfT3DctXkc6DGz+Q+DsKS2XXIsawhow9Tj6B/kUQjkZA6Es+5LFstNgrECPFWgvH2AjHCvb1gbIeKKQ2st7V4Z/FGdtO6eTeAywX1ci9EkJytDFkZQ68B/OqdlQwnRKcesyQPk6nOtAmbNeianUxLeuleE73oECdT52xlXNM3xNoUT5wnACMx086ocgoBptDB2HI1Qnz8ETVSyjE7kbhAVHO7Q1PcCZt7Z9IWEjbyk+E6+q97y6il+fjBJLL1Kmk7LxW3m1bqvTQfmYOACbnsH1jFaj6YvyEUmVGl4zSTBKbhZs+dbZ4UI9O5JhwbM9zbZVfg/gE+2v4ENnLilZMYvYVuhB5SBdM3T2gc/fDOY0UQGdvDltzf29vQURSRYT4dmfLqEEIAnaRvKhMjOI7ywbImQShR0yA+AOISdTphsQoIgbqbhqcadZ7FlTT1NstGPSIaNEer87Xlvyl2/ebk4tEZAQ7lgK7YyRDSZu1Vd91OjeXJpeCMajNwW45BcAT+9GJnxSeeW6Z0ITSlaZCGk/gvyXtZJvn8ncclWrgDYl0I3ZlrV+2VOxHqjGDS03ApErk/jNknIPuZYvYJJOvcbhHLIP9djD88XRcuYiS+F4xkcCG8UgbCbtR5TtomaP3JVUckuIH3nYaSdLrlqjbSWIg8YrwQB7EmiUbfrhEWtnmfqp9hhHv/wY1mON3/pIY4wWTRIO9cuVdY4mCWLdahHSWqZeft6Mq79acEl1bHvUl3b9/fO1xBBpz7V94lfNQrktGFmHPEA9I5GoGmotq70EJMky/ueylN6uecdEpIHDTEDLpMBtz71Kp296/HRzT4o9etVo3YnVSIkS149SgJhuazmRYX/ko4vH52/silj/Ka/fjjYVV1zC15Gd7a2Xt+uLf36EnWZ7lhWNxgH3/Wwr0X3nGDrR9M2xamRkKeU6958QvlSjTH8oROQXMf4lYJzMsd1gFnXFemURDfh7+vCYKYuLbB6zfmDM7IgVfABSMg/l7Ua9cndKm/5MbfJoWraMCmqnBhekAq5iWS6sSNUbnsGvM70yR0Dg1xAuFvXhe7SneTjQaqW9AxhcU3WhVt7r2tbsjjYKCxk848/s/vj0/+i96FiRv0VSry7VqM4mPS8IM6HcPq4l0on899Pg5mvD4fAtqJmBgzcqfreSjZougz5Z3E4OgNXIfYcQ5nhyoEWQCdsOBb1Fx3agYkBPSDbimNv9DAZdHHYFIYL2Gy0c13bHdD2a2dYzY4rtwYt8Wyq83Y/34Nx1tWGb0LUbm1Ws5aZE5QVWDsVaRq1IsryOx/M+FIxTyCN83fobUNMGDTCkNN6YIKJy9O12nunka44dbNX6jC4UAFc2Cf4NUxMvPzCA77n9cd3kGbABpr9CpcMZ1bEOxzxKOv1HNFTeSI0Lq6YNYKvcUqMdvCMpaOieGCUYpSdcyBSrO7VJXY5WWgXcDVITWMb703XN3+iYMM0GrqRQ+dhSy2hMiplhXXKyrSgkP9h+OjJ9eu62h/b2+/z32djNw2hqkpvxG74Vri+iWriudbwu/k6DnO32Vf03RPzJLvb2nUsx8n+9cMe/D8xfYGPnj+4pqhn+8fbG/o5/sHG4aW9fZCdo4Bu4tzDnG84L0QI9WdbsO9cvD8xdOXT/u7pdoetieq6G0PoKhyy8tuBkm5sBTRvRfP9tbQ/J1H8IYTOB6dqKuhCqQXr1loW8wHTW9viDawsGJkdpDG43ib1qttNiAZ/SNbF9bqMnQsuP85uHPDDTByYRW65tVtZGDD7XJbKLVl6eCnStJ1B+3uVYQz8rc7erR6iIwccQAEXO9qOSc63TukHWlRigv43pwlPnWYAqhLFnmEPzekMe6/eLpWidlyvRD2wxaJeu5G8GSFZWlWVSnrjya7wRq+NwQcLUEa9hhkGaPG35h1mDzJ1skULb+AXbs1peWcKm6hbc7jn5y+ojtHdZLz8PhsTZnxe+dqlSbgvhAqNdl/EOomi/0HoYI9CvM551qv0uZavLuVDwVu0z5iPGiafVercyglNXF7pj+Z6XCYxptGK/KlC4/obleA2fFp8MkgktFTbwf3z6UUxR3M3a+oDPhXXwL8Kyz/HVD6Skp/B66+AZV/XdnvIZ0eSn5/DSW/v8Zy319Bqe+hOR7Or/jg6hPsPJZqpWMM7IRbKHdTGc0HfwZSAideCToVYWVVemN423Nla6rCfZSl/UI2SaxFO4gbpVX8Mfx9jRqCVcR3YRG7deuuGt3vvFwoLe2yitlzUtPdY9y7rvqoQ8ZQ8mVVqdoZ4CKEgp8cPR/DKbD/xIXKNFqQTMvYpCgCGvPow3cXTwHEbMUQfK1zboIZ1kfODe4QbN0bbV0I7W7VmREN1yikFOQSXOYodtJo3Fmwx6bGFTPuSMcMdxDMLPnTD8/3D8Kl0m0Y80v7jb68y+hf4y36ko6iMCbur3r7Kfx9zX6axG6G4agGm1HcUIkd0bQI3Olab4bNgxR/fJv9OWyCjVfCiOEaXlzhw66fVHfX7ayDmDfsDDKn9m9MeE1TXX8EQDBrzG0liEuuC8RDjdmF1LZFZq1vp2nG7AidtnS4m3dqArbiv7czxCThHgXOMXOH7YSoSWlFnoTK3ecp+W4tBqs33uDc/PTyxYcXzx5aHT20OnpodfTQ6uih1dH/Q62OcH5uCZPRjwQ7yEyMlSz7saWAzi6p11BSD0qRB8zQiqGqsH+pRmMwRXqdq0fXWkn3Mx8ykdy4Mg2DmJhIx5Ap4ftsUkeGMYz5EK8Y7UGqs+0CZimf99qO9FRRtNWIAPNBV6DsdCa4dYJouk6F5gYqbC7Gh2VjsgkF6LIv0H7qR1rKzWNuiz/fXsubSeU/z5UJRyac+JPrtOqUqCAkXf7Iry0vYUcHnFgoiYnZhBIyvIqlP7rKG3A5Iz4G8aew4lghcomiBV53dWwUgfrKhmsLr0w255UsV32q3dvR9O6MefjscfCda1EsuR2zQswkr8dsroWYmQJXhC6Cf3gN4t8c4N2W5bawXtd5qVlQ73KTslFYqEq1UY6e8Jy9O2Mn6p/8on+Vo0yWpCF8gTn40UJ2IVaCu1a+PnR9gPmz7Fm2t7O/f7BDNU3WsR/utW3TP71DpmlcRfD/WMc2uKG+FMZhPOJ7+ISVGbN21ta2vY7Xub6U9Tr2NNsvhfxteQQVQJ9l+zdcoN6PCD6n9N018fu90uxVqdoiZIBpQx3Ou1wlOvnd6L4K8NQeZJUoZFuhVcKcXVRdfDX1R090XZLtUGTnaSFj5C0511t6g9id1RHipjO7L4bb5paBIVdd1J/FDgmkdcTw5bYZLtvTg+cPve0eets99LZ76G330Nvu6+1th/zYnnP9/Pz0Buc6NbdLomB+PD8/jdlcLqvfYTBtdTkNeVXC3Ue6LAJCCq+0OraNQ0kgYe5w+Rg+mKlilaXN/K/bHhvSBdNP+8RNY9LW0GRu1HXyvnz57dUoUhTlLZD8HE44J1vPL8a1WP4oylKhTFxZbMZ2C7Q8V4hmNddR9DGQdZvdt+nZoLnuP3u6mcCo8aqKW+D8OaQd9Ujqh0pEnKO8Y3JnDPsC1TOR5gdbFS9MfeHAUJw6Y2eCCiupvK1CnG+EHfoJPjoOWaGwtl6/OtvUt0HYMYr4439bu5FMWsyF1lsLc31P4OmclabHjIPVhOwxh7u7s1ItMnqKthO7a7hTI50vvc+p9v8tN3qK5Jfd6dfhefVWD/h+6b1O2H7eZiekUTyoNRv86L8/lb5PUz/QZnf6s73+HeR27WeHFw0xpJSzjwMioRA1nehv1OJ2B7p36PFe/V/IrVAg+/Yns5t8nxD3ou2M3oVEfWAVr5iohHgIXlqrvNorlnXJdT0ds6mreoh/yA21fYTW/emoxULoLcwndrrqJrFwpr5BXixSCBHpS3dNsbpFi+4q5aqXSp9C8V2+/Gr6yt50CMURnMOXnBc89DLd6FtUepEJ1MiTua+dlM2Usig512R/Df/qESvUUtgCuUaBAr2aDVj5UGCKr1cGdHIyeYPAxraNpimldW4paVFUVNadjt9w3Svke+xMFKt519RhSmCDluuJnvrqeZ1UggXEtIRJYFyCkhZA6k8jTHY8mFComRNhuo6/oc6AK+LhM3Y8F7lYKwSPex+VqHPlnM2IHxWXrtUYvOeVuogyi0EK5CXqWrTNOsoJeT6rPhczispvjUZOaaJGTxHqLPhi0zZcn1+my10EO+fVyYoEZWBcyoxPRefb5NHV4tPVDQx59f2II2Ceq6pqa9rFPjXEVWMmcduFN7kg/wAnjRjqmDAZ6bPikwL0YKsR2PWKAbEg0x0ihDpJ1d/593YCjiZuoahonlVr8pGOg0Yrq3JV9msOcz2TVnPdXUKxrj0mKav1wvhNUbl6T1SzYOw4kJfGtWSD1FW9l83HVSM6x67Mfx2zOc/FTKmPY2YvJXqPEjKXYZ1CUEFX77lrlcIuRF3EsEvHE1Q/IUymENBHipgoEutL+4pau2gOwY5Pfc6MgUmgUekhgXkpdSgR8hXaMVz2G89tUFEHx8ld1NORt0UdWF/WzFktLrx9prBvnO9YqmTjuep1Uyox7b6konJJJ474PFTRHbNp2Kz0kz+7ZLcSpq2GBHj6Yq24upcgdvVha67S0cT7/VzDFEzSzS6ZnGt/h2fETUkPrFQPCduvi5vpyz/aCc4Vb5Uqd/iiVtAuUDG4Lrgu0mL4Eey8VJfpYrwRXKNmOiKIbLQjF9Iu25mzIMEgro3TLg1vVzuy2IFiO6T3/uHy3b+Zt89+/LeTH56f/H335fJY/8fpr/mzf/ztt72/9JYiskZ/He5FvXl0FIAHTS6Ia6s5egdmv9Tvk1ra3XF6+EvNfiGQjP3C/sxkPVNtXfxSM/ZnFEVM/kJtTV3z0v8mPqV/tbUr//xL/UuNHlopzIo3TdLmyQkdf3jtzDgWO6mTSt1+xvFAShSbFGaUXAAzQnt+WbtKORdSXGYehysGDqRBGTyhZYXGnB6RHtK3w6lDpIcBMHGXajRYCjkOmj1aZyeifY9v5kpfuo7gg76UdwmD6fowdjWpaLsmP5GCjP6hQ4fA/ncoErqfHfTQk7zmH3wgXR+7exMwx5O3E3YapMNbNxR7HHYuWr4Dh0zpxa4/mOHrMrtBnux45IYPsk9LW5XRecDYGckRZ/KEOp3hK0Pyh5eu2B/6C3qN562w36M/MCSccf8i93aEi4q8pN+35N/eNKcBwV/0CL3tOySvHM1WVNbStWxT4fQNXhgZOXqA7Q/OxfmznMse2r411R0O4U0HLgH5rCOXvt1w6Ha/bDh2w48RZDiANx+8B8/6s6alvWHan7NYozffBusiDuNGzZj4lDHsizErHYv/k+cfx1351fj6V6i5xcukQMGI9TZIeAaG5ybyciLEvNaO3A7Bu6Jvgv27HyfdhrEFY0fhkq+QhN4WzZjZvBkz2Vy82JF51YyZsHn25OujvM2bLxIhc+wPnXdnx65mSclsz7DBb4Gt34CKGWj3zFMwsZIaI/Ixa2TlCPr1kRNIJ64BqkqpU9/Au/TZNc6BSR2KWupBOhLUUcnLwMHjWAwB1toGk9pXC4t9WdCNPLfjAN995IqziZsh7vTPN1KuIF19R71ODlMfM1rh6C4MCUgeTY7is0rH9oLr9Q1VPZeLtmvoitTUtr49AWL956TWdz8hai61uORlaRBDaXXrAhA9haSqdxvtpoiHMTyWRk21RPRNUzqW/r0Usx4WySAuHaFEY9lNoEHIyekJUcOpHQHRwA2pAwfF9q7235CA8nj7mJt6BWdhUl8Z8zSRFUyo6+jZwTB+CxKHaooEk2oqshPv6cOBgYBxzOz1+RuYdY1CfhP1CZJ1aHWQKOuxNGNQHeCEh2vQFa8tXG/+QA9kuOFcuYPT6SHt6yHt6yHt6yHt6yHt6yHt64q0r/Wsr3Da9EP0PtMpkzhdrgW/nTSlk8mrq4Z/yL95yL95yL95yL/ZUv6NEVrycrsO42Bfw4RyJmLiXt22l+N82fVRTcVq6HJxTde4c3eP69Juk6I6sWFUBwk1PbJNIUrhqkCnPf2C4elClgrj/tMYarT+aeX+ocpSaPzLG7H4V2eCboiNCDB7JO3dPt8nUePM/QhpgH9/UTfug3tBIbIUDZEWmVF6wWv5W6fsBzfP+vMb4kBSOMG+F7VGqIfTZCHf+skNMTgDFjWvwymtNOmrPaZbi9SIjHfuukXTeEtRNsgGY1xr1MGHoT+XpaUuF2gmR753XvsgHST7qn6KQ0Sjm89dKsb8C5J6UlT7LLXNYyxd76AehHGV6bFSFMFnXaexq9kJQujdWSxOSvFkm1lnvYnZ7UM1/5Ca4R9cLfwD64R/IIXwD6wN0jy/FOa3ZY1OFYw0TprbkpQ7TR5df1Z2B9bVwo2HITafdIiIi6ddl7BIPucePPBRB47JYjfhZQoq6cXVYiQ2DcM3LnFxbkWNSKWVCU0E/FBMWiNKdLKMS4FZNQi0pVDhRalmvKTbLdxbBXQ7h9Jt5DXXC7MlvhhNtOYrCpfApBnXC3cjnPrJTvgKjjqvT/jp4UZa5BYFQox0KdMJ4bPRGhvRnzvMxHzWHbYTxOEObhOC/rkD6wP/t9+PohCfRN66jmdbIsVk5tpmil6B/ECVbvTBDtltjd6dyXo3zO2hmcl/l2YmWzwZRyRTSc+gV5gzHlHTAXKwRDB9o9VCc6q+isBhWcmS68EWjFsvIN/crlPRnTKpjjsNXc3TrPsoX4Tp7auZAHwELPUp28jiBmPwTngFYsoiWyfLs4Nn/bi4prl/upxyVzGLZj1izWZE9u+1Z+c5tVztEZx6cw5GHx3s7b/Y2Xu+c/D0fO/l4d7zw6fPspfPn/6jf/XkepoX2f1T6NwBZsdHNy8Q4bDFzUfIbFTx/eg7e32UoAdtCZkoCZyylYiCc1pW93zs836w3tT1jSKlwsK7yeDK0yc2zERXZfowgkzK0DDOZlpdouiDESFdipAIpyNCJRrkYFFJuNLFINai2GYZmjChO1WiQaiNrBcfYomYWyDzeZwjwlgU34gaMmqeYj7Att/ZLqCcBuuQnv0+eXStnh2DnNGFFM2xQ234Oc9lKS0U5kZeKLesXCNQHHqyFHnSbht+o8huOHf8C2a9rSmlqBi0OkYuHa9XrCk53vTuJpRXpq7K5ykKBNonGQIT8upUY+o8CWYN+inCZdwQFALvWVCSTo0k4aITLZSSVrMpUTGbxplMEJmUa2GjExae3e5aD02vu5w+FB1xJfBwXxljbfSYYrCDuzaJTh2zvJSuh3l4FSEAFIqTpUHhrkSU89kh46twUzw+Daq+VR32spmOvb3DXTBeTUSj0iw+Avj4lFktLyQSRcfwRVcciUg+zYiASovYJsG1KMZwAIZAunSoQ57NsjwrpncwUWRziw21+UJ1UsaEXuSbuDVWobJVaE0QxkmuOmlPnHVPrtkSE3a2KRyvS08vqLhNWCgwSU3Rg11FfApxcp3NwW7MCAMPixkn7yMkS7OZjPHNMAF9eHmudNEZVqgxdv7qlKD6QAfyVlM8vxa5kBedNkWpvezs728ptPqxCU2TCCgAdrhkrtCVL+QfgogHI1GF9HI1oAfBXMtLqQ0n4E4qUAAc47lteVmuWPt/2bvW5zZuJP/dfwVK/mBnSxpTD1uxr25dWsnZ1UWOVZGU3N0XEeSAJOLhgDcPScxff/UDGo958SGLtrPFimu3xJnpbjQaDaCfFCtfiGzKdhy8HSggXY0iAGupSGuE57b+pH5MV38b79HMciSIZNwAeVBseQ1FOA5SSFcVBAhVo1RrgujD82SKOf6jTIfetmBWOn3dBsyz1lcy8iCxes007umDFEmCE5BTA/6VHYLrEKEVDa4AKbQWy8WUp0ioooQXMBr5nA+mJy7pMwIqc20+QYWmQrE7ieGibIN3OaRsKLKCV5IVra7KHI4RAi8tTGpujc6tY5XNjbKiJNW8QC9dkebo1K1f60g3A8NGMkmc2gh6RCTzNbQRafIVVNKjDmRa6qnVvZkYt3Vod55TMNOBHJeqzJO5kWb9TbXFcO7uc9pdyKHGdxm3Zee0ei91gVcU+C8ixv7Hc5ZK/IYFlkyCOWy9RJOV+35EP1DeuhMyRPti5y4sVKyv0oSIGltPP5KzPnRan4r59WHLx5YFFehaH/hm/QzQpHUduVnJo5W9x10HQfIEGTjYMJWjEoNE6FaqpqrMyc5p+O5/JphOUxCglydXv/xABb6SoC1dzgQfTpzOoOJxaISOpgrNY+fr/Tdv62OuuKi+tleqQt4/lRongl1cnG401/YfeADDYOFz7EiB0TQZtdlkX613Y1vlyAZlj2EV6WkDP9qGF2/Di7fhxdvw4m148b9ReLGcLaGh/TL6ohnea4N76XWGdQ25qsXOsPPLuyMcks4v795YGKJ+BvpqUcFtIckpLyI5W2FZd/DmGtmSdBmagT3h4X3AkaH2y8m1uxNT1zlJpyUCqQ0bs0zewQZ19vF/w8TK6lrRN6xE8ZgNeIJkM6xWG8BmLtmZKrGIa0zGOJsJqMvOmctt1CEDAP87ZgElQVc5sOhUVxnoJWVtP+YMV7XVr5AHvNYUXBLbu0R8W3F8W3F8W3F8W3F8W3H8u6o4TtXM6nZ7+9MCw701AqMWWt0KbDcB/UxlLR02cdIn4tBgc6iSRKAqY3tkmYsqG0n4w9I4kE5dCgb2WsrgH8mhw407GMXTrWGkFLOJmKIz6QYrfH2wOEL1pOh6Y8l/KUf6MCseZF6gJi+BYlS3K6ZSDGiSpu3JMPVnyILPhA4nyE3pjT4B1KsvVrrlqC0nGAjHj/xo9LrXG1WYsZHl9OKmvn6s1GZlmsJ2aSlm5/5IAZaYVI9ZJvNA56iR8W3qRqrm3lgZsjefOv+7Fpgkod6rTcbSJ3XD4zwkhsoXTflnOFQLVPvO5SARFe3pIGs5DUo6aFWq0qC+L4Gtegjh7kSlUjlEv3pNrwMppihoGIe9wt2zX1RBNn2p62axVBhrbE51OchjptdlhQz4U+10WJIc2MB7QEkMijwMugUaqXRYpPWfEDgq/NKUt/jwWLwWg5HocfFmePT2+CAeiLej3v7xEd9/c3g8GPx4cHQ8Wlaz6WkkMtyCadTkdAm0U0t+EUtbPpS5X5lQ/6ZMJskLbOP3OQpHFPfKtTz2pgwLi089QJ75pWGPLXwaNDqaiLmrSGmjR6QLScB/g3l1YYBu73tn7ATBJ/BCG/IgnbHElWtQYuT0GRx3vNDlQlRQnReTnbfLPRhKLmw/WLqR0VBqkXRU1kYXR1Ij9iGseByyWQsbFUOxhwg8HiZljsUTWiSMT/cfghd5E4TUTdRjMeJlgijnoZq50BDHLyh78tA4mHKEtWph0AoSLaIuwjHskQaoyHUexF08pWCfUk9IDd/JFo3pm+TvrbW6gNeFe1ClHX0oaTsFVLSr02vBccaOpK2B5vnIAPFVUrTLsEpdVRh3/WoCVF/YzJVg6lcmvr9EMCrTQeeWTczIb5RiUJsQ52e+5wtnxeswXcdMfYZBmFM2myiYSpM5XRTcpkKjgQByi7DJjcPoIApLPRl3dOVw6n9ZcDY1bzWOpY3gBEJgqDKWmVfVjbQKKYhCWBJ/EFqfKAjhu/SSk79/6yXfesm3XvIFXnKzTmiagsX9DV3lhqStq3zrKt+6yreu8q2rfOsqX+Aq15vFX85VTlRv1FVOV4AlLmKekF+VgGpPsfUet7qJg4hp1IvWF6B0/N27zTvZEX0hP75Dt/nqh7qv6Dtvkfmt73zrO9/6zre+863v/LvynRcZH1qNTubJ6+CnbvvkWeBXISDtXkSe8mT+p0BTHcgGNkxWTDJVjieKmgrjPB/2SGMwJEsUIEdSDxIXZaqdyLqLD/k34SdGgbNE5hMRw/kRjoXBvGtZQe2Cc7bnN06b7IYsY3puzXSjTKXFHnImiR6CuIfzAXIapcjZlMduHF4uBnz4Ofwyj1Y2mIJ6sTll2O2uNoj9kj3BUW9Iiij3YyNnq+5oF+TpkTfN1FpghRoL5APq1EAHkuRv16oOy/AJT2OUmRjMPRp9ANujk2fgtIte1IX5aDB6ezA6fH18PDg8ivkbfjgUbw/exj3RE0fHh1Wfa5hZ+G2Y7NDXWG1/t31IJ3I8AXPcfVtnB00FR4qbidqE6rWM2dVt+xxIU3KJ+IvlZyMZG+zr9Ua9N8ec9wb8be9gcBxohTJLQo1w8+vFEm1w8+sFCTXy0O/QbDYvZzhjUmUizFihdagOBOAJu/n1gtoa0Jv2YAweDDLBTZq7ukfeNxr9DxFtskuHsF1dSIe+V0ylqy+0zR5Cz8hpQEo4S3ZdW8UdNKUiZ1o0VDvBmjtPtRdC+4sxgeDnlM9NQislXOJGbHowaL6aBOBk7guaWY+Fg4rxRsYVjZgTnotdyoR2t1Tj5Rsre4Pok3eBHBQNoakOocLXUcbHU3+benLO4oYhVchaxkcF1VDtP+8HjC7ULOTuNXzgz/u2iywk0EKxREcvqmPZXD3A85GGruVfu6rkFPNJJRR0YjragbnZmgc+IZ2LadEx7Ff9MksiwOsjD1er3rANnUQYFVpjFFmJ0DS9AE2Wr93tqg4x6o5Vn/awrZqf/ndHR4evjNv3/f/9J/1u/n5eqGr/INtweENcfXGTmq7EIvaNnLWIUNJ/OFo3yrZIo7Sli8tuWLQ3dqtTd6+xk6n5nwlu1ZieHj5ETQ7tlDcw8KnMqe7bH+iq5dKubQ8fKLaK8Iaz6WptuM8cWK6PXvB7W0J3K4q3NV7uURMLKep4XJnzGc/zYCafes4vCbxdy7TrVU/f4ObG8BeTGu5ABxGDdqIlZpdWch5temnQcXR02LgFHB0dVojSdTpWoOoxTNKhMxoBCbEz5mt6zRNcJdJx6xgIJrors52asDV0/Hut48UDSquKoAlniEXXGjA7LN08sSuw/vu+XqHuUqYrLqaKvtW020hOKG2s1P77vo5NtW/tBsj0BxT16iC6XtjTWeHp0aSbN/v0dS1aqBIOxwaiuBfCb/NAijA8bBl1m6M5NW1qbq809E7Z29HaJZwlRHSaOkb9d637saG3Q09VRoaLxAZNMjcEvja4sFyMqytpj8n27+5zsl4N+M6elu1qqBo8XGyPftXsS9jJE3HH3WZdqJag2Z+CMqbo249bs4DntWK+wC8S1eL1UrBmH9P+uJhwc9mWsQ2ZtUd6VzGJdkq9zPLgpm07YH77Y/g3tAV/SzPwX8gC/Bcw/n5ru+/W5LvU5CvjDVhVv8Ta+70aevHWLR/bu16wZTH/6wobl4Fhty+fuwPrBRW9tpUd3ZZJxF1PxNxWvJ6oe1bOIFAwwJIpC+OqtEHRC2PGMxyDSkeqPTitsdcIFyhfnZuNrGTCVp8SeTmx4ZndwrIRgjzrGkRd8RHP5Ne8qd+kNKFBOLUl8radyI/qT5kk/NXrqMdeGjb+Bzu9vCGWoinC/sHtvjFN29L9P7CT2SwRv4vBz7J49ab3Gl3qbblsxl7+/K/rjxe75pt/iuFn9QOjmPJX+wdRj31UA5mIV/uvP+wf/Uh8evWmV+9ctO2Ftu2Ftu2Ftu2F9nS90DZLai1vZsHWAC34bA/8eMcGQneG5ulwojLz595QTaeao3SWQGLasxq2v2sSTq2dxXyiP7cnCHd5wFkAN0ltj6ZuZs/at3OzS9R6fLaxZGHjThp1BTIoi1Ax8U+fSWEA80Q60y5siu/o4l17eSrHkARwushKUYVuxkJvGrBq8IcY2kOu+eN26Uj+Tj8GnNXzaJui4y5GyGrjE1nm3LL1g1Mnkg/4iODZozsWKo9jSRVocXbHBNp8R42H7qbVOQypCbL1umZwAVmetCAdzoLWE9mQjuYkuoTfVeZPA20VuybgVhldCB2ShNaEIo9sjumzbsZUmHItTZ4tAhPst0zGdvUOE1XGfqGe4k9r1NGZgpxKGbRw+iM9NefxYeXTHEYIir3AgorjW/3CrQVpi5KrLFzKlVHrD6JZpiD63hzgtBA92Xt4tlAYwuMufQJ5pHQbPWKQwFgLcjlFe54maj6Ve3wwjPcPDo8WYz8HBHZ+5mwMelRuKkg2n7MTiIl+SSVxqA4sQWBc5Fii52eJnLW+vFDOAhyWQF8kYjEaNyAZPxbTCkunhmvV9RNgo5Ty20DBLEZGH0TBB6viog1MJrKY366wbSz+alWsJOOrTlxjfa2KJ9PR/ivhqLzaCt/qoxjVhjOvkM7s3y3LyzxDi6yintBLz7Cuc5hHbs3+hxaESS6C44rBt+eUUcexwpHVvjuGn4Sf0Y4Yhh62MytgWPsnrUzrQAWNsz42fBVud2tirX25GtLHo0v4QCQ5Y8/Z9aezT+/Yv9Q9zJRTPoOSzcX7AGzLcWrJkWqBPvc63ZAQWcnFfu7lFq3g26X2HOehQFppW8Dntt5FFAgofm8VT9o30OPCnpfhfHT52GKYR/NpEtF7pmkrgkqwD6Yq3fNf1kzLhvTFkt49NRX7rwUxUCoRPF2RvSPPER2Z76e9iVfl0aCUSRNlc0bd7r2z/+PZfu/tzmrkfLpiGkNohW4nBKaK1nWwiJa8yEQxnKxOjMViHCzp3Eng53IAM4RJFyY5/Dn8rQWuf+4Oe9WTmwfqT2xLtar/aKlm9a8ulbk6x2cqjlZk9wKOBhyYqVhT1ZxcoCpl/GSYLlXMbs7Pmojwv/mMD8WTofIQm8iQofukHEytsa6JjNTl375YMQePb6d8NkN7JaNldv62szbFtJFM+axJsk4soDYU3xvdAW3txGdCt1LIReUS68lvErgaYg+3Y6JjMUvUXEdOPiliD7cDMQ6C8C8++ZADwB2o/Q71pIgd2KVo2w99X47XwKUNhnS5312oQ1z71mLbx7l9xV1q2/YBD3u9TUA8rHrsJAxRo6Fr29GTRvyHStRnyfd4WahY5kN1F15O/ss8ZWf0ZM7C95wtZBXrSQuocBcmOhzIqIOL9F5kTExVc3GbSLTQhX/WEkz1V9TIEUCW0W6cMl4f3QdUcdGf61gj7r3qVLuGYt6EtP2kwISYxSWi5HABzIpyVjHe6oMwXAS6YIGzfgIzgsr4VCA8XGVsIABCz5so9JHchD7pH/CnidyTsSYtF3e6qiXixHMTrYa2OUGTPSbjXbw6wTGtShIiF3QrQ22TbGMh5WPMMhWXw2J9Rl5TdRCzdgkMjolubIvQPlpcKmhf5M7F8TLA/MMS1GmsssdhNt9aVvvhB7KQu+qCMm2nw6a1rI0dwaITXD4RWW/QkbRqShYxfVj6iP22a1IH1t9dLL8dHwK7rYjTlZKX6NZXoJcS+VER423VWt0/c2r/XsFDs6ZzJogzGUlSuXXtXsH6k0wE4wVlENLdtE3X5aIo5SL+uTuWNia08PQKTSop2QNYJ9S4rW9A99kAZbdQ1usTlSLVDe3uZV73KeSiGG+OlvFatJggjfWlmbGToO2l8Uq6KFStJH3yJtyjWcoTiggJOhXiH2WZJCKqBk26LAcjsrG6T1EsgWx2EfuUulgJ/KPkL5SyQHfP4aerXXYnuWbL549n54WY/o6I658yNc29yFjXEv6zvJIjS2kYSkZBxk6U7Zc6GPY2lDnP3Yzf0y/tzF3AXpecqs0vlPxKtn7KMMkRSIWsaDgC+HiMho+Yp5yCEgNoNJ6gWaolESHh9KKhsBAPxbOFxN2kCF6LdXFVG0ROu5q4kyjYhs2rmLiOu/roif+v9IBkNOuYSJdMhKGUMzSLjjUl+p2c3U8IBf68RWrnuFI2aKriktZBH2RFIoW6Qb1jUffNzlQuH275MOk8PnaM+/LT1fl/21QcDClTiS7158L4QJ3NENKmZBoDzhZlWKV5MGf9sShGfJiwvbRPTPAyrFJ2IdPywQpZu3gZUujHLglzygSu3Xf7vV7vXXa/5x5XxvrBxHPb4dBQT04vohpmqg+7KmrN3HcHvXfZ3sNKmG392ZPTCxDDfex1bTIfsJItC1qu2guu2R2z/IEiJIJtxVKF6d01qhRLMCWJODm9oEagn8VcF8Z0wPCVg0P1pNhv1BsYixg2Vp1LmMm0gMgaicHPE+F5JVKcEKlmDGf93kMfS2YkHxaIToVRuUggUKvJPE5KVx80GIpWLeYhExaLpctn6JaMBjZ1n/okZzNpdZnLVCLWBqshvcjdaDLlqW/NIHg8aIrkd3EEVSQ6/mbV9ZL31sCrIetl0ZiA+jRXgCEGv2qmDqe0Ijy8jGWxmuic4FVWtsLuEhh3GOpiTBcG34jfgqpYVNYDFlTettDEaISyVRQwuHTsH+zrGx1/DcuX86AGMAyftCDDIbexoROifqmNE3VetHKji/wl6DxLOpjySLgtEoItwQQaPuviTQXkT+79jcpIHc2XC0kd4hNISQDyq4hJA99TyUkDcIug5Pyudn8POVMBd4VXNyoeAYYvl4wA2BMIhYH2VeQhRPVUohDCTPlURM/+fwBhWjUG"
 }
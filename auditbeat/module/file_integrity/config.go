@@ -67,6 +67,12 @@ const (
 	XXH64       HashType = "xxh64"
 )
 
+// Enum of event reader backends.
+const (
+	BackendFSNotify = "fsnotify" // inotify on Linux.
+	BackendFanotify = "fanotify" // Linux only.
+)
+
 // Config contains the configuration parameters for the file integrity
 // metricset.
 type Config struct {
@@ -80,6 +86,16 @@ type Config struct {
 	Recursive           bool            `config:"recursive"` // Recursive enables recursive monitoring of directories.
 	ExcludeFiles        []match.Matcher `config:"exclude_files"`
 	IncludeFiles        []match.Matcher `config:"include_files"`
+	Diff                DiffConfig      `config:"diff"`
+	Backend             string          `config:"backend"`
+}
+
+// DiffConfig contains the configuration parameters for reporting the content
+// changes of small text files.
+type DiffConfig struct {
+	Enabled          bool   `config:"enabled"`
+	MaxFileSize      string `config:"max_file_size"`
+	MaxFileSizeBytes uint64 `config:",ignore"`
 }
 
 // Validate validates the config data and return an error explaining all the
@@ -116,6 +132,20 @@ nextHash:
 		errs = append(errs, errors.Errorf("max_file_size value (%v) must be positive", c.MaxFileSize))
 	}
 
+	c.Diff.MaxFileSizeBytes, err = humanize.ParseBytes(c.Diff.MaxFileSize)
+	if err != nil {
+		errs = append(errs, errors.Wrap(err, "invalid diff.max_file_size value"))
+	} else if c.Diff.MaxFileSizeBytes <= 0 {
+		errs = append(errs, errors.Errorf("diff.max_file_size value (%v) must be positive", c.Diff.MaxFileSize))
+	}
+
+	c.Backend = strings.ToLower(c.Backend)
+	switch c.Backend {
+	case BackendFSNotify, BackendFanotify:
+	default:
+		errs = append(errs, errors.Errorf("invalid backend value '%v'", c.Backend))
+	}
+
 	c.ScanRateBytesPerSec, err = humanize.ParseBytes(c.ScanRatePerSec)
 	if err != nil {
 		errs = append(errs, errors.Wrap(err, "invalid scan_rate_per_sec value"))
@@ -168,4 +198,9 @@ var defaultConfig = Config{
 	MaxFileSizeBytes: 100 * 1024 * 1024,
 	ScanAtStart:      true,
 	ScanRatePerSec:   "50 MiB",
+	Diff: DiffConfig{
+		MaxFileSize:      "100 KiB",
+		MaxFileSizeBytes: 100 * 1024,
+	},
+	Backend: BackendFSNotify,
 }
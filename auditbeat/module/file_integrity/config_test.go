@@ -132,6 +132,57 @@ func TestConfigInvalidMaxFileSize(t *testing.T) {
 	t.Fatal("expected error")
 }
 
+func TestConfigInvalidDiffMaxFileSize(t *testing.T) {
+	config, err := common.NewConfigFrom(map[string]interface{}{
+		"paths":              []string{"/etc"},
+		"diff.enabled":       true,
+		"diff.max_file_size": "0", // Value must be > 0.
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := defaultConfig
+	if err := config.Unpack(&c); err != nil {
+		t.Log(err)
+		return
+	}
+
+	t.Fatal("expected error")
+}
+
+func TestConfigBackend(t *testing.T) {
+	config, err := common.NewConfigFrom(map[string]interface{}{
+		"paths":   []string{"/etc"},
+		"backend": "FANotify",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := defaultConfig
+	if err := config.Unpack(&c); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, BackendFanotify, c.Backend)
+
+	config, err = common.NewConfigFrom(map[string]interface{}{
+		"paths":   []string{"/etc"},
+		"backend": "kqueue",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c = defaultConfig
+	if err := config.Unpack(&c); err != nil {
+		t.Log(err)
+		return
+	}
+
+	t.Fatal("expected error")
+}
+
 func TestConfigEvalSymlinks(t *testing.T) {
 	dir := setupTestDir(t)
 	defer os.RemoveAll(dir)
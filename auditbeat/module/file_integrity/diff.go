@@ -0,0 +1,78 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package file_integrity
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+	"github.com/pmezard/go-difflib/difflib"
+
+	"github.com/elastic/beats/libbeat/common/file"
+)
+
+// diffContextLines is the number of unchanged lines included around each
+// change in a unified diff.
+const diffContextLines = 3
+
+// readTextFile returns the content of the named file if it is a text file no
+// larger than maxSize bytes. It returns nil content and no error for files
+// that are bigger or that contain binary data. The content of an empty file
+// is non-nil.
+func readTextFile(name string, maxSize uint64) ([]byte, error) {
+	f, err := file.ReadOpen(name)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open file for reading content")
+	}
+	defer f.Close()
+
+	// Read one byte past the limit to detect files that grew.
+	content, err := ioutil.ReadAll(io.LimitReader(f, int64(maxSize)+1))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read file content")
+	}
+
+	if uint64(len(content)) > maxSize || !isText(content) {
+		return nil, nil
+	}
+	if content == nil {
+		// Empty files are eligible too.
+		content = []byte{}
+	}
+	return content, nil
+}
+
+// isText returns true if data is valid UTF-8 without any NUL bytes.
+func isText(data []byte) bool {
+	return bytes.IndexByte(data, 0) < 0 && utf8.Valid(data)
+}
+
+// unifiedDiff returns a unified diff between the old and the new content of
+// the file at path.
+func unifiedDiff(path string, old, new []byte) (string, error) {
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(old)),
+		B:        difflib.SplitLines(string(new)),
+		FromFile: path,
+		ToFile:   path,
+		Context:  diffContextLines,
+	})
+}
@@ -0,0 +1,95 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package file_integrity
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadTextFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "audit-file-diff")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	write := func(name string, data []byte) string {
+		path := filepath.Join(dir, name)
+		if err := ioutil.WriteFile(path, data, 0600); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	t.Run("text", func(t *testing.T) {
+		content, err := readTextFile(write("text", []byte("hello\nworld\n")), 100)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, "hello\nworld\n", string(content))
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		content, err := readTextFile(write("empty", nil), 100)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.NotNil(t, content)
+		assert.Empty(t, content)
+	})
+
+	t.Run("too large", func(t *testing.T) {
+		content, err := readTextFile(write("large", []byte("hello world")), 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Nil(t, content)
+	})
+
+	t.Run("binary", func(t *testing.T) {
+		content, err := readTextFile(write("binary", []byte{0x7f, 'E', 'L', 'F', 0x02, 0x00}), 100)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Nil(t, content)
+	})
+
+	t.Run("missing", func(t *testing.T) {
+		_, err := readTextFile(filepath.Join(dir, "missing"), 100)
+		assert.Error(t, err)
+	})
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	diff, err := unifiedDiff("/etc/hosts",
+		[]byte("127.0.0.1 localhost\n::1 localhost\n"),
+		[]byte("127.0.0.1 localhost\n10.0.0.1 evil.example.com\n::1 localhost\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Contains(t, diff, "--- /etc/hosts\n")
+	assert.Contains(t, diff, "+++ /etc/hosts\n")
+	assert.Contains(t, diff, "+10.0.0.1 evil.example.com\n")
+	assert.Contains(t, diff, " 127.0.0.1 localhost\n")
+}
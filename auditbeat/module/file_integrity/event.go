@@ -31,7 +31,10 @@ import (
 	"path/filepath"
 	"runtime"
 	"strconv"
+	"strings"
 	"time"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/OneOfOne/xxhash"
 	"github.com/pkg/errors"
@@ -116,6 +119,8 @@ type Event struct {
 	Source     Source              `json:"source"`                // Source of the event.
 	Action     Action              `json:"action"`                // Action (like created, updated).
 	Hashes     map[HashType]Digest `json:"hash,omitempty"`        // File hashes.
+	Diff       string              `json:"diff,omitempty"`        // Unified diff of the file content.
+	Process    *Process            `json:"process,omitempty"`     // Process that caused the event (fanotify only).
 
 	// Metadata
 	rtt    time.Duration // Time taken to collect the info.
@@ -124,20 +129,27 @@ type Event struct {
 
 // Metadata contains file metadata.
 type Metadata struct {
-	Inode  uint64      `json:"inode"`
-	UID    uint32      `json:"uid"`
-	GID    uint32      `json:"gid"`
-	SID    string      `json:"sid"`
-	Owner  string      `json:"owner"`
-	Group  string      `json:"group"`
-	Size   uint64      `json:"size"`
-	MTime  time.Time   `json:"mtime"`  // Last modification time.
-	CTime  time.Time   `json:"ctime"`  // Last metadata change time.
-	Type   Type        `json:"type"`   // File type (dir, file, symlink).
-	Mode   os.FileMode `json:"mode"`   // Permissions
-	SetUID bool        `json:"setuid"` // setuid bit (POSIX only)
-	SetGID bool        `json:"setgid"` // setgid bit (POSIX only)
-	Origin []string    `json:"origin"` // External origin info for the file (MacOS only)
+	Inode  uint64            `json:"inode"`
+	UID    uint32            `json:"uid"`
+	GID    uint32            `json:"gid"`
+	SID    string            `json:"sid"`
+	Owner  string            `json:"owner"`
+	Group  string            `json:"group"`
+	Size   uint64            `json:"size"`
+	MTime  time.Time         `json:"mtime"`            // Last modification time.
+	CTime  time.Time         `json:"ctime"`            // Last metadata change time.
+	Type   Type              `json:"type"`             // File type (dir, file, symlink).
+	Mode   os.FileMode       `json:"mode"`             // Permissions
+	SetUID bool              `json:"setuid"`           // setuid bit (POSIX only)
+	SetGID bool              `json:"setgid"`           // setgid bit (POSIX only)
+	Origin []string          `json:"origin"`           // External origin info for the file (MacOS only)
+	XAttrs map[string][]byte `json:"xattrs,omitempty"` // Extended attributes, including POSIX ACLs (Linux only, nil if not read)
+}
+
+// Process contains information about the process that modified a file.
+type Process struct {
+	PID        int    `json:"pid"`
+	Executable string `json:"executable,omitempty"` // Empty if the process exited.
 }
 
 // NewEventFromFileInfo creates a new Event based on data from a os.FileInfo
@@ -230,6 +242,10 @@ func buildMetricbeatEvent(e *Event, existedBefore bool) mb.Event {
 		file["target_path"] = e.TargetPath
 	}
 
+	if e.Diff != "" {
+		file["diff"] = e.Diff
+	}
+
 	if e.Info != nil {
 		info := e.Info
 		file["inode"] = strconv.FormatUint(info.Inode, 10)
@@ -269,6 +285,9 @@ func buildMetricbeatEvent(e *Event, existedBefore bool) mb.Event {
 		if len(info.Origin) > 0 {
 			file["origin"] = info.Origin
 		}
+		if len(info.XAttrs) > 0 {
+			addExtendedAttributes(file, info.XAttrs)
+		}
 	}
 
 	if len(e.Hashes) > 0 {
@@ -279,6 +298,16 @@ func buildMetricbeatEvent(e *Event, existedBefore bool) mb.Event {
 		out.MetricSetFields.Put("hash", hashes)
 	}
 
+	if e.Process != nil {
+		process := common.MapStr{
+			"pid": e.Process.PID,
+		}
+		if e.Process.Executable != "" {
+			process["executable"] = e.Process.Executable
+		}
+		out.MetricSetFields.Put("process", process)
+	}
+
 	if e.Action > 0 {
 		actions := e.Action.InOrder(existedBefore, e.Info != nil).StringArray()
 		out.MetricSetFields.Put("event.action", actions)
@@ -287,6 +316,53 @@ func buildMetricbeatEvent(e *Event, existedBefore bool) mb.Event {
 	return out
 }
 
+// addExtendedAttributes adds the extended attributes to the file fields. POSIX
+// ACLs are decoded and reported under posix_acl, any other attribute is
+// reported under xattrs.
+func addExtendedAttributes(file common.MapStr, xattrs map[string][]byte) {
+	acls := common.MapStr{}
+	other := common.MapStr{}
+	for name, value := range xattrs {
+		var aclKey string
+		switch name {
+		case posixACLAccessXAttr:
+			aclKey = "access"
+		case posixACLDefaultXAttr:
+			aclKey = "default"
+		}
+
+		if aclKey != "" {
+			if entries, err := parsePOSIXACL(value); err == nil {
+				acls[aclKey] = entries
+				continue
+			}
+		}
+		other[name] = xattrValueString(value)
+	}
+
+	if len(acls) > 0 {
+		file["posix_acl"] = acls
+	}
+	if len(other) > 0 {
+		file["xattrs"] = other
+	}
+}
+
+// xattrValueString returns the value of an extended attribute as a string if
+// it is printable text, otherwise it returns the value hex encoded with a 0x
+// prefix.
+func xattrValueString(value []byte) string {
+	text := bytes.TrimRight(value, "\x00")
+	if utf8.Valid(text) && strings.IndexFunc(string(text), isNotPrintable) < 0 {
+		return string(text)
+	}
+	return "0x" + hex.EncodeToString(value)
+}
+
+func isNotPrintable(r rune) bool {
+	return !unicode.IsPrint(r)
+}
+
 // diffEvents returns true if the file info differs between the old event and
 // the new event. Changes to the timestamp and action are ignored. If old
 // contains a superset of new's hashes then false is returned.
@@ -340,6 +416,13 @@ func diffEvents(old, new *Event) (Action, bool) {
 			result |= AttributesModified
 		}
 
+		// Extended attributes include POSIX ACLs (e.g. modified with setfacl).
+		// They are nil if they weren't read, e.g. for events stored by
+		// versions that didn't collect them.
+		if o.XAttrs != nil && n.XAttrs != nil && !equalXAttrs(o.XAttrs, n.XAttrs) {
+			result |= AttributesModified
+		}
+
 		// For files consider mtime and size.
 		if n.Type == FileType && (!o.MTime.Equal(n.MTime) || o.Size != n.Size) {
 			result |= AttributesModified
@@ -354,6 +437,19 @@ func diffEvents(old, new *Event) (Action, bool) {
 	return result, result != None
 }
 
+func equalXAttrs(a, b map[string][]byte) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for name, valueA := range a {
+		valueB, found := b[name]
+		if !found || !bytes.Equal(valueA, valueB) {
+			return false
+		}
+	}
+	return true
+}
+
 func hashFile(name string, hashType ...HashType) (map[HashType]Digest, error) {
 	if len(hashType) == 0 {
 		return nil, nil
@@ -49,6 +49,16 @@ func testEvent() *Event {
 			CTime:  testEventTime,
 			MTime:  testEventTime,
 			SetGID: true,
+			XAttrs: map[string][]byte{
+				posixACLAccessXAttr: testPOSIXACL(
+					aclUserObj, 6, 0,
+					aclUser, 4, 1000,
+					aclGroupObj, 4, 0,
+					aclMask, 4, 0,
+					aclOther, 0, 0,
+				),
+				"user.comment": []byte("beats"),
+			},
 		},
 		Hashes: map[HashType]Digest{
 			SHA1:   mustDecodeHex("abcd"),
@@ -168,6 +178,48 @@ func TestDiffEvents(t *testing.T) {
 		assert.True(t, changed)
 		assert.EqualValues(t, AttributesModified, action, "action: %v", action)
 	})
+
+	t.Run("updated posix acl", func(t *testing.T) {
+		e := testEvent()
+		e.Info.XAttrs[posixACLAccessXAttr] = testPOSIXACL(
+			aclUserObj, 6, 0,
+			aclUser, 6, 1000,
+			aclGroupObj, 4, 0,
+			aclMask, 6, 0,
+			aclOther, 0, 0,
+		)
+
+		action, changed := diffEvents(testEvent(), e)
+		assert.True(t, changed)
+		assert.EqualValues(t, AttributesModified, action, "action: %v", action)
+	})
+
+	t.Run("removed xattr", func(t *testing.T) {
+		e := testEvent()
+		delete(e.Info.XAttrs, "user.comment")
+
+		action, changed := diffEvents(testEvent(), e)
+		assert.True(t, changed)
+		assert.EqualValues(t, AttributesModified, action, "action: %v", action)
+	})
+
+	t.Run("removed all xattrs", func(t *testing.T) {
+		e := testEvent()
+		e.Info.XAttrs = map[string][]byte{}
+
+		action, changed := diffEvents(testEvent(), e)
+		assert.True(t, changed)
+		assert.EqualValues(t, AttributesModified, action, "action: %v", action)
+	})
+
+	t.Run("xattrs not read", func(t *testing.T) {
+		e := testEvent()
+		e.Info.XAttrs = nil
+
+		action, changed := diffEvents(testEvent(), e)
+		assert.False(t, changed)
+		assert.Zero(t, action)
+	})
 }
 
 func TestHashFile(t *testing.T) {
@@ -285,6 +337,8 @@ func TestBuildEvent(t *testing.T) {
 		e.Info.Group = "staff"
 		e.Info.SetUID = true
 		e.Info.Origin = []string{"google.com"}
+		e.Diff = "--- /home/user\n+++ /home/user\n"
+		e.Process = &Process{PID: 1234, Executable: "/usr/bin/vim"}
 
 		fields := buildMetricbeatEvent(e, false).MetricSetFields
 		assert.Equal(t, testEventTime, e.Timestamp)
@@ -304,6 +358,9 @@ func TestBuildEvent(t *testing.T) {
 		assertHasKey(t, fields, "file.setuid")
 		assertHasKey(t, fields, "file.setgid")
 		assertHasKey(t, fields, "file.origin")
+		assertHasKey(t, fields, "file.diff")
+		assertHasKey(t, fields, "file.posix_acl.access")
+		assertHasKey(t, fields, "file.xattrs")
 		if runtime.GOOS != "windows" {
 			assertHasKey(t, fields, "file.gid")
 			assertHasKey(t, fields, "file.mode")
@@ -311,6 +368,9 @@ func TestBuildEvent(t *testing.T) {
 
 		assertHasKey(t, fields, "hash.sha1")
 		assertHasKey(t, fields, "hash.sha256")
+
+		assertHasKey(t, fields, "process.pid")
+		assertHasKey(t, fields, "process.executable")
 	})
 	t.Run("no setuid/setgid", func(t *testing.T) {
 		e := testEvent()
@@ -373,6 +433,35 @@ func TestBuildEvent(t *testing.T) {
 	})
 }
 
+func TestBuildEventExtendedAttributes(t *testing.T) {
+	e := testEvent()
+	e.Info.XAttrs["security.ima"] = []byte{0x03, 0x02, 0x04}
+	e.Info.XAttrs["security.selinux"] = []byte("system_u:object_r:etc_t:s0\x00")
+	e.Info.XAttrs[posixACLDefaultXAttr] = []byte("corrupt")
+
+	fields := buildMetricbeatEvent(e, false).MetricSetFields
+
+	acl, err := fields.GetValue("file.posix_acl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, common.MapStr{
+		"access": []string{"user::rw-", "user:1000:r--", "group::r--", "mask::r--", "other::---"},
+	}, acl)
+
+	// Undecodable ACLs are reported as raw attributes.
+	xattrs, err := fields.GetValue("file.xattrs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, common.MapStr{
+		posixACLDefaultXAttr: "corrupt",
+		"security.ima":       "0x030204",
+		"security.selinux":   "system_u:object_r:etc_t:s0",
+		"user.comment":       "beats",
+	}, xattrs)
+}
+
 func mustDecodeHex(v string) []byte {
 	data, err := hex.DecodeString(v)
 	if err != nil {
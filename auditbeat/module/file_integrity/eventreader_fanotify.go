@@ -0,0 +1,477 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build linux
+
+package file_integrity
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+	"unsafe"
+
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+
+	"github.com/elastic/beats/libbeat/logp"
+)
+
+// fanotify(7) definitions that are not available in golang.org/x/sys/unix.
+const (
+	fanCloexec        = 0x1
+	fanNonblock       = 0x2
+	fanClassNotif     = 0x0
+	fanReportFID      = 0x200
+	fanReportDirFID   = 0x400
+	fanReportName     = 0x800
+	fanReportDFIDName = fanReportDirFID | fanReportName
+
+	fanMarkAdd        = 0x1
+	fanMarkFilesystem = 0x100
+
+	fanModify    = 0x2
+	fanAttrib    = 0x4
+	fanMovedFrom = 0x40
+	fanMovedTo   = 0x80
+	fanCreate    = 0x100
+	fanDelete    = 0x200
+	fanQOverflow = 0x4000
+	fanOnDir     = 0x40000000
+
+	fanotifyMetadataVersion = 3
+	fanEventMetadataLen     = 24
+
+	fanEventInfoTypeFID      = 1
+	fanEventInfoTypeDFIDName = 2
+	fanEventInfoTypeDFID     = 3
+
+	// Size of the fanotify_event_info_fid header preceding the file handle
+	// (info header and fsid).
+	fanEventInfoFIDHeaderLen = 12
+	// Size of the struct file_handle header preceding f_handle.
+	fileHandleHeaderLen = 8
+)
+
+// fanotifyEventMask contains the events reported by the fanotify reader.
+const fanotifyEventMask = fanModify | fanAttrib | fanMovedFrom | fanMovedTo |
+	fanCreate | fanDelete | fanOnDir
+
+const fanotifyReadBufferSize = 64 * 1024
+
+var nativeEndian = getNativeEndian()
+
+type fanotifyReader struct {
+	file     *os.File
+	mountFDs map[[2]int32]int // Open fd on each marked filesystem, by fsid.
+	config   Config
+	eventC   chan Event
+	log      *logp.Logger
+}
+
+// newFanotifyReader creates a new EventProducer backed by fanotify. Each
+// filesystem containing a configured path is watched as a whole and events are
+// filtered in user-space. It returns an error if the kernel doesn't support
+// reporting directory file handles and entry names (Linux 5.9) or if the
+// process lacks the CAP_SYS_ADMIN capability.
+func newFanotifyReader(c Config) (EventProducer, error) {
+	fd, err := fanotifyInit(fanCloexec|fanNonblock|fanClassNotif|fanReportFID|fanReportDFIDName,
+		unix.O_RDONLY|unix.O_LARGEFILE|unix.O_CLOEXEC)
+	if err != nil {
+		return nil, fanotifyInitError(err)
+	}
+
+	r := &fanotifyReader{
+		mountFDs: map[[2]int32]int{},
+		config:   c,
+		log:      logp.NewLogger(moduleName),
+	}
+	for _, p := range c.Paths {
+		if err = r.addMark(fd, p); err != nil {
+			r.closeMountFDs()
+			unix.Close(fd)
+			return nil, err
+		}
+	}
+
+	r.file = os.NewFile(uintptr(fd), "fanotify")
+	return r, nil
+}
+
+// fanotifyInitError explains why fanotify_init failed.
+func fanotifyInitError(err error) error {
+	switch err {
+	case unix.EPERM:
+		return errors.Wrap(err, "fanotify_init failed, the CAP_SYS_ADMIN capability is required")
+	case unix.ENOSYS:
+		return errors.Wrap(err, "fanotify_init failed, the kernel was built without fanotify support")
+	case unix.EINVAL:
+		// FAN_REPORT_FID is supported since Linux 5.1, but directory file
+		// handles and entry names are only reported since Linux 5.9.
+		if fd, probeErr := fanotifyInit(fanCloexec|fanClassNotif|fanReportFID, unix.O_RDONLY); probeErr == nil {
+			unix.Close(fd)
+			return errors.Wrap(err, "fanotify_init failed, the kernel supports "+
+				"FAN_REPORT_FID but not FAN_REPORT_DFID_NAME, Linux 5.9 or newer is required")
+		}
+		return errors.Wrap(err, "fanotify_init failed, Linux 5.9 or newer is required")
+	}
+	return errors.Wrap(err, "fanotify_init failed")
+}
+
+// addMark watches the filesystem containing path unless it's already watched.
+func (r *fanotifyReader) addMark(fd int, path string) error {
+	var st unix.Statfs_t
+	if err := unix.Statfs(path, &st); err != nil {
+		// Same as with fsnotify, paths that don't exist are not watched.
+		r.log.Warnw("Failed to add watch", "file_path", path, "error", err)
+		return nil
+	}
+	if _, found := r.mountFDs[st.Fsid.Val]; found {
+		return nil
+	}
+
+	if err := fanotifyMark(fd, fanMarkAdd|fanMarkFilesystem, fanotifyEventMask, unix.AT_FDCWD, path); err != nil {
+		return errors.Wrapf(err, "fanotify_mark failed for %v", path)
+	}
+
+	// Needed to open the file handles reported in events.
+	mountFD, err := unix.Open(path, unix.O_RDONLY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open %v", path)
+	}
+	r.mountFDs[st.Fsid.Val] = mountFD
+	return nil
+}
+
+func (r *fanotifyReader) Start(done <-chan struct{}) (<-chan Event, error) {
+	r.eventC = make(chan Event, 1)
+	go r.consumeEvents(done)
+
+	r.log.Infow("Started fanotify watcher",
+		"file_path", r.config.Paths,
+		"recursive", r.config.Recursive)
+	return r.eventC, nil
+}
+
+func (r *fanotifyReader) consumeEvents(done <-chan struct{}) {
+	defer close(r.eventC)
+	defer r.closeMountFDs()
+
+	// Closing the file unblocks the pending read.
+	go func() {
+		<-done
+		r.file.Close()
+	}()
+
+	buf := make([]byte, fanotifyReadBufferSize)
+	for {
+		n, err := r.file.Read(buf)
+		if err != nil {
+			select {
+			case <-done:
+				r.log.Debug("fanotify reader terminated")
+			default:
+				r.log.Errorw("Failed to read fanotify events", "error", err)
+			}
+			return
+		}
+
+		events, err := parseFanotifyEvents(buf[:n])
+		if err != nil {
+			r.log.Warnw("Failed to parse fanotify events", "error", err)
+		}
+
+		for _, fe := range events {
+			e := r.newEvent(fe)
+			if e == nil {
+				continue
+			}
+
+			select {
+			case r.eventC <- *e:
+			case <-done:
+				return
+			}
+		}
+	}
+}
+
+// newEvent returns the Event for the given fanotify event or nil if the event
+// must not be reported.
+func (r *fanotifyReader) newEvent(fe fanotifyEvent) *Event {
+	if fe.mask&fanQOverflow != 0 {
+		r.log.Warn("fanotify event queue overflowed, some file changes were not reported")
+		return nil
+	}
+	// Ignore changes made by Auditbeat itself (e.g. to its datastore).
+	if int(fe.pid) == os.Getpid() || fe.handle == nil {
+		return nil
+	}
+
+	path, err := r.resolvePath(fe)
+	if err != nil {
+		// Typically the directory has been removed since the event was queued.
+		r.log.Debugw("Failed to resolve fanotify event path", "error", err)
+		return nil
+	}
+	if !r.isWatchedPath(path) || r.config.IsExcludedPath(path) ||
+		!r.config.IsIncludedPath(path) {
+		return nil
+	}
+	r.log.Debugw("Received fanotify event",
+		"file_path", path,
+		"event_flags", fmt.Sprintf("%#x", fe.mask),
+		"pid", fe.pid)
+
+	start := time.Now()
+	e := NewEvent(path, fanotifyMaskToAction(fe.mask), SourceFSNotify,
+		r.config.MaxFileSizeBytes, r.config.HashTypes)
+	e.Process = newProcess(int(fe.pid))
+	e.rtt = time.Since(start)
+
+	return &e
+}
+
+// resolvePath returns the path of the file referred by the event.
+func (r *fanotifyReader) resolvePath(fe fanotifyEvent) (string, error) {
+	mountFD, found := r.mountFDs[fe.fsid]
+	if !found {
+		return "", errors.Errorf("event for unknown filesystem %v", fe.fsid)
+	}
+
+	fd, err := openByHandleAt(mountFD, fe.handle, unix.O_PATH|unix.O_CLOEXEC)
+	if err != nil {
+		return "", errors.Wrap(err, "open_by_handle_at failed")
+	}
+	defer unix.Close(fd)
+
+	path, err := os.Readlink("/proc/self/fd/" + strconv.Itoa(fd))
+	if err != nil {
+		return "", err
+	}
+	path = strings.TrimSuffix(path, " (deleted)")
+
+	if fe.name == "" || fe.name == "." {
+		return path, nil
+	}
+	return filepath.Join(path, fe.name), nil
+}
+
+// isWatchedPath returns true if path is one of the configured paths or, like
+// with fsnotify watches, is contained in one (at any depth when recursive).
+func (r *fanotifyReader) isWatchedPath(path string) bool {
+	for _, p := range r.config.Paths {
+		if path == p {
+			return true
+		}
+
+		prefix := p
+		if !strings.HasSuffix(prefix, "/") {
+			prefix += "/"
+		}
+		if strings.HasPrefix(path, prefix) &&
+			(r.config.Recursive || !strings.Contains(path[len(prefix):], "/")) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *fanotifyReader) closeMountFDs() {
+	for fsid, fd := range r.mountFDs {
+		unix.Close(fd)
+		delete(r.mountFDs, fsid)
+	}
+}
+
+func fanotifyMaskToAction(mask uint64) Action {
+	var action Action
+	if mask&(fanCreate|fanMovedTo) != 0 {
+		action |= Created
+	}
+	if mask&fanDelete != 0 {
+		action |= Deleted
+	}
+	if mask&fanMovedFrom != 0 {
+		action |= Moved
+	}
+	if mask&fanModify != 0 {
+		action |= Updated
+	}
+	if mask&fanAttrib != 0 {
+		action |= AttributesModified
+	}
+	return action
+}
+
+// newProcess returns the Process with the given PID. The executable is empty
+// if the process already exited.
+func newProcess(pid int) *Process {
+	p := &Process{PID: pid}
+	p.Executable, _ = os.Readlink(filepath.Join("/proc", strconv.Itoa(pid), "exe"))
+	return p
+}
+
+// fanotifyEvent is a decoded fanotify event.
+type fanotifyEvent struct {
+	mask uint64
+	pid  int32
+	fsid [2]int32
+	// The struct file_handle of the directory containing the file, or of the
+	// file itself when name is empty.
+	handle []byte
+	name   string
+}
+
+// parseFanotifyEvents decodes the events read from a fanotify file descriptor
+// initialized with FAN_REPORT_FID and FAN_REPORT_DFID_NAME. The directory
+// handle and name are preferred over the file handle so that the path of
+// deleted files can be resolved.
+func parseFanotifyEvents(buf []byte) ([]fanotifyEvent, error) {
+	var events []fanotifyEvent
+	for len(buf) >= fanEventMetadataLen {
+		eventLen := int(nativeEndian.Uint32(buf))
+		if eventLen < fanEventMetadataLen || eventLen > len(buf) {
+			return events, errors.Errorf("invalid fanotify event length %d", eventLen)
+		}
+		if version := buf[4]; version != fanotifyMetadataVersion {
+			return events, errors.Errorf("unsupported fanotify metadata version %d", version)
+		}
+		metadataLen := int(nativeEndian.Uint16(buf[6:]))
+		if metadataLen < fanEventMetadataLen || metadataLen > eventLen {
+			return events, errors.Errorf("invalid fanotify metadata length %d", metadataLen)
+		}
+
+		event := fanotifyEvent{
+			mask: nativeEndian.Uint64(buf[8:]),
+			pid:  int32(nativeEndian.Uint32(buf[20:])),
+		}
+
+		var fileFSID [2]int32
+		var fileHandle []byte
+		for info := buf[metadataLen:eventLen]; len(info) >= 4; {
+			infoLen := int(nativeEndian.Uint16(info[2:]))
+			if infoLen < 4 || infoLen > len(info) {
+				return events, errors.Errorf("invalid fanotify info record length %d", infoLen)
+			}
+
+			switch infoType := info[0]; infoType {
+			case fanEventInfoTypeFID, fanEventInfoTypeDFIDName, fanEventInfoTypeDFID:
+				fsid, handle, name, err := parseFIDRecord(info[:infoLen], infoType == fanEventInfoTypeDFIDName)
+				if err != nil {
+					return events, err
+				}
+				if infoType == fanEventInfoTypeFID {
+					fileFSID, fileHandle = fsid, handle
+				} else {
+					event.fsid, event.handle, event.name = fsid, handle, name
+					if event.name == "" {
+						event.name = "."
+					}
+				}
+			}
+			info = info[infoLen:]
+		}
+		if event.handle == nil {
+			event.fsid, event.handle = fileFSID, fileHandle
+		}
+
+		events = append(events, event)
+		buf = buf[eventLen:]
+	}
+	return events, nil
+}
+
+// parseFIDRecord decodes a struct fanotify_event_info_fid record. The returned
+// handle is a copy of the record's struct file_handle.
+func parseFIDRecord(rec []byte, hasName bool) (fsid [2]int32, handle []byte, name string, err error) {
+	if len(rec) < fanEventInfoFIDHeaderLen+fileHandleHeaderLen {
+		return fsid, nil, "", errors.Errorf("fanotify fid record too short (%d bytes)", len(rec))
+	}
+	fsid[0] = int32(nativeEndian.Uint32(rec[4:]))
+	fsid[1] = int32(nativeEndian.Uint32(rec[8:]))
+
+	rec = rec[fanEventInfoFIDHeaderLen:]
+	handleLen := fileHandleHeaderLen + int(nativeEndian.Uint32(rec))
+	if handleLen > len(rec) {
+		return fsid, nil, "", errors.Errorf("invalid file handle length %d", handleLen)
+	}
+	handle = append([]byte(nil), rec[:handleLen]...)
+
+	if hasName {
+		name = string(rec[handleLen:])
+		if i := strings.IndexByte(name, 0); i >= 0 {
+			name = name[:i]
+		}
+	}
+	return fsid, handle, name, nil
+}
+
+func fanotifyInit(flags, eventFlags uint) (int, error) {
+	fd, _, errno := unix.Syscall(unix.SYS_FANOTIFY_INIT, uintptr(flags), uintptr(eventFlags), 0)
+	if errno != 0 {
+		return -1, errno
+	}
+	return int(fd), nil
+}
+
+func fanotifyMark(fd int, flags uint, mask uint64, dirFD int, path string) error {
+	p, err := unix.BytePtrFromString(path)
+	if err != nil {
+		return err
+	}
+
+	var errno unix.Errno
+	if unsafe.Sizeof(uintptr(0)) == 8 {
+		_, _, errno = unix.Syscall6(unix.SYS_FANOTIFY_MARK, uintptr(fd), uintptr(flags),
+			uintptr(mask), uintptr(dirFD), uintptr(unsafe.Pointer(p)), 0)
+	} else {
+		// On 32-bit architectures the mask is split in two arguments.
+		first, second := uint32(mask), uint32(mask>>32)
+		if nativeEndian == binary.ByteOrder(binary.BigEndian) {
+			first, second = second, first
+		}
+		_, _, errno = unix.Syscall6(unix.SYS_FANOTIFY_MARK, uintptr(fd), uintptr(flags),
+			uintptr(first), uintptr(second), uintptr(dirFD), uintptr(unsafe.Pointer(p)))
+	}
+	if errno != 0 {
+		return errno
+	}
+	return nil
+}
+
+func openByHandleAt(mountFD int, handle []byte, flags int) (int, error) {
+	fd, _, errno := unix.Syscall(unix.SYS_OPEN_BY_HANDLE_AT, uintptr(mountFD),
+		uintptr(unsafe.Pointer(&handle[0])), uintptr(flags))
+	if errno != 0 {
+		return -1, errno
+	}
+	return int(fd), nil
+}
+
+func getNativeEndian() binary.ByteOrder {
+	var buf [2]byte
+	*(*uint16)(unsafe.Pointer(&buf[0])) = 1
+	if buf[0] == 1 {
+		return binary.LittleEndian
+	}
+	return binary.BigEndian
+}
@@ -0,0 +1,183 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build linux
+
+package file_integrity
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseFanotifyEvents(t *testing.T) {
+	handle := []byte{
+		4, 0, 0, 0, // handle_bytes
+		1, 0, 0, 0, // handle_type
+		0xde, 0xad, 0xbe, 0xef, // f_handle
+	}
+
+	var buf []byte
+	// Event with a directory handle and name.
+	buf = appendFanotifyEvent(buf, fanCreate, 42,
+		fanotifyInfoRecord(fanEventInfoTypeDFIDName, [2]int32{1, 2}, handle, "file.txt"),
+		fanotifyInfoRecord(fanEventInfoTypeFID, [2]int32{1, 2}, handle, ""))
+	// Event for a directory itself.
+	buf = appendFanotifyEvent(buf, fanAttrib|fanOnDir, 43,
+		fanotifyInfoRecord(fanEventInfoTypeDFID, [2]int32{1, 2}, handle, ""))
+	// Event with only a file handle.
+	buf = appendFanotifyEvent(buf, fanModify, 44,
+		fanotifyInfoRecord(fanEventInfoTypeFID, [2]int32{3, 4}, handle, ""))
+
+	events, err := parseFanotifyEvents(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !assert.Len(t, events, 3) {
+		return
+	}
+
+	assert.Equal(t, fanotifyEvent{mask: fanCreate, pid: 42, fsid: [2]int32{1, 2}, handle: handle, name: "file.txt"}, events[0])
+	assert.Equal(t, fanotifyEvent{mask: fanAttrib | fanOnDir, pid: 43, fsid: [2]int32{1, 2}, handle: handle, name: "."}, events[1])
+	assert.Equal(t, fanotifyEvent{mask: fanModify, pid: 44, fsid: [2]int32{3, 4}, handle: handle}, events[2])
+
+	// Truncated buffer.
+	events, err = parseFanotifyEvents(buf[:len(buf)-1])
+	assert.Error(t, err)
+	assert.Len(t, events, 2)
+}
+
+func TestFanotifyMaskToAction(t *testing.T) {
+	assert.EqualValues(t, Created, fanotifyMaskToAction(fanCreate))
+	assert.EqualValues(t, Created, fanotifyMaskToAction(fanMovedTo))
+	assert.EqualValues(t, Moved, fanotifyMaskToAction(fanMovedFrom))
+	assert.EqualValues(t, Deleted, fanotifyMaskToAction(fanDelete|fanOnDir))
+	assert.EqualValues(t, Updated|AttributesModified, fanotifyMaskToAction(fanModify|fanAttrib))
+}
+
+func TestFanotifyReader(t *testing.T) {
+	dir, err := ioutil.TempDir("", "audit-fanotify")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if dir, err = filepath.EvalSymlinks(dir); err != nil {
+		t.Fatal(err)
+	}
+
+	config := defaultConfig
+	config.Paths = []string{dir}
+	r, err := newFanotifyReader(config)
+	if err != nil {
+		t.Skip("fanotify not available:", err)
+	}
+
+	done := make(chan struct{})
+	defer close(done)
+	events, err := r.Start(done)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Changes made by this process are ignored so use an external one.
+	sh, err := exec.LookPath("sh")
+	if err != nil {
+		t.Skip("sh not found")
+	}
+	sh, _ = filepath.EvalSymlinks(sh)
+	txt := filepath.Join(dir, "test.txt")
+
+	mustRun(t, "created", func(t *testing.T) {
+		if err := exec.Command(sh, "-c", "echo hello > "+txt).Run(); err != nil {
+			t.Fatal(err)
+		}
+
+		event := readTimeout(t, events)
+		assert.Equal(t, txt, event.Path)
+		assert.EqualValues(t, Created, event.Action&Created)
+		if assert.NotNil(t, event.Process) {
+			assert.NotZero(t, event.Process.PID)
+		}
+	})
+
+	mustRun(t, "deleted", func(t *testing.T) {
+		if err := exec.Command(sh, "-c", "rm "+txt).Run(); err != nil {
+			t.Fatal(err)
+		}
+
+		// Skip the events for the write made after the file creation.
+		event := readTimeout(t, events)
+		for event.Action&Deleted == 0 {
+			event = readTimeout(t, events)
+		}
+		assert.Equal(t, txt, event.Path)
+		assert.Nil(t, event.Info)
+	})
+
+	mustRun(t, "unwatched path", func(t *testing.T) {
+		other, err := ioutil.TempDir("", "audit-unwatched")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(other)
+
+		if err := exec.Command(sh, "-c", "echo hello > "+filepath.Join(other, "test.txt")+
+			"; echo hello > "+txt).Run(); err != nil {
+			t.Fatal(err)
+		}
+
+		event := readTimeout(t, events)
+		assert.Equal(t, txt, event.Path)
+	})
+}
+
+func fanotifyInfoRecord(infoType byte, fsid [2]int32, handle []byte, name string) []byte {
+	rec := make([]byte, fanEventInfoFIDHeaderLen, 64)
+	rec[0] = infoType
+	nativeEndian.PutUint32(rec[4:], uint32(fsid[0]))
+	nativeEndian.PutUint32(rec[8:], uint32(fsid[1]))
+	rec = append(rec, handle...)
+	if name != "" {
+		rec = append(rec, name...)
+		rec = append(rec, 0)
+	}
+	// Records are padded to 4 bytes.
+	for len(rec)%4 != 0 {
+		rec = append(rec, 0)
+	}
+	nativeEndian.PutUint16(rec[2:], uint16(len(rec)))
+	return rec
+}
+
+func appendFanotifyEvent(buf []byte, mask uint64, pid int32, info ...[]byte) []byte {
+	event := make([]byte, fanEventMetadataLen)
+	event[4] = fanotifyMetadataVersion
+	nativeEndian.PutUint16(event[6:], fanEventMetadataLen)
+	nativeEndian.PutUint64(event[8:], mask)
+	nativeEndian.PutUint32(event[16:], 0xffffffff) // FAN_NOFD
+	nativeEndian.PutUint32(event[20:], uint32(pid))
+	for _, rec := range info {
+		event = append(event, rec...)
+	}
+	nativeEndian.PutUint32(event, uint32(len(event)))
+	return append(buf, event...)
+}
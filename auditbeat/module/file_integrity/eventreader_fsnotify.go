@@ -37,8 +37,18 @@ type reader struct {
 	log     *logp.Logger
 }
 
-// NewEventReader creates a new EventProducer backed by fsnotify.
+// NewEventReader creates a new EventProducer backed by fsnotify, or by
+// fanotify when configured and supported by the system.
 func NewEventReader(c Config) (EventProducer, error) {
+	if c.Backend == BackendFanotify {
+		r, err := newFanotifyReader(c)
+		if err == nil {
+			return r, nil
+		}
+		logp.NewLogger(moduleName).Infow("fanotify backend not available, "+
+			"falling back to fsnotify", "reason", err)
+	}
+
 	watcher, err := monitor.New(c.Recursive)
 	if err != nil {
 		return nil, err
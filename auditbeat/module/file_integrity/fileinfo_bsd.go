@@ -29,3 +29,9 @@ func fileTimes(stat *syscall.Stat_t) (atime, mtime, ctime time.Time) {
 		time.Unix(0, stat.Mtimespec.Nano()).UTC(),
 		time.Unix(0, stat.Mtimespec.Nano()).UTC()
 }
+
+// getExtendedAttributes is not supported in this platform and always returns
+// no attributes and no error.
+func getExtendedAttributes(path string) (map[string][]byte, error) {
+	return nil, nil
+}
@@ -20,8 +20,12 @@
 package file_integrity
 
 import (
+	"strings"
 	"syscall"
 	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
 )
 
 func fileTimes(stat *syscall.Stat_t) (atime, mtime, ctime time.Time) {
@@ -29,3 +33,57 @@ func fileTimes(stat *syscall.Stat_t) (atime, mtime, ctime time.Time) {
 		time.Unix(0, stat.Mtim.Nano()).UTC(),
 		time.Unix(0, stat.Ctim.Nano()).UTC()
 }
+
+// getExtendedAttributes returns the extended attributes of path without
+// following symlinks. POSIX ACLs are stored by the kernel in the
+// system.posix_acl_access and system.posix_acl_default attributes so they are
+// part of the result. Filesystems that don't support extended attributes
+// yield nil and no error, files without attributes an empty map.
+func getExtendedAttributes(path string) (map[string][]byte, error) {
+	size, err := unix.Llistxattr(path, nil)
+	if err != nil {
+		if err == unix.ENOTSUP {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to list extended attributes of %v", path)
+	}
+	if size == 0 {
+		return map[string][]byte{}, nil
+	}
+
+	buf := make([]byte, size)
+	if size, err = unix.Llistxattr(path, buf); err != nil {
+		return nil, errors.Wrapf(err, "failed to list extended attributes of %v", path)
+	}
+
+	xattrs := map[string][]byte{}
+	for _, name := range strings.Split(string(buf[:size]), "\x00") {
+		if name == "" {
+			continue
+		}
+
+		value, err := getExtendedAttribute(path, name)
+		if err != nil {
+			if err == unix.ENODATA {
+				// Removed since the attributes were listed.
+				continue
+			}
+			return xattrs, errors.Wrapf(err, "failed to get extended attribute %v of %v", name, path)
+		}
+		xattrs[name] = value
+	}
+	return xattrs, nil
+}
+
+func getExtendedAttribute(path, name string) ([]byte, error) {
+	size, err := unix.Lgetxattr(path, name, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	value := make([]byte, size)
+	if size, err = unix.Lgetxattr(path, name, value); err != nil {
+		return nil, err
+	}
+	return value[:size], nil
+}
@@ -0,0 +1,56 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build linux
+
+package file_integrity
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/sys/unix"
+)
+
+func TestNewMetadataExtendedAttributes(t *testing.T) {
+	f, err := ioutil.TempFile("", "xattrs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	f.Close()
+
+	if err = unix.Lsetxattr(f.Name(), "user.beats", []byte("auditbeat"), 0); err != nil {
+		if err == unix.ENOTSUP || err == unix.EPERM {
+			t.Skip("user extended attributes not supported on the temp dir:", err)
+		}
+		t.Fatal(err)
+	}
+
+	info, err := os.Lstat(f.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	meta, err := NewMetadata(f.Name(), info)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []byte("auditbeat"), meta.XAttrs["user.beats"])
+}
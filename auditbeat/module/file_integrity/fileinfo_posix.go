@@ -76,5 +76,8 @@ func NewMetadata(path string, info os.FileInfo) (*Metadata, error) {
 	if fileInfo.Origin, err = GetFileOrigin(path); err != nil {
 		errs = append(errs, err)
 	}
+	if fileInfo.XAttrs, err = getExtendedAttributes(path); err != nil {
+		errs = append(errs, err)
+	}
 	return fileInfo, errs.Err()
 }
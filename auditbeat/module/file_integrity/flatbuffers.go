@@ -19,6 +19,7 @@ package file_integrity
 
 import (
 	"os"
+	"sort"
 	"sync"
 	"time"
 
@@ -128,6 +129,7 @@ func fbWriteMetadata(b *flatbuffers.Builder, m *Metadata) flatbuffers.UOffsetT {
 	if m.SID != "" {
 		sidOffset = b.CreateString(m.SID)
 	}
+	xattrsOffset := fbWriteXAttrs(b, m.XAttrs)
 
 	schema.MetadataStart(b)
 	schema.MetadataAddInode(b, m.Inode)
@@ -159,9 +161,45 @@ func fbWriteMetadata(b *flatbuffers.Builder, m *Metadata) flatbuffers.UOffsetT {
 	case SymlinkType:
 		schema.MetadataAddType(b, schema.TypeSymlink)
 	}
+	if xattrsOffset > 0 {
+		schema.MetadataAddXattrs(b, xattrsOffset)
+	}
 	return schema.MetadataEnd(b)
 }
 
+// fbWriteXAttrs writes the extended attributes. An empty vector is written if
+// the attributes were read but the file has none, such that it can be told
+// apart from events stored without extended attributes.
+func fbWriteXAttrs(b *flatbuffers.Builder, xattrs map[string][]byte) flatbuffers.UOffsetT {
+	if xattrs == nil {
+		return 0
+	}
+
+	// Sort by name so the encoding is deterministic.
+	names := make([]string, 0, len(xattrs))
+	for name := range xattrs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	offsets := make([]flatbuffers.UOffsetT, 0, len(names))
+	for _, name := range names {
+		nameOffset := b.CreateString(name)
+		valueOffset := b.CreateByteVector(xattrs[name])
+
+		schema.XAttrStart(b)
+		schema.XAttrAddName(b, nameOffset)
+		schema.XAttrAddValue(b, valueOffset)
+		offsets = append(offsets, schema.XAttrEnd(b))
+	}
+
+	schema.MetadataStartXattrsVector(b, len(offsets))
+	for i := len(offsets) - 1; i >= 0; i-- {
+		b.PrependUOffsetT(offsets[i])
+	}
+	return b.EndVector(len(offsets))
+}
+
 func fbWriteEvent(b *flatbuffers.Builder, e *Event) flatbuffers.UOffsetT {
 	if e == nil {
 		return 0
@@ -268,9 +306,29 @@ func fbDecodeMetadata(e *schema.Event) *Metadata {
 		rtn.Type = UnknownType
 	}
 
+	if fbHasXAttrs(info) {
+		n := info.XattrsLength()
+		rtn.XAttrs = make(map[string][]byte, n)
+
+		var xattr schema.XAttr
+		for i := 0; i < n; i++ {
+			if info.Xattrs(&xattr, i) {
+				rtn.XAttrs[string(xattr.Name())] = append([]byte{}, xattr.ValueBytes()...)
+			}
+		}
+	}
+
 	return rtn
 }
 
+// fbHasXAttrs returns true if the xattrs field is set. It is not set for
+// events stored by versions that didn't collect extended attributes.
+func fbHasXAttrs(info *schema.Metadata) bool {
+	const xattrsField = 4 + 2*9 // vtable offset of field 9 (xattrs)
+	tab := info.Table()
+	return tab.Offset(xattrsField) != 0
+}
+
 func fbDecodeHash(e *schema.Event) map[HashType]Digest {
 	hash := e.Hashes(nil)
 	if hash == nil {
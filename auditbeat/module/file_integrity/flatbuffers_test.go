@@ -42,6 +42,44 @@ func TestFBEncodeDecode(t *testing.T) {
 	assert.Equal(t, e, out)
 }
 
+func TestFBEncodeDecodeEmptyXAttrs(t *testing.T) {
+	e := testEvent()
+	e.Info.XAttrs = map[string][]byte{}
+
+	builder, release := fbGetBuilder()
+	defer release()
+	out := fbDecodeEvent(e.Path, fbEncodeEvent(builder, e))
+	if out == nil {
+		t.Fatal("decode returned nil")
+	}
+
+	assert.NotNil(t, out.Info.XAttrs)
+	assert.Empty(t, out.Info.XAttrs)
+}
+
+// Events stored by versions that didn't collect extended attributes must not
+// be reported as modified because the file has extended attributes (e.g. an
+// SELinux label).
+func TestFBDecodeStoredWithoutXAttrs(t *testing.T) {
+	stored := testEvent()
+	stored.Info.XAttrs = nil
+
+	builder, release := fbGetBuilder()
+	defer release()
+	old := fbDecodeEvent(stored.Path, fbEncodeEvent(builder, stored))
+	if old == nil {
+		t.Fatal("decode returned nil")
+	}
+	assert.Nil(t, old.Info.XAttrs)
+
+	e := testEvent()
+	e.Info.XAttrs["security.selinux"] = []byte("system_u:object_r:etc_t:s0\x00")
+
+	action, changed := diffEvents(old, e)
+	assert.False(t, changed)
+	assert.Zero(t, action)
+}
+
 func BenchmarkFBEncodeEvent(b *testing.B) {
 	builder, release := fbGetBuilder()
 	defer release()
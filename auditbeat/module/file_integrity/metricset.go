@@ -24,6 +24,7 @@ import (
 	"time"
 
 	bolt "github.com/coreos/bbolt"
+	"github.com/joeshaw/multierror"
 	"github.com/pkg/errors"
 
 	"github.com/elastic/beats/auditbeat/datastore"
@@ -37,6 +38,10 @@ const (
 	metricsetName = "file"
 	bucketName    = "file.v1"
 
+	// contentBucketName is the bucket holding the content of the files that
+	// are eligible for diffs (see diff.enabled).
+	contentBucketName = "file.content.v1"
+
 	// Use old namespace for data until we do some field renaming for GA.
 	namespace = "."
 )
@@ -69,10 +74,11 @@ type MetricSet struct {
 	log     *logp.Logger
 
 	// Runtime params that are initialized on Run().
-	bucket       datastore.BoltBucket
-	scanStart    time.Time
-	scanChan     <-chan Event
-	fsnotifyChan <-chan Event
+	bucket        datastore.BoltBucket
+	contentBucket datastore.Bucket // Only set when diffs are enabled.
+	scanStart     time.Time
+	scanChan      <-chan Event
+	fsnotifyChan  <-chan Event
 }
 
 // New returns a new file.MetricSet.
@@ -133,10 +139,18 @@ func (ms *MetricSet) Run(reporter mb.PushReporterV2) {
 
 // Close cleans up the MetricSet when it finishes.
 func (ms *MetricSet) Close() error {
+	var errs multierror.Errors
+	if ms.contentBucket != nil {
+		if err := ms.contentBucket.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
 	if ms.bucket != nil {
-		return ms.bucket.Close()
+		if err := ms.bucket.Close(); err != nil {
+			errs = append(errs, err)
+		}
 	}
-	return nil
+	return errs.Err()
 }
 
 func (ms *MetricSet) init(reporter mb.PushReporterV2) bool {
@@ -149,6 +163,16 @@ func (ms *MetricSet) init(reporter mb.PushReporterV2) bool {
 	}
 	ms.bucket = bucket.(datastore.BoltBucket)
 
+	if ms.config.Diff.Enabled {
+		ms.contentBucket, err = datastore.OpenBucket(contentBucketName)
+		if err != nil {
+			err = errors.Wrap(err, "failed to open persistent datastore")
+			reporter.Error(err)
+			ms.log.Errorw("Failed to initialize", "error", err)
+			return false
+		}
+	}
+
 	ms.fsnotifyChan, err = ms.reader.Start(reporter.Done())
 	if err != nil {
 		err = errors.Wrap(err, "failed to start fsnotify event producer")
@@ -215,6 +239,9 @@ func (ms *MetricSet) reportEvent(reporter mb.PushReporterV2, event *Event) bool
 	}
 
 	changed, lastEvent := ms.hasFileChangedSinceLastEvent(event)
+	if ms.contentBucket != nil {
+		ms.updateContent(event)
+	}
 	if changed {
 		// Publish event if it changed.
 		if ok := reporter.Event(buildMetricbeatEvent(event, lastEvent != nil)); !ok {
@@ -256,6 +283,51 @@ func (ms *MetricSet) hasFileChangedSinceLastEvent(event *Event) (changed bool, l
 	return changed, lastEvent
 }
 
+// updateContent persists the content of the file if it is eligible for diffs
+// and sets the event's Diff when it differs from the previously persisted
+// content. Content belonging to files that are no longer eligible (deleted,
+// grown too large, or binary) is removed.
+func (ms *MetricSet) updateContent(event *Event) {
+	previous, err := loadContent(ms.contentBucket, event.Path)
+	if err != nil {
+		ms.log.Warnw("Failed during DB load", "error", err)
+	}
+
+	var content []byte
+	if info := event.Info; info != nil && info.Type == FileType && info.Size <= ms.config.Diff.MaxFileSizeBytes {
+		if content, err = readTextFile(event.Path, ms.config.Diff.MaxFileSizeBytes); err != nil {
+			ms.log.Debugw("Failed to read file content", "file_path", event.Path, "error", err)
+			return
+		}
+	}
+
+	if content == nil {
+		if previous != nil {
+			ms.deleteContent(event.Path)
+		}
+		return
+	}
+
+	if previous != nil && bytes.Equal(previous, content) {
+		return
+	}
+
+	if previous != nil {
+		if event.Diff, err = unifiedDiff(event.Path, previous, content); err != nil {
+			ms.log.Warnw("Failed to diff file content", "file_path", event.Path, "error", err)
+		}
+	}
+	if err = ms.contentBucket.Store(event.Path, content); err != nil {
+		ms.log.Errorw("Failed during DB store", "error", err)
+	}
+}
+
+func (ms *MetricSet) deleteContent(path string) {
+	if err := ms.contentBucket.Delete(path); err != nil {
+		ms.log.Errorw("Failed during DB delete", "error", err)
+	}
+}
+
 func (ms *MetricSet) purgeDeleted(reporter mb.PushReporterV2) {
 	for _, prefix := range ms.config.Paths {
 		deleted, err := ms.purgeOlder(ms.scanStart, prefix)
@@ -265,6 +337,10 @@ func (ms *MetricSet) purgeDeleted(reporter mb.PushReporterV2) {
 		}
 
 		for _, e := range deleted {
+			if ms.contentBucket != nil {
+				ms.deleteContent(e.Path)
+			}
+
 			// Don't persist!
 			if !ms.config.IsExcludedPath(e.Path) {
 				reporter.Event(buildMetricbeatEvent(e, true))
@@ -349,3 +425,17 @@ func load(b datastore.Bucket, path string) (*Event, error) {
 	}
 	return e, nil
 }
+
+// loadContent loads the persisted content of a file from the datastore. It
+// returns nil if no content was persisted for the path.
+func loadContent(b datastore.Bucket, path string) ([]byte, error) {
+	var content []byte
+	err := b.Load(path, func(blob []byte) error {
+		content = append([]byte{}, blob...)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load locally persisted content for %v", path)
+	}
+	return content, nil
+}
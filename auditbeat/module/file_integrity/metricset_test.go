@@ -223,6 +223,75 @@ func TestExcludedFiles(t *testing.T) {
 	}
 }
 
+func TestContentDiff(t *testing.T) {
+	defer abtest.SetupDataDir(t)()
+
+	bucket, err := datastore.OpenBucket(bucketName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bucket.Close()
+
+	contentBucket, err := datastore.OpenBucket(contentBucketName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer contentBucket.Close()
+
+	dir, err := ioutil.TempDir("", "audit-file")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	dir, err = filepath.EvalSymlinks(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Simulate a previous run that saw different content.
+	configFile := filepath.Join(dir, "app.conf")
+	if err = ioutil.WriteFile(configFile, []byte("listen = 127.0.0.1\ndebug = false\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err = store(bucket, &Event{
+		Timestamp: time.Now().UTC(),
+		Path:      configFile,
+		Action:    Created,
+		Hashes:    map[HashType]Digest{SHA1: sha1.New().Sum([]byte("different string"))},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err = contentBucket.Store(configFile, []byte("listen = 127.0.0.1\n")); err != nil {
+		t.Fatal(err)
+	}
+
+	config := getConfig(dir)
+	config["diff.enabled"] = true
+
+	ms := mbtest.NewPushMetricSetV2(t, config)
+	events := mbtest.RunPushMetricSetV2(10*time.Second, 2, ms)
+
+	var diff interface{}
+	for _, e := range events {
+		if e.Error != nil {
+			t.Fatalf("received error: %+v", e.Error)
+		}
+		if path, _ := e.MetricSetFields.GetValue("file.path"); path == configFile {
+			diff, _ = e.MetricSetFields.GetValue("file.diff")
+		}
+	}
+	if assert.IsType(t, "", diff) {
+		assert.Contains(t, diff, "+debug = false\n")
+	}
+
+	content, err := loadContent(contentBucket, configFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "listen = 127.0.0.1\ndebug = false\n", string(content))
+}
+
 func TestIncludedExcludedFiles(t *testing.T) {
 	defer abtest.SetupDataDir(t)()
 
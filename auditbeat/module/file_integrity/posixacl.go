@@ -0,0 +1,107 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package file_integrity
+
+import (
+	"encoding/binary"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// Names of the extended attributes used by Linux to store POSIX ACLs.
+const (
+	posixACLAccessXAttr  = "system.posix_acl_access"
+	posixACLDefaultXAttr = "system.posix_acl_default"
+)
+
+// Binary format of the POSIX ACL extended attributes (see
+// include/uapi/linux/posix_acl_xattr.h). All values are little-endian.
+const (
+	posixACLXAttrVersion    = 2
+	posixACLXAttrHeaderSize = 4
+	posixACLXAttrEntrySize  = 8
+)
+
+// POSIX ACL entry tags.
+const (
+	aclUserObj  = 0x01
+	aclUser     = 0x02
+	aclGroupObj = 0x04
+	aclGroup    = 0x08
+	aclMask     = 0x10
+	aclOther    = 0x20
+)
+
+// parsePOSIXACL decodes a POSIX ACL as stored in the system.posix_acl_access
+// and system.posix_acl_default extended attributes. The entries are returned
+// in the short text form used by getfacl with numeric IDs (e.g.
+// "user:1000:rw-").
+func parsePOSIXACL(data []byte) ([]string, error) {
+	if len(data) < posixACLXAttrHeaderSize ||
+		(len(data)-posixACLXAttrHeaderSize)%posixACLXAttrEntrySize != 0 {
+		return nil, errors.Errorf("invalid POSIX ACL length %d", len(data))
+	}
+	if version := binary.LittleEndian.Uint32(data); version != posixACLXAttrVersion {
+		return nil, errors.Errorf("unsupported POSIX ACL version %d", version)
+	}
+
+	data = data[posixACLXAttrHeaderSize:]
+	entries := make([]string, 0, len(data)/posixACLXAttrEntrySize)
+	for ; len(data) > 0; data = data[posixACLXAttrEntrySize:] {
+		tag := binary.LittleEndian.Uint16(data)
+		perm := binary.LittleEndian.Uint16(data[2:])
+		id := binary.LittleEndian.Uint32(data[4:])
+
+		var tagName, qualifier string
+		switch tag {
+		case aclUserObj:
+			tagName = "user"
+		case aclUser:
+			tagName = "user"
+			qualifier = strconv.FormatUint(uint64(id), 10)
+		case aclGroupObj:
+			tagName = "group"
+		case aclGroup:
+			tagName = "group"
+			qualifier = strconv.FormatUint(uint64(id), 10)
+		case aclMask:
+			tagName = "mask"
+		case aclOther:
+			tagName = "other"
+		default:
+			return nil, errors.Errorf("unknown POSIX ACL entry tag 0x%x", tag)
+		}
+		entries = append(entries, tagName+":"+qualifier+":"+aclPermString(perm))
+	}
+	return entries, nil
+}
+
+func aclPermString(perm uint16) string {
+	rwx := []byte("---")
+	if perm&0x4 != 0 {
+		rwx[0] = 'r'
+	}
+	if perm&0x2 != 0 {
+		rwx[1] = 'w'
+	}
+	if perm&0x1 != 0 {
+		rwx[2] = 'x'
+	}
+	return string(rwx)
+}
@@ -0,0 +1,81 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package file_integrity
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePOSIXACL(t *testing.T) {
+	t.Run("access", func(t *testing.T) {
+		entries, err := parsePOSIXACL(testPOSIXACL(
+			aclUserObj, 7, 0,
+			aclUser, 5, 1000,
+			aclGroupObj, 5, 0,
+			aclGroup, 2, 20,
+			aclMask, 7, 0,
+			aclOther, 1, 0,
+		))
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, []string{
+			"user::rwx",
+			"user:1000:r-x",
+			"group::r-x",
+			"group:20:-w-",
+			"mask::rwx",
+			"other::--x",
+		}, entries)
+	})
+
+	t.Run("invalid length", func(t *testing.T) {
+		_, err := parsePOSIXACL(testPOSIXACL(aclUserObj, 7, 0)[:10])
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid version", func(t *testing.T) {
+		data := testPOSIXACL(aclUserObj, 7, 0)
+		binary.LittleEndian.PutUint32(data, 1)
+		_, err := parsePOSIXACL(data)
+		assert.Error(t, err)
+	})
+
+	t.Run("unknown tag", func(t *testing.T) {
+		_, err := parsePOSIXACL(testPOSIXACL(0x40, 7, 0))
+		assert.Error(t, err)
+	})
+}
+
+// testPOSIXACL encodes a POSIX ACL extended attribute from a list of
+// (tag, perm, id) triplets.
+func testPOSIXACL(entries ...uint32) []byte {
+	data := make([]byte, posixACLXAttrHeaderSize, posixACLXAttrHeaderSize+len(entries)/3*posixACLXAttrEntrySize)
+	binary.LittleEndian.PutUint32(data, posixACLXAttrVersion)
+	for i := 0; i+2 < len(entries); i += 3 {
+		entry := make([]byte, posixACLXAttrEntrySize)
+		binary.LittleEndian.PutUint16(entry, uint16(entries[i]))
+		binary.LittleEndian.PutUint16(entry[2:], uint16(entries[i+1]))
+		binary.LittleEndian.PutUint32(entry[4:], entries[i+2])
+		data = append(data, entry...)
+	}
+	return data
+}
@@ -147,8 +147,28 @@ func (rcv *Metadata) MutateType(n byte) bool {
 	return rcv._tab.MutateByteSlot(20, n)
 }
 
+func (rcv *Metadata) Xattrs(obj *XAttr, j int) bool {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(22))
+	if o != 0 {
+		x := rcv._tab.Vector(o)
+		x += flatbuffers.UOffsetT(j) * 4
+		x = rcv._tab.Indirect(x)
+		obj.Init(rcv._tab.Bytes, x)
+		return true
+	}
+	return false
+}
+
+func (rcv *Metadata) XattrsLength() int {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(22))
+	if o != 0 {
+		return rcv._tab.VectorLen(o)
+	}
+	return 0
+}
+
 func MetadataStart(builder *flatbuffers.Builder) {
-	builder.StartObject(9)
+	builder.StartObject(10)
 }
 func MetadataAddInode(builder *flatbuffers.Builder, inode uint64) {
 	builder.PrependUint64Slot(0, inode, 0)
@@ -177,6 +197,12 @@ func MetadataAddCtimeNs(builder *flatbuffers.Builder, ctimeNs int64) {
 func MetadataAddType(builder *flatbuffers.Builder, type_ byte) {
 	builder.PrependByteSlot(8, type_, 1)
 }
+func MetadataAddXattrs(builder *flatbuffers.Builder, xattrs flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(9, flatbuffers.UOffsetT(xattrs), 0)
+}
+func MetadataStartXattrsVector(builder *flatbuffers.Builder, numElems int) flatbuffers.UOffsetT {
+	return builder.StartVector(4, numElems, 4)
+}
 func MetadataEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
 	return builder.EndObject()
 }
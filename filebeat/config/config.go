@@ -25,9 +25,12 @@ import (
 	"sort"
 	"time"
 
+	"github.com/dustin/go-humanize"
+
 	"github.com/elastic/beats/libbeat/autodiscover"
 	"github.com/elastic/beats/libbeat/cfgfile"
 	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/cfgtype"
 	"github.com/elastic/beats/libbeat/common/cfgwarn"
 	"github.com/elastic/beats/libbeat/logp"
 	"github.com/elastic/beats/libbeat/paths"
@@ -55,6 +58,10 @@ type Registry struct {
 	Permissions  os.FileMode   `config:"file_permissions"`
 	FlushTimeout time.Duration `config:"flush"`
 	MigrateFile  string        `config:"migrate_file"`
+
+	// LogSize limits the size of the registry update log. All states are
+	// written to a new checkpoint once the limit is exceeded.
+	LogSize cfgtype.ByteSize `config:"log_size"`
 }
 
 var (
@@ -63,6 +70,7 @@ var (
 			Path:        "registry",
 			Permissions: 0600,
 			MigrateFile: "",
+			LogSize:     10 * humanize.MiByte,
 		},
 		ShutdownTimeout:    0,
 		OverwritePipelines: false,
@@ -32,5 +32,5 @@ func init() {
 // AssetFieldsYml returns asset data.
 // This is the base64 encoded gzipped contents of fields.yml.
 func AssetFieldsYml() string {
-	return "eJzs/Xt3GzmSIIr/X58CP/U5P9m9FPWw/Cjt9sxVW64qbfuhsVRT2721RwQzQRKtzEQWgBTNuud+93sQEXjkQxJli27XHdXMaYtkJhAIBALxjj+xX44/vj99/+P/j50oVinLRC4tswtp2EwWguVSi8wWqxGTli25YXNRCc2tyNl0xexCsDevz1mt1T9FZkff/YlNuRE5UxV8fy20kapi++P98d74uz+xs0JwI9i1NNKyhbW1OdrdnUu7aKbjTJW7ouDGymxXZIZZxUwznwtjWbbg1VzAV27YmRRFbsbffbfDrsTqiInMfMeYlbYQR+6B7xjLhcm0rK1UFXzFfqB3GL199B1jO6zipThi2/+XlaUwlpf19neMMVaIa1EcsUxpAZ+1+K2RWuRHzOoGv7KrWhyxnFv82Jpv+4RbsevGZMuFqABN4lpUlikt57Jy6Bt/B+8xduFwLQ08lIf3xCereebQPNOqjCOM3MQy40WxYlrUWhhRWVnNYSIaMU43uGFGNToTYf7TWfIC/sYW3LBKeWgLFtAzQtK45kUjAOgATK3qpnDT0LA02UxqY+H9DlhaZEJeR6hqWYtCVhGuj4Rz3C82U5rxosARzBj3SXziZe02fftgb//Fzt7znYNnF3uvjvaeHz07HL96/uwf28k2F3wqCjO4wbibauqoGL7APy/x+yuxWiqdD2z068ZYVboHdhEnNZfahDW85hWbCta4I2EV43nOSmE5k9VM6ZK7Qdz3tCZ2vlBNkcMxzFRluaxYJYzbOgQHyNf9d1wUuAeGcS2YscohihsPaQDgjUfQJFfZldATxqucTa5emQmho4NJeo/XdSEzjqucKbUz5Zp+EtX1kTvweZO5nxP8lsIYPhe3INiKT3YAiz8ozQo1JzwAOdBYtPmEDfzJPUk/j5iqrSzl74HsHJlcS7F0R0JWjMPT7guhA1LcdMbqJrONQ1uh5oYtpV2oxjJeRapvwTBiyi6EJu7BMtzZTFUZt6JKCN8qB0TJOFs0Ja92tOA5nxaCmaYsuV4xlRy49BSWTWFlXYS1GyY+SeNO/EKs4oTlVFYiZ7KyiqkqPN09ET+JolDsF6WLPNkiy+e3HYCU0OW8Ulpc8qm6Fkdsf+/gsL9zb6Wxbj30ngmUbvmcCZ4t/Crbh/V/b0X62RqxLVFdH2z9n/So8rmokFKIqx+HL+ZaNfUROxigo4uFwDfDLtEpIt7KGZ+6TUYuOLNLd3gc/7Tufpt52q9WDufcHcKicMduxHJh8Q+lmZoaoa/d9iC5KkdmC+V2Smlm+ZUwrBTcNFqU7gEaNjzWPZyGySormlywvwru2ACs1bCSrxgvjGK6qdzbNK82Y7jQYKHjP9NSaUizcDxyKiI7Bsp28HNZGE97iCTdVJU7JwoR5GBL1ufP+3IhdMq8F7yuhaNAt1g4qWGpwNgdAiqixplStlLW7blf7BE7xekyJwioGS4azq07iKMI39iRAiNBZCq4HSfn9/jsHYgkdHG2F0Q7zut61y1FZmLMIm2kzDdXwqMOuC7IGUzOkFqkYe56ZXahVTNfsN8a0bjxzcpYURpWyCvB/sZnV3zEPopcIn3UWmXCGFnN/abQ46bJFo5Jv1VzY7lZMFwHOwd0E8rwIAKRIwqDtBJPh6gXohSaF5fScx06z+KTFVUeeVHvVN94rrtn6Y2fg8ncHZGZFBrJRxpC5BM5Aw4EbMo8DXTtZRp3k+kSpAMvwPFMK+Muf2O5dudp2lg2we2W+QT2w+0EISNhGq/44ez53t6shYju8gM7+6Kl/1zJ35x4c/91h+vWkSgSNry3hHt9KhiQscxvXF7eWp77300skKQWOF8pR+jtoGEcn0J2iFfQXF4LEFt4Ra/h0/TzQhT1rCncIXKHmlYYBrZLxX6gA81kZSyvMhJjOvzIuImBKTkioeuUxetU1FxzEkFo+YZVQuSofywXMlv0pwonO1Olm8yJ18m6T2dO8PWcB5aKLMl/pWZWVKwQM8tEWdtVfytnSrV20W3UJnbxYlXfsn2e27kJmLF8ZRgvlu6fgFsnCpqFJ03cVpLG8V13m48jaqrAswNW47NI4jTFVMRH4AqTs9bGxx3rEkBr80ueLZxK0EdxOo7HMymbG0D1f5Ia20Z2B6YX473x3o7ODlIxxrRkmMaqSpWqMewcroQ75JnjivH4Ct4i7Mnx+VM8mCSdEGCZqioBCuNpZYWuhGVnWlmVqYIgfXJ69pRp1YC6WGsxk5+EYU2VC7zInbCkVeEGc9xNaVYqLVgl7FLpK6Zqp0Yq7QQer+OJBS9m7gXO3H1XCMbzUlbSWHcyr71w5cbKVYmSGLeM1FZcRFmqasSyQnBdrAL2ZyDkBmhVIbMVCJYL4URfWOB47QuzasppEGhuuyoLFW7t1lbQlYDjOD1UZSBcEUS9bSJ5I3wdCJ52kQZ6cnz+/ilrYPBiFW8cg8JzQD2eidPWuhPS23++/+L71oKVnvNK/g7scdy/Rh5MTPiQzANT92D7USlHF2/fvk7ORVbIjnz/On5zi4B/TG+6A+BphBsiCmmlo08kR486OhYOvJkKKiwK7lrMuc5BoHPymqrMKHkehbmpRAuYVE4jnBVqybTInK7TUicvXp/RqHhbRDB7sLkv3OMJZHAojKiCGO+eOf/7e1bz7ErYJ+bpGGZBDbSmY92bCi09TtxqTer1Dw1mLGEcHCQheyxZzSvDAZgxO1elCDJrY1D2t0KXbMubr5TeitquFjPPQQiUqrNAg8eBfibdDHd2KoJuArpZggA6Kg6sau63OU6Rwo9aJhGRn8DdKI1pHEJo1KgUycqB98+mwg0AHQm1Hm9cHBgs4rdStjekE3Zwv3bglHmrTrAF4Xi7fp5gvYPDg+ITz3NmRMkrKzPgx+KTJUlLfEIZeoSCjT+lJshbVrFr6ZYrfxdR4XULFRqUYCNtw2k7TmdspRod5pjxovDE57m043BzpVcj96gXFIyVRcFE5VQ+ols0GTphIhfGOvJwKHUIm8miCEyG17VWtZbcimJ1D2WH57kWxmxKzwFqR82WaIsmJJkksJlyKueNakyxQmqGdwJfXzq0GFUKMJWyQhqwJZ2ejRj3d5/SjDtm/4kZ5ehkzNjfI2ZJdAJbXpSWF4JpvvQwebqfjOmLCaKsLflVTjGOgl3eoC0Pr6vJWNYTB8pkjGBNRiwXtahyEr1RblZVBALUbNqxKNmM/8tdqtyMv9F7NcI4XVlh7hCBk/1AS0j7tRYgf3U/oBUkOCLonNA2ITvro+/VYQswJLYNCOfEV3H8cWvOuVDjTNrV5YYU6ddOth3cnXdOlha86IOjKisrUdlNwfQ+UerDZD343ittF+y4FFpmfADIprJ6dSmNusxUvhHU4RTs9PwDc1P0IHx9fCNYm9pNAmlwQ1/ziud9TAHLulvpnAt1WSsZ7ou2EV1Vc2mbHO/Qglv40INg+/9mW4Wqto7Yzstn4xf7h6+e7Y3YVsHt1hE7fD5+vvf8+/1X7P/Z7gG5QT61/bMResffkclPKIV79IwY2QpQMlIzNte8agqupV2ll92KZe7SBVEwudRe+7ssWGKQwqVGKScTjouTQDwrlNJ0GYzA8rCQUdyMtwaCV7B6sTLS/eE9AZk/1iYB4b2yibcT/BwS9fMSLq25UH61fXvFVBmrqp086+2NFnOpqk2etI8ww20Hbec/Xt8E14aOGsE0eNL+oxFT0UaUrO+AITzQJs7TsyA4eY4Il0VKWWi09AYP74I7Pbs+dF+cnl2/iAJhRwYqebYB3Lw7fn0T1KxlG7bjLl4Gj/UNuLlwKh9qLqdnbiKS4zF+4/3xRVCK2RMxno/J6sKLVHlnqAF6g0zLBRDOSqIHOkUTzHTVnBWK52zKC15lcHRnUoulU0NA79aqcSe6g3G36Fppez+h0ws5xmo5LImm2HDj/1HwgfrmPeS91qrP8O3Pku4O2nD09mQdofPm/TijPbiJ+Bsj9HhIony4iy2Vo9AEpDQaVtzkaIEtBSgcapbs8w/R5zFyGuDbk+MzcPRlYBA9CUORUgg8cLu/OlFyWWxoce7SZjCB5zQD6J01RTHA/x8UiG3D3DQwLVzV/JrLgk+L/rVwXEyFtuyNrIwVtO0teMGKMN6YQ7TvFJyRAxwmDn4LUEV364JbR+YDeEU4N4jYlHJxsj4QC24WGxMJEVNwTNw8jpVkSmvh+GvL+z5Diwicp4rxSlWrNJYHOUVytn42gjyLE1iFzNGSAR/c6iYh4iNT1Qz3ihetOZ2MnfEqWvCYj9AaOoUbcTB/6AgbTZe0wsUPMPSh2pBUdr5wbBfFa4jGkFUfkORIcjiSLbO+anDKYNX3X9xs1MfATIbkEYw/MBQDS/VM8xCtFeNQ0DqHTlx/r4Arl90YdzJj74TVMkN/sEn9zbxib14foLfZUchM2GwhDGgXyehMWkOhPhFIR13tCLVWqJE0wY/ZBoHG1U1FMURalMoGrydTjTUyF8lMXcgQJs4oyMUvyG96FV8lzagdTIeDxoEgmocm93e/G1aaCCoh7D722wz09s1x5u2LiCCcC6KYUguazENkGp2yFcvlbCZ0KrmB/ichHstd7u547lhR8coyUV1LraqyrTxE2jr+5TxMLvORt84B/bMPH39kpznGjoEHp3fg+xrjixcvXr58+erVq++/7xgh8YaUhbSry9+jmfahsXqczMPcPA4raBsGmoajEg9Rjzk0ZkdwY3f2O6ocOfw3Rw6nPtDj9MRzL4DVH8IuoHJn/+DZ4fMXL199v8enWS5me8MQb/DKDjCnITl9qBPFE77sR5Y8GETvPB9IgkxuRaM9GJcil03ZVgy0upb5Wl6CLzZ2wlnzE4794UzjpPnSjBj/vdFixOZZPQoHWWmWy7m0vFCZ4FX/plua1rLQOrKhRZFx5DOPW3odI6Mn7PsrufXlLb728GDbn0qezl4YexJZW4tMzqS3jQQo0F1ILnHSrtUsHSTJiRBG+HkXoqgTARLuK9TKw9CGbsJq5RBkZVCp1rmgNiLjkRAcFy/z9hmWJZ9vlKekZwMmCy4BBGjJDZs2srDuOh8AzfL5hiCLlEVw8XkbgCRR4/bZk4SNW1I2uswWJqXsh9a8G9yNuOZo9AzcBEl2U+wER2clr/jcSW/ATwId9DgJJookbCTx6qeM5KTz9S2sJHn09ugPlJ6Tp8GLgFau3XbCxMCYScDHXaEeyH0o1ONbjEVohVKsFZAQxVjMsXqggIQwLAQmPAYkPAYkfHsBCelh8XZrSnLs4vBrRSWk7OkxNOExNOFhQHoMTVgfZ4+hCY+hCX+k0ITkEvujxSe0QGebCVKQtZstvenv8MyLlku+1vKaW8FO3v3j6ZBTHk4N6AbfVFwCOMITewmtFKwoETdWsekKMHEiINv14Ve4iUiDe4htXy/c4EZafow5eIw5eIw5eIw5eIw5+KZiDvKqlWN78v4cPt5ijfyhZYGU1dy9xH5rhJbCwF7xyixFUsbH/U5BB2TFEhIcuSGHKybA+rFWTuRwp1WxubCYwobD0qBPJnllwIV3BM9PnlJFjZWfJB0dWJbPAUOCirVNaEScNhhUDVuKonD/8qIIucsIA/pilkIL7zHLibdIg+P0ocRXJ0/vYy9trfjBLfnbxxXjWvOVRwZimd7H8gM8WxAYzFC6pRa20VVy5H1hLIp1jMITBETIysFAKItWTL83uAVG+BpNLSPtdMXevD6POfQfMXcUx1rwa4E51imzKONy8Ec/ecWW7q03r89p+K4O6LbZkR/onShJYQkD+KVtaHfPeTJnx5aVspJlU47oyzCuX1TZGNsqpzNxs0wccBDW0luGE1b8xTpiJa+jcutGyxbg+7O+pBs3rFbGyCmKMDmkQvJq5f6VPvsWD663xg4Dyg3LsLxFy7rfochxVvCN2fExHoWjfhQ2xHtccqQYCVVQUKrHjOIerzt9Pwh6EpO0kVAagDbhjmDyF52qcXQ4BMeAIG/JwFdrUeXGSycQQQAMy6MkHdCvvWeX2N8b+/8fxMImLUeAhSgqO4pLXPEd0FmN+bWmXUWEs2zB8TJ7/f743Rt3IKbCIcu9X1yLfJQyp+1twyYoTkQWYxOvjqp8FRYn1phaORSDOhcPAwwC53LMTgOvqpRlRpZ1seqN6SudTSAv3LsQJu7mEVCksLcty+VyPAdL/zhT5eDOWLuODnGTquhwD/5K0OKvQZJynBvWCwgY3ATHNaeCZTxbpIxdzIAvtbxP0mRc5yIfs38IrXx8iCNlPz6dgQR/04g0nGLAszBMpxuM0blYxPicz2QxQJotuBeC50JfzgpfKW4D5+sY7mw1YwesENYKDVwSZ2YwcyvIrsa6JjGQ54gdH4/YxesR+3gyYh+PR+z4ZMRen4zYyYceydLHHfbxJP7ZtuBvTIFzO+SWhtaTVJHjxsh5lZS/1GqueYkUGEp2BiS4R0AsQ5djMhD48msZvZTIHExfm31xsL+/31q3qgcsuw++eCwc42QCNxmJURgjJDAY6EpWuSMHFGBbMi0L9Q2xyFSoPGqE9biLVSnQtI/DoIwMmIFaiemYN+LoP35+8/HvLRwFzvjVJAY18yVG6MJA1eRO+aDFwzd5NcKd2AEtvfqCJ6QTb1ypaqfWsrJQvytbcKhwqw17MhWFWrJnBxCR4CBg+wcvno4S8lem9UZk50FJwlIwwmS8dseKG8H29+AWmcMcv56cnDyNkvhfeXbFTMHNgpS+3xoFnuUwMg01Zhd8akYs41pLPhekPhgUUwuZxCXMhMjTETJVXQtNFtpf7Yj9qvGtXysgQQH2uWKghtgt12zYZi3m0lihRX65WbOk2/OFnC+EsSxOShLSCOyqtcM5iXammXqPd8BMy0KJXKozDmhrWzOlknVvuYO+lXzuFqhDbkCF53JhhS7h+qu1yKQRxQolJI7hL1CuEZhtMy1kxkwzm8lPYUR45snC2vpodxcfwSfGSs+fjtmFXoE4rLCUySdZcivwmp2uvIRl+VU0MiPfLrixUP0MQ84wMscJFRD1ATq6W/vF25NYInIrU+PmaqtPGHcRxVcSN0jqup0/HR8ft+9ZL/lefolP6Lin8BcFOz1zN4KAqNlJqihNOhqL/3HiDQdEO3I2k1lTgD7aGDFiU5HxxgSj5jXXUtiVF7XikQeF1zgR0w1FYI3ZG6zfHeFLorg8oBYrqyoGNpYEOZN4+UE1WWmDcozppbn45N4uHamkQyN3wZfgd8GNExKsCiPGGkHI9NxVOVP9DIQgK3UVsfZ3+90Nhnv1a4gVfq5h1/H7D28+fvzwsQXdBs/Gdno4grmQZbyGGtMjQrS73oD+2hcmlGKKEdGJuVFVxQpMOAaKMCWGylZVJngs08JXowf4qliheIawdS2O60IRAfDmQzIutoDozA8VUgELtdC0/ieqRltOsXJDGKUqX9iLZD88HU/H7LjKIbPJKX5hTMJq++zfbPb01kEnFRJP6DHUYEYKxXWzlkEZ2wncZlB+JyzfSU1fPgCebFvrlym8q4LlQBuCL6vxm7RogHss4NctxjCrxmwiMjOmhybogvRgRCYIch6wnsZYrIsL3pWiVwWNsV8WosI9gw3EgsDBLyGrXGbCsJ0dMrmQORRKqlvFTCHnC1sMpW8lq4H3qYmFA60QjkU7UVBTtTWe/9OB6t3O2UKUvIN/1qrUPkA6++O98V5KOVqrVq7Fm/DF7UXLY65DBhVuvWkZBjRIvivQkgIef8a6fCUaufE5MirXtYCg2UJgsqBDs2cE4PTKuLuFQl3v79KzJa0RxSzK7LzC0e9h9N9QsBAgE1XIjnESAbxVo3/InI4Bd+wABGkzhJvBCA0RBhfrVd+Uxq47hTPfXK9ZGB/3dyiXxxcBHE7nKZQXZzHtR8usRSuBJI+h10K7Mqcjny7PTmrRQ3z6AveRx9Ly/jZ/G3tCAGPxdfKttxFyGwyzIIlX8zhGLK+vZskiaDw/FPfl2hlUJPfpwJTkG+uIkgUHBd4QxkJjem8L6AZpBMkIQ+wHipFOhV06MZCHqoN03yWV93EyquOJBfezQhm3tmO/E3ejG0PHaEis6NtgcG0BI2KVR/iYdi0AgIYRnTxGw8a6/y2sp9QSUV6KUoF7VBioIknD5QniI8FdN0UlNOahythYgR42Ga/c0qGtwn1SktcIjP1sMRBHD7Kft1K101dIgQ2hn1SyNfGfJW2DwJovDe5elC4WvGITfMDX6pxEA0fYCHfWJ4CQHZ7nkxGbEMnvAMkL+GomC7GDElw+QSOjN7WFEUM1/8S7idlldQHUMJTI3Bihd2pujEPmDvqv29cFgb6J7XhDUjjO0EV+uOQWcr6goq3DPBA4pJekO7sSdTXla8R2NgcJYjLye2pEZcgOGsN2eQAzwBVH9tIR9+V0f+HaHW5opjFroDJCEH3UzIlCI7YUrC54hdGP4ONnvG3scIJFlokaDXJkXw9hANT2psaWXU4XBmNKxpvhSGLYacgyi6zhZpng4VSvU7qPs8TIHBZBTbNaHRsSOkgyrrzD3C3UM9Ece46FmgGhM09TJelXI6okXcTUMIbsD/sHFbyaN+4PpZlbHsi9IH8ip1XXTkWXpXBaj8dn8KkmFOaI5xdZ5Wpp8N5npyf9fTh8cfiqjXw81nccsDwqb238EofBQXqFLob7nLkLAVp/Bdi14MAwfNMIrK69Qq2z1/yLTihK745PSnenZhQ8Gtu1hWLFyVc2rbRlo12UxetsoLta8IV2+fRpxUplbFI+eUQBH3apYmc0sutNxYCKgvzUf8xSX2KrP1jGiwyyFikStQCnJgoKqXZO/iGKdkESD2O27m3YFnjV90XSxnqRR+RMdpp3eEhKVclYOpwlQ2xvgxrhd8x99FUirGJXQtSsqZFTwEvp4WpjFZpJAKRtPLr7Ck9cxotRurPRsj4QO5dzy424Ky74y+NycZqOs79q988D6zF4FkpMmuQVOvDJQuQEZaW9YIRR644TJ/yjUPMR6hXuz6ejdHJ3IvxOoTiwilmSySnMVJkklXQ7ncBWapGpsgRODG1WKmWDfg/DOxGhNTd4dkLgQanyJunugkG1M1UUaokCAme5wnI5VW+YAWtMzbOFGCe4CNvb6HXSmQbivjtvyqpu7KX/seKVougCL3Q2Nn2Am3eyKOTgM+hmABrZHyScE5q6JTcwCCsJ07YpCbkPYt2dZPwsnHKgBbuq1LJKWzC2YkWGOIxnHzB7hUYa2lPZCwIX1TqO8Jsuighq747oXg9Ib+469N87yeY6zbhyNwh4TqgdWad8wgaDiX/iZsGe1EIveG2gKRk065rJai40+C+fgguEL+l+ssptAEfrfLS/ilJV0AgFWxai+Una1UB2g68/M/TX8V9fn3w128bpiVtNSM5P9JYOzIP9qq7kWgT02ZqVjxO4UZ1CQ3Vfhl+SrN0tONLilUiz8SL1LSFJ50+MureoBB21C76dxDEnxnIrnMLFC67LybcpyQOQbWtWyuY3drfiLEko4W1tukC6IDkFJCEQcExT10pTE9FMVQ4nIIvD0Ci6FM0cmJPyglAYNvpLOPXDogsdr+hjuJ2AJTwdee0ORw5he0MyZ0wLBSXePX/T1dfCupdJN4H3j3wJ1segpagZZJnqQMo/k4RxCyO7QVp3QgQ4KQVeOLnKLpMyTLk0jkxzUKAxLwLkZsF1thB5PC1OIJGh75wWVktx7YX2ySXuzaSPynNRs/3v2d6ro4MXR/t7WDzp9Zsfjvb+/3/aPzj87+cia9wC8BOzC6fboOaq8bv9MT26v0d/RLagdMlMAxLKrHFqhrGqrkXuX8B/jc7+sr83dv+3z3Jj/3Iw3h8fjA9Mbf+yf/CsndCmGutktU3yTpriJvbZ6gIdrVJOW8vQkhk5iWlf8K2Rk95uvp9QtAjig8QaCYXUkXjGZdFoMcgQw4hrMcb1GWIYd33G2PQF0w2XONs+Dx7ZoX1DMwDkgiLf8xEk5ytDWkbfavBWzRMtuXTHXrU5VnQDe9XGH9aBVPfYS/eW6EWkLOSj5ysDTd8W1tb5UyyMCD3kmilVTqGBKXQwtFwNIz65EroSxYi9k5lWbv4dWuKOP9w7x00u3btP+/uIb7e2UUtzdWkS3noTt50Vig/6bD5Kc8VgBOwCK5WWtt3umdZvCERmVAGUZpLAtJ+NIGUflgzqNpkmUOZfCN0tIBVgv6yULtegxBsXsf0ejLzyd5HDsHcsaBTs8GCxCovYc0dyf29voKNoyWWF6ciUV7dSDRy9tqpMhAAUhcGyJgHItO0dboglx6rlRjgmUMVlINbI0cyLwvc26yg/RvzWJKrTw+Vwn9PAvhzQjQKsCDD4R8HdTo3lyaQASrXpmS1HYLXhV+0Af/GJZ5YpnQtNaRok4ST2S7JeFkk+f7S4BA23h6xrkRTIeJAs7HMas+MUCdTv5+xI3L9QzH5UvLzZLb6Rxvg7HnYNESPhOa8kOyoE57hjdttRLGzq0HoyujoCwsGJRVNJ4esSV0YaC449JDwfB9HhRNsvO4h1uvkXK+Go4d+phpP/J1XEW7e3U8ijKfcGTdwRywbr0G4nomWSfBfbm7eWtL1tEupNunszEkrJgUwwt1XFQguer4hH52LGm8L6ezTaJxNWjSY0H9OExX2X0qR2zuMohIRJfcwgZDJwR5CqAv/r6QlNvvWm0aoWu8elsULnvNxKgqH5dKrFNbqE/ePnF1tPMbqM/fTTUVlG4pa88E/t7D0/2tvbeto5y/2wuAfSMASSC4hdpNo2GM8Q1nKGkhe/VlCiOZQnxP12L0KmtlMGAWoP80ySNkpRED/4z7c2D3VvdT3mkEjQswpAMIJhU8cV2u4Tcuq7X8Gb5F3RbmyqChcag7rpfF4iiU7cGJXJ2JgfVBPfObTVzhKDOXcd7mTRCtIhi/GIwuJrrfImw4sBpjz1Chp7F9Xj//3D6bv/Q89CJBCNSEW+ocUohAyhhO/F6X55Rj6bYT4OYLOzHk81gcWEmJH7VQwH78QXsMHttxB0LUsUVgFUx8j80O3MWRJcK8qhjVtp0KFhNc+uvEphzJDpdNDHdj+QAf0wDtCgm2NdKGNtxvb7HRjXrDJ6H6Rya7WcNhZNK6WwHDPRwM8/jGb8LeTxwjBkTUMfWlPDZTUp3VQTclC5m9fdrhNYxSSx0qHXDR2q7lBTwRxI3JSlGDEjnUhFw4FMVUW4vTThwOh6lKCYzobuNazUc0NN5ABQTwHtFHoLVWI2BWUoHRPCBQMXpeqYPRh3F6oUu7zwuAveBQdUP771wWCF8xMm6YFVk9QZSoltLOvvTMuS6xUVaXGX+o+nJ09v3dft/b29/U55vMAjNw1hqsoPQtffywU3i3GZP98QfO9OnuMU/UnNgu9vaNbzn473b5n24PmLzU188PzFLVM/pwJYG5n6+f7BwNSy2lzIzqkbO8Y5+zheZCxV+NuLU92zcvD8xbNXzzq17jYH7TsHbHI8HIgqs7zotPDuA7r34nCvA+YXXsEDN3C4Ojn4FuRMdjW0r1T3iXDjNKwQme258Sh401q1zXoooz/GXWatltXGLKwoprsJtiGsQg/WfuzzwJrbTbmgf2iKAsZPhaTbLtrdmxBn5O/3tGgNCKVuEEf1UJQ5kek+VMWKaVGIa+4I0GniEEgKOUYgaW25jwNpjPsvnnUqMVuu58JebhCpFzADotVplmZVFrK66tSh22CSGOASvNBPHFpG7hyAMkmQPO3tcND8QimujZYqAF3bySs/g7yio6E6yXl4ct4RZvDs3CzSJLVbUQVElf1H+niLxv6jUGliTMa1XqXNtXj0yvsCt2kfMe4lzbapFSMFYk3cluofcom1DJ5GK7IFhEdE74qD7PQsiVPHmDS9Y5ra6Sn5ffJlvp0y4N98CfBvsPz3N1b6+5sv+/1Y8vvbLPn9LZb7/gZKfffVcX9/hS9uvsEuQqnWJO+uFOSpjIme8AwlcLpHvEzll6i6kXjr3CvfdFnar12Lthc3Srv4k/98R/bkAkNAqVWp37foQoTfeTFXWtpFGbLnpCbfY+IUEEWO55mSL8tSVfC+8KHg706ej8Aa8RSoodaCeNqYHee5B2MWbPjYVZKGmK5YoZZCZ9x4NawNHLIsByA6XJoqFxrd/EbUXHOrQslObrDYSa0lt4I9MRW/Qh/piGEow4I/u3y+f3CfqqBf22709U1G/xpr0dc0FIXzpEwrHfkn//lWR5zvZthyxGHcUOFORN1YTH2l1pv+8Lx5fY65nn/2h2DQJSztYsBxBZOq2FWxnfju84ZBIQOxfzDhNU11dWsFjIbcVhpxwXW+5FqM2LXUtuGF75ppRuwE2qslrQuxZsvfmin0LBCGVSoX92pKprOFtCJLQuUetHJ0JwarNV/v3vz06sXli7Zm/9jq6LHV0f1BWlffeWx19Kj3PLY6+hqtjtz9uSFItn+isdNW061cxVh8IES1LX2x3omHbALStDu/VKPRqyKtztXbt2pJD7MeUpFQzknDII5NwKPPlMA+m9SRYQRBiBSvGPRBqrMNAbOUz3trR3qqKNpo0E0an98xmQpuscRzFwuf18YKJCBZD3d02Uz7qZ9oK4fn3BR9vr+VNpPKf0iVCUUmlPgzdFrFkB1ikpA/8lvDC3DbhTGT4uO+hIwDwFfNDZU3oEUGRQ47LY7lIpM5FHdysiuQUWTsUNmws/HKjGe8lMWmAkg+nDMcnz3xtnMt8gW3I5aLqeTViM20EFOTj9gSI/j7bhB8sgd3U2yqWVFP5sWdaDs3feU0X5VqWATlmcPBO/VPfi26K0jSEL7CGnC2ADboXJovKSK7B/nh+HC8t7O/f7BDNU260G9QoLkB/6kPmZZxE8L/Vxdab4b6WhD7+YjunWykzIg106ayzW20zvVS9mh9sDLg5oBfl0b298b7h+N2DdBNhRNfUPpuh/3+oDR7XagmD4lYhjqcx1wluvnR9wpVgCf2YFyKXDblBNqXXJdpsWlIO01k3aCsj7Dcni9krDSZ3lr9W8JdHUYcurM7jZ/qNQNDbnLUn4cOCSR1hPBl34sr3bZnB8/b0z/2tnvsbffY2+6xt91jb7tvqbfdwtqWy/Gni4sz+Hyzcf0H76IKUTDupZDNNfaFY9mk0cXE51UJzJy0yaodkLqI7Zqgwvz6zkf/wlTlq3HazP+eeZXpq23kpjFpHTAZzNpF76tXL28GkaIoN3SGL0jXw824FcqfRFEotlS6yIeh3QAuL5TlRTvKr4vRJw5YOOzYpmdAct0/fDaM4FLYhdrUPbLdQilO1cmqRSLHNFqoIzsVaX6wVcFhioUDfXHqMTsXVFhJZU3p43zD2L6f4Napzwp1IvSb1+dDfRuEHbEaisrWjR1EkxYzofXGwlw/0vCxCkKKud5uOt5jjnZ3p4Waj310aabK3Q7s1Ejna59zqv2/5kFPgfy6J/02OG8+6h7er33WCdrPO+wEtLHcNmbdDhD3yhBv4xQnGjanH+61fZCb1Z8BrpsMEvugH8fwvHl6o7+lj3de6GjQ4636vwrK8aSJ5evczLD4DUg72x98or6DKriYqIR4r9wBVl5tFctacl1NRmwCVQ/dH3Kgto/Qur0cNZ93eeaDrOeiU+QEJ2KyMlBmpmK8rgsqOjsO1S0a04CXIk2lT0fBLl+4m1jZmy6hMMMIq3JjtrDvZTpoW1R6PhYFN1ZmWDtpPFXKGqt5Pf6r/6uFrE0WlPIYaNVscDvvC0zxbmVA4JPJE4kCMcNK14XEzsnSsgZqvQYZv+a6Vcj3FC3wmsemDhMa1ku5iPTUVs+rpBKsGzEtYeIJl0ZJCyB16h/RYke9BfmaOWFM6Pjr6wxAEQ/M2Ml8VwoMHkcblagyBcZmpVklltBqzEn2pbpOa+solhWCV1Ckog3yl9bnYkZR+a3tbRCaqNFT3Cdvi03bcH1+mS5wBIPx6t2KGGXw62BmfMo63ydf3RG85/Pq2xFHaNkry6bypaMhNQSqMRO7jeFNDHchyc+niCGT5BWEmT4rPsmP3imF2a0YEAoy3SNCKHKqTUnhx8jlsGge5F6ks9J1UGtlVaaKds1hrqfSaq6jE4rF9pgkrFZzg4eihHpPVLNgBBTICwMt2YoVnvz4sLla1SIadmX224jNeCamSl2NmF1Ka9F/Jg1bpqWFoedsqPec5D5fiypPyiJDigzAEhNHnDySh0SRUF8aT8Fu7rSU0zPMmTFOJdDWjFgy5lJqXyLkG9RjuGw3nhsQUdepAnSjeLqN8inKpVDWDLQW2JGpcucGDL5QBb9VvW5CJabhTSoql3TiCN/7KrojNvGHlX7Cu0vGnTBN2UfAsxed4urIQezqcmOm0u1jtPtBwxQoEQBMOy4O2t+574iakh5YqRzij1+Mm2nzvyjFcGaVKnb4vFJOunCidpVznafF8MOws0It0814K7im1vXcBj1yLu2imYIG6QgEaqTvBuTtyHzHCbYDmYJHiw//zbw//Om/vfvx+bu/775anOr/dfZbdviP//h97y+trQiksQHxZuvED+4lOc+ureazmczGv1Yfk1raSbPiXyv2a0DOr+zPTFZT1VT5rxVjf2aqscknaHpd8QI/OQqKn5oKCPfX6tfql4Wo0jFLXtdJmydgOnh57Uy52+ykTip1+xmFCykRbNIxA+dyw2wbBpFzbvHXUizHCMMNE3vUKM1qoWUprNAISAvo9WCKgLQgcP+CU40mS0cOk463uuREuG/RzUzpJXQE7/WlvE8YTOzDGGtS0XFNfiIBudbq00Ah6O8Pxvvj/XG7OKjkFb/EQLoNMZjT4/fH7Mxzh/dYe+6JP7nL5XLsYBgrPd/Fixn6Vux6frKDwPW/GH9a2LJICmadEx+B+8rX6fRvGeI/vIBif8DBQOJ5L+wPhVpi7XL4i8zbYdxCzb1S1ZB9e2hN/ZbYLURv2oeEwtF0RWUtoWWb8revicGU/l7qQvsjmDh/kTPZAhtbU93jEh66cGmQz7py6d2BSzf+MnDt+h+jfEYX8PDFe9C26Hiq2YQq+/al1y7inQkaOBOfxnCjjVgBFPVPnjlJ0pdfjRLutye5BWdSCNTwUG8CheeQY2QCLSdMDKV28DvzWPRNsL/hPOkxDC0YI4YLvnLMqcnrEbNZPWKyvn6xI7OyHjFhs/HTbw/zNusgfkMRMqd46Xw4P4WaJQVeoss0ksWT9VuHxbHD3SFiMNGSaiOyEatlCQj99tDpgE5MA1SVstV480P63W2ZSFV4vV8XsBaZ5IWn4FEohoARmT2VGquFhb4subAisyM/Plr1oEjc3SPutO83Eq6gvyrU0jPtWgYhVimYC30CEg7Kq0xgFCkttVPfUFUzOW9iQ1ermG6q9REQ6j8ntb7bCVEzqcWSF4UZOQlXNxBchhiSqtqtNSwRhvLhsV6GTKREIyqjdCj9uxTTFhTJJJCOUChj2NDQDpHHZ+8IGyB2eEA9NaQGHI4F5m6w3/gS2DA4xtxUq1FaCR3XaQIpGF/XEcnBRIH5FhT7aoo0JtVUZO/ItvpbIxocmL25eAspdKrCSr+k61Grg3YzWCInb2nSAkyDULw2h978Hh9uQ6GX8fpGp8e0r8e0r/uD9Jj2tT7OHtO+HtO+/tBpX92sr3D7tu0fn2eUSYwutw6/mTSld8evb5q+Nftj/s0g1I/5N4/5N18G8X/Z/BsjtOTFZg3GXr+myei+v6uQ4sO1WLeUC5SyVd/l4paucRfgx4UAiKSoTjBEx5FWtTDjoRAl7yrQaU8/r3hCyFJu4J/aUKP1Tyv4QxWFgJgmVGLdX1EFHYiN8GO2UNryPj8kUsPKcYY0wH/cgWDgHDxMEH8EITCWGLY055X8PQr73szT/f6OOJB0HK/fi0rLbIGEA4r9TR3gy5pX/pZWmuTVFtF1IjXSwBATGissRFFDvyKuNa+wKfhMFpa6XGAQPoq3FQbpgMegneIQwIjruU/FmH9BUk8K6lerBJbSRxAPIldvkVJgweex09jthd2caNVqh3cD6XSbmK0fqvmHlAz/4GLhH1gm/AMJhH9gafCbFwUTD2loVklc7iz56va7Ml5YNzM37qcYvukyXsXbLiYsks25NR4GNvrhmMx3E1qmoJJWXC0w4ImfvobExZkVFTOWr4xvIoBTMWmNKGaMh+bUICDWEh01kNZZqCkvkq5THtxoUFqvEtt8nXSNz4sB05qvKFwCkMT1HBxpqZ3sHV+xqSB5ApdXa2VFZsF5IiFlOhXuunInfdxhJuSz7rCdIvzZmKBT7DDf3rYdRSE+iayBjmcbQsXxFNpmilaBfI+VOHu/XH5j9O5UVrt+bY/NTDY08TfXzGSTRnjiqSRnhKPolEdoGsgyXhQCylPMNS9DPrCRpSy4Hmgy3CHPer1ORffKpDqNEnqa2Z7wF2Fa52oq3PiGWdXGbH1nRve94DoLN0Bf9jk4bMfF1b25vxwvZxwqZtGqt93yhgDpWFy+sGfnBbVcbSGcenMOdL/Z23+xs/d85+DZxd6ro73nR88Ox6+eP/tHp6njQguer1e+4V4YuoCB2enJ3RtEMGzw8BEwgyI+zr6z1wbJyUGb5gQwSScCzG0rfD/CvB9kDaFRHTdh4zHQ7DWvMLFhKmKV6aMwZFKGhnE21WppwBrn06UICH87LsWU1XweSsIVEINY9Ws0PGQZGr+ge1WiWSp9Jav55aYb27k9obmScjTEC4NY24G23dkuZr7GYB2Ssz8mX90qZ8fWtgIKIofa8DOeyUJaJzDX8lrBtnKtmip3crIUWdJuG7qjenIDoyU8YLptTSlFxbi9kBUrebVyilEG4TqMQ4CH76p8kYJAQ2OSIdhV0apTjqjzpCNWL59Ch203hS9iqMhZDDK1qVWVR9ZCKWkVmxAWx5OwkmOnemRa2GCEdRiKbj1hRklO31RgIXPwV4ZYGz2iGOxRJAIfnTpiWSGhh7l/lFd5CFhMg8KhRBTY7OpauB0oCnZ65kV9qyL0sp6MUN/hoIJUhDQqzYIRwKdnzGp5LXlRrEasUqzk1kLSmQh3p7QwGdciH7HpKgTSpVMd8fF0nI3zyX1Mf+u0FBx2qB4XIaH39MzgHitf2cq3Jki8EJ2YvPP1IvLouYFcPSIeKm4TAsQyVVUUPRgr4lOIE3Q2zzF2zDg12oyS5yHvik1liG92KiCGl2dK50nNfqXZxeuz0JcX2HYAE2HLhLyO0hSl9rLzv7+n0OonxjdN8rry67MEljFMgtXEQkB8dyaqkI7pxS18+O1r56VUhtPgwBV8t1ie2cYHUmB0rdAl2wrjbWFzillQ9VIoqg7gxtefhJ9J9ffxHv0sR89KqJR4hozNdKZI10EM6bw1AYde0rAKGjGG52G1on82VRZtC3jS6e2hwSJqYyWjOKQ7vbiNOxhE45Pu6cnXOPyuX0K7MSCaQnjuuHzJKyszn/BCmZLiE/bEJX4WrRQLUdSzpnCPXUu3XPm7SFwOFcuEBuNMTFb0vEqHOWa8KDyvktTcOuNWzJVeIbOiJFVjZVEwUUFDe3jshnQzh7CZdFoNDZv0iChW9zGYICfflECGDjxsdY8bE64OTHT2DKacynmjGlOskJrhnSBsQYthE/Q5cBdyx8ZHjPuyc1h5Cwq8KkcnY8b+HjFLJX7TAkt4qjRfxtQgpPvJmL6gvPW2IFm5myEmFecNhoiirWfi7h+o4EXF/CYjlgt3ZUEauW99EJv1wz0jTUcK5Ga8tvf4JkGQPEE4jrswVYDSLZI3VlWqVI3xThHAe/w6AOjtzZSUdHz+/ikV+CqStnSGCZ4tYuIZovIUsulEPwJz//n+i++7a265qL62V6oF3o9KzQvB3r5th4Y9dK7tXyHJFhrZxDRl8oArqlYhhwJY9zu9G4cqRz5MBTWEBsdvGx4ew4sfw4vvD9JjePH6OHsML34ML958ePFnRvdu98N7fXBvpCw0C3RiZ9jp2fWh++L07PpFFAg7MtBXiwoeCkmuuB1/gaK+feFUP1KGwKafCu9YEOD98UXQianrnCRpKZ5ZxWotr7kV7OTdP9LEyvZZAQ2rUDxnU17wKoPTmmRjKc20atwhHndbgdpxPwH1y23UKQIgafTbRcGXJW+fUdb258hwHWfK3XnA93OkENpvIvHHiuOPFccfK44/Vhx/rDj+TVUcp2pm8Fxit/df3RFf7Wuhda3ANv1N6YEOm07SJ+CW3LBMFYXIwP1N3w7HUM9klVNdSU+dUAoGyTJUSvVzuyd9mOL6RkpRL0QpNC82WOHrjZ8jZU+K1BsP/hM5A2FWfJLGmqfd8o4yT5qkgT3ZMJ5pZQzTAsIJqGDehAaE05craDlq+4rNK344e763N2uL65s4Ttt91uxLEjdVhe4bhJidzlrUhKketZYm4Tlqhr5NaKSKemNrydF8GvzvQDDuGoPeq33E0itdw+MqBYbKF5X8ShgmLauVMXKKTvhAn2FkoNOkpAMejEr0qLbtIXQHpubaysxp2ABvGFKU0lqqJdstt/teWbLpS3RlVgKtsYbqcqQVvFpgYNvcFtpj7kviPaAkBkUeBmiBRizdcXj46LBPhV/69JY/eymei+lM7HHxIjv8/uVBPhXfz/b2Xx7y/RfPXk6nrw4OX87uqtn08A3fPLHF5CLiTgP5RakFI6XScDLhrgQ/cCh3VailAVvGUoWWxyal5kCmgZHpeDS82OJ+D42O0NpStaJHZKtkFnWQCwcDdiptVFhg9VcCz1FnLp28P23cyn0JTtxs3YArONyHbrPNMN2j59J76mixpJHRUjqRdFTWBmrKqBl7k1Y8bp0/QD0WQ/FCBCpAjXGUmVokUMr/q+DW9IeQ0EQ9FzPeFBaKJNYhNCTgy9EWeWjCmHLmzqofI3TrGyhina5hJ63CkcSU2Y0YQqknJIzfodN/Tf7evU4XvOjDPajSDkrvA1JAi7sGvpaIM34lQw00T2c4SKySAqeuDV2bGEcd6giDhhJMk9bGD1U3T39vbcfmMu+2/9NnzLQ3JPiZWxJZf1ciD4PyT+qKcXdqMJtNWKaqYtWVyK7jlDyQX7/W6vhgnJZ6Qnd0SziN39wim+JTdwcneH83QIWWmd32RdoeKYlCuCP+ILU+URDCN+klJ3//o5f80Uv+6CW/xUuO54S2Ka142cPhV3OVI0iPrvJHV/nDgPToKl8fZ4+u8kdX+R/KVY6Fm/9ornKCmm3SVU5X+x0uYl6QXzWeWhW8x4Nu4iRimlnNQQGq5t+82/xGdIy/EB/foNt8faHuK/rOB2j+0Xf+6Dt/9J0/+s4ffefflO/cap55jk7myYvkq5vtkyeJX4UGGfYi8ooXq98Fq4WGLa3ASqtVM1+oxu8ob/VIY5CyaUVmG6dQFI4cQMyDLj6x4VOWqbIupFmIHFxDCeAMXmv3gzZsJ16cPtltKaahETOZ6WZaVXZHVHnH7r7jloPtBA0reR7WEeliyrOr9M17tDh10IvNMcOb3dU4ceJEw28QXBPXRs5WaFKX5OmRNw1rLTCr5sIuhIbUwDBkvF2RdXiEL3iVF7h5YRoQwHZI8kycdn3N7HA6+/5g9uz5y5fTZ4c5f8GfZeL7g+/zPbEnDl8+e9FFb8gs/NcgOUzfQbX/3qdlLuR84ZAT9G1sKSC4aTSJn5BLGlztpgnpd4xKLhF+3fHzkYw99O3tzfZevOR8b8q/3zuYvky4QqOLlCP8/PHtHdzg549vvX+h1upa5oKZpgZ5HCsTuSktMCkIBOCFe4XaGtCTITV5IdhUC45p7mpZOZJQzGQL4UQOFMJGUEiH3lfMy7vrHLTNCqEn5DQgJqyLUWiruLVcLn3v23GmttruYqiwkHFwYAA+S77ChFZKuHQaMfZgALyihFusYkEz3l4ao6oM4IqGzpVGjCgTOnb9AuvMXIX+s+RdIAdFj2jaS2jhdab5vNxck/Jtp2EkHr9GF4zPLNVQnfxpkiDaqnqr44Sd/Gniu8hS01zi9Qh0R5LYYD3A0xkK0I7+wVUlS7efVEIBkmAbI+JurRKfENbZDOuSFZs0ugC5fzJiywWw3rQNnTSQF14Zqxvgpo56MMvX33Zth1iqug001m9v/9Hh4bNddPv++29/abmB/2TVOl2cH5LzYldiWCM1cgYSMaF2RFht35SURBpVA11cRmnR3jycTuhe4zdzhKUQuEm3h2dQTaRQczJwulelobpv/2yMjWnXvoePY2w3dkEOtTbCa2FYDqLXkpsA6KjFeAfj5T5rY91oN/zcMXgYk+zkQ+/5GQ3fEfM6tZ643ZTadAadl1tzJzyIELQ1vsPs8gD1nxLTSw+Ow8Nn/aJHh89aQEGdjk0dTMd8YQIi4mDMB3jxF1zb4BpSwWarQ2w9Hv/vwOPFJ2jjlDThTGeBcEy8YUNH9Eq5d+GEJl58rLmdwO4jObEeN4f5po0NT42SyXCxGPUaRgy9sMvaRngAdHxyQm93ooVa4XBsKuxSiHjNg3y5VCg8dC4ylJo2tbfnMPrNZwC4y1aHz2Ido8nR4H2M8N7Ap3pq9YZtXWlYZMJcUghaYrK5u1TMhbdGduN6hssww6N4L7mbvBDXPFzWJLG1Y31+SMqY8mt0jghwjabmC/eNFIaOgjf7YPtju+CobMvch8x6kT5UTKKbEo6ZSTTt8h4BQv+ftQX/K83AfyAL8B/A+Puvtvs+mnzvNPl+c9beb9XQ65665HOv6yVXFovfrnFx4Rj++oq5O6oUvui1r+wYrkwC7sIps1TxeqGWrKkdQS3FNETvQvBy0gYF1ldz7cSgJoDqBad73DUiBMp/hZNMs3W3RJ4tfHjmVyjxmwIUUdcD6pzPuJZfU1P/uaINvW5HcEfiGojI+10WBd99Pt5jTxCN/529PvuZUMo+nLP9g8t9NE370v1P2XFdF+IXMf2btLsv9p6P98f7zwM7efK3ny7evR3hOz+K7Eo9ZRRTvrt/MN5j79RUFmJ3//mb/cNXhKfdF3vdzkWPvdAGoX7shfbYC+3LIP4v2wtts6D+Z5/r3nA1OC743Y6b5IhNBXSG5lW2UBo/7mSqLAFMkiX+is+0Zvs3GPS1t7PgK/B6SEfxygMIlwVVqYQFgm4zmFsC8HZ6fA6hpAVLt3Enrbo1soNsbGUpfo+ZFDgwL2Qw7dbcLo5I8e48XMq55jif1Y1oj45raQ2rpv8UmRdy8cPlnSv5t3CLBczCPvqm6IBOythpQyC0Dm7ZruB04yRv3EudzipQATXPpcUKtE52hxwiyneEeUIt6nQP2XC23k07eAtYEbQkHa61kT3q6G9iSPhdZ/9g0EGy6w88SKO3jg4pSBBcMPY5puuS9oXEPFsJVbHxXaca0enNCtXk8aC+dh+9UQcyBTmVMhjA9Dv6FeXxrPWqcSRAsRcLyMC6hAcu/ZC+KLnS6VFurRpeGNdaOdKP5oDAheiXnU+302gq7tIrjh4p3QZWjNQ4MLks+VwMTM1LucOnWb5/8GyQlcbZT90I7PQk2BgQT34riDb/xI4dmWDSPCSfB3YQwpKF5eOAEkDyHXQ2+PCtdJbM4QGMRSJunyYsKDx/75nWODqdudY9P8lslFJ+mTCY2yejF8bJC+vORReYLKRdXa5xbdz+1rqzEo2vu3G987XuPJhLsNYcrUcHx/f8KFfZFdAqMaQT/3ngeOFvkPrdTeil39y5Ngul7SXef0dsxgsjEnEF59sJzOgGsSKAxQZvx5tuMboR09DDYWQlCBt+ZRBpN0zlOM79ZwNOlxyoe87aeXO9ST9/uoJPRWEc47z4cPLBSXBLZhUree2YrBH/3oOlJU6x20UqdrtogTwdQRh7ynX3eaTbn/DTwCCnTh5KqJWuBShL4nlNQqDu+0HypHvjzevzNH1bhnxskZnxqizG9BwWHOKakq1UtRPf7JiWEfTbKf3mrWnZf/0QU6UKwas10TuLGAFvTNz2/rzKjKeNLPpT9nc03N5b+69O9ve+31oPnA/nDGZodxEeAiRTuRg8B7fBYqwWNlusD4yfBR0s1SpQ4FUzhfwUSIYjOvxb+t3AuPH3IOy1Jbc4KEup8HauGl+6k7O2gL6d5roYr1U+zHbudZgTDNQKey/1N9dN1Qzw8M+d6Uzl7OfTk/5EkJ9Y8+zhFhVH7E+m8h7L/8LJvLGuPxmxyz9/MWNOfr4seV3Lak7Pbv15zVOUQEwXScnrPsjgZaI2FN8a3Alsw8BrAUUijLAPu8Vx3Bs2Ohd1oVYQOfmgE8dxb5gYagDNmuLBl5wMfMPUd8hBnztxGPbOaYeFvi+fF8elCyZ24O313x0Y17ePC/dKUGqH7oG0u+99LgHxaV2x03dB6zV0ZQOiJ634n6pQV5Lv8MaqXJpMXafKyf/EX9kJ/bJi6XMs0bzvtJ4MDJXewgRHGPIm8yc9N0YTU9tcfA/bobcEU/0VNQsAJPbg4TnlbXbom6yIPFuQ/3YBZvHgVW83RBPS95NySMhZ3kBsINSCxGafwXgLgrDSJRYsCNZPiCCouealgOJ+mk0F2CvdvgmLNToh9Am+cB8xck/mAJoR11DVsubaGoxWOz0bedMStfocQdQE+K1aIPEqx1aGYJMcQiHlY9Ra5U1m74/IC6oOgmeXhnFiYljbbdN+Nrm0pt02wcXxJJn56R1TV3nH+rz2zPhuWhwFl5/QggnVBbu1ZDwcPq3l3rP//PEtWzjlE+qGwXRErQDJbUjPGt3x2rTVpBtm/SXE8vv1YUEzJHFSKXljF6KyEktX+Bhvz9YKNY9c7K2as5ksEGAM97jNUVP4xwtZtf0wrWUWaj52j42TKOsh1GrxWyO1yKMScQfCYe5OuV0HCmABGrSmcfEhjhewNdRpG4AMMyRBfkdssnvN9W6h5rtUrqpQ88m4v07KGWjXNfvixZ63ipf1lqzm5A/z64Z2bVQAYABINZsZ0eYpSSj5520DjklBsLXSVuS4F1iel/GuE80pu7x8KAw5ysUR2XIhKsCCO+SRB2B2BR3IbWNz1dhtdxrc30Lr7TZ4sqobm1p6IzggFdyJFRgAqzp29ivuFbbmg4umncABqESihF6jseJmmMNbiyYWynUqLMpDCSw4uaHmkcQPf5CFAKcqMggi9/amrAycVuyPuno4EqEBmfhkNY+2WbwtpdLSroZB8b8+GCh+wBBLDvO0AqQ7IMBNL+3qEhTEh2Rgi6bkSKvgjPUT3b4pGwfDT9QBwwvN1O39IQGo2n45N/wA25oVfD5Yxy4dbJjdw6t+hqGtXlhbj7FNqBFjuv4uC1HNO1fWgHO49epU5atxWnHvVh9KJ97ybm0nmhjDmvuv3KwidSO/+zvYAs9xiI4KfU+ZKPAcGgqTAgLfQ1lpaEP81KXKm2K9IIvWo7ei3ZH6JXjSLS/rtQbPtEiKTN86OnqIxtxa/aBhHOm4kbzJauU0ClFdS60qsItccy3daTZsqaW1ooLCzzDCtmH/8/zDe9gbd7DmkEKt5XWMEO50JeBaYK3bGIcDtywknmLt4CBy0g3UHpeup26giczKepxURbsHMk5fvzujcmf9IXse3fWHHIgSkfPPH/LH4SGv+OyKr3nkk9gPVcvsxpMbv7/NsuMmpoF640PSvY16Hxu6cNeaIIxEdoMBH0kqg37+RCR2+n4SpTCGzwfMY1di9RCIuxKrUbvbuxfT8HcS1iAB25+EG2GaFiq76rGhCF+eFpdfBxeNFZo9ga4RwhiRP8UpWJyiB8NC8Fxo05sbMrjWm/w46ZCPgOCgFM1oSFKNuzMKJVRioCj+t/U/rsTq347Y/wA8/tvW+Lv/NwAA//97RGZa"
+	return "eJzsvftzHDeSJ/67/wp8NRHflmabxYcelnkxEdcjyjZjRYkj0uedWW+o0VXoboyqCmUARap9cf/7xQdIoFBdzZfM1si3jN0Yi9VViUQikchM5ONP7OfJ+7fHb3/4/9iRYrWyTBTSMruUhs1lKVghtchtuRozadklN2whaqG5FQWbrZhdCvb61RlrtPqnyO34mz+xGTeiYKp2zy+ENlLVbD/bz/ayb/7ETkvBjWAX0kjLltY25nB3dyHtsp1luap2RcmNlfmuyA2zipl2sRDGsnzJ64VwjwB2LkVZmOybb3bYR7E6ZCI33zBmpS3FIcb9hrFCmFzLxkpVu0fse/qG0deH3zC2w2peiUM2+p9WVsJYXjWjbxhjrBQXojxkudLC/a3Fr63UojhkVrf+kV014pAV3Po/e+ONjrgVu4DJLpeidmQSF6K2TGm5kDXIl33jvmPsHLSWxr1UxO/EJ6t5DjLPtao6CGNmV43MeVmumBaNFkbUVtYLNxBB7IbbuGBGtToXcfzjeYKf/40tuWG1CtiWLJJn7FnjgpetYNIkyDSqaUtMjMDSYHOpjXXfJ6MALS1yIS86rBrZiFLWHV7vieZ+vdhcacbL0kMwmV8n8YlXDRZ9dLC3/2Jn7/nOwdPzvZeHe88Pnz7LXj5/+o9Rsswln4nSbFxgv5pqBi52L/h/fvDPP4rVpdLFhoV+1RqrKnDhrqdJw6U2cQ6veM1mgrXYElYxXhSsEpYzWc+VrjiAgKdpTuxsqdqycNswV7Xlsma1MFg6j45jX8CdlCVz4xnGtWDGKhCKm4BpROB1INC0UPlHoaeM1wWbfnxppkSONUrSd7xpSpk7BA/ZXKmdGdf0k6gvDrHhizbHzwl9K2EMX4hrCGzFJ7uBit8rzUq1IDo4RiFYtPhEDb9J8Cb9PGaqsbKSv0W2A5tcSHGJLSFrxh1cPBA6EgXDGavb3LYgW6kWhl1Ku1StZbzuuL6Hw5gpuxSapAfL/crmqs65FXXC+FaBVyvG2bKteL2jBS/4rBTMtFXF9YqpZMNFnI7nrGpLK5syzt0w8Ukaiy0nVt2A1UzWomCytoqpOr69viN+FGWp2M9Kl0WyRJYvrtsAKaPLRa20+MBn6kIcsv29g2fDlXsjjcV86DsTOd3yBRM8X4ZZ9lAb/eejjn8ejdkjUV8cPPqvdKvyhag9p5BUn8QHC63a5pAdbOCj86XwX8ZVol1EspUzPsMi40+j5vYSmwfy0+J8m9NS8HoFmnPLclWWIrdmzAph/T+UZmpmhL4QJrCrApstFVZKaWb5R2FYJbhptaiwrwlsfG19cxom67xsC8H+KjjEgJurYRVfMV4axXRb40ClcbXJ3IHmJpr9maZKIM0SMnImOnHsOBv4c1mawHvuW8CtsU8ghJbC4ZbML+z3y6XQqfBe8qYR4EBMdinSqToFAQSoiRvnStlaWax5mOwhO/bD5VAE1NxPGlsGW9WMO/wysAIjRWQmOLGR37+T0xOnkkizYUK04rxpdjEVmYuMdbyRCt9CibA+Tuo6PYPJOQ52jrFxvDK71KpdLNmvrWhBMLMyVlSGlfKjYP/O5x/5mL0XhTSOAxqtcmGMrBcEObxu2nzJuGFv1MJYbpZ4eXJ6ws7ATppI5jeiY3L3d6etdLtDNEtRCc3LDzJIHdrP4pMVddHJosGuvnJfr++l12EMJgtskbkU2rOPNETIx3LuJJATU+ZJ5Oug0+Ak05XTDoICx3OtDA5/Y7nGfpq1lk0duEwWU7ceOP+IGInQeMmfzZ/v7c17hFiffhRnv2vqP9Xy11Z8zryJyQ8di3rGdvS6dOf6TDDHxrK4cnpFb3r4321MkLQWgO9JhMEKGsbd2U7i0B9BC3kBnVbhrPQr59+mE2opymbelthE2NQ0wwjYXir2PW1oJmtjeZ2TGrMmjwwGdkIJTELHKeuOU9FwzUkFoekbVgtRQDbV7HIp8+VwqLizc1VhMKjXybyP51B8g+RxU/UiKTxScytqVoq5ZaJq7Gq4lHOleqsITtzGKp6vmmuWj565AZixfGUYLy/xn0hbqIJmGVjTzTVo4w6eO82D0GWQ20FmR6p273oWpyFmonvFHWFy3lv4CHPAAL3Fr3i+hEkwJHEKJ9CZjM0tkPp/kRnbJ/YaTi+yvWxvR+cHqRpjejpMa1WtKtUaduaOhBv0mUnNePeJP0XY48nZE/AhD9oJIZaruhbOYDyurdC1sOxUK6tyVRKmj49PnzCtWmcuNlrM5SdhWFsXwh/kULK1KrG+kG5Ks0ppwWphL5X+yFQDu19pKDwEcSaWvJzjA85w3pWC8aKStTQWO/MiKFc46ApVwZ5xgoTMVj+JqlL1mOWl4LpcEeBCzJ2SG7FVpcxXkDlAVNIEs1sfmHVbzYTuc8bGo7JU9WITB9CR4OHADlVQ+4uA0WCZSN+Ijwlm0AUIISzm2yesdcDLVXfiGK88R9KDbiIu7ID19p/vv/iuN2GlF7yWvznxmA2PkXtTE94l47ihB7j9oNSiFOzNm1fJvshLuabfvyrlLRT8CX2JDRB4BCqnYwppJfjTs2MgHW0LoDdXgQNIcddiwXUB/jLQ11Rtxsn7XpmbSe8Bk6rmJZuX6pJpkcPWidIWZ/35q1OC6k+LDs0BbniA1xPM3KYwoo5qPN45+/tb1vD8o7CPzZPMaRTeAm1oWw+G8p4eqFu9QQmm0s6NJeAsCBpyoJLVvDbczTJjZ6oSxKfOoHNvWqEr9ohMY6v0o4CpYlrMhe6hUq9N0PjtQD+Tbeb5aCaibeJsswB2GVBgQKtehGXuhkjxd6TP2KveADhRWtNC/ySonVEka6D3z7Z2+HkbCaZCNPA3AevoWys7AAllx6/XjttlxA+RTQjebhgneu/c5vHqExxERlS8tjIHgvCXgMS8ZuKT16HHXrEhoNJEfcsquFVbXsrfRHAmwtPEcqGdEWykbTktx/GcrVSr4xhzXpJnjLEgpSHhFkqvxng1KArGSjjhatM6o5BHlyGUiUIYC/YASUGwuSzLKGR402jVaMmtKFd3MHZ4UWhhzJYE2Mhxu1uqwFs0IOkkUcxUM7loVWvKledm9w2BZOwSZDGqEnB1wjI0zpd0fDpmPJx98GBC2H9iBs44mzH2946ypDoZ22kszK2j5pcBp8D304weTD1/RiaD6SVqGMYEFfur9b4874OcZrKZQrJNM4/WFN6NRtQFqd6OvWDXRZDOzM5G/VUx2X+7Q5Wb7Cs9VzscZysrzA0qcLIe3hPS/6yHyF8Bz3tB4kUE7RNaJi/OhuR7+ayHmGe2GzD7HFKRXPXws96YC6GyXNrVh+FK3c/Q0q42r84JdGnByyE6Ctc1orbbwultYtTHwQb4vVXaLtmkElrmfAOSbW316oM06kOuim2g+coPwY7P3jEMMcDw1eRKtLa1moTSxgV9xWteDClVqjx1QVyFzkKoD42Std007htVL6SF/xdnaMmt+2OAweh/s0elqh8dsp1vn2Yv9p+9fLo3Zo9Kbh8dsmfPs+d7z7/bf8n+T19OA8ktyqnRT0bonXBGJj95LTyQZ8zIV+AIhN8WmtdtybW0QTlj4Z5DC++mTw61V+Esi54Yz+FSe3dOLmAakUI8L5XSdBjAre9dd0HdDFKOEXola5Yrg0vMeBOQh23d6fiMvVU2ue2EZwSHMc6oyh1aC6HCbLPR+trNlLGq3inywdposZCq3uZOe+9GuG6j7fzt1VV4bWmrEU4bd9rfWjETfULJ5gYcZLNplNHxaVScgkR0h0XKWd5pGRwe4Qru+PTiGZSk49OLFwGGCLfOAa2K5zfg9Tm0OZm8ugrrdPAajuTmFtv6Ctqca14bb7kcn2Ig0uN9/MbbyXk0itljkS0y8rrwkrAhoO6+MzhkelcAca8kdiCzmjs3Xb1gpeIFm/ES7j9txmwutbiEGeLsbnh+hF6nOCbdKG1vMe0NSo6xuruUuZIagP9HoYe3N02fHNfpe71Zn/qvP0u7O+jjMViT2yidV6/HKa3BVczfGqHJfOkPu5EVPmcXjlI9yruAlPaOFQwOzw5nlXCWi5on6/x9d+cxhgX45mhyigWc5M4hehRBkVGICa2tKgbIRMVluaXJ4dBmboAgaTaQd96W5QYl9V6RGBmGYdy83VHNL7gscb0z4LhJORPaste4MRCyHuLrvAjZ1i5EyViN1qSGWHH84AYO5qQ3RXebkluw+Qa6ute3qZOlnOsHGyKx5Ga5peFHRClMFoFkS5wQudJa4LTp3b6Dgpz2U814repVGsvjJUWyt34ygm4Wp/jI3RjDk+H+AEWnMeIjV/XcrxUve2NCx8553XnwWIjQ2rQLt3LB/G5N2WjXWSse/G5iQ6yGzHMveJ0tIXYBHOiVaiHrISLJluRuS/bc+qot+l798OBqp74PzGSePaLzJy9V60JMZD3XPEZrdXEo3jvnL3EJMRxh2TVxJ3N2IqyWOW4gIcCT+2aOeNUDHwIDDpkLmy+FcdZFAp1JayjUp0MSHB34zgxDjSQiefw9Zh8FgqvbmmKItKiUjbeeTLXWyEIk5FjHzOPEGQW5hAkRYPIVuk/JMuoH07lfEkB22Q0ezn6ZI86zQ5UIdhf/bZ7DsN6eZB6ddwTyY4FvUk8dQlFCZBrtshUr5HwudKq54QcLPyEMO28L7FhR89oyUV9Ireqqbzx0vDX5+SwOLotx8M69chR+9/4Hdlw4tdbf4Aw2fDZa31svXrz49ttvX758+d13a05If0LKEn6t3zo37X1TdZKMwzAOzF3vG3b2NHZBsokGwqE1O4Ibu7O/ZsrRhf/22OGYRmDHR0F6OVyJsweIyp39g6fPnr/49uV3e3yWF2K+txnjLR7ZEec0JGeIdUApPBxGltwbRidBDqyaaxBKyGgPskoUsq16mDZaXchC6C1hmao6XgKEAbMQi5XGSfNLM2b8t1aLMVvkzZhAMuzMQi6k5aXKBa8Hk+OXpjct7x3Z0qTIOfKZ2y09jr2gF7p3JPceXnPXHl/s36fSTecgjD2JrG1ELucy+EYiFv66kK7EybpW8xRIFK3nS2HouPIXnIkC6c4r76WIoA2dhPUKZxSu4O5wQMliC7oUKcHd5GXR38Oy4outypR0b7jB4pWARwixurNWlhbH+QbULF9sCbOOswgvvugjkCRqXD96krBxTcrG2vDHblDKfuiNu8XV6ObcOT3DsMSyWxr5vYfOKl7zBbQ3d3xHPhhIkgJ30zoRI8mtfipIjtYeXyNKklevj/5wLJpGEbhbBO/l2u0nTGyAmQR83BTq4aUPhXp8jbEIKRFuF5BAECm66d4CEiJYF5jwEJDwEJDw9QUkpJvFql6S478qKiEVTw+hCQ+hCQ+hCQ+hCQ+hCQ+hCVeHJiSH2B8tPqGH+paCFGSD0ZKRbrqZF0GiuSv5RssLXD8dnfzjyaZLebdrnG3wVcUluIvwxF9CM4UnyHa0sQp5W28n5+xI4CIgu/8ZbiPS4A5q25cLN7iSlx9iDh5iDh5iDh5iDh5iDr6qmIOi7uXYHr09u8kb+X3PAwmP6NHbM9R20Lj7haHDa3MpkjI++J2CDsiLJaRdpjlcXQJsgLVijZbYrYothPUpbB4sAX08LWqTOcq596dPqKLGKrjKUuiQjDEHzDMUcZ2Nrj8HpnOoGnYpyhL/RU0QIirh4O9iLoUW4casINkijSPFaoil/3T65C7+0t6Mr9v1n+XJHyFDWmu+CsTwVKbv3YRcyo/HnBlKt9TCtrpOtvxs1Yt1jM/PXUCErCESDWW7RS9mWBu/BMiLd6P2nbSzFWq1BC5GUSuXO+phLfmF8DnWqbCouun4H8Pg8IRyC3gEft0GxDKD/Zzd6RVGX8IAuVVrjna8R6uTsYllyNqu2mpMDyPcMKmqNV1RKoiJKUaZgjIum3AwDWm6g3XMKh4UfAaGrFAJBXd/NpR044Y1yhjp3gZ78wL7cAVTQ4bsW8dhQVW8AlFuWO7LW/S8+2scmeUl35ofH2zj4EOkxgUh4sGUAccgdEKQVu8zigey7vjtRtSTmKT7xtyF0gA+PZ5hQ4HYAdX1zSG4DwgKngz/KbIJTdBOgI0XWIEkKUDKps5G65Pf38vC/2+kwhaVGU+FTlUGxyVX8Wuos8bn16a78RjXVvkSANScvXo7OXkNk20mQCx8X16IYpwKp9HIsCkGmyYiphPtDAmZlJYLtcY0CiR25ly3GRwQLN80Y8dRVqGwkZFVU64GMEOls6nLCw9XCFOcawJW93BZLi8vs4Xz9KNe48aVsfY2NsRVpiJojysrH0R/4TQpSG43X0eAjYsAqTlDUal8GQeCljV3cimV24U0OdeFKDL2D6FViA+pBKe85xjBl9Bv1hHNDzHYrPsvN/PpFmN0zsPuUvPPFTGONXt4LwUvhP4wL0OluPvHezRxZ7aaswNWCmuFdlLSj8zcyMleev2p8XVNaKG4hmU2GbPzV2P2/mjM3k/GbHI0Zq+Oxuzo3YBl6c8d9v6o+2ffg781Aw4rhKl570lqyHFj5II0BDBco9VCc/hFuO1qrBJMlxrn1TJ/5ZgAcnf5jexuKb1wMENr9sXB/v5+b96q2eDZvffJ+8IxUG0wGKlRPkYIJV2Xgn2UdYGDwc2QFCqCyGJ9Q3beqzxqhA2066pSAAgnMO7I8ZRxtRJTmFfS6G8/vX7/9x6NomT8YhqDmtNuDQcG5iPFjfpBT4ZvCVF3NGK4ddTo5VhO1r2zVryzVvVOo2VtoROipq+rcKsNezwTKKzy9AAWkMOA7R+8eNLF59mlMr0vOnEejSRfAFWYnDfYVtwItr/nTpEFDJ7HvxwdHT0JNGTsrzz/yEzJzZKMvl9bZUUKmUBl7JzPUBmGay0ROeTNB0QSIl1XJnEJcyGKFEKu6guhyUP7ix2zX7T/6pcaBxjkmrzoqmHc7piNy4wgRGOFFsWH7bolseZLuViiEnM3KGlIY+dXbUBzUu1MOws33ps9lNizAzjOWns0VyqZ9yMoTY+SvxOIiTSgwnOo0akrVzeq0SKXBsEWTkPivh6HK9eIwZt2VsqcmXY+l58iRPfOYxSlPtzd9a/4NxBk8SRj53qF3YhyLChl8knids0fs7NV0LAs/9g5mcG4gqGktat+5kPOfGQOlDJXhsLZ6Jj7+ZujrkTko1xl7cdHQ8a4iSm+kLpBWtf18mkymfTP2aD5fvg9d0KTgcFfluz4FJcLqHxSs2lQvaDETXssI+KP0+A4IN6R87nM29LZo60RYzYTOUfxI2LqC66lQCWzeZoTEi4XDCxZsCGhhehjV7+7wy94vUWHKFxIAgM6T1BCnGkEX7lqstJG4xivy7oQn4BVBVZJQXvp4j9yvwtuoG1YFSF2NYLwKpZuhUkMOI3+3BkYYv1nfYUinKtfQq0IY22+On777vX79+/e97Db4t4YpZsjugtZzhtXY3pMhMbx5pgz4cpQiokU9fR72NDlyrlwDF5KHZW9qkzutVyLUI0e/1/UXYXiucdt3eN4Wyw6BGj3BOdiD4m18WGwuvFhINP8HytHL3cfyQ0zCs5x02m3kPfYxk8yNoEPiAy/CJOo2t/7V7s9g3dQzaM5NhCo0Y0UuETkPYfy61c3OZRPhOU7qesrBMCTbyu7tbv0pgqWG9oQ/C6mTVs0uHMs0heTQbuFjE1FbjJ6aYr14RENgklz8aIHbkJXFxeSOKnX33Haz4gHdWvmFtAXBI73ErIuJNyWOzvkciF3KBACPU0pF0tbbkrfSmbjvqcmFkCtxOWzUwW1WyLDePFPoEo2k8mXouLh6wiRZD9NYcA66Gmxl3KO1kr3eCc+uOY6opfrgDOkc/sLfO9sAnhCYSXFHfuTca6cCrI7vEdOZZTnBvFK4ZMFQeYgCDSWBVVcTVfXO0wLryDBUZTzsMWgGXvo2ejWXDyU/fdyU/QaaDhhv+6c9Ahea9HfCwZ0ybjhOnYDBmS23oBGbIiwcbLB9E15LFadCzwWH1zPY7S+m3J5QhHAzek8pQrqrMMI2as9XoksOUEB97XKnLzuZEqQ2UFSuxoblbBLPEx4t9Mk33Q9Idz9Q6iTb4OPkNvomMVTAIowQgKYG6ibBMELoHgo144aydqGdGBK8u3qiJIHxyu8MYyFYIbbFggonkaQuICajcVIZ8JeQg3kod4Gp/MuqbzvB6M6nrCvNDKscWvMJmElbiY3zmGSQowq+ra+7lfpIPoqjwgS6nUtcOJ8M6GT1whsV/e/R/WUWzqSV6JCLAqEHEYL4IqE8ARWaXbRlii66fJQpTBrLxtk8ovCfXQHCQXFXNVbkBBODfTQo+4XvFT99BUyYEmSkR8s3YB0zUaVTY9d4pxbvU67WPKaTf0LoVbnNBskJ7u9PnXCYYcXxXTMpsTyO47lhXuEHgs7XoMrpt7JGFxtEWKs5h84jmYGy9dxw6ZEZgQP7DTcGBBzx9cw7S1GQH0by/GatHA/wjrxaZMY59ygoq2bZSDejJr02qpEmG51nOt2bXE8Q0zHYU2NqA35QbuwXR7RjHh1kIN25CGZjP3MNWxfZDuzeQs+61QfNceN6phdCtaUuNRQ4Y6fdXZrSZ1beJ6LxjvkyL8ewwCo7U3jW3bBFnbOlJy3myOJ3Uq7LLNONFytE9yf6XVM53GeOJnjJKhpVq9TRcIHScZVuDDHRIMQLbAxk5oBdCCjanqSfjWmStJllxrGQFt6m5W8XrT4h9IM04NU9von6GSYQjlgiFlYPYGe8U414TAwz8+yLtSl8ec+Oz4arsOzF89e9onvt3Wf/oMNFluZrdOXJIwHMih0sbnPGQ4E1/qLIMJ2QVjtKjaNcEfjbAUDUg+bf9EOdSwIyVdInKk5BY927dpiseLkUbenCNcIMx5nG7qr0SUCGSwJIsc1q5D43pVPHlPAB3x6cVjy683EBhPFy9PwZx7IzIL3KdSmyHmZty5iDZgWonSXml5RSK1zJ2Q4xRRRX7gIs3duXy7Dp6EvEtqxkfzHzcha846ASaVq2ZUOZwkI3DGrbsXwZ6gSYRX7KETD2sYX1HYfpZurT1WYIaDkOh1xXvkdl/NynK4sORoIz2zU43K45YywN3D574/L9cOkU5nHVQgL5LzH7mbBHQruMFBJNQUoyopoE6LWIYkT+VGqxdibXlCrn4zTwbEjwkp5dWBF6pmi0i1BgFWig7je6cRi7eDIrSrnmXNtVuCXDva9Aw8VoTc2BHoXeFCpok26u+DHMZurslSXuIXBuVYoXy6nHoAZyi7e4HY9S2gRl7ftNXu5Q9z32peyblr7IfxY81pRdAH9rlqbvsDNiSxLufEdf83gpOT+RsY5oqF7egNkVjJsn5PcG5lTzJwG7v8WMA60YB9rdUmuGndaB9cbpR9tkDBBfLjRAQU9Xxz0pEpCoLGoiz55Nx7SVx0UHaqDM2L9ePD8pnT3HJrNRZpxhRPE3ZxQO7Ii66G6xWDiHxE//LgReskbg83nm3XNZb0Q2t1fPsF6oiy7P5+QfoGatSWc+QQRMCtVu0YozoYm95O0q2yd6bv6M5v+Nfnrq6Mv5ts4PsKmD1ZJt2LZrfpVwUPVx+3eFmV0nsQJJGj19QXvqB7q8Jeka68XHElYMvBsd+kcWkKSzZ84da8xCdbMLvd02sGcGsutmI7ZlJdcV9OvU5N3SPZWtifmt3a2+lGSUMLr2nQ57YL0FLzhFRzTNsgOomYhqoZ1gyXyoL3qUrYLp8CqoAhFsHQgg5rU5owOdH9ET9zphN1snoyDdechx7A94qMIMgZDBH3evz8kuj/6elQPOuk26P6eXzrvY7RS1NxlmerIyj+RhnGNIOvvvqitQ4lwl5TwSqEviMo/EE9iVxTSQFgWzoCGB0dhkzEjuEZoXbdboJDQxeoMIQNWS3ERlPbpB7820yEpz0TD9r9jey8PD14c7u859xB79fr7w73//0/7B8/+x5nIW6T3+r+YXcK28Zar9s/2M3p1f4/+EZG6xE2EaZ2Ggqj8FdrvIv4hfOD/a3T+l/093BBk+6ww9i8H2X52kB2Yxv5l/+BpP6FNtRa6Wn+d71d20hB9cdXbUPExfT3DctXkc6DGz+Q+DsKS2XXIsawhow9Tj6B/kUQjkZA6Es+5LFstNgrECPFWgvH2AjHCvb1gbIeKKQ2st7V4Z/FGdtO6eTeAywX1ci9EkJytDFkZQ68B/OqdlQwnRKcesyQPk6nOtAmbNeianUxLeuleE73oECdT52xlXNM3xNoUT5wnACMx086ocgoBptDB2HI1Qnz8ETVSyjE7kbhAVHO7Q1PcCZt7Z9IWEjbyk+E6+q97y6il+fjBJLL1Kmk7LxW3m1bqvTQfmYOACbnsH1jFaj6YvyEUmVGl4zSTBKbhZs+dbZ4UI9O5JhwbM9zbZVfg/gE+2v4ENnLilZMYvYVuhB5SBdM3T2gc/fDOY0UQGdvDltzf29vQURSRYT4dmfLqEEIAnaRvKhMjOI7ywbImQShR0yA+AOISdTphsQoIgbqbhqcadZ7FlTT1NstGPSIaNEer87Xlvyl2/ebk4tEZAQ7lgK7YyRDSZu1Vd91OjeXJpeCMajNwW45BcAT+9GJnxSeeW6Z0ITSlaZCGk/gvyXtZJvn8ncclWrgDYl0I3ZlrV+2VOxHqjGDS03ApErk/jNknIPuZYvYJJOvcbhHLIP9djD88XRcuYiS+F4xkcCG8UgbCbtR5TtomaP3JVUckuIH3nYaSdLrlqjbSWIg8YrwQB7EmiUbfrhEWtnmfqp9hhHv/wY1mON3/pIY4wWTRIO9cuVdY4mCWLdahHSWqZeft6Mq79acEl1bHvUl3b9/fO1xBBpz7V94lfNQrktGFmHPEA9I5GoGmotq70EJMky/ueylN6uecdEpIHDTEDLpMBtz71Kp296/HRzT4o9etVo3YnVSIkS149SgJhuazmRYX/ko4vH52/silj/Ka/fjjYVV1zC15Gd7a2Xt+uLf36EnWZ7lhWNxgH3/Wwr0X3nGDrR9M2xamRkKeU6958QvlSjTH8oROQXMf4lYJzMsd1gFnXFemURDfh7+vCYKYuLbB6zfmDM7IgVfABSMg/l7Ua9cndKm/5MbfJoWraMCmqnBhekAq5iWS6sSNUbnsGvM70yR0Dg1xAuFvXhe7SneTjQaqW9AxhcU3WhVt7r2tbsjjYKCxk848/s/vj0/+i96FiRv0VSry7VqM4mPS8IM6HcPq4l0on899Pg5mvD4fAtqJmBgzcqfreSjZougz5Z3E4OgNXIfYcQ5nhyoEWQCdsOBb1Fx3agYkBPSDbimNv9DAZdHHYFIYL2Gy0c13bHdD2a2dYzY4rtwYt8Wyq83Y/34Nx1tWGb0LUbm1Ws5aZE5QVWDsVaRq1IsryOx/M+FIxTyCN83fobUNMGDTCkNN6YIKJy9O12nunka44dbNX6jC4UAFc2Cf4NUxMvPzCA77n9cd3kGbABpr9CpcMZ1bEOxzxKOv1HNFTeSI0Lq6YNYKvcUqMdvCMpaOieGCUYpSdcyBSrO7VJXY5WWgXcDVITWMb703XN3+iYMM0GrqRQ+dhSy2hMiplhXXKyrSgkP9h+OjJ9eu62h/b2+/z32djNw2hqkpvxG74Vri+iWriudbwu/k6DnO32Vf03RPzJLvb2nUsx8n+9cMe/D8xfYGPnj+4pqhn+8fbG/o5/sHG4aW9fZCdo4Bu4tzDnG84L0QI9WdbsO9cvD8xdOXT/u7pdoetieq6G0PoKhyy8tuBkm5sBTRvRfP9tbQ/J1H8IYTOB6dqKuhCqQXr1loW8wHTW9viDawsGJkdpDG43ib1qttNiAZ/SNbF9bqMnQsuP85uHPDDTByYRW65tVtZGDD7XJbKLVl6eCnStJ1B+3uVYQz8rc7erR6iIwccQAEXO9qOSc63TukHWlRigv43pwlPnWYAqhLFnmEPzekMe6/eLpWidlyvRD2wxaJeu5G8GSFZWlWVSnrjya7wRq+NwQcLUEa9hhkGaPG35h1mDzJ1skULb+AXbs1peWcKm6hbc7jn5y+ojtHdZLz8PhsTZnxe+dqlSbgvhAqNdl/EOomi/0HoYI9CvM551qv0uZavLuVDwVu0z5iPGiafVercyglNXF7pj+Z6XCYxptGK/KlC4/obleA2fFp8MkgktFTbwf3z6UUxR3M3a+oDPhXXwL8Kyz/HVD6Skp/B66+AZV/XdnvIZ0eSn5/DSW/v8Zy319Bqe+hOR7Or/jg6hPsPJZqpWMM7IRbKHdTGc0HfwZSAideCToVYWVVemN423Nla6rCfZSl/UI2SaxFO4gbpVX8Mfx9jRqCVcR3YRG7deuuGt3vvFwoLe2yitlzUtPdY9y7rvqoQ8ZQ8mVVqdoZ4CKEgp8cPR/DKbD/xIXKNFqQTMvYpCgCGvPow3cXTwHEbMUQfK1zboIZ1kfODe4QbN0bbV0I7W7VmREN1yikFOQSXOYodtJo3Fmwx6bGFTPuSMcMdxDMLPnTD8/3D8Kl0m0Y80v7jb68y+hf4y36ko6iMCbur3r7Kfx9zX6axG6G4agGm1HcUIkd0bQI3Olab4bNgxR/fJv9OWyCjVfCiOEaXlzhw66fVHfX7ayDmDfsDDKn9m9MeE1TXX8EQDBrzG0liEuuC8RDjdmF1LZFZq1vp2nG7AidtnS4m3dqArbiv7czxCThHgXOMXOH7YSoSWlFnoTK3ecp+W4tBqs33uDc/PTyxYcXzx5aHT20OnpodfTQ6uih1dH/Q62OcH5uCZPRjwQ7yEyMlSz7saWAzi6p11BSD0qRB8zQiqGqsH+pRmMwRXqdq0fXWkn3Mx8ykdy4Mg2DmJhIx5Ap4ftsUkeGMYz5EK8Y7UGqs+0CZimf99qO9FRRtNWIAPNBV6DsdCa4dYJouk6F5gYqbC7Gh2VjsgkF6LIv0H7qR1rKzWNuiz/fXsubSeU/z5UJRyac+JPrtOqUqCAkXf7Iry0vYUcHnFgoiYnZhBIyvIqlP7rKG3A5Iz4G8aew4lghcomiBV53dWwUgfrKhmsLr0w255UsV32q3dvR9O6MefjscfCda1EsuR2zQswkr8dsroWYmQJXhC6Cf3gN4t8c4N2W5bawXtd5qVlQ73KTslFYqEq1UY6e8Jy9O2Mn6p/8on+Vo0yWpCF8gTn40UJ2IVaCu1a+PnR9gPmz7Fm2t7O/f7BDNU3WsR/utW3TP71DpmlcRfD/WMc2uKG+FMZhPOJ7+ISVGbN21ta2vY7Xub6U9Tr2NNsvhfxteQQVQJ9l+zdcoN6PCD6n9N018fu90uxVqdoiZIBpQx3Ou1wlOvnd6L4K8NQeZJUoZFuhVcKcXVRdfDX1R090XZLtUGTnaSFj5C0511t6g9id1RHipjO7L4bb5paBIVdd1J/FDgmkdcTw5bYZLtvTg+cPve0eets99LZ76G330Nvu6+1th/zYnnP9/Pz0Buc6NbdLomB+PD8/jdlcLqvfYTBtdTkNeVXC3Ue6LAJCCq+0OraNQ0kgYe5w+Rg+mKlilaXN/K/bHhvSBdNP+8RNY9LW0GRu1HXyvnz57dUoUhTlLZD8HE44J1vPL8a1WP4oylKhTFxZbMZ2C7Q8V4hmNddR9DGQdZvdt+nZoLnuP3u6mcCo8aqKW+D8OaQd9Ujqh0pEnKO8Y3JnDPsC1TOR5gdbFS9MfeHAUJw6Y2eCCiupvK1CnG+EHfoJPjoOWaGwtl6/OtvUt0HYMYr4439bu5FMWsyF1lsLc31P4OmclabHjIPVhOwxh7u7s1ItMnqKthO7a7hTI50vvc+p9v8tN3qK5Jfd6dfhefVWD/h+6b1O2H7eZiekUTyoNRv86L8/lb5PUz/QZnf6s73+HeR27WeHFw0xpJSzjwMioRA1nehv1OJ2B7p36PFe/V/IrVAg+/Yns5t8nxD3ou2M3oVEfWAVr5iohHgIXlqrvNorlnXJdT0ds6mreoh/yA21fYTW/emoxULoLcwndrrqJrFwpr5BXixSCBHpS3dNsbpFi+4q5aqXSp9C8V2+/Gr6yt50CMURnMOXnBc89DLd6FtUepEJ1MiTua+dlM2Usig512R/Df/qESvUUtgCuUaBAr2aDVj5UGCKr1cGdHIyeYPAxraNpimldW4paVFUVNadjt9w3Svke+xMFKt519RhSmCDluuJnvrqeZ1UggXEtIRJYFyCkhZA6k8jTHY8mFComRNhuo6/oc6AK+LhM3Y8F7lYKwSPex+VqHPlnM2IHxWXrtUYvOeVuogyi0EK5CXqWrTNOsoJeT6rPhczispvjUZOaaJGTxHqLPhi0zZcn1+my10EO+fVyYoEZWBcyoxPRefb5NHV4tPVDQx59f2II2Ceq6pqa9rFPjXEVWMmcduFN7kg/wAnjRjqmDAZ6bPikwL0YKsR2PWKAbEg0x0ihDpJ1d/593YCjiZuoahonlVr8pGOg0Yrq3JV9msOcz2TVnPdXUKxrj0mKav1wvhNUbl6T1SzYOw4kJfGtWSD1FW9l83HVSM6x67Mfx2zOc/FTKmPY2YvJXqPEjKXYZ1CUEFX77lrlcIuRF3EsEvHE1Q/IUymENBHipgoEutL+4pau2gOwY5Pfc6MgUmgUekhgXkpdSgR8hXaMVz2G89tUFEHx8ld1NORt0UdWF/WzFktLrx9prBvnO9YqmTjuep1Uyox7b6konJJJ474PFTRHbNp2Kz0kz+7ZLcSpq2GBHj6Yq24upcgdvVha67S0cT7/VzDFEzSzS6ZnGt/h2fETUkPrFQPCduvi5vpyz/aCc4Vb5Uqd/iiVtAuUDG4Lrgu0mL4Eey8VJfpYrwRXKNmOiKIbLQjF9Iu25mzIMEgro3TLg1vVzuy2IFiO6T3/uHy3b+Zt89+/LeTH56f/H335fJY/8fpr/mzf/ztt72/9JYiskZ/He5FvXl0FIAHTS6Ia6s5egdmv9Tvk1ra3XF6+EvNfiGQjP3C/sxkPVNtXfxSM/ZnFEVM/kJtTV3z0v8mPqV/tbUr//xL/UuNHlopzIo3TdLmyQkdf3jtzDgWO6mTSt1+xvFAShSbFGaUXAAzQnt+WbtKORdSXGYehysGDqRBGTyhZYXGnB6RHtK3w6lDpIcBMHGXajRYCjkOmj1aZyeifY9v5kpfuo7gg76UdwmD6fowdjWpaLsmP5GCjP6hQ4fA/ncoErqfHfTQk7zmH3wgXR+7exMwx5O3E3YapMNbNxR7HHYuWr4Dh0zpxa4/mOHrMrtBnux45IYPsk9LW5XRecDYGckRZ/KEOp3hK0Pyh5eu2B/6C3qN562w36M/MCSccf8i93aEi4q8pN+35N/eNKcBwV/0CL3tOySvHM1WVNbStWxT4fQNXhgZOXqA7Q/OxfmznMse2r411R0O4U0HLgH5rCOXvt1w6Ha/bDh2w48RZDiANx+8B8/6s6alvWHan7NYozffBusiDuNGzZj4lDHsizErHYv/k+cfx1351fj6V6i5xcukQMGI9TZIeAaG5ybyciLEvNaO3A7Bu6Jvgv27HyfdhrEFY0fhkq+QhN4WzZjZvBkz2Vy82JF51YyZsHn25OujvM2bLxIhc+wPnXdnx65mSclsz7DBb4Gt34CKGWj3zFMwsZIaI/Ixa2TlCPr1kRNIJ64BqkqpU9/Au/TZNc6BSR2KWupBOhLUUcnLwMHjWAwB1toGk9pXC4t9WdCNPLfjAN995IqziZsh7vTPN1KuIF19R71ODlMfM1rh6C4MCUgeTY7is0rH9oLr9Q1VPZeLtmvoitTUtr49AWL956TWdz8hai61uORlaRBDaXXrAhA9haSqdxvtpoiHMTyWRk21RPRNUzqW/r0Usx4WySAuHaFEY9lNoEHIyekJUcOpHQHRwA2pAwfF9q7235CA8nj7mJt6BWdhUl8Z8zSRFUyo6+jZwTB+CxKHaooEk2oqshPv6cOBgYBxzOz1+RuYdY1CfhP1CZJ1aHWQKOuxNGNQHeCEh2vQFa8tXG/+QA9kuOFcuYPT6SHt6yHt6yHt6yHt6yHt6yHt64q0r/Wsr3Da9EP0PtMpkzhdrgW/nTSlk8mrq4Z/yL95yL95yL95yL/ZUv6NEVrycrsO42Bfw4RyJmLiXt22l+N82fVRTcVq6HJxTde4c3eP69Juk6I6sWFUBwk1PbJNIUrhqkCnPf2C4elClgrj/tMYarT+aeX+ocpSaPzLG7H4V2eCboiNCDB7JO3dPt8nUePM/QhpgH9/UTfug3tBIbIUDZEWmVF6wWv5W6fsBzfP+vMb4kBSOMG+F7VGqIfTZCHf+skNMTgDFjWvwymtNOmrPaZbi9SIjHfuukXTeEtRNsgGY1xr1MGHoT+XpaUuF2gmR753XvsgHST7qn6KQ0Sjm89dKsb8C5J6UlT7LLXNYyxd76AehHGV6bFSFMFnXaexq9kJQujdWSxOSvFkm1lnvYnZ7UM1/5Ca4R9cLfwD64R/IIXwD6wN0jy/FOa3ZY1OFYw0TprbkpQ7TR5df1Z2B9bVwo2HITafdIiIi6ddl7BIPucePPBRB47JYjfhZQoq6cXVYiQ2DcM3LnFxbkWNSKWVCU0E/FBMWiNKdLKMS4FZNQi0pVDhRalmvKTbLdxbBXQ7h9Jt5DXXC7MlvhhNtOYrCpfApBnXC3cjnPrJTvgKjjqvT/jp4UZa5BYFQox0KdMJ4bPRGhvRnzvMxHzWHbYTxOEObhOC/rkD6wP/t9+PohCfRN66jmdbIsVk5tpmil6B/ECVbvTBDtltjd6dyXo3zO2hmcl/l2YmWzwZRyRTSc+gV5gzHlHTAXKwRDB9o9VCc6q+isBhWcmS68EWjFsvIN/crlPRnTKpjjsNXc3TrPsoX4Tp7auZAHwELPUp28jiBmPwTngFYsoiWyfLs4Nn/bi4prl/upxyVzGLZj1izWZE9u+1Z+c5tVztEZx6cw5GHx3s7b/Y2Xu+c/D0fO/l4d7zw6fPspfPn/6jf/XkepoX2f1T6NwBZsdHNy8Q4bDFzUfIbFTx/eg7e32UoAdtCZkoCZyylYiCc1pW93zs836w3tT1jSKlwsK7yeDK0yc2zERXZfowgkzK0DDOZlpdouiDESFdipAIpyNCJRrkYFFJuNLFINai2GYZmjChO1WiQaiNrBcfYomYWyDzeZwjwlgU34gaMmqeYj7Att/ZLqCcBuuQnv0+eXStnh2DnNGFFM2xQ234Oc9lKS0U5kZeKLesXCNQHHqyFHnSbht+o8huOHf8C2a9rSmlqBi0OkYuHa9XrCk53vTuJpRXpq7K5ykKBNonGQIT8upUY+o8CWYN+inCZdwQFALvWVCSTo0k4aITLZSSVrMpUTGbxplMEJmUa2GjExae3e5aD02vu5w+FB1xJfBwXxljbfSYYrCDuzaJTh2zvJSuh3l4FSEAFIqTpUHhrkSU89kh46twUzw+Daq+VR32spmOvb3DXTBeTUSj0iw+Avj4lFktLyQSRcfwRVcciUg+zYiASovYJsG1KMZwAIZAunSoQ57NsjwrpncwUWRziw21+UJ1UsaEXuSbuDVWobJVaE0QxkmuOmlPnHVPrtkSE3a2KRyvS08vqLhNWCgwSU3Rg11FfApxcp3NwW7MCAMPixkn7yMkS7OZjPHNMAF9eHmudNEZVqgxdv7qlKD6QAfyVlM8vxa5kBedNkWpvezs728ptPqxCU2TCCgAdrhkrtCVL+QfgogHI1GF9HI1oAfBXMtLqQ0n4E4qUAAc47lteVmuWPt/2bvW5zZuJP/dfwVK+SB7ixq9aCv27cXltZysNnbiiqXbuvsighyQRDQc8OYhmfnrr35A4zEz4EMyFTtXrHVtRZyZ7kajATT6SbHylShmbM/B28MGpKtRBGAtFXmL8NLWn9SP6epv4z26WY4EkYwbIA8bW9lCEY6DNqRPDQQIVaNUa4Low/Nkjjn+vc5H3rZgVjp9HQPmWesrGXmQWL1mGg+0IkWS4ATkrQF/aIfgOkTojQZXgBy7FivFjOdIqKKEFzAa+ZyfTU9c2s8IqCy1+QQVmirFbiWGi7IN3uWQs5EoKt5IVrR7VeFwjBF4aWFSc2t0bp2oYmE2K0pSLSv00hV5iU7d+rUl6WZg2Fhmmds2gh4R2eIeuxHt5BtsSQ9SyLTUU6t7MzHu6NDuPLfBzIZyUqu6zBZGmvU3zRbDpbvPaXchxzbeY9yWndPbe60LvKLAf5Uw9t+es1TiNyywZBLMYeslmqzcDxL6gfLWnZAh2hcnd2WhYn3VJkTU2HoGiZwPsKcNqJjfALZ8HFnYAl3rA9+snwGatK4jNytlsrH3eJkiSJ4gAwcHpnJUYpAI3crVTNUl2TkN3/3PBNPtFATo6ZtPvzyjAl9Z0JauZIKPpm7PoOJxaISOpgpdtfP58YuX7TE3XFR/tleqQd5PSk0ywd6/f/uoubb/wAMYBiufY0cbGE2T2Ta77Gv1boxVjuxQ9hBW0T5t4Ce78OJdePEuvHgXXrwLL/5/FF4s52toiF9G97vhvTa4l15nWNeQq1bsDLv4eNuHknTx8faFhSHaOtCfFhUcC0nOeZXI+QbLeglvLpEtSZehOdgTKu9Djgy1X95cujsxdZ2TpC0RSG3YmBfyFjao8w//EyZWNteKvmFliqdsyDMkm2G12gA2c8kuVI1F3GIyxtlNQF2nZ663UYcMAPxvmAWUBN3kwCqtrjHQj5S1/RAdrmmr3yAP+F5T8JHYvkzEdxXHdxXHdxXHdxXHdxXHv6mK41TNrG23tz+tMNxbIzBqobWtwPYQ0M9UEemwCU2fiEODzZHKMoGqjPHIMhdVNpbwh+VpIJ26FAzstZTBP5Yjhxt3MIqnu4eRUsynYobOpI9Y4eudxRFuT4quN5b8p3KslVnxWZYVavISKEZ1u1IqxYAmadqeDFN/gSz4QuhwgtKU3hgQQL36UqVbjtpygoFwfM/74+dHR+MGMx5lOe1ftdePldqiznPYLi3F7MKrFGCJSfWYF7IM9hw1Nr5N3UjV3BsbQ/bmU+d/1wKTZdR7tctY+qRteFyExFD5ohm/gUO1QrXvUg4z0dg9HWQtp0FJB72Vqjyo70tgmx5CuDtRqVSO0K9e0+tAihkKGqZhr3D37BdVkU1f6rpZLBfGGltSXQ7ymOl12SAD/lQ7HZYkBzbwHlASgyIPg26BRls6LNL6TwgcFX7pylt6eiaei+FYHHHxYtR/eXaSDsXL8dHxWZ8fvzg9Gw6/P+mfjdfVbNqORIZHMI2anC7B7hTJL2J55ENZ+pWJ7d+UySR5gW38rkThiOpOuZbH3pRhYfGZB8gLvzSs2sJnQaOjqVi4ipQ2ekS6kAT8b7hoLgzQ7X3vjL1B8Am80IY8SGcqceUa1hg5fQbHHa90uRAVVOfFZJdxuQdDyYXtB0s3MhpKK5KOytro4khqzN6FFY9DNmtho2IoVonA41FWl1g8oUXC+HT/IXhVdkFI3UQ9FWNeZ4hyHqm5Cw1x/MJmTx4aB1OOsVYtDFpBIiLqIhzDAe0ADbkug7iLbQr2W+oJqeE72aIxfZX8vXutLuB14R5UaUcrJTEtoLG7un0tUGfsSGINNC/GBoivkqJdhk3qmsLY86sJUH1hM1eCadCY+MEawWhMB+ktjzEj/0UpBq0JcX7mO75yVvwepuuYqRsYhDlls4mKqTxb0EXBHSo0Ggggtwi73DhNTpKw1JNxRzeUU//LCt3UvNVRSzvBCYTAUGUsM4fNg7QJKYhCWBN/EFqfKAjhm/SSk79/5yXfecl3XvIVXnKzTmiagsX9FV3lhqSdq3znKt+5yneu8p2rfOcqX+Eq14fFX85VTlQ/qqucrgBrXMQ8I78qAdWeYus9jrqJg4hp1IvWF6B88s27zZeyI/lCfnyDbvPNlbo/0Xcekfmd73znO9/5zne+853v/JvynVcFH9kdncyTl8FPy+2T54FfhYDEvYg859niD4GmOpANHJismhaqnkwVNRWGPh/2SGMwJEsUIEdSDxIXZa6dyLqLD/k34SdGgbNMllORwvkRjoXBvGtZQe2CS3bgD06b7IYsY3puzXTjQuXVAXImiR6CeAD9ADmNUpRsxlM3Di8XQz66Cb8sk40NpqBePN5muNxdbRD7JfsGqt6INqLSj42crbqjXZCnR940U2uBVWoikA+oUwMdSJK/nt06LMOnPE9RZmK48Gi0AnZAmmfgtEv228LcH45fnoxPn5+dDU/7KX/BT0fi5cnL9Egcif7ZadPnGmYWfh0mO/QtVtvfbR/SqZxMwRx339bZQTPBkeJmojax9VrG9HTbPgfSlFwi/mL52UjGDvuOjsZHL844Pxryl0cnw7NgV6iLLNwRrn57v2Y3uPrtPQk18tBv0Wy2rOfQMakyEWas0nuoDgTgGbv67T21NaA3rWIMHgwLwU2au7pD3jca/Y8QbdIjJaynC+nQ94qpfPOF9rhK6Dk5DWgTLrKea6u4h6ZU5ExLRmovWHMXufZCaH8xJhD8nPGFSWilhEvciE0PBs1XkwCcLXxBM+uxcFAx3sS4ohFzwkvRo0xod0s1Xr6JsjeIAXkXyEHREZrmEBp8HRd8MvO3qa1zFjcMqULWMj6uqIbq4LtBwOhKzUPuXsIH/t3AdpGFBFooluhkvzmWx6sHeDHW0LX8a1eVnGE+qYSCTkxHOzA3W4vAJ6RzMS06hvNqUBdZAngD5OHqrTdsQycRRoXWGFVRIzRNL0CT5WtPu6ZDjLpjtac9bKvmp/9Vv396aNy+r//3P+l38/d3lWr2D7INhx+Jq/tXuelKLFLfyFmLCCX9h6N1o4xFGuWRLi69sGhv6lan7l5jJ1PzvxDcbmN6evgINTm0U97AwKeypLpvv6Orlku7tj18sLE1hDecTVdrw33mwHKtesHvbQntNTbeaLzcgyYWUrTkcWPO57wsg5nc9px/JPB2LdOp19S+wc1Hw19NW7iDPYgYtJesMbtEyXmw6aVDR79/2rkF9PunDaJ0nY4NqHoIk3TojEZAQuyM+Zpe8wRXiXwSHQPBRHdlttcSts4e/1rv8eIzSquKoAlniEXXGjAnLN08cSqwweuBXqHuUqYrLuaKvtW020hObNpYqYPXAx2bat/qBcj0BxT16iC6XtizeeXp0aSbNwf0dStaqBEOx4aiuhPCH/NAijA8HBltm6PRmh5rbj9p6Etlb0/vLuEsIaLT1DEavIqex4beJftUY2S4SDyiSeaKwLcGF5aLcXUlrZps/16uJ+vVgO+stmxXQ9Pg4WJ79KvmXMJJnolb7g7rSkWCZn8Mypiibz9uzQKe14b5Ar9IVIvXS8GafUz742rKzWVbpjZk1qr0rmISnZR6mZXBTdt2wPz6avhXtAV/TTPwX8gC/Bcw/n5tu+/O5LvW5CvTR7Cqfom191s19OKtaz6xd73gyGL+1w0OLgPDHl8+dwfWCyp6bSs7uiOTiLucioWteD1Vd6yeQ6BggCVTFsbVaIOiF8acF1CDakeqVZzucdYIFyjfnJtHWcmErT0l8uPUhmcuF5ZHIcizrkPUJz7mhfwzb+pXOU1oEE5tibyOE/lB/SGzjB8+T47YU8PG/2BvP14RS9EU4fjk+tiYpm3p/mfszXyeiX+L4c+yOnxx9Bxd6m25bMae/vzPyw/ve+abn8ToRj1jFFN+eHySHLEPaigzcXj8/N1x/3vi0+GLo3bnol0vtF0vtF0vtF0vtO31QntcUlt5MyuOBuyCTw7Aj1dsKHRnaJ6Ppqowfx6M1GymOUq6BBLTnrSw/aBJeGvtLOYT/bnVINzlAboAbpLaHk3dzJ7Ej3NzSrR6fMZYsrJxJ426ARmUJaiY+IfPpDCAeSadaRc2xVd08W69PJMTSAI4XRW1aEI3Y6E3DVg1/F2MrJJr/rheO5If6MeAs3oebVN03MUIWWt8oiicW7atOC1F8g4fETyrumOh8jSVVIEWujsm0OY7ajx0N23OYUhNkK23bAZXkOVJC9LhLGg9kR3p6E6iS/jdZP400KjYdQFHZXQldEgSWhOKMrE5pk+WM6bBlEtp8mwRmGC/ZTK1q3eUqTr1C/Ut/rRGHZ0pyKmUQYTTH+ip0cdHjU9LGCEo9gILKk2v9QvXFqQtSq6KcCk3Rq0/SOaFguh7c4DbhejJwecnK4UhVHfpE8gjpdvoEYMExiLI5Qztebqo+Uwe8OEoPT457a/GfgEI7OLc2Rj0qNxUkGx+x95ATPRLKkvD7cASBMYljiV6ftbIWfTllXIW4LAE+iIRq9G4Acn0oZg2WDotXJuunwAbpZRfBxvMamT0QRJ8sCkuOsBkJqvF9QbHxuqvNsVKMr7pxHXW16Z4Ch3tvxGOxqtR+HY/SlFtuPAb0rn9O7K8zDO0yKraCb30DOu6hHnk2px/aEGYlSJQVwy+A7cZLVErHFnx0zH8JPyMTsQw9DDOrIBh8U+iTFuCCjvO/bHhq/C4uyfW1pebIX04uowPRVYy9h27/PX811fsn+oOZsoZn2OTLcXrAGxEnVqjUq3Yz/2ebkhIrOTiPPdyi1bwcam9gD4USCsdC/jc1rtIAgHF71HxpHMDPS6svgzno8vHFqMyWcyyhN4zTVsRVIJzMFf5gf+yZVo2pK+W9OVT07D/WhBDpTLB8w3ZO/Yc0ZH5ftq7eFWZDGuZdVF2Z9Sd3nvH358fH73c24ycXz8xjSG0QscJgakiug5W0VJWhahG082JsViMgyVfOAm8qYcwQ5h0YZLDn8PfInD9c6fsNTU3D9RrbGt3Vf/R2p3Vv7pW5tocn6s02ZDdKzgacGCuUk1Vd3KBqpbp1jB9VCm7ujjvIsL/l3M+EltD5SF2kSFDd6sczK2xrouMtsu/ffHGHDy+nvH5HO2VzC6z97e9e1NMB8mMz7sk68QCakPxrdEd0BYnvhC6lUIpGpdYT36XwM0Qe7hLJjoV80wtdOTkVhF7uEsQQxGEf3HrQw4AL0HtT6itInZg16KNK31fjtfApQOG9nJ/ulCHuPjRYtvHuXPFXWpj54CHfb9DQHzeVO0kDEmnoWtM9aQR/64ydSP5Aa8rlcpypG7Dy8m/zFN2Tk8WLHzP2UI2sZ5EQIWnMNHhQCZLuEjvJcbE1DQXx0QiQhf+WUsw1V9RY0cAWUaX45Tp/dG9QxUX/bmONeLeq061ayjmTUjbTwpMSFlaI0oOF8CiqucN461WhOEi0AULnPUTmBFUxmcC4eGqYEMBEHreRKVVchP6pH/AnyZyT6aatFLc6qqWiBMvTbQa2uYETfaYTHt4dQo1rUkSIhd0K0Ntk4yxkPIx5oVK61F1f0ZeUnUQs3YJDNREN7ZVaB8sLg20+6VzcTwNMD9bgzpPVfEwzOZby2o//EAWSlddUOZxOmxay72xI1h0issnIusNOpJWTckqpo9qH7EfuyYtwfpvF8tvx4fAbividKXkNbr1VeilRH5UxHjbbS1TE7+LvVcTNpaZYZ0J91jlqMns65nMm36YxjAzNUnwWhJEWcdYi0gQWYjUXyLWMBxA2+V2QYrmAlqkUh1KTZUVB5M+xWOdtgEviQX5vWKDw1teHGZqckjlqjI1GSTdcVLOQLOu2RcPlmqzEdTOkNWE/GF23OzQFwCIEKnG41JUy0LJHzYNBiYFwSJ+XaRmLvSWXDLedqLhsstn2+IQJNdAZHcokgYuYDf3ewCihnp2Qe6XVarqah9RBfhvURT7TfJkPq+r0NLrydEGqbVc0QA0d9vz5edKu/xRlKIhqBLJKJUVSt1r1FfcdDisE2EAFAOmNBE2gcUgL6l5JO2HP8pMwDlFGwSJe3NSFiWkmPqjLrYnIgQQHuqCe9ssaJ0XUqFKUJwU+3RrpFiA9pQweMhPGyVBn/Sw2+sL4rYkFmyZ1jNuZBU6KLOIVk/Ko5NhEcXJiKkGXzIfVkNoTodtFNozNTaxHiDwv/34lj3vn/TZTJQln4gyTiMUMJluk0VWpbs4t4S2SekhrwoHP6oLmxQ8372UsQFBSOaIdJVjejuvdYW2+DBm5WS7owiL07bpj5NgUgKhS1yT93eJ1WOFxePeZHqkptSqyATu+uUy0ntA6G5IDhR9hobu5l5hf3CaAFRwdwVoy5KbsEJNiu2dVO1K2gS+hRy8H2d8Uq4DFldv9KcWQ2xrQ6ZMYtriliIhde86E/mkpaJFgiEanw5VukjCCpNRz41F68M3N7zd2w+VBR/7pP3R8kyH7gw2yMOJ2DIZxeZ7xcy6M5ZAmbIH7pw3d4PYhFjUM5XWmVgzBQZA49WVbMfWfq0jRyo+m28EfFSIoKj6SujGI5rwqirKB20QS/gZwvXiTVZa3KBFfisLlWNvYLe8kDi9SnZXoFdAjt3AQNgv2b8+/fqLnhvovxMohmkhXeemThcO+Mb0uUMO+EwYrVInWuNhcMUijasJl9Sx9pYiR7N5MlLpA4Tr4u2Hj1TerwsyUFPvCzISFSUnDwf5UxzkDR/f8CfL1u+y1VupOcXDLidkBTH49zMQE6AOfJhuZFDcM6rQbITAQSI7Wdc+27hzPRwRXbNs/xQ6ALvobsSig+sBjLsRqJapCmqgDgGna4khhy4nVtvRT5bSNMzU6KazDXn60rCZwia8QPk89lR3SUHn6/SZQcE8ig4NU8FTUZQd3DpjcTPkb2x+oxoTIQYoRe+W5Mf3s9OznAkCo82/vb/fiMUPr9jfb3lWix/2kif/NwC3nUux"
 }
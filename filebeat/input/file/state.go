@@ -40,6 +40,10 @@ type State struct {
 	Type        string            `json:"type"`
 	Meta        map[string]string `json:"meta"`
 	FileStateOS file.StateOS
+
+	// Cursor stores the read position of inputs not reading from files, for
+	// example the last pagination cursor of an HTTP API.
+	Cursor string `json:"cursor,omitempty"`
 }
 
 // NewState creates a new file state
@@ -50,6 +50,7 @@ type kafkaInputConfig struct {
 	TLS            *tlscommon.Config `config:"ssl"`
 	Username       string            `config:"username"`
 	Password       string            `config:"password"`
+	Sasl           kafka.SaslConfig  `config:"sasl"`
 }
 
 type kafkaFetch struct {
@@ -140,6 +141,12 @@ func (c *kafkaInputConfig) Validate() error {
 	if c.Username != "" && c.Password == "" {
 		return fmt.Errorf("password must be set when username is configured")
 	}
+
+	if c.Username != "" {
+		if err := c.Sasl.ValidateVersion(c.Version); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -178,9 +185,7 @@ func newSaramaConfig(config kafkaInputConfig) (*sarama.Config, error) {
 	}
 
 	if config.Username != "" {
-		k.Net.SASL.Enable = true
-		k.Net.SASL.User = config.Username
-		k.Net.SASL.Password = config.Password
+		config.Sasl.ConfigureSarama(k, config.Username, config.Password)
 	}
 
 	// configure client ID
@@ -0,0 +1,79 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package log
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"os"
+
+	"github.com/elastic/beats/libbeat/common/file"
+)
+
+// gzipMagic are the first bytes of every gzip stream (RFC 1952).
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// isGzipFile checks the magic bytes at the beginning of the file without
+// changing its read offset.
+func isGzipFile(f *os.File) (bool, error) {
+	buf := make([]byte, len(gzipMagic))
+	n, err := f.ReadAt(buf, 0)
+	if err != nil && err != io.EOF {
+		return false, err
+	}
+	return n == len(gzipMagic) && bytes.Equal(buf, gzipMagic), nil
+}
+
+// GzipFile reads the decompressed content of a gzip compressed file.
+// Offsets in the decompressed stream can't be mapped back to the compressed
+// file, so the source is not continuable and does not support seeking: it is
+// always read from the beginning until the end of the stream is reached.
+type GzipFile struct {
+	File   *os.File
+	reader *gzip.Reader
+}
+
+func newGzipFile(f *os.File) (*GzipFile, error) {
+	r, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, err
+	}
+	return &GzipFile{File: f, reader: r}, nil
+}
+
+func (g *GzipFile) Name() string               { return g.File.Name() }
+func (g *GzipFile) Stat() (os.FileInfo, error) { return g.File.Stat() }
+func (g *GzipFile) Continuable() bool          { return false }
+func (g *GzipFile) HasState() bool             { return true }
+func (g *GzipFile) Removed() bool              { return file.IsRemoved(g.File) }
+
+// Read reads decompressed data. Like for plain files, io.EOF is only returned
+// once no more data is available, so the last read lines are not discarded.
+func (g *GzipFile) Read(b []byte) (int, error) {
+	n, err := g.reader.Read(b)
+	if n > 0 && err == io.EOF {
+		err = nil
+	}
+	return n, err
+}
+
+func (g *GzipFile) Close() error {
+	g.reader.Close()
+	return g.File.Close()
+}
@@ -0,0 +1,148 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package log
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/filebeat/input/file"
+	"github.com/elastic/beats/libbeat/reader/readfile"
+	"github.com/elastic/beats/libbeat/reader/readfile/encoding"
+)
+
+func TestIsGzipFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "filebeat-gzip")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	tests := map[string]struct {
+		content  []byte
+		expected bool
+	}{
+		"empty":      {content: nil, expected: false},
+		"short":      {content: []byte{0x1f}, expected: false},
+		"plain text": {content: []byte("hello world\n"), expected: false},
+		"gzip":       {content: gzipData(t, "hello world\n"), expected: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(dir, name)
+			require.NoError(t, ioutil.WriteFile(path, test.content, 0644))
+
+			f, err := os.Open(path)
+			require.NoError(t, err)
+			defer f.Close()
+
+			compressed, err := isGzipFile(f)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, compressed)
+
+			// The read offset must not have been changed
+			offset, err := f.Seek(0, os.SEEK_CUR)
+			assert.NoError(t, err)
+			assert.Equal(t, int64(0), offset)
+		})
+	}
+}
+
+func TestReadGzipFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "filebeat-gzip")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	firstLineString := "first line\n"
+	secondLineString := "this is line 2\n"
+
+	path := filepath.Join(dir, "test.log.gz")
+	require.NoError(t, ioutil.WriteFile(path, gzipData(t, firstLineString+secondLineString), 0644))
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	info, err := f.Stat()
+	require.NoError(t, err)
+
+	h := Harvester{
+		config: config{
+			LogConfig: LogConfig{
+				CloseInactive: 500 * time.Millisecond,
+				Backoff:       100 * time.Millisecond,
+				MaxBackoff:    1 * time.Second,
+				BackoffFactor: 2,
+			},
+			BufferSize:     100,
+			MaxBytes:       1000,
+			LineTerminator: readfile.LineFeed,
+		},
+		// An offset from the registry must not be used to resume the compressed stream
+		state: file.State{Source: path, Fileinfo: info, Offset: 5},
+	}
+
+	var ok bool
+	h.encodingFactory, ok = encoding.FindEncoding(h.config.Encoding)
+	require.True(t, ok)
+
+	h.source, err = h.validateFile(f)
+	require.NoError(t, err)
+	assert.True(t, h.compressed)
+	assert.Equal(t, int64(0), h.state.Offset)
+	assert.False(t, h.source.Continuable())
+
+	r, err := h.newLogFileReader()
+	require.NoError(t, err)
+
+	_, text, bytesread, _, err := readLine(r)
+	assert.NoError(t, err)
+	assert.Equal(t, firstLineString[:len(firstLineString)-1], text)
+	assert.Equal(t, len(firstLineString), bytesread)
+
+	_, text, bytesread, _, err = readLine(r)
+	assert.NoError(t, err)
+	assert.Equal(t, secondLineString[:len(secondLineString)-1], text)
+	assert.Equal(t, len(secondLineString), bytesread)
+
+	// The end of the stream closes the reader instead of waiting for more data
+	_, _, _, _, err = readLine(r)
+	assert.Equal(t, io.EOF, err)
+
+	h.markCompressedFileRead()
+	assert.Equal(t, info.Size(), h.state.Offset)
+}
+
+func gzipData(t *testing.T, content string) []byte {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	_, err := w.Write([]byte(content))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
@@ -91,6 +91,11 @@ type Harvester struct {
 	states *file.States
 	log    *Log
 
+	// compressed is set for gzip files. As their state can't be resumed,
+	// readOffset tracks the offset in the decompressed stream instead.
+	compressed bool
+	readOffset int64
+
 	// file reader pipeline
 	reader          reader.Reader
 	encodingFactory encoding.EncodingFactory
@@ -271,6 +276,11 @@ func (h *Harvester) Run() error {
 			case ErrClosed:
 				logp.Info("Reader was closed: %s. Closing.", h.state.Source)
 			case io.EOF:
+				if h.compressed {
+					logp.Info("End of compressed file reached: %s. Closing.", h.state.Source)
+					h.markCompressedFileRead()
+					break
+				}
 				logp.Info("End of file reached: %s. Closing because close_eof is enabled.", h.state.Source)
 			case ErrInactive:
 				logp.Info("File is inactive: %s. Closing because close_inactive of %v reached.", h.state.Source, h.config.CloseInactive)
@@ -289,7 +299,14 @@ func (h *Harvester) Run() error {
 		// the old offset is reported
 		state := h.getState()
 		startingOffset := state.Offset
-		state.Offset += int64(message.Bytes)
+		if h.compressed {
+			// The state of a compressed file keeps pointing to the beginning
+			// of the file until it was read completely.
+			startingOffset = h.readOffset
+			h.readOffset += int64(message.Bytes)
+		} else {
+			state.Offset += int64(message.Bytes)
+		}
 
 		// Stop harvester in case of an error
 		if !h.onMessage(forwarder, state, message, startingOffset) {
@@ -434,30 +451,37 @@ func (h *Harvester) openFile() error {
 	harvesterOpenFiles.Add(1)
 
 	// Makes sure file handler is also closed on errors
-	err = h.validateFile(f)
+	h.source, err = h.validateFile(f)
 	if err != nil {
 		f.Close()
 		harvesterOpenFiles.Add(-1)
 		return err
 	}
 
-	h.source = File{File: f}
 	return nil
 }
 
-func (h *Harvester) validateFile(f *os.File) error {
+func (h *Harvester) validateFile(f *os.File) (harvester.Source, error) {
 	info, err := f.Stat()
 	if err != nil {
-		return fmt.Errorf("Failed getting stats for file %s: %s", h.state.Source, err)
+		return nil, fmt.Errorf("Failed getting stats for file %s: %s", h.state.Source, err)
 	}
 
 	if !info.Mode().IsRegular() {
-		return fmt.Errorf("Tried to open non regular file: %q %s", info.Mode(), info.Name())
+		return nil, fmt.Errorf("Tried to open non regular file: %q %s", info.Mode(), info.Name())
 	}
 
 	// Compares the stat of the opened file to the state given by the input. Abort if not match.
 	if !os.SameFile(h.state.Fileinfo, info) {
-		return errors.New("file info is not identical with opened file. Aborting harvesting and retrying file later again")
+		return nil, errors.New("file info is not identical with opened file. Aborting harvesting and retrying file later again")
+	}
+
+	h.compressed, err = isGzipFile(f)
+	if err != nil {
+		return nil, fmt.Errorf("Failed reading header of file %s: %s", h.state.Source, err)
+	}
+	if h.compressed {
+		return h.openGzipFile(f)
 	}
 
 	h.encoding, err = h.encodingFactory(f)
@@ -468,19 +492,56 @@ func (h *Harvester) validateFile(f *os.File) error {
 		} else {
 			logp.Err("Initialising encoding for '%v' failed: %v", f, err)
 		}
-		return err
+		return nil, err
 	}
 
 	// get file offset. Only update offset if no error
 	offset, err := h.initFileOffset(f)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	logp.Debug("harvester", "Setting offset for file: %s. Offset: %d ", h.state.Source, offset)
 	h.state.Offset = offset
 
-	return nil
+	return File{File: f}, nil
+}
+
+// openGzipFile sets up reading the decompressed content of a gzip file.
+// Reading always starts from the beginning of the file, as a previous offset
+// can't be resumed in the middle of a compressed stream.
+func (h *Harvester) openGzipFile(f *os.File) (harvester.Source, error) {
+	if h.state.Offset > 0 {
+		logp.Info("Compressed file %s can't be resumed at offset %d. Reading from the beginning.", h.state.Source, h.state.Offset)
+	}
+	h.state.Offset = 0
+	h.readOffset = 0
+
+	gz, err := newGzipFile(f)
+	if err != nil {
+		return nil, fmt.Errorf("Failed opening gzip file %s: %s", h.state.Source, err)
+	}
+
+	h.encoding, err = h.encodingFactory(gz)
+	if err != nil {
+		logp.Err("Initialising encoding for '%v' failed: %v", f, err)
+		gz.reader.Close()
+		return nil, err
+	}
+
+	logp.Debug("harvester", "Reading gzip compressed file: %s", h.state.Source)
+	return gz, nil
+}
+
+// markCompressedFileRead sets the offset of a completely read compressed file
+// to its size. This way it is not picked up again unless it changes.
+func (h *Harvester) markCompressedFileRead() {
+	info, err := h.source.Stat()
+	if err != nil {
+		logp.Err("Failed getting stats for file %s: %s", h.state.Source, err)
+		info = h.state.Fileinfo
+	}
+	h.state.Offset = info.Size()
 }
 
 func (h *Harvester) initFileOffset(file *os.File) (int64, error) {
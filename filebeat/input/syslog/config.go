@@ -30,8 +30,22 @@ import (
 	"github.com/elastic/beats/libbeat/common"
 )
 
+// Supported syslog message formats.
+const (
+	formatAuto    = "auto"
+	formatRFC3164 = "rfc3164"
+	formatRFC5424 = "rfc5424"
+)
+
+// Supported TCP framing methods.
+const (
+	framingDelimiter = "delimiter"
+	framingRFC6587   = "rfc6587"
+)
+
 type config struct {
 	harvester.ForwarderConfig `config:",inline"`
+	Format                    string                 `config:"format"`
 	Protocol                  common.ConfigNamespace `config:"protocol"`
 }
 
@@ -39,11 +53,38 @@ var defaultConfig = config{
 	ForwarderConfig: harvester.ForwarderConfig{
 		Type: "syslog",
 	},
+	Format: formatAuto,
+}
+
+// Validate validates the syslog input configuration.
+func (c *config) Validate() error {
+	switch c.Format {
+	case formatAuto, formatRFC3164, formatRFC5424:
+		return nil
+	default:
+		return fmt.Errorf("invalid format '%s', expected one of %s, %s or %s",
+			c.Format, formatAuto, formatRFC3164, formatRFC5424)
+	}
 }
 
 type syslogTCP struct {
 	tcp.Config    `config:",inline"`
 	LineDelimiter string `config:"line_delimiter" validate:"nonzero"`
+	Framing       string `config:"framing"`
+}
+
+// Validate validates the TCP framing method.
+func (c *syslogTCP) Validate() error {
+	if err := c.Config.Validate(); err != nil {
+		return err
+	}
+
+	switch c.Framing {
+	case framingDelimiter, framingRFC6587:
+		return nil
+	default:
+		return fmt.Errorf("invalid framing '%s', expected %s or %s", c.Framing, framingDelimiter, framingRFC6587)
+	}
 }
 
 var defaultTCP = syslogTCP{
@@ -52,6 +93,7 @@ var defaultTCP = syslogTCP{
 		MaxMessageSize: 20 * humanize.MiByte,
 	},
 	LineDelimiter: "\n",
+	Framing:       framingDelimiter,
 }
 
 var defaultUDP = udp.Config{
@@ -77,6 +119,10 @@ func factory(
 			return nil, fmt.Errorf("error creating splitFunc from delimiter %s", config.LineDelimiter)
 		}
 
+		if config.Framing == framingRFC6587 {
+			splitFunc = tcp.OctetCountingSplitFunc(splitFunc)
+		}
+
 		factory := tcp.SplitHandlerFactory(nf, splitFunc)
 
 		return tcp.New(&config.Config, factory)
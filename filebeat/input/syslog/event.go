@@ -72,6 +72,12 @@ type event struct {
 	year       int
 	loc        *time.Location
 	sequence   int
+
+	// RFC 5424 only fields.
+	version        int
+	procID         string
+	msgID          string
+	structuredData map[string]map[string]interface{}
 }
 
 // newEvent() return a new event.
@@ -86,6 +92,7 @@ func newEvent() *event {
 		second:   -1,
 		year:     time.Now().Year(),
 		sequence: -1,
+		version:  -1,
 	}
 }
 
@@ -282,6 +289,66 @@ func (s *event) Sequence() int {
 	return s.sequence
 }
 
+// SetVersion sets the version of the RFC 5424 syslog protocol.
+func (s *event) SetVersion(b []byte) {
+	s.version = bytesToInt(b)
+}
+
+// Version returns the version of the syslog protocol, -1 for RFC 3164 events.
+func (s *event) Version() int {
+	return s.version
+}
+
+// SetProcID sets the process ID, it can be any string in RFC 5424.
+func (s *event) SetProcID(b []byte) {
+	s.procID = string(b)
+}
+
+// ProcID returns the process ID.
+func (s *event) ProcID() string {
+	return s.procID
+}
+
+// SetMsgID sets the message type identifier.
+func (s *event) SetMsgID(b []byte) {
+	s.msgID = string(b)
+}
+
+// MsgID returns the message type identifier.
+func (s *event) MsgID() string {
+	return s.msgID
+}
+
+// AddStructuredData adds a parameter of a structured data element. Parameters
+// with the same name in the same element are collected into a list.
+func (s *event) AddStructuredData(id, name, value string) {
+	if s.structuredData == nil {
+		s.structuredData = map[string]map[string]interface{}{}
+	}
+	params, ok := s.structuredData[id]
+	if !ok {
+		params = map[string]interface{}{}
+		s.structuredData[id] = params
+	}
+	if name == "" {
+		return
+	}
+
+	switch prev := params[name].(type) {
+	case nil:
+		params[name] = value
+	case string:
+		params[name] = []string{prev, value}
+	case []string:
+		params[name] = append(prev, value)
+	}
+}
+
+// StructuredData returns the structured data elements by ID.
+func (s *event) StructuredData() map[string]map[string]interface{} {
+	return s.structuredData
+}
+
 // SetNanoSecond sets the nanosecond.
 func (s *event) SetNanosecond(b []byte) {
 	// We assume that we receive a byte array representing a nanosecond, this might not be
@@ -129,9 +129,9 @@ func NewInput(
 	forwarder := harvester.NewForwarder(out)
 	cb := func(data []byte, metadata inputsource.NetworkMetadata) {
 		ev := newEvent()
-		Parse(data, ev)
-		if !ev.IsValid() {
-			log.Errorw("can't parse event as syslog rfc3164", "message", string(data))
+		format, err := parseEvent(config.Format, data, ev)
+		if err != nil {
+			log.Errorw("can't parse event as syslog "+format, "message", string(data), "error", err)
 			// On error revert to the raw bytes content, we need a better way to communicate this kind of
 			// error upstream this should be a global effort.
 			forwarder.Send(beat.Event{
@@ -198,6 +198,21 @@ func (p *Input) Wait() {
 	p.Stop()
 }
 
+// parseEvent parses data using the configured format. In auto mode the
+// format is detected by the header of each message. The name of the format
+// used is returned.
+func parseEvent(format string, data []byte, ev *event) (string, error) {
+	if format == formatRFC5424 || (format == formatAuto && IsRFC5424(data)) {
+		return formatRFC5424, ParseRFC5424(data, ev)
+	}
+
+	Parse(data, ev)
+	if !ev.IsValid() {
+		return formatRFC3164, errors.New("invalid rfc3164 message")
+	}
+	return formatRFC3164, nil
+}
+
 func createEvent(ev *event, metadata inputsource.NetworkMetadata, timezone *time.Location, log *logp.Logger) beat.Event {
 	f := common.MapStr{
 		"message": strings.TrimRight(ev.Message(), "\n"),
@@ -254,6 +269,26 @@ func createEvent(ev *event, metadata inputsource.NetworkMetadata, timezone *time
 		f["event.sequence"] = ev.Sequence()
 	}
 
+	if ev.Version() != -1 {
+		syslog["version"] = ev.Version()
+	}
+
+	if ev.ProcID() != "" {
+		syslog["procid"] = ev.ProcID()
+	}
+
+	if ev.MsgID() != "" {
+		syslog["msgid"] = ev.MsgID()
+	}
+
+	if sd := ev.StructuredData(); len(sd) > 0 {
+		elements := common.MapStr{}
+		for id, params := range sd {
+			elements[id] = common.MapStr(params)
+		}
+		syslog["structured_data"] = elements
+	}
+
 	return beat.Event{
 		Timestamp: ev.Timestamp(timezone),
 		Meta: common.MapStr{
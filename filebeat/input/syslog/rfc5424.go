@@ -0,0 +1,351 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package syslog
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const nilValue = '-'
+
+var (
+	utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
+	errRFC5424Truncated = errors.New("rfc5424: message truncated")
+)
+
+// IsRFC5424 returns true if the message starts with a priority followed by
+// a protocol version, which is the header of RFC 5424 messages. RFC 3164
+// messages are followed by the timestamp or the hostname.
+func IsRFC5424(data []byte) bool {
+	i := bytes.IndexByte(data, '>')
+	if len(data) == 0 || data[0] != '<' || i < 2 || i > 4 {
+		return false
+	}
+
+	// VERSION = NONZERO-DIGIT 0*2DIGIT
+	v := data[i+1:]
+	n := 0
+	for n < len(v) && n < 3 && isDigit(v[n]) {
+		n++
+	}
+	return n > 0 && v[0] != '0' && n < len(v) && v[n] == ' '
+}
+
+// ParseRFC5424 parses a syslog message in the format defined by RFC 5424:
+//
+//   <PRI>VERSION TIMESTAMP HOSTNAME APP-NAME PROCID MSGID STRUCTURED-DATA [MSG]
+//
+// Unlike RFC 3164 messages, the header is strictly defined, an error is
+// returned if the message does not follow the format.
+func ParseRFC5424(data []byte, event *event) error {
+	p := rfc5424Parser{data: data}
+
+	pri, err := p.priority()
+	if err != nil {
+		return err
+	}
+	event.SetPriority(pri)
+
+	version, err := p.version()
+	if err != nil {
+		return err
+	}
+	event.SetVersion(version)
+
+	if err := p.timestamp(event); err != nil {
+		return err
+	}
+
+	fields := []struct {
+		name   string
+		maxLen int
+		set    func([]byte)
+	}{
+		{"hostname", 255, event.SetHostname},
+		{"app-name", 48, event.SetProgram},
+		{"procid", 128, func(b []byte) {
+			event.SetProcID(b)
+			if isNumber(b) {
+				event.SetPid(b)
+			}
+		}},
+		{"msgid", 32, event.SetMsgID},
+	}
+	for _, f := range fields {
+		v, err := p.headerField(f.name, f.maxLen)
+		if err != nil {
+			return err
+		}
+		if v != nil {
+			f.set(v)
+		}
+	}
+
+	if err := p.structuredData(event); err != nil {
+		return err
+	}
+
+	if p.done() {
+		return nil
+	}
+	if err := p.expect(' '); err != nil {
+		return err
+	}
+	event.SetMessage(bytes.TrimPrefix(p.data[p.pos:], utf8BOM))
+	return nil
+}
+
+type rfc5424Parser struct {
+	data []byte
+	pos  int
+}
+
+func (p *rfc5424Parser) done() bool {
+	return p.pos >= len(p.data)
+}
+
+func (p *rfc5424Parser) expect(c byte) error {
+	if p.done() {
+		return errRFC5424Truncated
+	}
+	if p.data[p.pos] != c {
+		return fmt.Errorf("rfc5424: expected '%c' at position %d, found '%c'", c, p.pos, p.data[p.pos])
+	}
+	p.pos++
+	return nil
+}
+
+// token returns all bytes up to the next space, the space is consumed.
+func (p *rfc5424Parser) token() ([]byte, error) {
+	start := p.pos
+	for !p.done() && p.data[p.pos] != ' ' {
+		p.pos++
+	}
+	if p.done() {
+		return nil, errRFC5424Truncated
+	}
+	tok := p.data[start:p.pos]
+	p.pos++
+	return tok, nil
+}
+
+func (p *rfc5424Parser) priority() ([]byte, error) {
+	if err := p.expect('<'); err != nil {
+		return nil, err
+	}
+	start := p.pos
+	for !p.done() && isDigit(p.data[p.pos]) && p.pos-start < 3 {
+		p.pos++
+	}
+	pri := p.data[start:p.pos]
+	if len(pri) == 0 || bytesToInt(pri) > 191 {
+		return nil, fmt.Errorf("rfc5424: invalid priority '%s'", pri)
+	}
+	return pri, p.expect('>')
+}
+
+func (p *rfc5424Parser) version() ([]byte, error) {
+	v, err := p.token()
+	if err != nil {
+		return nil, err
+	}
+	if len(v) == 0 || len(v) > 3 || v[0] == '0' || !isNumber(v) {
+		return nil, fmt.Errorf("rfc5424: invalid version '%s'", v)
+	}
+	return v, nil
+}
+
+// timestamp parses the RFC 3339 timestamp. If the timestamp is missing, the
+// current time is used.
+func (p *rfc5424Parser) timestamp(event *event) error {
+	tok, err := p.token()
+	if err != nil {
+		return err
+	}
+
+	var ts time.Time
+	if len(tok) == 1 && tok[0] == nilValue {
+		ts = time.Now().UTC()
+	} else {
+		ts, err = time.Parse(time.RFC3339Nano, string(tok))
+		if err != nil {
+			return fmt.Errorf("rfc5424: invalid timestamp '%s'", tok)
+		}
+	}
+
+	event.year = ts.Year()
+	event.month = ts.Month()
+	event.day = ts.Day()
+	event.hour = ts.Hour()
+	event.minute = ts.Minute()
+	event.second = ts.Second()
+	event.nanosecond = ts.Nanosecond()
+	event.loc = ts.Location()
+	return nil
+}
+
+// headerField returns the next header field, or nil if the field has the
+// NILVALUE.
+func (p *rfc5424Parser) headerField(name string, maxLen int) ([]byte, error) {
+	tok, err := p.token()
+	if err != nil {
+		return nil, err
+	}
+	if len(tok) == 0 || len(tok) > maxLen || !isPrintASCII(tok) {
+		return nil, fmt.Errorf("rfc5424: invalid %s '%s'", name, tok)
+	}
+	if len(tok) == 1 && tok[0] == nilValue {
+		return nil, nil
+	}
+	return tok, nil
+}
+
+func (p *rfc5424Parser) structuredData(event *event) error {
+	if p.done() {
+		return errRFC5424Truncated
+	}
+	if p.data[p.pos] == nilValue {
+		p.pos++
+		return nil
+	}
+
+	for !p.done() && p.data[p.pos] == '[' {
+		if err := p.sdElement(event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sdElement parses an element like `[id param="value" ...]`.
+func (p *rfc5424Parser) sdElement(event *event) error {
+	if err := p.expect('['); err != nil {
+		return err
+	}
+	id, err := p.sdName("SD-ID")
+	if err != nil {
+		return err
+	}
+	event.AddStructuredData(string(id), "", "")
+
+	for {
+		if p.done() {
+			return errRFC5424Truncated
+		}
+		if p.data[p.pos] == ']' {
+			p.pos++
+			return nil
+		}
+
+		if err := p.expect(' '); err != nil {
+			return err
+		}
+		name, err := p.sdName("PARAM-NAME")
+		if err != nil {
+			return err
+		}
+		if err := p.expect('='); err != nil {
+			return err
+		}
+		value, err := p.sdValue()
+		if err != nil {
+			return err
+		}
+		event.AddStructuredData(string(id), string(name), value)
+	}
+}
+
+// sdName parses SD-NAME = 1*32PRINTUSASCII except '=', SP, ']', '"'.
+func (p *rfc5424Parser) sdName(kind string) ([]byte, error) {
+	start := p.pos
+	for !p.done() {
+		c := p.data[p.pos]
+		if c == '=' || c == ' ' || c == ']' || c == '"' || !isPrintASCIIByte(c) {
+			break
+		}
+		p.pos++
+	}
+	name := p.data[start:p.pos]
+	if len(name) == 0 || len(name) > 32 {
+		return nil, fmt.Errorf("rfc5424: invalid %s '%s'", kind, name)
+	}
+	return name, nil
+}
+
+// sdValue parses a quoted parameter value. The characters '"', '\' and ']'
+// are escaped with a backslash.
+func (p *rfc5424Parser) sdValue() (string, error) {
+	if err := p.expect('"'); err != nil {
+		return "", err
+	}
+
+	var value []byte
+	start := p.pos
+	for !p.done() {
+		c := p.data[p.pos]
+		switch c {
+		case '\\':
+			if p.pos+1 < len(p.data) {
+				if next := p.data[p.pos+1]; next == '"' || next == '\\' || next == ']' {
+					value = append(value, p.data[start:p.pos]...)
+					p.pos++
+					start = p.pos
+				}
+			}
+		case '"':
+			value = append(value, p.data[start:p.pos]...)
+			p.pos++
+			return string(value), nil
+		}
+		p.pos++
+	}
+	return "", errRFC5424Truncated
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isNumber(b []byte) bool {
+	if len(b) == 0 {
+		return false
+	}
+	for _, c := range b {
+		if !isDigit(c) {
+			return false
+		}
+	}
+	return true
+}
+
+func isPrintASCIIByte(c byte) bool {
+	return c >= 33 && c <= 126
+}
+
+func isPrintASCII(b []byte) bool {
+	for _, c := range b {
+		if !isPrintASCIIByte(c) {
+			return false
+		}
+	}
+	return true
+}
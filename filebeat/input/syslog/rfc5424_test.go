@@ -0,0 +1,215 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package syslog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/logp"
+)
+
+func TestIsRFC5424(t *testing.T) {
+	tests := map[string]bool{
+		"<34>1 2003-10-11T22:14:15.003Z mymachine su - ID47 - message": true,
+		"<165>12 - - - - - -":                                   true,
+		"<34>Oct 11 22:14:15 mymachine su: message":             false,
+		"<190>589265: Feb 8 18:55:31.306: %SEC-11-IPACCESSLOGP": false,
+		"<13>2018-06-19 02:13:38 super mon message":             false,
+		"<13>0 - - - - - -":                                     false,
+		"<13>1":                                                 false,
+		"hello":                                                 false,
+		"":                                                      false,
+	}
+
+	for msg, expected := range tests {
+		assert.Equal(t, expected, IsRFC5424([]byte(msg)), msg)
+	}
+}
+
+func TestParseRFC5424(t *testing.T) {
+	tests := []struct {
+		title    string
+		log      string
+		check    func(t *testing.T, e *event)
+		expected time.Time
+	}{
+		{
+			title:    "full header without structured data",
+			log:      "<34>1 2003-10-11T22:14:15.003Z mymachine.example.com su - ID47 - 'su root' failed for lonvick on /dev/pts/8",
+			expected: time.Date(2003, 10, 11, 22, 14, 15, 3000000, time.UTC),
+			check: func(t *testing.T, e *event) {
+				assert.Equal(t, 34, e.Priority())
+				assert.Equal(t, 1, e.Version())
+				assert.Equal(t, "mymachine.example.com", e.Hostname())
+				assert.Equal(t, "su", e.Program())
+				assert.Equal(t, "", e.ProcID())
+				assert.False(t, e.HasPid())
+				assert.Equal(t, "ID47", e.MsgID())
+				assert.Nil(t, e.StructuredData())
+				assert.Equal(t, "'su root' failed for lonvick on /dev/pts/8", e.Message())
+			},
+		},
+		{
+			title:    "timezone offset and BOM",
+			log:      "<165>1 2003-08-24T05:14:15.000003-07:00 192.0.2.1 myproc 8710 - - \xEF\xBB\xBF%% It's time to make the do-nuts.",
+			expected: time.Date(2003, 8, 24, 12, 14, 15, 3000, time.UTC),
+			check: func(t *testing.T, e *event) {
+				assert.Equal(t, "192.0.2.1", e.Hostname())
+				assert.Equal(t, "myproc", e.Program())
+				assert.Equal(t, "8710", e.ProcID())
+				assert.Equal(t, 8710, e.Pid())
+				assert.Equal(t, "", e.MsgID())
+				assert.Equal(t, "%% It's time to make the do-nuts.", e.Message())
+			},
+		},
+		{
+			title:    "structured data",
+			log:      `<165>1 2003-10-11T22:14:15.003Z mymachine.example.com evntslog - ID47 [exampleSDID@32473 iut="3" eventSource="Application" eventID="1011"][examplePriority@32473 class="high"] An application event log entry...`,
+			expected: time.Date(2003, 10, 11, 22, 14, 15, 3000000, time.UTC),
+			check: func(t *testing.T, e *event) {
+				assert.Equal(t, map[string]map[string]interface{}{
+					"exampleSDID@32473": {
+						"iut":         "3",
+						"eventSource": "Application",
+						"eventID":     "1011",
+					},
+					"examplePriority@32473": {
+						"class": "high",
+					},
+				}, e.StructuredData())
+				assert.Equal(t, "An application event log entry...", e.Message())
+			},
+		},
+		{
+			title:    "structured data only, escaped values and repeated parameters",
+			log:      `<165>1 2003-10-11T22:14:15Z host app worker-1 - [meta sequenceId="1" ip="192.0.2.1" ip="192.0.2.2" text="a \"quoted\" \] \\ \x"][empty]`,
+			expected: time.Date(2003, 10, 11, 22, 14, 15, 0, time.UTC),
+			check: func(t *testing.T, e *event) {
+				assert.Equal(t, "worker-1", e.ProcID())
+				assert.False(t, e.HasPid())
+				assert.Equal(t, map[string]map[string]interface{}{
+					"meta": {
+						"sequenceId": "1",
+						"ip":         []string{"192.0.2.1", "192.0.2.2"},
+						"text":       `a "quoted" ] \ \x`,
+					},
+					"empty": {},
+				}, e.StructuredData())
+				assert.Equal(t, "", e.Message())
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.title, func(t *testing.T) {
+			e := newEvent()
+			require.NoError(t, ParseRFC5424([]byte(test.log), e))
+			assert.Equal(t, test.expected, e.Timestamp(time.Local))
+			test.check(t, e)
+		})
+	}
+}
+
+func TestParseRFC5424NilTimestamp(t *testing.T) {
+	e := newEvent()
+	require.NoError(t, ParseRFC5424([]byte("<13>1 - - - - - -"), e))
+	assert.WithinDuration(t, time.Now(), e.Timestamp(time.Local), time.Minute)
+	assert.Equal(t, "", e.Hostname())
+	assert.Equal(t, "", e.Program())
+}
+
+func TestParseRFC5424Invalid(t *testing.T) {
+	tests := map[string]string{
+		"invalid priority":        "<192>1 - - - - - -",
+		"invalid version":         "<13>a - - - - - -",
+		"invalid timestamp":       "<13>1 Oct 11 22:14:15 host app - - - msg",
+		"missing structured data": "<13>1 - host app - -",
+		"unterminated element":    `<13>1 - host app - - [id a="b" msg`,
+		"unquoted value":          `<13>1 - host app - - [id a=b] msg`,
+		"missing space":           `<13>1 - host app - - [id a="b"]msg`,
+		"too long msgid":          "<13>1 - host app - 0123456789012345678901234567890123456789 - msg",
+	}
+
+	for title, log := range tests {
+		t.Run(title, func(t *testing.T) {
+			assert.Error(t, ParseRFC5424([]byte(log), newEvent()))
+		})
+	}
+}
+
+func TestCreateEventRFC5424(t *testing.T) {
+	e := newEvent()
+	log := `<165>1 2003-10-11T22:14:15.003Z mymachine evntslog 123 ID47 [exampleSDID@32473 iut="3"] message`
+	require.NoError(t, ParseRFC5424([]byte(log), e))
+
+	event := createEvent(e, dummyMetadata(), time.Local, logp.NewLogger("syslog"))
+
+	syslog, err := event.GetValue("syslog")
+	require.NoError(t, err)
+	assert.Equal(t, common.MapStr{
+		"priority":       165,
+		"facility":       20,
+		"facility_label": "local4",
+		"severity_label": "Notice",
+		"version":        1,
+		"procid":         "123",
+		"msgid":          "ID47",
+		"structured_data": common.MapStr{
+			"exampleSDID@32473": common.MapStr{"iut": "3"},
+		},
+	}, syslog)
+
+	process, err := event.GetValue("process")
+	require.NoError(t, err)
+	assert.Equal(t, common.MapStr{"pid": 123, "program": "evntslog"}, process)
+	assert.Equal(t, "mymachine", event.Fields["hostname"])
+	assert.Equal(t, "message", event.Fields["message"])
+}
+
+func TestParseEventFormat(t *testing.T) {
+	rfc3164 := []byte("<34>Oct 11 22:14:15 mymachine su: message")
+	rfc5424 := []byte("<34>1 2003-10-11T22:14:15.003Z mymachine su - - - message")
+
+	tests := []struct {
+		format   string
+		data     []byte
+		expected string
+		err      bool
+	}{
+		{formatAuto, rfc3164, formatRFC3164, false},
+		{formatAuto, rfc5424, formatRFC5424, false},
+		{formatRFC3164, rfc3164, formatRFC3164, false},
+		{formatRFC5424, rfc5424, formatRFC5424, false},
+		{formatRFC5424, rfc3164, formatRFC5424, true},
+	}
+
+	for _, test := range tests {
+		format, err := parseEvent(test.format, test.data, newEvent())
+		assert.Equal(t, test.expected, format)
+		if test.err {
+			assert.Error(t, err)
+		} else {
+			assert.NoError(t, err)
+		}
+	}
+}
@@ -0,0 +1,173 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package http
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+
+	"golang.org/x/net/netutil"
+
+	"github.com/elastic/beats/filebeat/inputsource"
+	"github.com/elastic/beats/libbeat/common/transport/tlscommon"
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/libbeat/outputs/transport"
+)
+
+// Server represent a HTTP server
+type Server struct {
+	config    *Config
+	Listener  net.Listener
+	server    *http.Server
+	handler   http.Handler
+	wg        sync.WaitGroup
+	log       *logp.Logger
+	tlsConfig *transport.TLSConfig
+}
+
+// New creates a new http server. Request bodies bigger than the configured
+// max_message_size are rejected with 413 Request Entity Too Large.
+func New(
+	config *Config,
+	handler http.Handler,
+) (*Server, error) {
+	tlsConfig, err := tlscommon.LoadTLSServerConfig(config.TLS)
+	if err != nil {
+		return nil, err
+	}
+
+	if handler == nil {
+		return nil, fmt.Errorf("Handler can't be empty")
+	}
+
+	return &Server{
+		config:    config,
+		handler:   handler,
+		log:       logp.NewLogger("http").With("address", config.Host),
+		tlsConfig: tlsConfig,
+	}, nil
+}
+
+// Start listen to the HTTP socket.
+func (s *Server) Start() error {
+	var err error
+	s.Listener, err = s.createServer()
+	if err != nil {
+		return err
+	}
+
+	s.server = &http.Server{
+		Handler:      http.HandlerFunc(s.serveHTTP),
+		ReadTimeout:  s.config.Timeout,
+		WriteTimeout: s.config.Timeout,
+	}
+	s.log.Info("Started listening for HTTP connection")
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		err := s.server.Serve(s.Listener)
+		if err != nil && err != http.ErrServerClosed {
+			s.log.Errorw("HTTP server stopped with error", "error", err)
+		}
+	}()
+	return nil
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	defer logp.Recover("recovering from a http client crash")
+
+	if max := int64(s.config.MaxMessageSize); r.ContentLength > max {
+		http.Error(w, "request body too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+	r.Body = http.MaxBytesReader(w, r.Body, int64(s.config.MaxMessageSize))
+	s.handler.ServeHTTP(w, r)
+}
+
+// Stop stops accepting new incoming HTTP connections and waits for active
+// requests to finish.
+func (s *Server) Stop() {
+	s.log.Info("Stopping HTTP server")
+	ctx, cancel := context.WithTimeout(context.Background(), s.config.Timeout)
+	defer cancel()
+	if err := s.server.Shutdown(ctx); err != nil {
+		s.log.Debugw("Failed to gracefully shutdown HTTP server", "error", err)
+		s.server.Close()
+	}
+	s.wg.Wait()
+	s.log.Info("HTTP server stopped")
+}
+
+func (s *Server) createServer() (net.Listener, error) {
+	var l net.Listener
+	var err error
+	if s.tlsConfig != nil {
+		t := s.tlsConfig.BuildModuleConfig(s.config.Host)
+		s.log.Info("Listening over TLS")
+		l, err = tls.Listen("tcp", s.config.Host, t)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		l, err = net.Listen("tcp", s.config.Host)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if s.config.MaxConnections > 0 {
+		return netutil.LimitListener(l, s.config.MaxConnections), nil
+	}
+	return l, nil
+}
+
+// Metadata returns the network metadata of a request.
+func Metadata(r *http.Request) inputsource.NetworkMetadata {
+	var remote net.Addr
+	if addr, err := net.ResolveTCPAddr("tcp", r.RemoteAddr); err == nil {
+		remote = addr
+	}
+
+	metadata := inputsource.NetworkMetadata{RemoteAddr: remote}
+	if state := r.TLS; state != nil {
+		metadata.TLS = &inputsource.TLSMetadata{
+			TLSVersion:       tlscommon.ResolveTLSVersion(state.Version),
+			CipherSuite:      tlscommon.ResolveCipherSuite(state.CipherSuite),
+			ServerName:       state.ServerName,
+			PeerCertificates: extractCertificate(state.PeerCertificates),
+		}
+	}
+	return metadata
+}
+
+func extractCertificate(certificates []*x509.Certificate) []string {
+	strCertificate := make([]string, len(certificates))
+	for idx, c := range certificates {
+		// Ignore errors here, problematics cert have failed
+		//the handshake at this point.
+		b, _ := x509.MarshalPKIXPublicKey(c.PublicKey)
+		strCertificate[idx] = string(b)
+	}
+	return strCertificate
+}
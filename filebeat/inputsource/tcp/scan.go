@@ -20,6 +20,7 @@ package tcp
 import (
 	"bufio"
 	"bytes"
+	"io"
 )
 
 // factoryDelimiter return a function to split line using a custom delimiter supporting multibytes
@@ -46,3 +47,45 @@ func dropDelimiter(data []byte, delimiter []byte) []byte {
 	}
 	return data
 }
+
+// maxOctetCountDigits limits the length of the message length prefix.
+const maxOctetCountDigits = 10
+
+// OctetCountingSplitFunc returns a function splitting messages framed with the
+// octet counting method of RFC 6587, where every message is prefixed by its
+// length and a space: `MSG-LEN SP MSG`. Messages not starting with a length
+// are split with the fallback function, as the non-transparent framing of RFC
+// 6587 is commonly used by the same senders.
+func OctetCountingSplitFunc(fallback bufio.SplitFunc) bufio.SplitFunc {
+	return func(data []byte, eof bool) (int, []byte, error) {
+		if eof && len(data) == 0 {
+			return 0, nil, nil
+		}
+
+		// MSG-LEN = NONZERO-DIGIT *DIGIT
+		if len(data) == 0 || data[0] < '1' || data[0] > '9' {
+			return fallback(data, eof)
+		}
+
+		length, i := 0, 0
+		for ; i < len(data) && i < maxOctetCountDigits && data[i] >= '0' && data[i] <= '9'; i++ {
+			length = length*10 + int(data[i]-'0')
+		}
+		if i == len(data) && i < maxOctetCountDigits && !eof {
+			// The length prefix might not be complete yet.
+			return 0, nil, nil
+		}
+		if i == len(data) || data[i] != ' ' {
+			return fallback(data, eof)
+		}
+
+		end := i + 1 + length
+		if len(data) < end {
+			if eof {
+				return 0, nil, io.ErrUnexpectedEOF
+			}
+			return 0, nil, nil
+		}
+		return end, data[i+1 : end], nil
+	}
+}
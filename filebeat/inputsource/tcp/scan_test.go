@@ -21,6 +21,7 @@ import (
 	"bufio"
 	"strings"
 	"testing"
+	"testing/iotest"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -106,3 +107,68 @@ func TestCustomDelimiter(t *testing.T) {
 		})
 	}
 }
+
+func TestOctetCounting(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		expected []string
+		err      bool
+	}{
+		{
+			name:     "Octet counted messages",
+			text:     "5 hello7 bonjour4 hola",
+			expected: []string{"hello", "bonjour", "hola"},
+		},
+		{
+			name:     "Messages containing newlines and spaces",
+			text:     "11 hello\nworld12 hola amigos!",
+			expected: []string{"hello\nworld", "hola amigos!"},
+		},
+		{
+			name:     "Non transparent framing",
+			text:     "<13>hello\n<13>bonjour\n",
+			expected: []string{"<13>hello", "<13>bonjour"},
+		},
+		{
+			name:     "Mixed framing",
+			text:     "5 hello<13>bonjour\n4 hola",
+			expected: []string{"hello", "<13>bonjour", "hola"},
+		},
+		{
+			name:     "Digits not followed by a space",
+			text:     "123abc\n5 hello",
+			expected: []string{"123abc", "hello"},
+		},
+		{
+			name:     "Truncated message",
+			text:     "5 hello10 bonj",
+			expected: []string{"hello"},
+			err:      true,
+		},
+		{
+			name:     "Empty string",
+			text:     "",
+			expected: []string(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Small reads make sure messages split over multiple reads are handled.
+			buf := iotest.OneByteReader(strings.NewReader(test.text))
+			scanner := bufio.NewScanner(buf)
+			scanner.Split(OctetCountingSplitFunc(bufio.ScanLines))
+			var elements []string
+			for scanner.Scan() {
+				elements = append(elements, scanner.Text())
+			}
+			assert.EqualValues(t, test.expected, elements)
+			if test.err {
+				assert.Error(t, scanner.Err())
+			} else {
+				assert.NoError(t, scanner.Err())
+			}
+		})
+	}
+}
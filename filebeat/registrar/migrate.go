@@ -32,7 +32,8 @@ import (
 
 const (
 	legacyVersion  = "<legacy>"
-	currentVersion = "0"
+	version0       = "0"
+	currentVersion = "1"
 )
 
 func ensureCurrent(home, migrateFile string, perm os.FileMode) error {
@@ -53,6 +54,8 @@ func ensureCurrent(home, migrateFile string, perm os.FileMode) error {
 	switch version {
 	case legacyVersion:
 		return migrateLegacy(home, fbRegHome, migrateFile, perm)
+	case version0:
+		return migrateVersion0(fbRegHome, perm)
 	case currentVersion:
 		return nil
 	case "":
@@ -99,6 +102,17 @@ func migrateLegacy(home, regHome, migrateFile string, perm os.FileMode) error {
 	return nil
 }
 
+// migrateVersion0 updates a version 0 registry to the current version. The
+// version 0 data.json file already uses the checkpoint format of the current
+// registry. An empty update log is created on load, such that only the
+// version in meta.json needs to be updated.
+// Filebeat versions reading version 0 registries would ignore the update log,
+// which is why the version must be changed.
+func migrateVersion0(regHome string, perm os.FileMode) error {
+	logp.Info("Migrate registry version %v to version %v", version0, currentVersion)
+	return writeMeta(regHome, perm)
+}
+
 func initRegistry(regHome string, perm os.FileMode) error {
 	if !isDir(regHome) {
 		logp.Info("No registry home found. Create: %v", regHome)
@@ -110,15 +124,26 @@ func initRegistry(regHome string, perm os.FileMode) error {
 	metaFile := filepath.Join(regHome, "meta.json")
 	if !isFile(metaFile) {
 		logp.Info("Initialize registry meta file")
-		err := safeWriteFile(metaFile, []byte(`{"version": "0"}`), perm)
-		if err != nil {
-			return errors.Wrap(err, "failed writing registry meta.json")
-		}
+		return writeMeta(regHome, perm)
 	}
 
 	return nil
 }
 
+func writeMeta(regHome string, perm os.FileMode) error {
+	metaFile := filepath.Join(regHome, "meta.json")
+	tmpFile := metaFile + ".new"
+
+	contents := fmt.Sprintf(`{"version": "%v"}`, currentVersion)
+	if err := safeWriteFile(tmpFile, []byte(contents), perm); err != nil {
+		return errors.Wrap(err, "failed writing registry meta.json")
+	}
+	if err := helper.SafeFileRotate(metaFile, tmpFile); err != nil {
+		return errors.Wrap(err, "failed writing registry meta.json")
+	}
+	return nil
+}
+
 func readVersion(regHome, migrateFile string) (string, error) {
 	if isFile(migrateFile) {
 		return legacyVersion, nil
@@ -28,7 +28,6 @@ import (
 
 	"github.com/elastic/beats/filebeat/config"
 	"github.com/elastic/beats/filebeat/input/file"
-	helper "github.com/elastic/beats/libbeat/common/file"
 	"github.com/elastic/beats/libbeat/logp"
 	"github.com/elastic/beats/libbeat/monitoring"
 	"github.com/elastic/beats/libbeat/paths"
@@ -40,6 +39,7 @@ type Registrar struct {
 	done         chan struct{}
 	registryFile string      // Path to the Registry File
 	fileMode     os.FileMode // Permissions to apply on the Registry File
+	store        *logStore   // checkpoint and update log
 	wg           sync.WaitGroup
 
 	states               *file.States // Map with all file paths inside and the corresponding state
@@ -47,6 +47,10 @@ type Registrar struct {
 	gcEnabled            bool         // gcEnabled indicates the registry contains some state that can be gc'ed in the future
 	flushTimeout         time.Duration
 	bufferedStateUpdates int
+
+	// state changes not yet written to the registry store
+	updated map[string]file.State
+	removed map[string]struct{}
 }
 
 type successLogger interface {
@@ -76,10 +80,13 @@ func New(cfg config.Registry, out successLogger) (*Registrar, error) {
 		return nil, err
 	}
 
-	dataFile := filepath.Join(home, "filebeat", "data.json")
+	regHome := filepath.Join(home, "filebeat")
 	r := &Registrar{
-		registryFile: dataFile,
+		registryFile: filepath.Join(regHome, "data.json"),
 		fileMode:     cfg.Permissions,
+		store:        openLogStore(regHome, cfg.Permissions, int64(cfg.LogSize)),
+		updated:      map[string]file.State{},
+		removed:      map[string]struct{}{},
 		done:         make(chan struct{}),
 		states:       file.NewStates(),
 		Channel:      make(chan []file.State, 1),
@@ -107,7 +114,7 @@ func (r *Registrar) Init() error {
 	if os.IsNotExist(err) {
 		logp.Info("No registry file found under: %s. Creating a new registry file.", r.registryFile)
 		// No registry exists yet, write empty state to check if registry can be written
-		return r.writeRegistry()
+		return r.store.Checkpoint(r.states.GetStates())
 	}
 	if err != nil {
 		return err
@@ -133,21 +140,17 @@ func (r *Registrar) GetStates() []file.State {
 }
 
 // loadStates fetches the previous reading state from the configure RegistryFile file
+// and replays all updates found in the registry log.
 // The default file is `registry` in the data path.
 func (r *Registrar) loadStates() error {
-	f, err := os.Open(r.registryFile)
-	if err != nil {
-		return err
-	}
-
-	defer f.Close()
-
 	logp.Info("Loading registrar data from %s", r.registryFile)
 
-	states, err := readStatesFrom(f)
+	states, err := r.store.Load()
 	if err != nil {
 		return err
 	}
+
+	states = prepareStates(states)
 	r.states.SetStates(states)
 	logp.Info("States Loaded from registrar: %+v", len(states))
 
@@ -161,9 +164,13 @@ func readStatesFrom(in io.Reader) ([]file.State, error) {
 		return nil, fmt.Errorf("Error decoding states: %s", err)
 	}
 
+	return prepareStates(states), nil
+}
+
+// prepareStates fixes and resets states loaded from disk.
+func prepareStates(states []file.State) []file.State {
 	states = fixStates(states)
-	states = resetStates(states)
-	return states, nil
+	return resetStates(states)
 }
 
 // fixStates cleans up the registry states when updating from an older version
@@ -225,6 +232,7 @@ func mergeStates(st, other *file.State) {
 		st.Timestamp = other.Timestamp
 		st.TTL = other.TTL
 		st.FileStateOS = other.FileStateOS
+		st.Cursor = other.Cursor
 
 		metaOld, metaNew = st.Meta, other.Meta
 	} else {
@@ -278,7 +286,10 @@ func (r *Registrar) Run() {
 	logp.Debug("registrar", "Starting Registrar")
 	// Writes registry on shutdown
 	defer func() {
-		r.writeRegistry()
+		if err := r.checkpoint(); err != nil {
+			logp.Err("Writing of registry returned error: %v", err)
+		}
+		r.store.Close()
 		r.wg.Done()
 	}()
 
@@ -340,7 +351,10 @@ func (r *Registrar) gcStates() {
 	}
 
 	beforeCount := r.states.Count()
-	cleanedStates, pendingClean := r.states.Cleanup()
+	cleanedStates, pendingClean := r.states.CleanupWith(func(id string) {
+		delete(r.updated, id)
+		r.removed[id] = struct{}{}
+	})
 	statesCleanup.Add(int64(cleanedStates))
 
 	logp.Debug("registrar",
@@ -359,6 +373,12 @@ func (r *Registrar) processEventStates(states []file.State) {
 	for i := range states {
 		r.states.UpdateWithTs(states[i], ts)
 		statesUpdate.Add(1)
+
+		st := states[i]
+		st.Timestamp = ts
+		id := st.ID()
+		r.updated[id] = st
+		delete(r.removed, id)
 	}
 }
 
@@ -380,23 +400,51 @@ func (r *Registrar) flushRegistry() {
 	r.bufferedStateUpdates = 0
 }
 
-// writeRegistry writes the new json registry file to disk.
+// writeRegistry writes all state changes since the last write to the registry
+// log. A new checkpoint is written if the registry log grows too big.
 func (r *Registrar) writeRegistry() error {
 	// First clean up states
 	r.gcStates()
-	states := r.states.GetStates()
-	statesCurrent.Set(int64(len(states)))
+	statesCurrent.Set(int64(r.states.Count()))
+
+	if r.store.NeedsCheckpoint() {
+		return r.checkpoint()
+	}
 
 	registryWrites.Inc()
 
-	tempfile, err := writeTmpFile(r.registryFile, r.fileMode, states)
-	if err != nil {
+	updates := make([]file.State, 0, len(r.updated))
+	for _, st := range r.updated {
+		updates = append(updates, st)
+	}
+	removed := make([]string, 0, len(r.removed))
+	for id := range r.removed {
+		removed = append(removed, id)
+	}
+
+	if err := r.store.Append(updates, removed); err != nil {
 		registryFails.Inc()
 		return err
 	}
 
-	err = helper.SafeFileRotate(r.registryFile, tempfile)
-	if err != nil {
+	logp.Debug("registrar", "Registry log updated. %d states updated, %d states removed.",
+		len(updates), len(removed))
+	registrySuccess.Inc()
+
+	r.resetChanges()
+	return nil
+}
+
+// checkpoint writes all states to a new registry data file and truncates the
+// registry log.
+func (r *Registrar) checkpoint() error {
+	r.gcStates()
+	states := r.states.GetStates()
+	statesCurrent.Set(int64(len(states)))
+
+	registryWrites.Inc()
+
+	if err := r.store.Checkpoint(states); err != nil {
 		registryFails.Inc()
 		return err
 	}
@@ -404,9 +452,15 @@ func (r *Registrar) writeRegistry() error {
 	logp.Debug("registrar", "Registry file updated. %d states written.", len(states))
 	registrySuccess.Inc()
 
+	r.resetChanges()
 	return nil
 }
 
+func (r *Registrar) resetChanges() {
+	r.updated = map[string]file.State{}
+	r.removed = map[string]struct{}{}
+}
+
 func writeTmpFile(baseName string, perm os.FileMode, states []file.State) (string, error) {
 	logp.Debug("registrar", "Write registry file: %s (%v)", baseName, len(states))
 
@@ -0,0 +1,356 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package registrar
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/filebeat/input/file"
+	helper "github.com/elastic/beats/libbeat/common/file"
+	"github.com/elastic/beats/libbeat/logp"
+)
+
+// logStore persists the registry states as a checkpoint file (data.json) plus
+// an append only update log (log.json). On flush only changed and removed
+// states are appended to the log. Once the log grows bigger than the configured
+// limit, all states are written to a new checkpoint and the log is truncated.
+//
+// Each log entry is written on a separate line, prefixed with the hex encoded
+// CRC32 checksum of the JSON encoded entry:
+//
+//	<crc32> {"op":"set","id":"<state id>","state":{...}}
+//	<crc32> {"op":"remove","id":"<state id>"}
+//
+// Applying an entry is idempotent. Replaying the log on top of a checkpoint
+// already containing the updates (e.g. after a crash during compaction)
+// results in the same set of states. Replay stops at the first corrupted or
+// partially written entry, dropping all entries following it. Failed writes
+// are removed from the log, so only a crash during a write leaves a partial
+// entry, which is then the last entry of the log. The states updated by a
+// dropped entry fall back to their previous values, so files may be partially
+// read again.
+type logStore struct {
+	dataFile string
+	logFile  string
+	perm     os.FileMode
+
+	log     logFile
+	logSize int64
+	maxSize int64
+
+	// broken is set if a failed write could not be removed from the log. No
+	// more entries are appended, until the log is truncated by a checkpoint.
+	broken bool
+
+	buf bytes.Buffer
+}
+
+// logFile is the open registry log file.
+type logFile interface {
+	io.Writer
+	io.Seeker
+	Truncate(size int64) error
+	Sync() error
+	Close() error
+}
+
+type logEntry struct {
+	Op    string      `json:"op"`
+	ID    string      `json:"id"`
+	State *file.State `json:"state,omitempty"`
+}
+
+const (
+	opSet    = "set"
+	opRemove = "remove"
+)
+
+var crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+func openLogStore(home string, perm os.FileMode, maxSize int64) *logStore {
+	return &logStore{
+		dataFile: filepath.Join(home, "data.json"),
+		logFile:  filepath.Join(home, "log.json"),
+		perm:     perm,
+		maxSize:  maxSize,
+	}
+}
+
+// Load reads the checkpoint and replays all valid log entries. A corrupted or
+// partially written entry ends the replay. The log is truncated after the last
+// valid entry, such that new entries can be appended.
+func (s *logStore) Load() ([]file.State, error) {
+	states, err := s.loadCheckpoint()
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.OpenFile(s.logFile, os.O_RDWR|os.O_CREATE, s.perm)
+	if err != nil {
+		return nil, err
+	}
+
+	states, valid, entries, err := replayLog(f, states)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	if info.Size() > valid {
+		logp.Warn("Registry log file %v contains invalid entries after offset %v. Truncating log file.",
+			s.logFile, valid)
+		if err := f.Truncate(valid); err != nil {
+			f.Close()
+			return nil, err
+		}
+	}
+	if _, err := f.Seek(valid, io.SeekStart); err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	logp.Debug("registrar", "Registry log replayed. %d entries applied.", entries)
+
+	s.log = f
+	s.logSize = valid
+	return states, nil
+}
+
+func (s *logStore) loadCheckpoint() ([]file.State, error) {
+	f, err := os.Open(s.dataFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	var states []file.State
+	if err := json.NewDecoder(f).Decode(&states); err != nil {
+		return nil, fmt.Errorf("Error decoding states: %s", err)
+	}
+	return states, nil
+}
+
+// replayLog applies all valid entries in the log to states. It returns the
+// updated states, the size of the valid log prefix and the number of entries
+// applied.
+func replayLog(in io.Reader, states []file.State) ([]file.State, int64, int, error) {
+	idx := make(map[string]int, len(states))
+	for i := range states {
+		idx[states[i].ID()] = i
+	}
+
+	var (
+		valid   int64
+		entries int
+	)
+
+	reader := bufio.NewReader(in)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err == io.EOF {
+			// partially written last line is ignored
+			break
+		}
+		if err != nil {
+			return nil, 0, 0, err
+		}
+
+		entry, ok := decodeLogEntry(line)
+		if !ok {
+			break
+		}
+
+		switch entry.Op {
+		case opSet:
+			if i, exists := idx[entry.ID]; exists {
+				states[i] = *entry.State
+			} else {
+				idx[entry.ID] = len(states)
+				states = append(states, *entry.State)
+			}
+		case opRemove:
+			if i, exists := idx[entry.ID]; exists {
+				delete(idx, entry.ID)
+				last := len(states) - 1
+				if i != last {
+					states[i] = states[last]
+					idx[states[i].ID()] = i
+				}
+				states = states[:last]
+			}
+		}
+
+		valid += int64(len(line))
+		entries++
+	}
+
+	return states, valid, entries, nil
+}
+
+func decodeLogEntry(line []byte) (logEntry, bool) {
+	var entry logEntry
+
+	line = bytes.TrimSuffix(line, []byte{'\n'})
+	sep := bytes.IndexByte(line, ' ')
+	if sep < 0 {
+		return entry, false
+	}
+
+	checksum, err := strconv.ParseUint(string(line[:sep]), 16, 32)
+	if err != nil {
+		return entry, false
+	}
+
+	payload := line[sep+1:]
+	if crc32.Checksum(payload, crcTable) != uint32(checksum) {
+		return entry, false
+	}
+
+	if err := json.Unmarshal(payload, &entry); err != nil {
+		return entry, false
+	}
+
+	switch entry.Op {
+	case opSet:
+		return entry, entry.State != nil && entry.ID != ""
+	case opRemove:
+		return entry, entry.ID != ""
+	default:
+		return entry, false
+	}
+}
+
+// Append writes all updated and removed states to the log file and syncs the
+// log to disk. If the write fails, the partially written entries are removed
+// from the log, such that entries appended later are not lost on replay.
+func (s *logStore) Append(updates []file.State, removed []string) error {
+	if len(updates) == 0 && len(removed) == 0 {
+		return nil
+	}
+	if s.broken {
+		return errors.New("registry log contains a partial write, a checkpoint is required")
+	}
+
+	s.buf.Reset()
+	for i := range updates {
+		st := &updates[i]
+		if err := s.encodeEntry(logEntry{Op: opSet, ID: st.ID(), State: st}); err != nil {
+			return err
+		}
+	}
+	for _, id := range removed {
+		if err := s.encodeEntry(logEntry{Op: opRemove, ID: id}); err != nil {
+			return err
+		}
+	}
+
+	n, err := s.log.Write(s.buf.Bytes())
+	if err != nil {
+		if n > 0 {
+			s.rollback()
+		}
+		return err
+	}
+	s.logSize += int64(n)
+	return s.log.Sync()
+}
+
+// rollback removes a partial write from the end of the log. If the log can
+// not be truncated, the store is marked as broken.
+func (s *logStore) rollback() {
+	err := s.log.Truncate(s.logSize)
+	if err == nil {
+		_, err = s.log.Seek(s.logSize, io.SeekStart)
+	}
+	if err != nil {
+		logp.Err("Failed to remove partial write from registry log %v: %v", s.logFile, err)
+		s.broken = true
+	}
+}
+
+func (s *logStore) encodeEntry(entry logEntry) error {
+	payload, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(&s.buf, "%08x ", crc32.Checksum(payload, crcTable))
+	s.buf.Write(payload)
+	s.buf.WriteByte('\n')
+	return nil
+}
+
+// NeedsCheckpoint returns true if the log file exceeds the configured size,
+// or if a partial write could not be removed from the log.
+func (s *logStore) NeedsCheckpoint() bool {
+	return s.broken || (s.maxSize > 0 && s.logSize > s.maxSize)
+}
+
+// Checkpoint writes all states to a new data file and truncates the log.
+func (s *logStore) Checkpoint(states []file.State) error {
+	tempfile, err := writeTmpFile(s.dataFile, s.perm, states)
+	if err != nil {
+		return err
+	}
+
+	if err := helper.SafeFileRotate(s.dataFile, tempfile); err != nil {
+		return err
+	}
+
+	if s.log == nil {
+		return nil
+	}
+
+	if err := s.log.Truncate(0); err != nil {
+		return errors.Wrap(err, "failed to truncate registry log")
+	}
+	if _, err := s.log.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	s.logSize = 0
+	s.broken = false
+	return s.log.Sync()
+}
+
+// Close closes the log file.
+func (s *logStore) Close() error {
+	if s.log == nil {
+		return nil
+	}
+	err := s.log.Close()
+	s.log = nil
+	return err
+}
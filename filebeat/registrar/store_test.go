@@ -0,0 +1,220 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package registrar
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/filebeat/input/file"
+)
+
+func TestLogStoreReplay(t *testing.T) {
+	dir := setupStoreDir(t)
+	defer os.RemoveAll(dir)
+
+	store := openLogStore(dir, 0600, 0)
+	states, err := store.Load()
+	require.NoError(t, err)
+	require.Len(t, states, 0)
+
+	a, b, c := makeState("a.log", 1, 10), makeState("b.log", 2, 20), makeState("c.log", 3, 30)
+	require.NoError(t, store.Checkpoint([]file.State{a, b}))
+
+	a.Offset = 100
+	require.NoError(t, store.Append([]file.State{a, c}, []string{b.ID()}))
+	require.NoError(t, store.Close())
+
+	store = openLogStore(dir, 0600, 0)
+	defer store.Close()
+	states, err = store.Load()
+	require.NoError(t, err)
+
+	offsets := stateOffsets(states)
+	assert.Equal(t, map[string]int64{"a.log": 100, "c.log": 30}, offsets)
+}
+
+func TestLogStoreTruncatesInvalidEntries(t *testing.T) {
+	dir := setupStoreDir(t)
+	defer os.RemoveAll(dir)
+
+	store := openLogStore(dir, 0600, 0)
+	_, err := store.Load()
+	require.NoError(t, err)
+	require.NoError(t, store.Append([]file.State{makeState("a.log", 1, 10)}, nil))
+	require.NoError(t, store.Close())
+
+	logFile := filepath.Join(dir, "log.json")
+	f, err := os.OpenFile(logFile, os.O_WRONLY|os.O_APPEND, 0600)
+	require.NoError(t, err)
+	_, err = f.WriteString("00000000 {\"op\":\"set\"}\n{\"op\":\"re")
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	store = openLogStore(dir, 0600, 0)
+	defer store.Close()
+	states, err := store.Load()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{"a.log": 10}, stateOffsets(states))
+
+	// new entries must be appended after the last valid entry
+	require.NoError(t, store.Append([]file.State{makeState("b.log", 2, 20)}, nil))
+	contents, err := ioutil.ReadFile(logFile)
+	require.NoError(t, err)
+	states, _, entries, err := replayLog(bytes.NewReader(contents), nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2, entries)
+	assert.Len(t, states, 2)
+}
+
+func TestLogStoreCheckpointTruncatesLog(t *testing.T) {
+	dir := setupStoreDir(t)
+	defer os.RemoveAll(dir)
+
+	store := openLogStore(dir, 0600, 64)
+	defer store.Close()
+	_, err := store.Load()
+	require.NoError(t, err)
+
+	a := makeState("a.log", 1, 10)
+	require.NoError(t, store.Append([]file.State{a}, nil))
+	require.True(t, store.NeedsCheckpoint())
+
+	require.NoError(t, store.Checkpoint([]file.State{a}))
+	assert.False(t, store.NeedsCheckpoint())
+
+	info, err := os.Stat(filepath.Join(dir, "log.json"))
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), info.Size())
+
+	f, err := os.Open(filepath.Join(dir, "data.json"))
+	require.NoError(t, err)
+	defer f.Close()
+	states, err := readStatesFrom(f)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{"a.log": 10}, stateOffsets(states))
+}
+
+// shortWriteFile writes only the first n bytes of the next write and fails.
+type shortWriteFile struct {
+	*os.File
+	n            int
+	failTruncate bool
+}
+
+func (f *shortWriteFile) Write(p []byte) (int, error) {
+	n, err := f.File.Write(p[:f.n])
+	if err != nil {
+		return n, err
+	}
+	return n, io.ErrShortWrite
+}
+
+func (f *shortWriteFile) Truncate(size int64) error {
+	if f.failTruncate {
+		return errors.New("truncate failed")
+	}
+	return f.File.Truncate(size)
+}
+
+func TestLogStoreShortWrite(t *testing.T) {
+	dir := setupStoreDir(t)
+	defer os.RemoveAll(dir)
+
+	store := openLogStore(dir, 0600, 0)
+	_, err := store.Load()
+	require.NoError(t, err)
+	require.NoError(t, store.Append([]file.State{makeState("a.log", 1, 10)}, nil))
+
+	f := store.log.(*os.File)
+	store.log = &shortWriteFile{File: f, n: 10}
+	assert.Error(t, store.Append([]file.State{makeState("b.log", 2, 20)}, nil))
+	assert.False(t, store.NeedsCheckpoint())
+
+	// entries appended after the failed write must not be lost on replay
+	store.log = f
+	require.NoError(t, store.Append([]file.State{makeState("c.log", 3, 30)}, nil))
+	require.NoError(t, store.Close())
+
+	store = openLogStore(dir, 0600, 0)
+	defer store.Close()
+	states, err := store.Load()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{"a.log": 10, "c.log": 30}, stateOffsets(states))
+}
+
+func TestLogStoreShortWriteRequiresCheckpoint(t *testing.T) {
+	dir := setupStoreDir(t)
+	defer os.RemoveAll(dir)
+
+	store := openLogStore(dir, 0600, 0)
+	defer store.Close()
+	_, err := store.Load()
+	require.NoError(t, err)
+
+	a, b := makeState("a.log", 1, 10), makeState("b.log", 2, 20)
+	f := store.log.(*os.File)
+	store.log = &shortWriteFile{File: f, n: 10, failTruncate: true}
+	assert.Error(t, store.Append([]file.State{a}, nil))
+
+	// the partial write can not be removed, the log must be rewritten
+	store.log = f
+	assert.True(t, store.NeedsCheckpoint())
+	assert.Error(t, store.Append([]file.State{b}, nil))
+
+	require.NoError(t, store.Checkpoint([]file.State{a}))
+	assert.False(t, store.NeedsCheckpoint())
+	require.NoError(t, store.Append([]file.State{b}, nil))
+}
+
+func setupStoreDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "registrar")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func makeState(source string, id int, offset int64) file.State {
+	return file.State{
+		Type:      "log",
+		Source:    source,
+		Offset:    offset,
+		Timestamp: time.Now(),
+		TTL:       -1,
+		Meta:      map[string]string{"id": strconv.Itoa(id)},
+	}
+}
+
+func stateOffsets(states []file.State) map[string]int64 {
+	offsets := map[string]int64{}
+	for _, st := range states {
+		offsets[st.Source] = st.Offset
+	}
+	return offsets
+}
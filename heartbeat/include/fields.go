@@ -32,5 +32,5 @@ func init() {
 // AssetFieldsYml returns asset data.
 // This is the base64 encoded gzipped contents of fields.yml.
 func AssetFieldsYml() string {
-	return "eJzs/Xt3GzeyKIr/n0+Bn2atn+w5FC3Jjzg+a599NZKT6I7taFvKzpk5OUsEu0ESUTfQAdCimXvvd78LVQU0+kGJskWPcrey9xqLZDdQKBQK9a6/sF+OPn44/fDD/4+daKa0YyKXjrmFtGwmC8FyaUTmitWISceW3LK5UMJwJ3I2XTG3EOzt8TmrjP5NZG70zV/YlFuRM63g+2thrNSKHYwPxvvjb/7CzgrBrWDX0krHFs5V9s2zZ3PpFvV0nOnymSi4dTJ7JjLLnGa2ns+FdSxbcDUX8JUfdiZFkdvxN9/ssSuxesNEZr9hzElXiDf+gW8Yy4XNjKyc1Aq+Yt/TO4zefvMNY3tM8VK8Ybv/h5OlsI6X1e43jDFWiGtRvGGZNgI+G/F7LY3I3zBnavzKrSrxhuXc4cfWfLsn3Ilnfky2XAgFaBLXQjmmjZxL5dE3/gbeY+zC41paeCiP74lPzvDMo3lmdNmMMPITy4wXxYoZURlhhXJSzWEiGrGZbnDDrK5NJuL8p7PkBfyNLbhlSgdoCxbRM0LSuOZFLQDoCEylq7rw09CwNNlMGuvg/Q5YRmRCXjdQVbIShVQNXB8J57hfbKYN40WBI9gx7pP4xMvKb/ru4f7Bq739l3uHzy/2X7/Zf/nm+Yvx65fP/7mbbHPBp6KwgxuMu6mnnorhC/zzEr+/EqulNvnARh/X1unSP/AMcVJxaWxcwzFXbCpY7Y+E04znOSuF40yqmTYl94P472lN7Hyh6yKHY5hp5bhUTAnrtw7BAfL1/x0VBe6BZdwIZp32iOI2QBoBeBsQNMl1diXMhHGVs8nVazshdHQwSe/xqipkxnGVM633ptzQT0Jdv/EHPq8z/3OC31JYy+fiBgQ78ckNYPF7bVih54QHIAcaizafsIE/+Sfp5xHTlZOl/COSnSeTaymW/khIxTg87b8QJiLFT2edqTNXe7QVem7ZUrqFrh3jqqH6Fgwjpt1CGOIeLMOdzbTKuBMqIXynPRAl42xRl1ztGcFzPi0Es3VZcrNiOjlw6Sks68LJqohrt0x8ktaf+IVYNROWU6lEzqRymmkVn+6eiB9FUWj2izZFnmyR4/ObDkBK6HKutBGXfKqvxRt2sH/4or9z76R1fj30no2U7vicCZ4twirbh/V/7TT0szNiO0JdH+787/So8rlQSCnE1Y/iF3Oj6+oNOxygo4uFwDfjLtEpIt7KGZ/6TUYuOHNLf3g8/3T+fpsF2lcrj3PuD2FR+GM3Yrlw+Ic2TE+tMNd+e5BctSezhfY7pQ1z/EpYVgpuayNK/wANGx/rHk7LpMqKOhfsb4J7NgBrtazkK8YLq5mplX+b5jV2DBcaLHT8V1oqDWkXnkdORcOOgbI9/FwWNtAeIsnUSvlzohFBHrZkfeG8LxfCpMx7watKeAr0i4WTGpcKjN0jQBE1zrR2Sju/52Gxb9gpTpd5QUDPcNFwbv1BHDXwjT0pMBJEpoK7cXJ+j87eg0hCF2d7QbTjvKqe+aXITIxZQxsp8821CKgDrgtyBpMzpBZpmb9emVsYXc8X7Pda1H58u7JOlJYV8kqwv/PZFR+xjyKXSB+V0ZmwVqp52BR63NbZwjPpd3puHbcLhutg54BuQhkeRCByRGGUVprTIaqFKIXhxaUMXIfOs/jkhMobXtQ71WvPdfcsvQ1zMJn7IzKTwiD5SEuIfCJnwIGATdmnka6DTONvMlOCdBAEOJ4Zbf3lbx03/jxNa8cmuN0yn8B++J0gZCRM4zV/MXu5vz9rIaK7/MjOvmjpPyv5uxdv7r7ueN16EkXChveWcK9PBQMylvna5eWt5fn/3cYCSWqB85VyhN4OWsbxKWSHeAXN5bUAsYUreg2fpp8XoqhmdeEPkT/UtMI4sFtq9j0daCaVdVxlJMZ0+JH1EwNT8kRC1ylrrlNRccNJBKHlW6aEyFH/WC5ktuhPFU92pks/mRevk3WfzrzgGzgPLBVZUvhKz5xQrBAzx0RZuVV/K2dat3bRb9Q2dvFiVd2wfYHb+QmYdXxlGS+W/p+IWy8K2kUgTdxWksbxXX+bjxvUqMizI1abZ5HEaYqpaB6BK0zOWhvf7FiXAFqbX/Js4VWCPorTcQKeSdncAqr/k9TYNrI7ML0a74/390x2mIoxtiXD1E4rXerasnO4Em6RZ44U480reIuwJ0fnT/FgknRCgGVaKQEK46lywijh2JnRTme6IEifnJ49ZUbXoC5WRszkJ2FZrXKBF7kXlowu/GCeu2nDSm0EU8IttbliuvJqpDZe4Ak6nljwYuZf4Mzfd4VgPC+lktb5k3kdhCs/Vq5LlMS4Y6S24iLKUqsRywrBTbGK2J+BkBuh1YXMViBYLoQXfWGB440vTFWX0yjQ3HRVFjre2q2toCsBx/F6qM5AuCKIettE8kb8OhI87SIN9OTo/MNTVsPgxaq5cSwKzxH1eCZOW+tOSO/g5cGr71oL1mbOlfwD2OO4f43cm5jwUzIPTN2D7QetPV28e3ecnIuskB35/rj55gYB/4je9Acg0Ai3RBTSSU+fSI4BdXQsPHgzHVVYFNyNmHOTg0Dn5TWt7Ch5HoW5qUQLmNReI5wVesmMyLyu01InL47PaFS8LRowe7D5L/zjCWRwKKxQUYz3z5z/4wOreHYl3BP7dAyzoAZa0bHuTYWWHi9utSYN+ocBM5awHg6SkAOWnOHKcgBmzM51KaLMWluU/Z0wJdsJ5ittdhpt14hZ4CAEiuos0OJxoJ9JN8OdnYqom4BuliCAjooHS83DNjdTpPCjlklEFCbwN0pta48QGrVRiqTy4P1WK9wA0JFQ6wnGxYHBGvwq7XpDemEH92sPTlmw6kRbEI73LMwTrXdweFB84nnOrCi5cjIDfiw+OZK0xCeUoUco2IRTaqO85TS7ln658g/RKLx+ocKAEmylqzltx+mMrXRt4hwzXhSB+AKX9hxurs1q5B8NgoJ1siiYUF7lI7pFk6EXJnJhnScPj1KPsJksishkeFUZXRnJnShWd1B2eJ4bYe229BygdtRsibZoQpJJIpspp3Je69oWK6RmeCfy9aVHi9WlAFMpK6QFW9Lp2YjxcPdpw7hn9p+Y1Z5Oxoz9o8EsiU5gy2uk5YVghi8DTIHuJ2P6YoIoa0t+yivGjWCX12jLw+tqMpbVxIMyGSNYkxHLRSVUTqI3ys1aNUCAmk071kg24/9ylyq34wd6rzYwTldO2FtE4GQ/0BLSfq0FyN/8D2gFiY4IOie0TcjO+uh7/aIFGBLbFoRz4qs4/rg151zocSbd6nJLivSxl20Hd+e9l6UFL/rgaOWkEsptC6YPiVIfJ+vB90Ebt2BHpTAy4wNA1sqZ1aW0+jLT+VZQh1Ow0/OfmJ+iB+Hx0VqwtrWbBNLghh5zxfM+poBl3a50zoW+rLSM90XbiK7VXLo6xzu04A4+9CDY/b/YTqHVzhu29+3z8auDF6+f74/YTsHdzhv24uX45f7L7w5es/9ntwfkFvnU7s9WmL1wRyY/oRQe0DNiZCtAyUjP2NxwVRfcSLdKL7sVy/ylC6Jgcqkdh7ssWmKQwqVBKScTnouTQDwrtDZ0GYzA8rCQjbjZ3BoIXsGqxcpK/0fwBGThWNsEhA/aJd5O8HNI1M9LuLTmQofV9u0VU22dVnt51tsbI+ZSq22etI8ww00Hbe8/jtfBtaWjRjANnrT/qMVUtBElq1tgiA+0ifP0LApOgSPCZZFSFhotg8EjuOBOz65f+C9Oz65fNQJhRwYqebYF3Lw/Ol4HNWvZht24i5fBY70GNxde5UPN5fTMT0RyPMZvfDi6iEoxeyLG8zFZXXiRKu8MNcBgkGm5AOJZSfRAr2iCmU7NWaF5zqa84CqDozuTRiy9GgJ6t9G1P9EdjPtFV9q4uwmdQcixzshhSTTFhh//z4IP1DfvIO+1Vn2Gb3+WdHfYhqO3J5sInev344z2YB3x11aY8ZBEeX8XWypHoQlIGzSs+MnRAlsKUDj0LNnn7xufx8hrgO9Ojs7A0ZeBQfQkDkVKIfDA3f7qRMllsaXF+UubwQSB0wygd1YXxQD/v1cgdi3z08C0cFXzay4LPi3618JRMRXGsbdSWSdo21vwghVhvDWHaN8pOCMHOEwc/Ragij6rCu48mQ/gFeHcImJTysXJ+kAsuF1sTSRETMEx8fN4VpJpY4Tnry3v+wwtInCeFONKq1Uay4OcIjlbP1tBnsUJrELmaMmAD351kxjxkWk1w73iRWtOL2NnXDUWPBYitIZO4VYczD91hI26S1rx4gcY+lBtSSo7X3i2i+I1RGNI1QckOZIcjmTLrK9rnDJa9cMX6436GJjJkDyi8QeGYmCpnhkeo7WaOBS0zqETN9wr4Mpla+NOZuy9cEZm6A+2qb+ZK/b2+BC9zZ5CZsJlC2FBu0hGZ9JZCvVpgPTU1Y5Qa4UaSRv9mG0QaFxTK4ohMqLULno9ma6dlblIZupChjBxRkEuYUFh01XzKmlG7WA6HLQZCKJ5aPJw9/thpW1AJYTdxX6bgd6+Pc68e9EgCOeCKKbUgibzGJlGp2zFcjmbCZNKbqD/SYjH8pe7P557TiiuHBPqWhqtyrby0NDW0S/ncXKZj4J1Duif/fTxB3aaY+wYeHB6B76vMb569erbb799/fr1d991jJB4Q8pCutXlH42Z9r6xepTMw/w8HitoGwaahqPSHKIec6jtnuDW7R10VDly+G+PHE5DoMfpSeBeAGs4hF1A5d7B4fMXL199+/q7fT7NcjHbH4Z4i1d2hDkNyelDnSie8GU/suTeIHof+EASZHIjGt3huBS5rMu2YmD0tcw38hJ8sbETzlqYcBwOZxonzZd2xPgftREjNs+qUTzI2rBczqXjhc4EV/2bbmlby0LryJYWRcaRzzxu6XWMjJ6wH67k1pc3+Nrjg21/Knk6e2HsSWRtJTI5k8E2EqFAdyG5xEm71rN0kCQnQlgR5l2IokoESLivUCuPQ1u6CdXKI8jJqFJtckFtRcYjIbhZvMzbZ1iWfL5VnpKeDZgsugQQoCW3bFrLwvnrfAA0x+dbgqyhLIKLz9sAJIkaN8+eJGzckLLRZbYwKWU/tObd4m40a26MnpGbIMlui53g6Kzkis+99Ab8JNJBj5NgokjCRhKvfspITjpf38BKkkdvjv5A6Tl5GrwIaOV61k6YGBgzCfi4LdQDuQ+FejzEWIRWKMVGAQmNGIs5VvcUkBCHhcCEx4CEx4CEhxeQkB6WYLemJMcuDr9WVELKnh5DEx5DE+4HpMfQhM1x9hia8Bia8GcKTUgusT9bfEILdLadIAVZ+dnSm/4Wz7xoueQrI6+5E+zk/T+fDjnl4dSAbvCg4hLAEZ7YS2ilYEVpcOM0m64AEycCsl3vf4XbiDS4g9j29cIN1tLyY8zBY8zBY8zBY8zBY8zBg4o5yFUrx/bkwzl8vMEa+X3LAinV3L/Efq+FkcLCXnFllyIp4+N/p6ADsmIJCY7cmMPVJMCGsVZe5PCnVbO5cJjChsPSoE8mubLgwnsDz0+eUkWNVZgkHR1YVsgBQ4JqapvQiDhtNKhathRF4f/lRRFzlxEG9MUshRHBY5YTb5EWx+lDia9Ont7FXtpa8b1b8nePFOPG8FVABmKZ3sfyAzxbEBjMUrqlEa42KjnyoTAWxTo2whMEREjlYSCUNVbMsDe4BVaEGk0tI+10xd4enzc59B8xdxTHWvBrgTnWKbMom+Xgj2FyxZb+rbfH5zR8Vwf02+zJD/ROlKSwhAH80ja0++cCmbMjx0qpZFmXI/oyjhsWVdbWtcrpTPwsEw8chLX0luGFlXCxjljJq0a59aNlC/D9uVDSjVtWaWvlFEWYHFIhuVr5f2XIvsWDG6yxw4ByyzIsb9Gy7ncocpwVfGt2fIxH4agfxQ0JHpccKUZCFRSU6jGjuMfrTj8Mgp7EJG0llAagTbgjmPxFp2ocHQ7BMSAoWDLw1Uqo3AbpBCIIgGEFlKQDhrX37BIH++Pw/4NY2KblCLDQiMqe4hJXfAd0VmF+rW1XEeEsW3C8zI4/HL1/6w/EVHhk+feLa5GPUua0u2vZBMWJhsW4xKujVajC4sUaW2mPYlDnmsMAg8C5HLPTyKuUdszKsipWvTFDpbMJ5IUHF8LE3zwCihT2tmW5XI7nYOkfZ7oc3BnnNtEh1qmKHvfgrwQt/hokKc+5Yb2AgMFN8FxzKljGs0XK2MUM+FLL+yRtxk0u8jH7pzA6xId4Ug7j0xlI8DdtkIZTDHgWhul0izE6F4smPuczWQyQZgvuheC5MJezIlSK28L5OoI7W8/YISuEc8IAl8SZGczcCrKrsK5JE8jzhh0djdjF8Yh9PBmxj0cjdnQyYscnI3byU49k6eMe+3jS/Nm24G9NgfM75JeG1pNUkePWyrlKyl8aPTe8RAqMJTsjEvwjIJahyzEZCHz5lWy8lMgcbF+bfXV4cHDQWreuBiy79754LBzjZQI/GYlRGCMkMBjoSqrckwMKsC2ZlsX6hlhkKlYetcIF3DVVKdC0j8OgjAyYgVqJ6ZhrcfQfP7/9+I8WjiJn/GoSg56FEiN0YaBqcqt80OLh27wa4U7sgJZefdET0ok3VlrtVUYqB/W7sgWHCrfGsidTUegle34IEQkeAnZw+OrpKCF/bVtvNOw8KklYCkbYjFf+WHEr2ME+3CJzmOPXk5OTp40k/jeeXTFbcLsgpe/3WoNnOY5MQ43ZBZ/aEcu4MZLPBakPFsXUQiZxCTMh8nSETKtrYchC+6sbsV8NvvWrAhIUYJ8rBmqI3XDNxm02Yi6tE0bkl9s1S/o9X8j5QljHmklJQhqBXbXyOCfRztbT4PGOmGlZKJFLdcYBbW1npnWy7h1/0HeSz90CdcgNqPBcLpwwJVx/lRGZtKJYoYTEMfwFyjUCs62nhcyYrWcz+SmOCM88WThXvXn2DB/BJ8bazJ+O2YVZgTissZTJJ1lyJ/Cana6ChOX4VWNkRr5dcOug+hmGnGFkjhcqIOoDdHS/9ot3J02JyJ1Mj+urnT5h3EYUX0ncIKnrZv50dHTUvmeD5Hv5JT6ho57CXxTs9MzfCAKiZiepojTpaCzhx0kwHBDtyNlMZnUB+mhtxYhNRcZrG42a19xI4VZB1GqOPCi81ouYfigCa8zeYv3uBr4kiisA6rCyqmZgY0mQM2kuP6gmK11UjjG9NBef/NulJ5V0aOQu+BL8Lrj1QoLTccSmRhAyPX9VznQ/AyHKSl1FrP3dQXeD4V79GmJFmGvYdfzhp7cfP/70sQXdFs/Gbno4ormQZbyCGtMjQrS/3oD+2hcmlGJqIqITc6NWxQpMOBaKMCWGylZVJngsMyJUowf4VFOheIawdS2Om0LRABDMh2RcbAHRmR8qpAIWKmFo/U90hbacYuWHsFqrUNiLZD88HU/H7EjlkNnkFb84JmG1ffbXmz2DddBLhcQTegw1mpFicd2sZVDGdgI3GZTfC8f3UtNXCIAn29bmZQpvq2A50Ibgy2r8Ji0a4B6L+PWLsczpMZuIzI7poQm6IAMYDRMEOQ9YT20d1sUF70rRq4LG2C8LoXDPYAOxIHD0S0iVy0xYtrdHJhcyh0JJdaeZLeR84Yqh9K1kNfA+NbHwoBXCs2gvChqqtsbz3zyowe2cLUTJO/hnrUrtA6RzMN4f76eUY4xu5Vq8jV/cXLS8yXXIoMJtMC3DgBbJdwVaUsTjz1iXr0QjNz5HRuWqEhA0WwhMFvRoDowAnF4Z97dQrOv9TXq2pLOimDUyO1c4+h2M/lsKFgJkogrZMU4igDdq9PeZ0zHgjh2AIG2GsB6M2BBhcLFB9U1p7LpTOPPt9YaF8XF/h3J5QhHA4XSeQgdxFtN+jMxatBJJ8gh6LbQrc3ry6fLspBY9xKcvcB95U1o+3Obvmp4QwFhCnXwXbITcRcMsSOJq3ozRlNfXs2QRNF4Yiody7Qwqkod0YErybeqIkgUHBd4YxkJjBm8L6AZpBMkIQ+wHipFOhVt6MZDHqoN03yWV93EyquOJBfezQlu/tqOwE7ejG0PHaEis6FtjcG0BI2KVR/iYdi0AgIYRnTxGwzZ1/1tYT6mlQXkpSg3uUWGhiiQNlyeIbwjuui6UMJiHKpvGCvSwzbjyS4e2CndJSd4gMPazxUAcPcp+wUrVTl8hBTaGflLJ1sR/lrQNAmu+tLh7jXSx4IpN8IFQq3PSGDjiRvizPgGE7PE8n4zYhEh+D0hewFczWYg9lODyCRoZg6ktjhir+SfeTcwuqwqghqFE5toKs1dxaz0y99B/3b4uCPRtbMdbksJxhi7y4yW3kPMFFW0d5oHAIYMk3dmVRlfToUZsZ3OQICajsKdWKEt20CZsl0cwI1zNyEE64qGc7i/c+MMNzTRmNVRGiKKPnnlRaMSWglUFVxj9CD5+xtvGDi9YZJmo0CBH9vUYBkBtbyps2eV1YTCmZLwejiSGnYYss4Y1rJcJ7k/1OqX7OEuMzHER1DSr1bEhoYMk4yo4zP1CAxPNsedYrBkQO/PUKkm/GlEl6aJJDWPI/rB/UMHVvPZ/aMP88kDuBfkTOa2+9iq6LIXXegI+o081oTBPPL9IleulxXufnZ709+HFqxev28jHY33LAcsb5a2NX+IwOEiv0MVwnzN/IUDrrwi7ERwYRmgagdW1V6h19pp/0QlF6d3zSenv1IyCR5t2bbFYcfKVSyttucYuyprrbKC7WvSFdvn0qWKlti4pnzyigA+31E1nNLLrTcWAioL8NHzMUl9iqz9YxosMshYpErUApyYKCql2Tv4hinZBEo9jtu5t2BZ4NfRFMtYFkUfkTHaadwRISq1kUzqcJUPs7oIaEXbMfwxVIpxmV0JUrK6QU8BL6eFqYxWaSQCkbTz6+wpPXMaLUbqzjWV9IHYu545bcVtc8JfH5eI0HWe/avfPA+sxeBZKTJrkCh34ZCHygrI2QTDCqHXPiRP+Uej5CPUK/+fTUTq5PxFhp1AcWDVZkskpzHSZJJV0O53AVhqR6bIETgxtVpR2Ub+H4b2I0JobPDsx8KDUeZ10d8Gg2pkuCr1EAYGzXGO5HNUbZsAaU/FsIcYJLuL21maTdKaBuO/Om1JVtbsMPyquNEUXBKGzdukD3L6XRSEHn0E3A9DIwSDhnNDULbmBQVhJnLZNSch9EOv+JONn4ZUDI9iV0kuVtmBsxYoMcZjAPmB2hUYa2lPZCwIXahNH+LqLogG1d0d0rwekN38dhu+9ZHOdZlz5GwQ8J9SOrFM+YYvBxD9yu2BPKmEWvLLQlAyadc2kmgsD/sun4ALhS7qfnPYbwNE639hfRakVNELBloVofpJuNZDdEOrPDP119Lfjk69m2zg98auJyfmJ3tKBebBf1ZXciIA+W7MKcQJr1Sk0VPdl+CXJ2t2CIy1eiTTbXKShJSTp/IlR9waVoKN2wbeTZsyJddwJr3Dxgpty8jAleQCybc1K2fzW7lacJQklvKlNF0gXJKeAJAQCjq2rShtqIppp5XECsjgMjaJLUc+BOekgCMVhG38Jp35YdKHjFX0EtxOwhKejoN3hyDFsb0jmbNJCQYn3z6+7+lpYDzLpNvD+kS/B+hi1FD2DLFMTSflnkjBuYGRrpHUvRICTUuCFk+vsMinDlEvryTQHBRrzIkBuFtxkC5E3p8ULJDL2nTPCGSmug9A+ucS9mfRReS4qdvAd23/95vDVm4N9LJ50/Pb7N/v//78cHL747+ciq/0C8BNzC6/boOZq8LuDMT16sE9/NGxBm5LZGiSUWe3VDOt0VYk8vID/WpP928H+2P/fAcut+7fD8cH4cHxoK/dvB4fP2wltunZeVtsm76Qp1rHPVhfoxirltbUMLZkNJ7HtC741ctLbLfQTaiyC+CCxRkIhdSSecVnURgwyxDjiRoxxc4YYx92cMdZ9wXTLJc52z6NHdmjf0AwAuaDI90IEyfnKkpbRtxq80/NESy79sddtjtW4gYNqEw7rQKp700v3huhFpCzko+crC03fFs5V+VMsjAg95OopVU6hgSl0MLZcjSM+uRJGiWLE3svMaD//Hi1xLxzuvaM6l/7dp/19xLdb22ikvbq0CW9dx21nheaDPpuP0l4xGAG7wEptpGu3e6b1WwKRWV0ApdkkMO1nK0jZhyWDuk2mCZT5F8J0C0hF2C+VNuUGlLh2EbsfwMgr/xA5DHvLgkbRDg8Wq7iIfX8kD/b3BzqKllwqTEemvLqVruHotVVlIgSgKAyWtQlAtm3v8EMsOVYtt8IzAdUsA7FGjmZeFKG3WUf5seL3OlGd7i+H+5wGDuWA1gqwIsIQHgV3OzWWJ5MCKNW2Z7YcgdWGX7UD/MUnnjmmTS4MpWmQhJPYL8l6WST5/I3FJWq4PWRdi6RAxr1kYZ/TmB2nSKT+MGdH4v6FYvYbxSuY3Zo30hh/z8OuIWIkPheUZE+F4Bz3zG63EQvrKraebFwdEeHgxKKppAh1iZWV1oFjDwkvxEF0ONHutx3Eet38i5Vw1PBvVcPJ/5Mq4q3b2yvkjSl3jSbuiWWLdWh3E9EySb5r2pu3lrS7axPqTbp7MxJKyYFMMLdVxcIInq+IR+dixuvChXu0sU8mrBpNaCGmCYv7LqVN7ZxHjRASJw0xg5DJwD1BagX+19MTmnznbW10JZ4dldYJk/NyJwmG5tOpEdfoEg6Pn1/sPMXoMvbjj2/KsiFuyYvw1N7+yzf7+ztPO2e5HxZ3TxqGQHIBsYtU2xrjGeJazlDy4tcaSjTH8oS43/5FyNT2yiBAHWCeSdJGKQri+/D5xuah/q2uxxwSCXpWAQhGsGzquULbfUJOff8reJOCK9qPTVXhYmNQP13ISyTRiVurM9k05gfVJHQObbWzxGDOZx53smgF6ZDFeERh8ZXReZ3hxQBTngYFjb1v1OP/9f3p+/9Nz0IkEI1IRb6hxSiEDKGEH8TpfnlGPpthPg5gs7OeQDWRxcSYkbtVDAfvxBewwd13EHQtSxRWAVTPyMLQ7cxZElwV5dA2W2nRoeEMz66CSmHtkOl00Md2N5AB/TAO0KCfY1Mom9qM7fc7MG5YZfQuSOXOGTmtHZpWSuE4ZqKBn38YzfhbzOOFYciahj60uoLLalL6qSbkoPI3r79dJ7CKSWKlQ68bOlT9oaaCOZC4KUsxYlZ6kYqGA5lKNXAHacKD0fUoQTGdLd1rWKlnTU3kCFBPAe0UeotVYrYFZSwdE8MFIxel6pg9GJ8tdCme8SLgLnoXPFD9+NZ7gxXOT5ykB1ZFUmcsJba1rL8zI0tuVlSkxV/qP5yePL1xX3cP9vcPOuXxIo/cNoSpKj8IXX8vF9wuxmX+ckvwvT95iVP0J7ULfrClWc9/PDq4YdrDl6+2N/Hhy1c3TP2SCmBtZeqXB4cDU0u1vZCdUz92E+cc4niRsaj4dxCnumfl8OWr56+fd2rdbQ/a9x7Y5Hh4EHXmeNFp4d0HdP/Vi/0OmF94BQ/cwPHq5OBbkDPZ1dC+Ut0nwo3XsGJkduDGo+hNa9U266GM/hh3mbVeqq1ZWFFM9xPsQliFGaz92OeBFXfbckF/XxcFjJ8KSTddtM/WIc7KP+5o0RoQSv0gnuqhKHMi0/2kihUzohDX3BOg18QhkBRyjEDS2vEfB9IYD14971RidtzMhbvcIlIvYAZEq9cs7aospLrq1KHbYpIY4BK80E88Wkb+HIAySZA87e1w1PxiKa6tlioAXdvLKz+DvGIaQ3WS8/DkvCPM4NlZL9IktVtRBUSV/Qf6eIPG/oPQaWJMxo1Zpc21eOOVDwVu0z5iPEiabVMrRgo0NXFbqn/MJTYyehqdyBYQHtF4Vzxkp2dJnDrGpJk9W1deT8nvki/zcMqAP/gS4A+w/PcDK/394Mt+P5b8fpglvx9iue8HUOq7r46H+yt+sf4Gu4ilWpO8u1KQp7JJ9IRnKIHTPxJkqrBE3Y3E2+ReedBlab92Ldpe3Cjt4o/h8y3ZkwsMAaVWpWHfGhci/M6LuTbSLcqYPScN+R4Tp4AocjzPlHxZllrB+yKEgr8/eTkCa8RToIbKCOJpY3aU5wGMWbThY1dJGmK6YoVeCpNxG9SwNnDIsjyA6HCpVS4MuvmtqLjhTseSndxisZPKSO4Ee2IVv0If6YhhKMOCP798eXB4l6qgX9tu9PVNRv8aa9HXNBTF86RtKx35x/D5Rkdc6GbYcsRh3FDhT0RVO0x9pdab4fC8PT7HXM+/hkMw6BKWbjHguIJJddNVsZ34HvKGQSEDsX8w4TVNdfVrBYzG3FYaccFNvuRGjNi1NK7mReiaaUfsBNqrJa0LsWbL3+sp9CwQlimdizs1JTPZQjqRJaFy91o5uhOD1Zqvd29+ev3q8lVbs39sdfTY6ujuIG2q7zy2OnrUex5bHX2NVkf+/twSJLs/0thpq+lWrmJTfCBGtS1Dsd5JgGwC0rQ/v1SjMagirc7VuzdqSfezHlKRUM5JwyCObMRjyJTAPpvUkWEEQYgUrxj1QaqzDQGzlM97Y0d6qihaG9BN6pDfMZkK7rDEcxcLn9fGCiQgWQ13dNlO+6kfaSuH59wWfX64kTaTyn9IlQlFJpT4M3RaxZAdYpKQP/J7zQtw28Uxk+LjoYSMByBUzY2VN6BFBkUOey2O5SKTORR38rIrkFHD2KGyYWfjtR3PeCmLbQWQ/HTOcHz2JNjOjcgX3I1YLqaSqxGbGSGmNh+xJUbw990g+GQP7rrYVrOinsyLO9F2bobKaaEq1bAIyjOPg/f6N34tuitI0hC+whpwtgg26FyGLykiuwf5i/GL8f7ewcHhHtU06UK/RYFmDf5THzItYx3C/2cX2mCG+loQh/mI7r1spO2I1dNaufomWudmKXu0PlgZcHvAb0ojB/vjgxfjdg3QbYUTX1D6bof9fq8NOy50ncdELEsdzptcJbr50fcKVYAn7nBcilzW5QTal1yXabFpSDtNZN2orI+w3F4oZKwNmd5a/VviXR1HHLqzO42fqg0DQ9Y56s9jhwSSOmL4cujFlW7b88OX7ekfe9s99rZ77G332NvusbfdQ+ptt3Cu5XL88eLiDD6vN65/H1xUMQrGvxSzucahcCyb1KaYhLwqgZmTLlm1B9IUTbsmqDC/ufMxvDDV+WqcNvO/Y15l+mobuWlMWgdMBrN20fv69bfrQaQoyi2d4QvS9XAzboTyR1EUmi21KfJhaLeAywvteNGO8uti9IkHFg47tukZkFwPXjwfRnAp3EJv6x7ZbaEUp+pk1SKRYxot1JGdijQ/2OnoMMXCgaE49ZidCyqspLO6DHG+cezQT3DnNGSFehH67fH5UN8G4UasgqKyVe0G0WTETBiztTDXjzR8UwUhxVxvNz3vsW+ePZsWej4O0aWZLp91YKdGOl/7nFPt/w0Pegrk1z3pN8G5/qgHeL/2WSdoP++wE9DWcVfbTTtA3ClDvI1TnGjYnP5iv+2D3K7+DHCtM0gcgH7chOfN0xv9HX289UJHgx5v1f/VUI4nTSzf5GaGxW9B2tn9KSTqe6iii4lKiPfKHWDl1VaxrCU3ajJiE6h66P+QA7V9hDHt5ej5vMsz72U9F50iJzgRk8pCmRnFeFUVVHR2HKtb1LYGL0WaSp+Ogl2+cDexsjddQnGGEVblxmzh0Mt00LaozXwsCm6dzLB20niqtbPO8Gr8t/BXC1nbLCgVMNCq2eB3PhSY4t3KgMAnkycSBWKGla4LiZ2TpWM11HqNMn7FTauQ7yla4A1vmjpMaNgg5SLSU1s9V0klWD9iWsIkEC6NkhZA6tQ/osWOegsKNXPimNDxN9QZgCIemLGTha4UGDyONiqhMg3GZm2YEktoNeYl+1Jfp7V1NMsKwRUUqWiD/KX1uZjVVH5rdxeEJmr01OxTsMWmbbg+v0wXOILBePV+RYwy+nUwMz5lnR+Sr24J3gt59e2II7TslWWtQuloSA2BaszEbpvwJoa7kOTnU8SQTfIK4kyfFZ8URu+UwuxWDIgFme4QIdRwqm1J4UfI5bBoHuRepLPSdVAZ7XSmi3bNYW6m0hluGicUa9pjkrCq5hYPRQn1nqhmwQgokBcWWrIVKzz5zcP2alWJxrArs99HbMYzMdX6asTcUjqH/jNp2TItLQw9Z2O95yT3+VqoPCmLDCkyAEuTOOLlkTwmisT60ngKnuVeSzk9w5wZ61UC4+yIJWMupQklQh6gHsNlu/HcgIi6SRWgteLpLsqnKJdCWTPQWmBHptqfGzD4QhX8VvW6CZWYhjepqFzSiSN+H6rojtgkHFb6Ce8u2eyErcs+Ap6/6hRXRw7iVpdbM5XuHqHdDxqmQIkAYNrN4qD9nf+OqCnpgZXKIeH4NXEzbf7XSDGcOa2LPT5X2ksXXtRWOTd5Wgw/Djsr9DLdjHeCG2pdz13UI+fSLeopaJCeQKBG+rOIvD2Z73nBdiBT8M3ip/9mP7z48b+9/+Hl+388e704Nf/z7PfsxT//44/9f2ttRSSNLYg3Oydh8CDJBXbtDJ/NZDb+VX1MamknzYp/VezXiJxf2V+ZVFNdq/xXxdhfma5d8gmaXite4CdPQc2nWgHh/qp+Vb8shErHLHlVJW2egOng5bU35X6zkzqp1O1nFC+kRLBJx4ycyw+zaxlEzvnFX0uxHCMMayYOqNGGVcLIUjhhEJAW0JvB1ADSgsD/C041miwdOU463umSE+G+RTczbZbQEbzXl/IuYTBNH8amJhUd1+QnEpAroz8NFIL+7nB8MD4Yt4uDSq74JQbSbYnBnB59OGJngTt8wNpzT8LJXS6XYw/DWJv5M7yYoW/Fs8BP9hC4/hfjTwtXFknBrHPiI3BfhTqd4S1L/IcXUOwPOBhIPB+E+77QS6xdDn+ReTuOW+h5UKpqsm8PranfEruF6G37kFA4mq6orCW0bNPh9rVNMGW4l7rQ/gAmzl/kTLbAxtZUd7iEhy5cGuSzrlx6d+DSbX4ZuHbDj418Rhfw8MV72LboBKrZhir77tugXTR3JmjgTHwaw402YgVQ1G8885JkKL/aSLgPT3KLzqQYqBGg3gYKzyHHyEZaTpgYSu3gd+ZN0TfB/o7zpMcwtmBsMFzwlWdOdV6NmMuqEZPV9as9mZXViAmXjZ8+PMy7rIP4LUXInOKl89P5KdQsKfASXaaRLIGs33ksjj3uXiAGEy2psiIbsUqWgNCHh04PdGIaoKqUrcabP6Xf3ZSJpOLr/bqAlcgkLwIFj2IxBIzI7KnUWC0s9mXJhROZG4Xx0aoHReJuH3Gvfb+RcAX9VaGWnm3XMoixStFcGBKQcFCuMoFRpLTUTn1DrWZyXjcNXZ1mplabIyDWf05qfbcTombSiCUvCjvyEq6pIbgMMSS1elYZWCIMFcJjgwyZSIlWKKtNLP27FNMWFMkkkI5QaGvZ0NAekUdn7wkbIHYEQAM1pAYcjgXm1thvQglsGBxjbtRqlFZCx3XaSAo21HVEcrCNwHwDikM1RRqTaiqy92Rb/b0WNQ7M3l68gxQ6rbDSL+l61Oqg3QyWyClYmowA0yAUr82hN3/Ah99Q6GW8udHpMe3rMe3r7iA9pn1tjrPHtK/HtK8/ddpXN+sr3r5t+8fnGWUSo8uNw28nTen90fG66VuzP+bfDEL9mH/zmH/zZRD/l82/scJIXmzXYBz0a5qM7vvbCineX4t1R7lAKVsNXS5u6Bp3AX5cCIBIiupEQ3Qz0qoSdjwUohRcBSbt6RcUTwhZyi38U1lqtP5pBX/oohAQ04RKrP+rUUEHYiPCmC2UtrzP94nUuHKcIQ3wH3cgGDgH9xPE34AQGUsTtjTnSv7RCPvBzNP9/pY4kHScoN8LZWS2QMIBxX5dB/iy4irc0tqQvNoiuk6kRhoYYmNjhYUoKuhXxI3hCpuCz2ThqMsFBuGjeKswSAc8Bu0UhwhGs567VIz5FyT1pKB+tUpgKX1E8aDh6i1Siiz4vOk0dnNhNy9atdrhrSGdbhOzzUM1/5SS4Z9cLPwTy4R/IoHwTywNPnhRMPGQxmaVxOXOkq9uviubC2s9c+NhiuGbLuOque2ahEWyObfGw8DGMByT+bOElimopBVXCwx4EqavIHFx5oRi1vGVDU0EcComnRXFjPHYnBoExEqiowbSOgs95UXSdSqA2xiUNqvENt8kXePzYsCM4SsKlwAkcTMHR1pqJ3vPV2wqSJ7A5VVGO5E5cJ5ISJlOhbuu3Ekf95iN+ax7bK+If9Y26hR7LLS3bUdRiE8iq6Hj2ZZQcTSFtpmiVSA/YKWZvV8uv7bm2VSqZ2Ftj81MtjTxg2tmsk0jPPFUkjPiUfTKIzQNZBkvCgHlKeaGlzEf2MpSFtwMNBnukGe1WaeiO2VSnTYSeprZnvAXYVvnair8+JY53cZsdWtG953gOos3QF/2OXzRjourenN/OV7OOFTMolXv+uUNAdKxuHxhz84LarnaQjj15hzofrN/8Gpv/+Xe4fOL/ddv9l++ef5i/Prl8392mjoujOD5ZuUb7oShCxiYnZ7cvkEEwxYPHwEzKOLj7Hv7bZC8HLRtTgCTdCLA/LbC9yPM+0HWEBvVcRs3HgPNjrnCxIapaKpMv4lDJmVoGGdTo5cWrHEhXYqACLfjUkxZxeexJFwBMYiqX6PhPsvQhAXdqRLNUpsrqeaX225s5/eE5krK0RAvjGJtB9p2Z7sm87UJ1iE5+2Py1Y1ydtPaVkBB5FgbfsYzWUjnBeZKXmvYVm50rXIvJ0uRJe22oTtqIDcwWsIDttvWlFJUrN8LqVjJ1corRhmE6zAOAR6hq/JFCgINjUmGYFdFq045os6TnliDfAodtv0UoYihJmcxyNS20ipvWAulpCk2ISyOJ3ElR171yIxw0QjrMdS49YQdJTl9U4GFzMFfGWNtzIhisEcNEYTo1BHLCgk9zMOjXOUxYDENCocSUWCzqyrhd6Ao2OlZEPWdbqCX1WSE+g4HFUQR0qg0C0YAn54xZ+S15EWxGjGlWcmdg6QzEe9O6WAybkQ+YtNVDKRLp3rDx9NxNs4ndzH9bdJScNihelTEhN7TM4t7rENlq9CaIPFCdGLyzjeLyKPnBnL1iHiouE0MEMu0UhQ92FTEpxAn6GyeY+yY9Wq0HSXPQ94Vm8oY3+xVQAwvz7TJk5r92rCL47PYlxfYdgQTYcuEvG6kKUrtZef/+ECh1U9saJoUdOXjswSWMUyC1cRiQHx3JqqQjunFLXyE7WvnpSjLaXDgCqFbLM9cHQIpMLpWmJLtxPF2sDnFLKp6KRSqA7gN9SfhZ1L9Q7xHP8sxsBIqJZ4hY7OdKdJ1EEM6b03AoZc0rIJGbMLzsFrRb7XKGtsCnnR6e2iwBrVNJaNmSH96cRv3MIgmJN3Tk8c4/LOwhHZjQDSF8Nxz+ZIrJ7OQ8EKZkuIT9sQlftZYKRaiqGZ14R+7ln658g+RuBwUy4QB40yTrBh4lYlzzHhRBF4lqbl1xp2Ya7NCZkVJqtbJomBCQUN7eGxNuplH2Ex6rYaGTXpEFKu7GEyQk29LIEMHHra6x42JVwcmOgcGU07lvNa1LVZIzfBOFLagxbCN+hy4C7ln4yPGQ9k5rLwFBV61p5MxY/9oMEslftMCS3iqDF82qUFI95MxfUF5621BUvmboUkqzmsMEUVbz8TfP1DBi4r5TUYsF/7KgjTy0PqgadYP94y0HSmQ2/HG3uN1giB5gnAcf2HqCKVfJK+dVrrUtQ1OEcB783UEMNibKSnp6PzDUyrwVSRt6SwTPFs0iWeIylPIphP9CMyDlwevvuuuueWi+tpeqRZ4P2g9LwR7964dGnbfubZ/gyRbaGTTpCmTB1xTtQo5FMB60OndOFQ58n4qqCE0OH7b8PAYXvwYXnx3kB7DizfH2WN48WN48fbDiz8zune3H94bgnsbykKzQCd2hp2eXb/wX5yeXb9qBMKODPTVooKHQpIVd+MvUNR3L7zqR8oQ2PRT4R0LAnw4uog6MXWdkyQtNWdWs8rIa+4EO3n/zzSxsn1WQMMqNM/ZlBdcZXBak2wsbZjRtT/E424rUDfuJ6B+uY06RQAkjT5cFHxZ8vYZZW1/jgzXcabcngd8N0cKoX0diT9WHH+sOP5Ycfyx4vhjxfEHVXGcqpnBc4ndPnx1S3x1qIXWtQK79DdtBjpsekmfgFtyyzJdFCID9zd9OxxDPZMqp7qSgTqhFAySZayUGub2T4Ywxc2NlKJaiFIYXmyxwtfbMEfKnjSpNwH8J3IGwqz4JK2zT7vlHWWeNEkDe7JlPDPaWmYEhBNQwbwJDQinL9fQctT1FZvX/MXs5f7+rC2ub+M47fZZcyhJXCuF7huEmJ3OWtSEqR6VkTbhOXqGvk1opIp6Y2vJjfk0+t+BYPw1Br1X+4ilV7qGx1UKDJUvKvmVsEw6Vmlr5RSd8JE+48hAp0lJBzwYSvSotu0h9Aem4sbJzGvYAG8cUpTSOaol2y23+0E7sulLdGUqgdZYS3U50gpeLTCwbW4L7U3uS+I9oCQGTR4GaIFGLN1zePjosU+FX/r0lj//VrwU05nY5+JV9uK7bw/zqfhutn/w7Qt+8Or5t9Pp68MX385uq9l0/w3fArE1yUXEnQbyi1ILRkql8WTCXQl+4FjuqtBLC7aMpY4tj21KzZFMIyMzzdEIYov/PTY6QmuLakWPyFbJLOogFw8G7FTaqLDA6q8EnqfOXHp5f1r7lYcSnLjZpgZXcLwP/WbbYbpHz2Xw1NFiSSOjpXQi6aisDdSU0TP2Nq143Dp/gHoshhKECFSAauspM7VIoJT/N8Gd7Q8hoYl6Lma8LhwUSaxiaEjEl6ct8tDEMeXMn9UwRuzWN1DEOl3DXlqFI4kpc1sxhFJPSBi/Q6f/mvy9O50ueDGEe1ClHZTeB6SAFneNfC0RZ8JKhhpons5wkKZKCpy6NnRtYhx1qCMOGkswTVobP1TdPP29tR3by7zb/c+QMdPekOhnbklk/V1peBiUf9JXjPtTg9lswjGtilVXIrtupuSR/Pq1VseH47TUE7qjW8Jp880Nsik+dXtwQvB3A1RomXnWvkjbIyVRCLfEH6TWJwpCeJBecvL3P3rJH73kj17yG7zkeE5om9KKlz0cfjVXOYL06Cp/dJXfD0iPrvLNcfboKn90lf+pXOVYuPnP5ionqNk2XeV0td/iIuYF+VWbU6uj93jQTZxETDNnOChAav7g3eZr0TH+Qnw8QLf55kLdV/SdD9D8o+/80Xf+6Dt/9J0/+s4flO/cGZ4Fjk7myYvkq/X2yZPEr0KDDHsRueLF6g/BKmFgSxVYaY2u5wtdhx3lrR5pDFI2nchc7RWKwpMDiHnQxadp+JRluqwKaRciB9dQAjiD19r9oC3bay7OkOy2FNPYiJnMdDOjldsTKu/Y3ff8crCdoGUlz+M6GrqY8uwqffMOLU499GJ7zHC9uxonTpxo+A2Ca5u1kbMVmtQleXrkTcNaC8zpuXALYSA1MA7Z3K7IOgLCF1zlBW5enAYEsD2SPBOnXV8zezGdfXc4e/7y22+nz1/k/BV/nonvDr/L98W+ePHt81dd9MbMwn8NkuP0HVSH70Na5kLOFx45Ud/GlgKC29qQ+Am5pNHVbuuYfseo5BLh1x+/EMnYQ9/+/mz/1bec70/5d/uH028TrlCbIuUIP398dws3+Pnju+BfqIy+lrlgtq5AHsfKRH5KB0wKAgF44V+htgb0ZExNXgg2NYJjmrteKk8SmtlsIbzIgULYCArp0PuaBXl3k4O2XSH0hJwGxIRNMYptFXeWy2XofTvO9E7bXQwVFjIODgzAZ8lXmNBKCZdeI8YeDIBXlHCLVVPQjLeXxqgqA7iioXOlFSPKhG66foF1Zq5j/1nyLpCDokc07SW08DozfF5ur0n5rtcwEo9fbQrGZ45qqE7+MkkQ7XS103HCTv4yCV1kqWku8XoEuiNJbLEe4OkMBWhP/+CqkqXfTyqhAEmwtRXNbq0SnxDW2YzrkopNalOA3D8ZseUCWG/ahk5ayAtX1pkauKmnHszyDbdd2yGWqm4DjfXb2//mxYvnz9Dt+++//1vLDfwXpzfp4nyfnBe7EsMaqZEzkIiNtSPiavumpCTSSA10cRmlRXvzeDqhe03YzBGWQuA23R6eQTWRQs/JwOlflZbqvv1WW9ekXYcePp6xre2CHGttxNfisBxEryW3EdBRi/EOxst91sb60db83DF4WJvs5H3v+RkN3xHzOrWeuNuW2nQGnZdbcyc8iBC0M77F7HIP9Z8S00sPjhcvnveLHr143gIK6nRs62B65gsTEBFHYz7Ai7/g2gbXkAo2Ox1i6/H4fwceLz5BG6ekCWc6C4Rj4g0bO6Ir7d+FE5p48bHmdgJ7iOTEetwc5pvWLj41SibDxWLUaxwx9sIuK9fAA6DjkxN6uxMt1AqHY1PhlkI01zzIl0uNwkPnIkOpaVt7ew6jrz8DwF12OnwW6xhN3gzexwjvGj7VU6u3bOtKwyIT5pJC0BKT7e2lYi6CNbIb1zNchhkexXvJ3+SFuObxsiaJrR3r831SxpRfo3NEgGs0NV/4b6SwdBSC2QfbH7sFR2Vb5iFkNoj0sWIS3ZRwzGyiaZd3CBD6/6wt+F9pBv4TWYD/BMbff7Xd99Hke6vJ98FZex+qodc/dcnnQddLrizWfLvBxYVjhOuryd3RpQhFr0Nlx3hlEnAXXpmlitcLvWR15QlqKaYxeheCl5M2KLC+ihsvBtUR1CA43eGuETFQ/iucZJqtuyXybBHCM79Cid8UoAZ1PaDO+Ywb+TU19Z8Vbeh1O4K7Ia6BiLw/ZFHwZy/H++wJovG/s+Oznwml7KdzdnB4eYCm6VC6/yk7qqpC/CKmf5fu2av9l+OD8cHLyE6e/P3Hi/fvRvjODyK70k8ZxZQ/Ozgc77P3eioL8ezg5duDF68JT89e7Xc7Fz32QhuE+rEX2mMvtC+D+L9sL7Ttgvqffa675mrwXPCbPT/JGzYV0Bmaq2yhDX7cy3RZApgkS/wNn2nN9j9g0ONgZ8FX4PWYjhKUBxAuC6pSCQsE3WYwtwTg7fT4HEJJC5Zu405adWtkD9nYyVL80WRS4MC8kNG0W3G3eEOKd+fhUs4Nx/mcqUV7dFxLa1g9/U1kQcjFD5e3ruR/xFssYhb2MTRFB3RSxk4bAmFMdMt2Bae1k7z1L3U6q0AF1DyXDivQetkdcogo3xHmibWo0z1kw9l663bwBrAa0JJ0uNZG9qijv4kx4XeT/YNBB8muP/Agjd44OqQgQXDBOOSYbkraFxLzbCVUxcZ3vWpEpzcrdJ03B/XYfwxGHcgU5FTKYADT7+lXlMez1qvWkwDFXiwgA+sSHrgMQ4ai5NqkR7m1anhhXBntSb8xB0QuRL/sfbqZRlNxl17x9EjpNrBipMaByWXJ52Jgal7KPT7N8oPD54OstJn91I/ATk+ijQHxFLaCaPMv7MiTCSbNQ/J5ZAcxLFk4Po4oASTfQmeDD99IZ8kcAcCmSMTN08QFxefvPNMGR6cz16bnJ5mNUsovEwZz82T0wjh5YdO56AKThXSryw2ujZvf2nRWovFNN653vjadB3MJNpqj9ejg+IEf5Tq7AlolhnQSPg8cL/wNUr+7Cb30mz/XdqGNu8T77w2b8cKKRFzB+fYiM1ojVkSw2ODtuO4WoxsxDT0cRlaCsOFXBpG2ZirPce4+G3C65EDdcdbOm5tN+vnTFXwqCusZ58VPJz95CW7JnGYlrzyTteLfe7C0xCl2s0jFbhYtkKcjCONAuf4+b+j2R/w0MMipl4cSaqVrAcqSBF6TEKj/fpA86d54e3yepm/LmI8tMjtelcWYnsOCQ9xQspVWe82bHdMygn4zpa/fmpb9Nwwx1boQXG2I3lmDEfDGNNven1fb8bSWRX/K/o7G23vn4PXJwf53O5uB89M5gxnaXYSHAMl0LgbPwU2wWGeEyxabAxNmQQeLWkUKvKqnkJ8CyXBEh39PvxsYt/k9Cnttya0ZlKVUeDNXbV66lbO2gL6Z5roYr3Q+zHbudJgTDFQaey/1N9dPVQ/w8M+d6Uzn7OfTk/5EkJ9Y8ez+FtWM2J9M5z2W/4WTBWNdfzJil3/9Ysac/HxZ8qqSak7P7vx1w1OUQEwXScmrPsjgZaI2FA8N7gS2YeCNgCIRVrj73eJm3DUbnYuq0CuInLzXiZtx10wMNYBmdXHvS04GXjP1LXLQ504ch7112mGh78vnxXHpgmk68Pb67w6MG9rHxXslKrVD90Da3fcul4D4tKnYGbqg9Rq6sgHRk1b8my70leR7vHY6lzbT16ly8n/ir+yEflmx9DmWaN63Wk8GhkpvYYIjDrnO/EnPjdHE1DYX38F2GCzBVH9FzyIAiT14eE55kx16nRWRZwvy3y7ALB696u2GaEKGflIeCTnLa4gNhFqQ2OwzGm9BENamxIIF0foJEQQVN7wUUNzPsKkAe6XfN+GwRieEPsEX/iNG7skcQLPiGqpaVtw4i9Fqp2ejYFqiVp8jiJoAv1ULJK5ybGUINskhFFI+RmV0Xmfu7oi8oOogeHZpGC8mxrXdNO1nk0tr2l0bXRxPkpmf3jK1yjvW541nxnfT4ii4/IQWbKwu2K0lE+AIaS13nv3nj+/YwiufUDcMpiNqBUhuQnpWm47Xpq0mrZn1lxjLH9aHBc2QxEml5LVbCOUklq4IMd7R6tvxz+wco/dlIbhx4IKhAPedDu9aw3bo6bXMe62LAmalt9tuiW26Bvy+hUk79fIGwi++fJLW7gzf5F+qW7Rmgxi83/SUnZ4wbjFUeLpqdndgvTkxxx4U6Tb2YLjQjhdJMgRzwrqhsbp7yVrRuq2vB+LcB+c+CexcqpAhCr1aB+TCNNCZ3SIl1KYY917oSgcbbckR5VHVpgixy2kMKZu4rJqM2MQV1v+zcM5/9JcE/G0nA9uU2GY2WUgn6eszF5J6R0M4Fl6btPP+zqSKK6W0FrJi5CwpF9saLr7kafL0bGCVsuqtUa6lwY7t6OxGKE9TqNqQBH/cqDUeJJDJipLemrQAjPu2urjGSishaS56eaguKTgRh1WVFt0b8Xstjch7+/I55kmVe76vjd+EwOewjuw1L2TOXbuEuYOuzEmobl/PWIjs6rLLCj4DtCPm9JVQQcDDJFRZ1oXjSkApRibVtb4SedPAHSa3kMOUVM6FjKq0sg/VM/UPhzuQeVIsBDv5cI6R7uNvwg1o67LkkNkSrsD3hCj6ZcObrxmH3X7z7Zy18+sKbh0FNYRa1yTSclwIReHHPURMJLH6OZQfsyPAjk2wI92CTUqdA3soJuOdWy7VgY2Vyom5MJvfP422EAGjtpt1lgmRJ3bhxq+y7F859zfxjMtC5HHT6cAmm+5ZG9R4rasNN7wZY4MNb0BNJmoZ3dfvyINl9ffNrxvWWavGH4KtpdewT+P6qLlRUInCQuCzmCcJW0kJ1KCGh0tg/K+SXQJ70tmVfZkQ6vlPx38/f+lVuk8bs6YwxjCO1mxKOlEsbN5GwTqKvfOudE7yu3NW8JWAGlkqZ87ICiNfNt0NqgwxuCVdQG4BBgCSpWgRjLCOTwtpF2hSCG26ryUPaPMPEQvqDRdrwjdxbckgTrdQP+68PrRsdhMhspuIsbf49QQZKNIVid1vx++VUJlZYVVI2LYNydIV64186/TshjJaBHkbC82EcXIGlZIvlXaXIP1cTsUsLcgc4MjTqv4dUN5yU0iomuFJgruk00uzhbs2nRDFEZhxvCFgULLgTnC94+4eofqXn98FV7ld8CuxtRM8k8ofXw9qnCw5mIURPF8lB5Ty/XsDJ43uH8pBDWEIzlWpgHNxcXZHYw6NMIz4deKNn+Zuh7OxuLENxJskIYF9rnBDnZ9AIQ82EULNvR8GQMiXnYagq33eYfi/e5QUSYeSxNlMGuugULgX92gLsToQynxL45USSLnrjWaErbSyQTwk6zcilSDXZpxM2jlkvQF7h651yHqPY7q4nDWThZxxP7wHaapzSG2Gfg2cVUbM5KcRGGoHTpn/j/SI0EIK7GirTjkNByYv4LiqrdN0tkkDIFiySOcP50IfmiyQ2qWH9N7pTaeb1BbAObY7a5klAmX1BShA4yMlsC1Sgj/y4pLYwGdRwo10YKkrBcooVIAm5TwDHKPPKdZd0w/xWh6aLFD45ULwvCXyfQGa27JO4PEpwsFP2t0Fj/wB5o7XgD+byS0BKjLtVov7+/1odu4GzefPvnOZVk4oN/48vf92+dQIZ6S4pqZ3NFsEjRFs42HggCHdO/dOwQs+7kA4rdqF7Bx6MoLtsTdcWlfz4vgs1Xy5c6Ks3Ji9VbmlOjhQvC3y895ouczRGNq6MB7y3fBQqJq0BJmVqZZwevz+bEPtgN5kd9EOTs9YBXn7GykGoSRrT/y+k134A+6SnDG/OPY2W+iPodar53/3YVWMI7OPCcP8KCpPD22pf0OZ/77ticF4k6W77c/fnSw22Z133E8RWPvnWG6SWmhsA+2w8/gXaYdQQRPP+H3QSMdGcvylWuE92zgH2Xxq5+ww6zuocY1p/6Ewvy2o2TdgtFF7/CfrRNVgD0q6eZbYRu9DQdT/GwAA//87CBux"
+	return "eJzsvftzHDeSJ/67/wp8NRHflmabxYcelnkxEdcjyjZjRYkj0uedWW+o0VXoboyqCmUARap9cf/7xQdIoFBdzZfM1si3jN0Yi9VViUQikchM5ONP7OfJ+7fHb3/4/9iRYrWyTBTSMruUhs1lKVghtchtuRozadklN2whaqG5FQWbrZhdCvb61RlrtPqnyO34mz+xGTeiYKp2zy+ENlLVbD/bz/ayb/7ETkvBjWAX0kjLltY25nB3dyHtsp1luap2RcmNlfmuyA2zipl2sRDGsnzJ64VwjwB2LkVZmOybb3bYR7E6ZCI33zBmpS3FIcb9hrFCmFzLxkpVu0fse/qG0deH3zC2w2peiUM2+p9WVsJYXjWjbxhjrBQXojxkudLC/a3Fr63UojhkVrf+kV014pAV3Po/e+ONjrgVu4DJLpeidmQSF6K2TGm5kDXIl33jvmPsHLSWxr1UxO/EJ6t5DjLPtao6CGNmV43MeVmumBaNFkbUVtYLNxBB7IbbuGBGtToXcfzjeYKf/40tuWG1CtiWLJJn7FnjgpetYNIkyDSqaUtMjMDSYHOpjXXfJ6MALS1yIS86rBrZiFLWHV7vieZ+vdhcacbL0kMwmV8n8YlXDRZ9dLC3/2Jn7/nOwdPzvZeHe88Pnz7LXj5/+o9Rsswln4nSbFxgv5pqBi52L/h/fvDPP4rVpdLFhoV+1RqrKnDhrqdJw6U2cQ6veM1mgrXYElYxXhSsEpYzWc+VrjiAgKdpTuxsqdqycNswV7Xlsma1MFg6j45jX8CdlCVz4xnGtWDGKhCKm4BpROB1INC0UPlHoaeM1wWbfnxppkSONUrSd7xpSpk7BA/ZXKmdGdf0k6gvDrHhizbHzwl9K2EMX4hrCGzFJ7uBit8rzUq1IDo4RiFYtPhEDb9J8Cb9PGaqsbKSv0W2A5tcSHGJLSFrxh1cPBA6EgXDGavb3LYgW6kWhl1Ku1StZbzuuL6Hw5gpuxSapAfL/crmqs65FXXC+FaBVyvG2bKteL2jBS/4rBTMtFXF9YqpZMNFnI7nrGpLK5syzt0w8Ukaiy0nVt2A1UzWomCytoqpOr69viN+FGWp2M9Kl0WyRJYvrtsAKaPLRa20+MBn6kIcsv29g2fDlXsjjcV86DsTOd3yBRM8X4ZZ9lAb/eejjn8ejdkjUV8cPPqvdKvyhag9p5BUn8QHC63a5pAdbOCj86XwX8ZVol1EspUzPsMi40+j5vYSmwfy0+J8m9NS8HoFmnPLclWWIrdmzAph/T+UZmpmhL4QJrCrApstFVZKaWb5R2FYJbhptaiwrwlsfG19cxom67xsC8H+KjjEgJurYRVfMV4axXRb40ClcbXJ3IHmJpr9maZKIM0SMnImOnHsOBv4c1mawHvuW8CtsU8ghJbC4ZbML+z3y6XQqfBe8qYR4EBMdinSqToFAQSoiRvnStlaWax5mOwhO/bD5VAE1NxPGlsGW9WMO/wysAIjRWQmOLGR37+T0xOnkkizYUK04rxpdjEVmYuMdbyRCt9CibA+Tuo6PYPJOQ52jrFxvDK71KpdLNmvrWhBMLMyVlSGlfKjYP/O5x/5mL0XhTSOAxqtcmGMrBcEObxu2nzJuGFv1MJYbpZ4eXJ6ws7ATppI5jeiY3L3d6etdLtDNEtRCc3LDzJIHdrP4pMVddHJosGuvnJfr++l12EMJgtskbkU2rOPNETIx3LuJJATU+ZJ5Oug0+Ak05XTDoICx3OtDA5/Y7nGfpq1lk0duEwWU7ceOP+IGInQeMmfzZ/v7c17hFiffhRnv2vqP9Xy11Z8zryJyQ8di3rGdvS6dOf6TDDHxrK4cnpFb3r4321MkLQWgO9JhMEKGsbd2U7i0B9BC3kBnVbhrPQr59+mE2opymbelthE2NQ0wwjYXir2PW1oJmtjeZ2TGrMmjwwGdkIJTELHKeuOU9FwzUkFoekbVgtRQDbV7HIp8+VwqLizc1VhMKjXybyP51B8g+RxU/UiKTxScytqVoq5ZaJq7Gq4lHOleqsITtzGKp6vmmuWj565AZixfGUYLy/xn0hbqIJmGVjTzTVo4w6eO82D0GWQ20FmR6p273oWpyFmonvFHWFy3lv4CHPAAL3Fr3i+hEkwJHEKJ9CZjM0tkPp/kRnbJ/YaTi+yvWxvR+cHqRpjejpMa1WtKtUaduaOhBv0mUnNePeJP0XY48nZE/AhD9oJIZaruhbOYDyurdC1sOxUK6tyVRKmj49PnzCtWmcuNlrM5SdhWFsXwh/kULK1KrG+kG5Ks0ppwWphL5X+yFQDu19pKDwEcSaWvJzjA85w3pWC8aKStTQWO/MiKFc46ApVwZ5xgoTMVj+JqlL1mOWl4LpcEeBCzJ2SG7FVpcxXkDlAVNIEs1sfmHVbzYTuc8bGo7JU9WITB9CR4OHADlVQ+4uA0WCZSN+Ijwlm0AUIISzm2yesdcDLVXfiGK88R9KDbiIu7ID19p/vv/iuN2GlF7yWvznxmA2PkXtTE94l47ihB7j9oNSiFOzNm1fJvshLuabfvyrlLRT8CX2JDRB4BCqnYwppJfjTs2MgHW0LoDdXgQNIcddiwXUB/jLQ11Rtxsn7XpmbSe8Bk6rmJZuX6pJpkcPWidIWZ/35q1OC6k+LDs0BbniA1xPM3KYwoo5qPN45+/tb1vD8o7CPzZPMaRTeAm1oWw+G8p4eqFu9QQmm0s6NJeAsCBpyoJLVvDbczTJjZ6oSxKfOoHNvWqEr9ohMY6v0o4CpYlrMhe6hUq9N0PjtQD+Tbeb5aCaibeJsswB2GVBgQKtehGXuhkjxd6TP2KveADhRWtNC/ySonVEka6D3z7Z2+HkbCaZCNPA3AevoWys7AAllx6/XjttlxA+RTQjebhgneu/c5vHqExxERlS8tjIHgvCXgMS8ZuKT16HHXrEhoNJEfcsquFVbXsrfRHAmwtPEcqGdEWykbTktx/GcrVSr4xhzXpJnjLEgpSHhFkqvxng1KArGSjjhatM6o5BHlyGUiUIYC/YASUGwuSzLKGR402jVaMmtKFd3MHZ4UWhhzJYE2Mhxu1uqwFs0IOkkUcxUM7loVWvKledm9w2BZOwSZDGqEnB1wjI0zpd0fDpmPJx98GBC2H9iBs44mzH2946ypDoZ22kszK2j5pcBp8D304weTD1/RiaD6SVqGMYEFfur9b4874OcZrKZQrJNM4/WFN6NRtQFqd6OvWDXRZDOzM5G/VUx2X+7Q5Wb7Cs9VzscZysrzA0qcLIe3hPS/6yHyF8Bz3tB4kUE7RNaJi/OhuR7+ayHmGe2GzD7HFKRXPXws96YC6GyXNrVh+FK3c/Q0q42r84JdGnByyE6Ctc1orbbwultYtTHwQb4vVXaLtmkElrmfAOSbW316oM06kOuim2g+coPwY7P3jEMMcDw1eRKtLa1moTSxgV9xWteDClVqjx1QVyFzkKoD42Std007htVL6SF/xdnaMmt+2OAweh/s0elqh8dsp1vn2Yv9p+9fLo3Zo9Kbh8dsmfPs+d7z7/bf8n+T19OA8ktyqnRT0bonXBGJj95LTyQZ8zIV+AIhN8WmtdtybW0QTlj4Z5DC++mTw61V+Esi54Yz+FSe3dOLmAakUI8L5XSdBjAre9dd0HdDFKOEXola5Yrg0vMeBOQh23d6fiMvVU2ue2EZwSHMc6oyh1aC6HCbLPR+trNlLGq3inywdposZCq3uZOe+9GuG6j7fzt1VV4bWmrEU4bd9rfWjETfULJ5gYcZLNplNHxaVScgkR0h0XKWd5pGRwe4Qru+PTiGZSk49OLFwGGCLfOAa2K5zfg9Tm0OZm8ugrrdPAajuTmFtv6Ctqca14bb7kcn2Ig0uN9/MbbyXk0itljkS0y8rrwkrAhoO6+MzhkelcAca8kdiCzmjs3Xb1gpeIFm/ES7j9txmwutbiEGeLsbnh+hF6nOCbdKG1vMe0NSo6xuruUuZIagP9HoYe3N02fHNfpe71Zn/qvP0u7O+jjMViT2yidV6/HKa3BVczfGqHJfOkPu5EVPmcXjlI9yruAlPaOFQwOzw5nlXCWi5on6/x9d+cxhgX45mhyigWc5M4hehRBkVGICa2tKgbIRMVluaXJ4dBmboAgaTaQd96W5QYl9V6RGBmGYdy83VHNL7gscb0z4LhJORPaste4MRCyHuLrvAjZ1i5EyViN1qSGWHH84AYO5qQ3RXebkluw+Qa6ute3qZOlnOsHGyKx5Ga5peFHRClMFoFkS5wQudJa4LTp3b6Dgpz2U814repVGsvjJUWyt34ygm4Wp/jI3RjDk+H+AEWnMeIjV/XcrxUve2NCx8553XnwWIjQ2rQLt3LB/G5N2WjXWSse/G5iQ6yGzHMveJ0tIXYBHOiVaiHrISLJluRuS/bc+qot+l798OBqp74PzGSePaLzJy9V60JMZD3XPEZrdXEo3jvnL3EJMRxh2TVxJ3N2IqyWOW4gIcCT+2aOeNUDHwIDDpkLmy+FcdZFAp1JayjUp0MSHB34zgxDjSQiefw9Zh8FgqvbmmKItKiUjbeeTLXWyEIk5FjHzOPEGQW5hAkRYPIVuk/JMuoH07lfEkB22Q0ezn6ZI86zQ5UIdhf/bZ7DsN6eZB6ddwTyY4FvUk8dQlFCZBrtshUr5HwudKq54QcLPyEMO28L7FhR89oyUV9Ireqqbzx0vDX5+SwOLotx8M69chR+9/4Hdlw4tdbf4Aw2fDZa31svXrz49ttvX758+d13a05If0LKEn6t3zo37X1TdZKMwzAOzF3vG3b2NHZBsokGwqE1O4Ibu7O/ZsrRhf/22OGYRmDHR0F6OVyJsweIyp39g6fPnr/49uV3e3yWF2K+txnjLR7ZEec0JGeIdUApPBxGltwbRidBDqyaaxBKyGgPskoUsq16mDZaXchC6C1hmao6XgKEAbMQi5XGSfNLM2b8t1aLMVvkzZhAMuzMQi6k5aXKBa8Hk+OXpjct7x3Z0qTIOfKZ2y09jr2gF7p3JPceXnPXHl/s36fSTecgjD2JrG1ELucy+EYiFv66kK7EybpW8xRIFK3nS2HouPIXnIkC6c4r76WIoA2dhPUKZxSu4O5wQMliC7oUKcHd5GXR38Oy4outypR0b7jB4pWARwixurNWlhbH+QbULF9sCbOOswgvvugjkCRqXD96krBxTcrG2vDHblDKfuiNu8XV6ObcOT3DsMSyWxr5vYfOKl7zBbQ3d3xHPhhIkgJ30zoRI8mtfipIjtYeXyNKklevj/5wLJpGEbhbBO/l2u0nTGyAmQR83BTq4aUPhXp8jbEIKRFuF5BAECm66d4CEiJYF5jwEJDwEJDw9QUkpJvFql6S478qKiEVTw+hCQ+hCQ+hCQ+hCQ+hCQ+hCVeHJiSH2B8tPqGH+paCFGSD0ZKRbrqZF0GiuSv5RssLXD8dnfzjyaZLebdrnG3wVcUluIvwxF9CM4UnyHa0sQp5W28n5+xI4CIgu/8ZbiPS4A5q25cLN7iSlx9iDh5iDh5iDh5iDh5iDr6qmIOi7uXYHr09u8kb+X3PAwmP6NHbM9R20Lj7haHDa3MpkjI++J2CDsiLJaRdpjlcXQJsgLVijZbYrYothPUpbB4sAX08LWqTOcq596dPqKLGKrjKUuiQjDEHzDMUcZ2Nrj8HpnOoGnYpyhL/RU0QIirh4O9iLoUW4casINkijSPFaoil/3T65C7+0t6Mr9v1n+XJHyFDWmu+CsTwVKbv3YRcyo/HnBlKt9TCtrpOtvxs1Yt1jM/PXUCErCESDWW7RS9mWBu/BMiLd6P2nbSzFWq1BC5GUSuXO+phLfmF8DnWqbCouun4H8Pg8IRyC3gEft0GxDKD/Zzd6RVGX8IAuVVrjna8R6uTsYllyNqu2mpMDyPcMKmqNV1RKoiJKUaZgjIum3AwDWm6g3XMKh4UfAaGrFAJBXd/NpR044Y1yhjp3gZ78wL7cAVTQ4bsW8dhQVW8AlFuWO7LW/S8+2scmeUl35ofH2zj4EOkxgUh4sGUAccgdEKQVu8zigey7vjtRtSTmKT7xtyF0gA+PZ5hQ4HYAdX1zSG4DwgKngz/KbIJTdBOgI0XWIEkKUDKps5G65Pf38vC/2+kwhaVGU+FTlUGxyVX8Wuos8bn16a78RjXVvkSANScvXo7OXkNk20mQCx8X16IYpwKp9HIsCkGmyYiphPtDAmZlJYLtcY0CiR25ly3GRwQLN80Y8dRVqGwkZFVU64GMEOls6nLCw9XCFOcawJW93BZLi8vs4Xz9KNe48aVsfY2NsRVpiJojysrH0R/4TQpSG43X0eAjYsAqTlDUal8GQeCljV3cimV24U0OdeFKDL2D6FViA+pBKe85xjBl9Bv1hHNDzHYrPsvN/PpFmN0zsPuUvPPFTGONXt4LwUvhP4wL0OluPvHezRxZ7aaswNWCmuFdlLSj8zcyMleev2p8XVNaKG4hmU2GbPzV2P2/mjM3k/GbHI0Zq+Oxuzo3YBl6c8d9v6o+2ffg781Aw4rhKl570lqyHFj5II0BDBco9VCc/hFuO1qrBJMlxrn1TJ/5ZgAcnf5jexuKb1wMENr9sXB/v5+b96q2eDZvffJ+8IxUG0wGKlRPkYIJV2Xgn2UdYGDwc2QFCqCyGJ9Q3beqzxqhA2066pSAAgnMO7I8ZRxtRJTmFfS6G8/vX7/9x6NomT8YhqDmtNuDQcG5iPFjfpBT4ZvCVF3NGK4ddTo5VhO1r2zVryzVvVOo2VtoROipq+rcKsNezwTKKzy9AAWkMOA7R+8eNLF59mlMr0vOnEejSRfAFWYnDfYVtwItr/nTpEFDJ7HvxwdHT0JNGTsrzz/yEzJzZKMvl9bZUUKmUBl7JzPUBmGay0ROeTNB0QSIl1XJnEJcyGKFEKu6guhyUP7ix2zX7T/6pcaBxjkmrzoqmHc7piNy4wgRGOFFsWH7bolseZLuViiEnM3KGlIY+dXbUBzUu1MOws33ps9lNizAzjOWns0VyqZ9yMoTY+SvxOIiTSgwnOo0akrVzeq0SKXBsEWTkPivh6HK9eIwZt2VsqcmXY+l58iRPfOYxSlPtzd9a/4NxBk8SRj53qF3YhyLChl8knids0fs7NV0LAs/9g5mcG4gqGktat+5kPOfGQOlDJXhsLZ6Jj7+ZujrkTko1xl7cdHQ8a4iSm+kLpBWtf18mkymfTP2aD5fvg9d0KTgcFfluz4FJcLqHxSs2lQvaDETXssI+KP0+A4IN6R87nM29LZo60RYzYTOUfxI2LqC66lQCWzeZoTEi4XDCxZsCGhhehjV7+7wy94vUWHKFxIAgM6T1BCnGkEX7lqstJG4xivy7oQn4BVBVZJQXvp4j9yvwtuoG1YFSF2NYLwKpZuhUkMOI3+3BkYYv1nfYUinKtfQq0IY22+On777vX79+/e97Db4t4YpZsjugtZzhtXY3pMhMbx5pgz4cpQiokU9fR72NDlyrlwDF5KHZW9qkzutVyLUI0e/1/UXYXiucdt3eN4Wyw6BGj3BOdiD4m18WGwuvFhINP8HytHL3cfyQ0zCs5x02m3kPfYxk8yNoEPiAy/CJOo2t/7V7s9g3dQzaM5NhCo0Y0UuETkPYfy61c3OZRPhOU7qesrBMCTbyu7tbv0pgqWG9oQ/C6mTVs0uHMs0heTQbuFjE1FbjJ6aYr14RENgklz8aIHbkJXFxeSOKnX33Haz4gHdWvmFtAXBI73ErIuJNyWOzvkciF3KBACPU0pF0tbbkrfSmbjvqcmFkCtxOWzUwW1WyLDePFPoEo2k8mXouLh6wiRZD9NYcA66Gmxl3KO1kr3eCc+uOY6opfrgDOkc/sLfO9sAnhCYSXFHfuTca6cCrI7vEdOZZTnBvFK4ZMFQeYgCDSWBVVcTVfXO0wLryDBUZTzsMWgGXvo2ejWXDyU/fdyU/QaaDhhv+6c9Ahea9HfCwZ0ybjhOnYDBmS23oBGbIiwcbLB9E15LFadCzwWH1zPY7S+m3J5QhHAzek8pQrqrMMI2as9XoksOUEB97XKnLzuZEqQ2UFSuxoblbBLPEx4t9Mk33Q9Idz9Q6iTb4OPkNvomMVTAIowQgKYG6ibBMELoHgo144aydqGdGBK8u3qiJIHxyu8MYyFYIbbFggonkaQuICajcVIZ8JeQg3kod4Gp/MuqbzvB6M6nrCvNDKscWvMJmElbiY3zmGSQowq+ra+7lfpIPoqjwgS6nUtcOJ8M6GT1whsV/e/R/WUWzqSV6JCLAqEHEYL4IqE8ARWaXbRlii66fJQpTBrLxtk8ovCfXQHCQXFXNVbkBBODfTQo+4XvFT99BUyYEmSkR8s3YB0zUaVTY9d4pxbvU67WPKaTf0LoVbnNBskJ7u9PnXCYYcXxXTMpsTyO47lhXuEHgs7XoMrpt7JGFxtEWKs5h84jmYGy9dxw6ZEZgQP7DTcGBBzx9cw7S1GQH0by/GatHA/wjrxaZMY59ygoq2bZSDejJr02qpEmG51nOt2bXE8Q0zHYU2NqA35QbuwXR7RjHh1kIN25CGZjP3MNWxfZDuzeQs+61QfNceN6phdCtaUuNRQ4Y6fdXZrSZ1beJ6LxjvkyL8ewwCo7U3jW3bBFnbOlJy3myOJ3Uq7LLNONFytE9yf6XVM53GeOJnjJKhpVq9TRcIHScZVuDDHRIMQLbAxk5oBdCCjanqSfjWmStJllxrGQFt6m5W8XrT4h9IM04NU9von6GSYQjlgiFlYPYGe8U414TAwz8+yLtSl8ec+Oz4arsOzF89e9onvt3Wf/oMNFluZrdOXJIwHMih0sbnPGQ4E1/qLIMJ2QVjtKjaNcEfjbAUDUg+bf9EOdSwIyVdInKk5BY927dpiseLkUbenCNcIMx5nG7qr0SUCGSwJIsc1q5D43pVPHlPAB3x6cVjy683EBhPFy9PwZx7IzIL3KdSmyHmZty5iDZgWonSXml5RSK1zJ2Q4xRRRX7gIs3duXy7Dp6EvEtqxkfzHzcha846ASaVq2ZUOZwkI3DGrbsXwZ6gSYRX7KETD2sYX1HYfpZurT1WYIaDkOh1xXvkdl/NynK4sORoIz2zU43K45YywN3D574/L9cOkU5nHVQgL5LzH7mbBHQruMFBJNQUoyopoE6LWIYkT+VGqxdibXlCrn4zTwbEjwkp5dWBF6pmi0i1BgFWig7je6cRi7eDIrSrnmXNtVuCXDva9Aw8VoTc2BHoXeFCpok26u+DHMZurslSXuIXBuVYoXy6nHoAZyi7e4HY9S2gRl7ftNXu5Q9z32peyblr7IfxY81pRdAH9rlqbvsDNiSxLufEdf83gpOT+RsY5oqF7egNkVjJsn5PcG5lTzJwG7v8WMA60YB9rdUmuGndaB9cbpR9tkDBBfLjRAQU9Xxz0pEpCoLGoiz55Nx7SVx0UHaqDM2L9ePD8pnT3HJrNRZpxhRPE3ZxQO7Ii66G6xWDiHxE//LgReskbg83nm3XNZb0Q2t1fPsF6oiy7P5+QfoGatSWc+QQRMCtVu0YozoYm95O0q2yd6bv6M5v+Nfnrq6Mv5ts4PsKmD1ZJt2LZrfpVwUPVx+3eFmV0nsQJJGj19QXvqB7q8Jeka68XHElYMvBsd+kcWkKSzZ84da8xCdbMLvd02sGcGsutmI7ZlJdcV9OvU5N3SPZWtifmt3a2+lGSUMLr2nQ57YL0FLzhFRzTNsgOomYhqoZ1gyXyoL3qUrYLp8CqoAhFsHQgg5rU5owOdH9ET9zphN1snoyDdechx7A94qMIMgZDBH3evz8kuj/6elQPOuk26P6eXzrvY7RS1NxlmerIyj+RhnGNIOvvvqitQ4lwl5TwSqEviMo/EE9iVxTSQFgWzoCGB0dhkzEjuEZoXbdboJDQxeoMIQNWS3ERlPbpB7820yEpz0TD9r9jey8PD14c7u859xB79fr7w73//0/7B8/+x5nIW6T3+r+YXcK28Zar9s/2M3p1f4/+EZG6xE2EaZ2Ggqj8FdrvIv4hfOD/a3T+l/093BBk+6ww9i8H2X52kB2Yxv5l/+BpP6FNtRa6Wn+d71d20hB9cdXbUPExfT3DctXkc6DGz+Q+DsKS2XXIsawhow9Tj6B/kUQjkZA6Es+5LFstNgrECPFWgvH2AjHCvb1gbIeKKQ2st7V4Z/FGdtO6eTeAywX1ci9EkJytDFkZQ68B/OqdlQwnRKcesyQPk6nOtAmbNeianUxLeuleE73oECdT52xlXNM3xNoUT5wnACMx086ocgoBptDB2HI1Qnz8ETVSyjE7kbhAVHO7Q1PcCZt7Z9IWEjbyk+E6+q97y6il+fjBJLL1Kmk7LxW3m1bqvTQfmYOACbnsH1jFaj6YvyEUmVGl4zSTBKbhZs+dbZ4UI9O5JhwbM9zbZVfg/gE+2v4ENnLilZMYvYVuhB5SBdM3T2gc/fDOY0UQGdvDltzf29vQURSRYT4dmfLqEEIAnaRvKhMjOI7ywbImQShR0yA+AOISdTphsQoIgbqbhqcadZ7FlTT1NstGPSIaNEer87Xlvyl2/ebk4tEZAQ7lgK7YyRDSZu1Vd91OjeXJpeCMajNwW45BcAT+9GJnxSeeW6Z0ITSlaZCGk/gvyXtZJvn8ncclWrgDYl0I3ZlrV+2VOxHqjGDS03ApErk/jNknIPuZYvYJJOvcbhHLIP9djD88XRcuYiS+F4xkcCG8UgbCbtR5TtomaP3JVUckuIH3nYaSdLrlqjbSWIg8YrwQB7EmiUbfrhEWtnmfqp9hhHv/wY1mON3/pIY4wWTRIO9cuVdY4mCWLdahHSWqZeft6Mq79acEl1bHvUl3b9/fO1xBBpz7V94lfNQrktGFmHPEA9I5GoGmotq70EJMky/ueylN6uecdEpIHDTEDLpMBtz71Kp296/HRzT4o9etVo3YnVSIkS149SgJhuazmRYX/ko4vH52/silj/Ka/fjjYVV1zC15Gd7a2Xt+uLf36EnWZ7lhWNxgH3/Wwr0X3nGDrR9M2xamRkKeU6958QvlSjTH8oROQXMf4lYJzMsd1gFnXFemURDfh7+vCYKYuLbB6zfmDM7IgVfABSMg/l7Ua9cndKm/5MbfJoWraMCmqnBhekAq5iWS6sSNUbnsGvM70yR0Dg1xAuFvXhe7SneTjQaqW9AxhcU3WhVt7r2tbsjjYKCxk848/s/vj0/+i96FiRv0VSry7VqM4mPS8IM6HcPq4l0on899Pg5mvD4fAtqJmBgzcqfreSjZougz5Z3E4OgNXIfYcQ5nhyoEWQCdsOBb1Fx3agYkBPSDbimNv9DAZdHHYFIYL2Gy0c13bHdD2a2dYzY4rtwYt8Wyq83Y/34Nx1tWGb0LUbm1Ws5aZE5QVWDsVaRq1IsryOx/M+FIxTyCN83fobUNMGDTCkNN6YIKJy9O12nunka44dbNX6jC4UAFc2Cf4NUxMvPzCA77n9cd3kGbABpr9CpcMZ1bEOxzxKOv1HNFTeSI0Lq6YNYKvcUqMdvCMpaOieGCUYpSdcyBSrO7VJXY5WWgXcDVITWMb703XN3+iYMM0GrqRQ+dhSy2hMiplhXXKyrSgkP9h+OjJ9eu62h/b2+/z32djNw2hqkpvxG74Vri+iWriudbwu/k6DnO32Vf03RPzJLvb2nUsx8n+9cMe/D8xfYGPnj+4pqhn+8fbG/o5/sHG4aW9fZCdo4Bu4tzDnG84L0QI9WdbsO9cvD8xdOXT/u7pdoetieq6G0PoKhyy8tuBkm5sBTRvRfP9tbQ/J1H8IYTOB6dqKuhCqQXr1loW8wHTW9viDawsGJkdpDG43ib1qttNiAZ/SNbF9bqMnQsuP85uHPDDTByYRW65tVtZGDD7XJbKLVl6eCnStJ1B+3uVYQz8rc7erR6iIwccQAEXO9qOSc63TukHWlRigv43pwlPnWYAqhLFnmEPzekMe6/eLpWidlyvRD2wxaJeu5G8GSFZWlWVSnrjya7wRq+NwQcLUEa9hhkGaPG35h1mDzJ1skULb+AXbs1peWcKm6hbc7jn5y+ojtHdZLz8PhsTZnxe+dqlSbgvhAqNdl/EOomi/0HoYI9CvM551qv0uZavLuVDwVu0z5iPGiafVercyglNXF7pj+Z6XCYxptGK/KlC4/obleA2fFp8MkgktFTbwf3z6UUxR3M3a+oDPhXXwL8Kyz/HVD6Skp/B66+AZV/XdnvIZ0eSn5/DSW/v8Zy319Bqe+hOR7Or/jg6hPsPJZqpWMM7IRbKHdTGc0HfwZSAideCToVYWVVemN423Nla6rCfZSl/UI2SaxFO4gbpVX8Mfx9jRqCVcR3YRG7deuuGt3vvFwoLe2yitlzUtPdY9y7rvqoQ8ZQ8mVVqdoZ4CKEgp8cPR/DKbD/xIXKNFqQTMvYpCgCGvPow3cXTwHEbMUQfK1zboIZ1kfODe4QbN0bbV0I7W7VmREN1yikFOQSXOYodtJo3Fmwx6bGFTPuSMcMdxDMLPnTD8/3D8Kl0m0Y80v7jb68y+hf4y36ko6iMCbur3r7Kfx9zX6axG6G4agGm1HcUIkd0bQI3Olab4bNgxR/fJv9OWyCjVfCiOEaXlzhw66fVHfX7ayDmDfsDDKn9m9MeE1TXX8EQDBrzG0liEuuC8RDjdmF1LZFZq1vp2nG7AidtnS4m3dqArbiv7czxCThHgXOMXOH7YSoSWlFnoTK3ecp+W4tBqs33uDc/PTyxYcXzx5aHT20OnpodfTQ6uih1dH/Q62OcH5uCZPRjwQ7yEyMlSz7saWAzi6p11BSD0qRB8zQiqGqsH+pRmMwRXqdq0fXWkn3Mx8ykdy4Mg2DmJhIx5Ap4ftsUkeGMYz5EK8Y7UGqs+0CZimf99qO9FRRtNWIAPNBV6DsdCa4dYJouk6F5gYqbC7Gh2VjsgkF6LIv0H7qR1rKzWNuiz/fXsubSeU/z5UJRyac+JPrtOqUqCAkXf7Iry0vYUcHnFgoiYnZhBIyvIqlP7rKG3A5Iz4G8aew4lghcomiBV53dWwUgfrKhmsLr0w255UsV32q3dvR9O6MefjscfCda1EsuR2zQswkr8dsroWYmQJXhC6Cf3gN4t8c4N2W5bawXtd5qVlQ73KTslFYqEq1UY6e8Jy9O2Mn6p/8on+Vo0yWpCF8gTn40UJ2IVaCu1a+PnR9gPmz7Fm2t7O/f7BDNU3WsR/utW3TP71DpmlcRfD/WMc2uKG+FMZhPOJ7+ISVGbN21ta2vY7Xub6U9Tr2NNsvhfxteQQVQJ9l+zdcoN6PCD6n9N018fu90uxVqdoiZIBpQx3Ou1wlOvnd6L4K8NQeZJUoZFuhVcKcXVRdfDX1R090XZLtUGTnaSFj5C0511t6g9id1RHipjO7L4bb5paBIVdd1J/FDgmkdcTw5bYZLtvTg+cPve0eets99LZ76G330Nvu6+1th/zYnnP9/Pz0Buc6NbdLomB+PD8/jdlcLqvfYTBtdTkNeVXC3Ue6LAJCCq+0OraNQ0kgYe5w+Rg+mKlilaXN/K/bHhvSBdNP+8RNY9LW0GRu1HXyvnz57dUoUhTlLZD8HE44J1vPL8a1WP4oylKhTFxZbMZ2C7Q8V4hmNddR9DGQdZvdt+nZoLnuP3u6mcCo8aqKW+D8OaQd9Ujqh0pEnKO8Y3JnDPsC1TOR5gdbFS9MfeHAUJw6Y2eCCiupvK1CnG+EHfoJPjoOWaGwtl6/OtvUt0HYMYr4439bu5FMWsyF1lsLc31P4OmclabHjIPVhOwxh7u7s1ItMnqKthO7a7hTI50vvc+p9v8tN3qK5Jfd6dfhefVWD/h+6b1O2H7eZiekUTyoNRv86L8/lb5PUz/QZnf6s73+HeR27WeHFw0xpJSzjwMioRA1nehv1OJ2B7p36PFe/V/IrVAg+/Yns5t8nxD3ou2M3oVEfWAVr5iohHgIXlqrvNorlnXJdT0ds6mreoh/yA21fYTW/emoxULoLcwndrrqJrFwpr5BXixSCBHpS3dNsbpFi+4q5aqXSp9C8V2+/Gr6yt50CMURnMOXnBc89DLd6FtUepEJ1MiTua+dlM2Usig512R/Df/qESvUUtgCuUaBAr2aDVj5UGCKr1cGdHIyeYPAxraNpimldW4paVFUVNadjt9w3Svke+xMFKt519RhSmCDluuJnvrqeZ1UggXEtIRJYFyCkhZA6k8jTHY8mFComRNhuo6/oc6AK+LhM3Y8F7lYKwSPex+VqHPlnM2IHxWXrtUYvOeVuogyi0EK5CXqWrTNOsoJeT6rPhczispvjUZOaaJGTxHqLPhi0zZcn1+my10EO+fVyYoEZWBcyoxPRefb5NHV4tPVDQx59f2II2Ceq6pqa9rFPjXEVWMmcduFN7kg/wAnjRjqmDAZ6bPikwL0YKsR2PWKAbEg0x0ihDpJ1d/593YCjiZuoahonlVr8pGOg0Yrq3JV9msOcz2TVnPdXUKxrj0mKav1wvhNUbl6T1SzYOw4kJfGtWSD1FW9l83HVSM6x67Mfx2zOc/FTKmPY2YvJXqPEjKXYZ1CUEFX77lrlcIuRF3EsEvHE1Q/IUymENBHipgoEutL+4pau2gOwY5Pfc6MgUmgUekhgXkpdSgR8hXaMVz2G89tUFEHx8ld1NORt0UdWF/WzFktLrx9prBvnO9YqmTjuep1Uyox7b6konJJJ474PFTRHbNp2Kz0kz+7ZLcSpq2GBHj6Yq24upcgdvVha67S0cT7/VzDFEzSzS6ZnGt/h2fETUkPrFQPCduvi5vpyz/aCc4Vb5Uqd/iiVtAuUDG4Lrgu0mL4Eey8VJfpYrwRXKNmOiKIbLQjF9Iu25mzIMEgro3TLg1vVzuy2IFiO6T3/uHy3b+Zt89+/LeTH56f/H335fJY/8fpr/mzf/ztt72/9JYiskZ/He5FvXl0FIAHTS6Ia6s5egdmv9Tvk1ra3XF6+EvNfiGQjP3C/sxkPVNtXfxSM/ZnFEVM/kJtTV3z0v8mPqV/tbUr//xL/UuNHlopzIo3TdLmyQkdf3jtzDgWO6mTSt1+xvFAShSbFGaUXAAzQnt+WbtKORdSXGYehysGDqRBGTyhZYXGnB6RHtK3w6lDpIcBMHGXajRYCjkOmj1aZyeifY9v5kpfuo7gg76UdwmD6fowdjWpaLsmP5GCjP6hQ4fA/ncoErqfHfTQk7zmH3wgXR+7exMwx5O3E3YapMNbNxR7HHYuWr4Dh0zpxa4/mOHrMrtBnux45IYPsk9LW5XRecDYGckRZ/KEOp3hK0Pyh5eu2B/6C3qN562w36M/MCSccf8i93aEi4q8pN+35N/eNKcBwV/0CL3tOySvHM1WVNbStWxT4fQNXhgZOXqA7Q/OxfmznMse2r411R0O4U0HLgH5rCOXvt1w6Ha/bDh2w48RZDiANx+8B8/6s6alvWHan7NYozffBusiDuNGzZj4lDHsizErHYv/k+cfx1351fj6V6i5xcukQMGI9TZIeAaG5ybyciLEvNaO3A7Bu6Jvgv27HyfdhrEFY0fhkq+QhN4WzZjZvBkz2Vy82JF51YyZsHn25OujvM2bLxIhc+wPnXdnx65mSclsz7DBb4Gt34CKGWj3zFMwsZIaI/Ixa2TlCPr1kRNIJ64BqkqpU9/Au/TZNc6BSR2KWupBOhLUUcnLwMHjWAwB1toGk9pXC4t9WdCNPLfjAN995IqziZsh7vTPN1KuIF19R71ODlMfM1rh6C4MCUgeTY7is0rH9oLr9Q1VPZeLtmvoitTUtr49AWL956TWdz8hai61uORlaRBDaXXrAhA9haSqdxvtpoiHMTyWRk21RPRNUzqW/r0Usx4WySAuHaFEY9lNoEHIyekJUcOpHQHRwA2pAwfF9q7235CA8nj7mJt6BWdhUl8Z8zSRFUyo6+jZwTB+CxKHaooEk2oqshPv6cOBgYBxzOz1+RuYdY1CfhP1CZJ1aHWQKOuxNGNQHeCEh2vQFa8tXG/+QA9kuOFcuYPT6SHt6yHt6yHt6yHt6yHt6yHt64q0r/Wsr3Da9EP0PtMpkzhdrgW/nTSlk8mrq4Z/yL95yL95yL95yL/ZUv6NEVrycrsO42Bfw4RyJmLiXt22l+N82fVRTcVq6HJxTde4c3eP69Juk6I6sWFUBwk1PbJNIUrhqkCnPf2C4elClgrj/tMYarT+aeX+ocpSaPzLG7H4V2eCboiNCDB7JO3dPt8nUePM/QhpgH9/UTfug3tBIbIUDZEWmVF6wWv5W6fsBzfP+vMb4kBSOMG+F7VGqIfTZCHf+skNMTgDFjWvwymtNOmrPaZbi9SIjHfuukXTeEtRNsgGY1xr1MGHoT+XpaUuF2gmR753XvsgHST7qn6KQ0Sjm89dKsb8C5J6UlT7LLXNYyxd76AehHGV6bFSFMFnXaexq9kJQujdWSxOSvFkm1lnvYnZ7UM1/5Ca4R9cLfwD64R/IIXwD6wN0jy/FOa3ZY1OFYw0TprbkpQ7TR5df1Z2B9bVwo2HITafdIiIi6ddl7BIPucePPBRB47JYjfhZQoq6cXVYiQ2DcM3LnFxbkWNSKWVCU0E/FBMWiNKdLKMS4FZNQi0pVDhRalmvKTbLdxbBXQ7h9Jt5DXXC7MlvhhNtOYrCpfApBnXC3cjnPrJTvgKjjqvT/jp4UZa5BYFQox0KdMJ4bPRGhvRnzvMxHzWHbYTxOEObhOC/rkD6wP/t9+PohCfRN66jmdbIsVk5tpmil6B/ECVbvTBDtltjd6dyXo3zO2hmcl/l2YmWzwZRyRTSc+gV5gzHlHTAXKwRDB9o9VCc6q+isBhWcmS68EWjFsvIN/crlPRnTKpjjsNXc3TrPsoX4Tp7auZAHwELPUp28jiBmPwTngFYsoiWyfLs4Nn/bi4prl/upxyVzGLZj1izWZE9u+1Z+c5tVztEZx6cw5GHx3s7b/Y2Xu+c/D0fO/l4d7zw6fPspfPn/6jf/XkepoX2f1T6NwBZsdHNy8Q4bDFzUfIbFTx/eg7e32UoAdtCZkoCZyylYiCc1pW93zs836w3tT1jSKlwsK7yeDK0yc2zERXZfowgkzK0DDOZlpdouiDESFdipAIpyNCJRrkYFFJuNLFINai2GYZmjChO1WiQaiNrBcfYomYWyDzeZwjwlgU34gaMmqeYj7Att/ZLqCcBuuQnv0+eXStnh2DnNGFFM2xQ234Oc9lKS0U5kZeKLesXCNQHHqyFHnSbht+o8huOHf8C2a9rSmlqBi0OkYuHa9XrCk53vTuJpRXpq7K5ykKBNonGQIT8upUY+o8CWYN+inCZdwQFALvWVCSTo0k4aITLZSSVrMpUTGbxplMEJmUa2GjExae3e5aD02vu5w+FB1xJfBwXxljbfSYYrCDuzaJTh2zvJSuh3l4FSEAFIqTpUHhrkSU89kh46twUzw+Daq+VR32spmOvb3DXTBeTUSj0iw+Avj4lFktLyQSRcfwRVcciUg+zYiASovYJsG1KMZwAIZAunSoQ57NsjwrpncwUWRziw21+UJ1UsaEXuSbuDVWobJVaE0QxkmuOmlPnHVPrtkSE3a2KRyvS08vqLhNWCgwSU3Rg11FfApxcp3NwW7MCAMPixkn7yMkS7OZjPHNMAF9eHmudNEZVqgxdv7qlKD6QAfyVlM8vxa5kBedNkWpvezs728ptPqxCU2TCCgAdrhkrtCVL+QfgogHI1GF9HI1oAfBXMtLqQ0n4E4qUAAc47lteVmuWPt/2fu25raNJf93fYop5cHJKQmWbNmO86//SfnI3hNt7EQVyXtq90UcEkMRFojhAqBspvbDb/16em64kNDNVrZYce0eEUB3T09Pz0xfOVa+VuVc7Dp4u1BAVI0iAGupKBqEV7b+JD3mq7+N92hnOTJENm6APCi2qoEiHAcrpLMIAULVONWaIfrwvKzAHH9aFhNvWzArnb/uAuZZ6ysZeZBYvWYa9+kgxZLgBOTYgH9qh+A6RJCiwRWggNYSlZrLAglVnPACRiOf84vpicv6jIFmFZlPUKGp1uI6w3BRtsG7HAoxUWUto2RFq6tKh2OKwEsLk5tbo3PrpS5XRllxkmpVo5euKip06qbXetLNwLBpludObQQ9IvLVDbQRa/IBKulWBzKSem51bybGbR3kznMKZj7OLpd6WeUrI830TdxiuHL3OXIXSqjxPSFt2TlS70sq8IoC/3UixH96znKJ37DAkkkwh62XabJyP0r4B85bd0KGaF/s3LWFivW1NCGixtYzSrLFCDptxMX8RrDlY8uCCnStD3yzfgFomXUduVmpksHe476DIHuCDBxsmNpRiUEidKvQc72s2M5p+O5/ZphOUzCg79+c/fYDF/jKg7Z0lVByMnM6g4vHoRE6miq0j50vDl++bo45clF9ba9URN4/tb7MlXj//vhBc23/gQcwDNY+x44VGE+TUZtt9jV6N3ZVjmxRdhtWsZ428JNtePE2vHgbXrwNL96GF/8fCi/OFhto6L6MPmmH99rgXn5dYF1DrhqxM+Lk9PoIh6ST0+uXFoZqnoG+WlRwV0hyIeskWwxY1j28OUe2JF+GFmBPeHgfS2So/fbm3N2JuetcxqclBkmGjUWZXcMG9fbDf4WJlfFaoRtWrmUqxjJHshlWqw1gM5fsUi+xiBtMxjjbCaibzpmbbdQhAwD/EbOAk6BjDqw71UUDPeWs7duc4WJb/YA84BtNwSmzvU/EtxXHtxXHtxXHtxXHtxXHH1XFca5m1rTb25/WGO6tERi10JpWYLsJ0DNddnTYxEmfiUODzYnOc4WqjN2RZS6qbJrBH1akgXRSKRjYazmDf5pNHG7cwTie7gZGSrWYqTk6kz5gha93FkeonjRfbyz532dTOsyqL1lVoyYvgxJctyvlUgxokkb2ZJj6S2TBl4rCCSpTemPEAGn1pZpajtpygoFw/CiPpi8ODqYRMx5kOT352Fw/VmrLZVHAdmkpFif+SAGWmFSPRZlVgc7RU+PbpEaq5t4YDdmbT53/nQQmz7n3apux/EnT8LgKieHyRXN5BYdqjWrfVTbOVaQ9HWSS06CkA6lSXQT1fRls7CGEuxOVSrMJ+tUTvQ6kmqOgYRr2CnfPftM12/QzqpslCmWssRXX5WCPGa3LiAz4U+10WJIc2MB7wEkMmj0M1AKNVTos0vQnBI4Lv7TlLX3+Sr1Q46k6kOrl5Oj1q2fpWL2eHhy+OpKHL5+/Go9/fHb0arqpZtP9SGS4BfOo2ekSaKeO/CJRdHyYVX5lQv2bMpksL7CNf65QOKL+rF3LY2/KsLDk3AOUpV8a9tgi50Gjo5lauYqUNnokcyEJ+G+8ihcG6Pa+dyHeIPgEXmhDHqQzzXDlGi8xcv4MjjtZU7kQHVTnxWRX3XIPhrIL2w+Wb2Q8lEYkHZe1oeJIeirehRWPQzaTsHExFHuIwONJvqyweEKLhPHp/kPJumqDyKiJeqqmcpkjynmiFy40xPELyp49NA5mNsVatTB4BakOUVfhGPZZA0RyXQVxF/cp2MfcE5LgO9niMX2T/L0brS7gdeEeXGmHDiVdp4BIuzq9Fhxn7Ei6GmieTA0QXyWFXIYxdbEw7vnVBKi+sJkrwTSKJn60QTCi6eBzy0PMyH9wikFjQpyf+bNcOyteh1EdM30Fg7DkbDZVC13kK74ouE2FRwMBlBZhmxvPk2dJWOrJuKOjw6n/Zc3Z1LzVOpa2ghMYgaHKWGaexhtpDCmIQtgQfxBanzgI4VF6ydnfv/WSb73kWy/5Gi+5WSc8TcHi/oauckPS1lW+dZVvXeVbV/nWVb51la9xldNm8ZdzlTPVD+oq5yvABhexzNmvykDJU2y9x51u4iBiGvWi6QJUXD56t3kvO5I78uMRus2HH+q+ou+8Q+a3vvOt73zrO9/6zre+80flO69LObEanc2T58FP/fbJt4FfhYF0exFlIfPVnwpNdSAb2DBFPSv18nKmuakwzvNhjzQBQ3KGAuRI6kHiYlaQE5m6+LB/E35iFDjLs2qmUjg/wrEImHctK7hdcCX2/cZpk92QZczPrZluWuqi3kfOJNPDEPdxPkBOY6YqMZepG4eXi7GcXIVfVslggymoVw+nDPvd1QaxX7JvcNSbsCKq/NjY2Uod7YI8PfammVoLotaXCvmAlBroQLL87VnVYRk+k0WKMhPjlUdDB7B9PnkGTrvkSVOYj8bT18+mz1+8ejV+fpTKl/L5RL1+9jo9UAfq6NXz2OcaZhZ+GyY79A1W299tH9JZdjkDc9x9m7KD5koixc1EbUL1WsbsUds+B9KUXGL+YvnZSMYW+w4OpgcvX0l5MJavD56NXwVaYVnmoUb4+Mf7Ddrg4x/vWaiRh36NZrPVcoEzJlcmwozVpEMpEEDm4uMf77mtAb9pD8bgwbhU0qS568/I+0aj/wmiTfb4ELZHhXT4ey10MXyhPewh9C07DVgJl/mea6u4i6ZU7ExLJno3WHMnBXkhyF+MCQQ/53JlElo54RI3YtODgfhqEoDzlS9oZj0WDirGmxhXNGJOZKX2OBPa3VKNl+9S2xvEiL0L7KBoCU08hIiv01Jezv1t6t45ixtGpkPWCjmtuYbq6LtRwOhaL0LunsMH/t3IdpGFBFoolujkSTyWh6sHeDIl6CT/5KrK5phPLqFAieloB+ZmaxX4hCgX06IT2K9GyzJPAG+EPFxSvWEbugxhVGiNUZdLhKbRAjRZvna3ix1i3B2rOe1hWzU//T8dHT1/aty+P//3/+ffzd/f1TruH2QbDj8QV598LExXYpX6Rs4kIpz0H47WjbIr0qjo6OKyFxbtTd3qpO41djKJ/6WSVo3R9MgJanKQU97AwKdZxXXfPqGrlku7tj18oNgi4Q1n09XacJ85sJKOXvB7W0L3IsXbGS93q4mFFPU8juZ8IasqmMn7nvNTBm/XMu968ekb3Hww/PWsgTvQQcyg3WSD2aWTnFubXlp0HB09b90Cjo6eR0RRnY4BVN2GSRQ6QwhYiJ0xn+g1T3CVKC47x8Aw0V1Z7DaEraXjfyYdr76gtKoKmnCGWKjWgNlh+eaJXUGMfh7RCnWXMqq4WGj+lmi3kZxQ2lipo59HFJtq39oLkNEHHPXqILpe2PNF7ekh0s2bI/66ES0UhcOJsao/K+W3eSBFGB62jKbN0ZyaHmpuzwh6r+ztknYJZwkRnaaO0einzv3Y0Nujp6KR4SLxgCaZjwy+MbiwXIyrK2mPyfbv/nMyrQZ8Z0/LdjXEBg8X20Ovmn0JO3murqXbrGvdETT7b0EZU/Ttx61ZwfMamS/wS4Zq8bQUrNnHtD+uZ9JctrPUhszaI72rmMQ7JS2zKrhp2w6Y3/4Y/g1twd/SDPwXsgD/BYy/39ruuzX5bjT5ZukDWFXvYu19rIZevHUhL+1dL9iyhP91wMZlYNjty+fuwHrBRa9tZUe3ZTJx5zO1shWvZ/qzWC4gUDDAsikL44raoNDCWMgSx6ClI9UenG6w1ygXKB/PzYOsZMbWnJLsdGbDM/uF5UEI8qxrEXUmp7LMvuZN/WPBExqEU1siL7qJ/KD/zPJcPn2RHIjvDRv/nzg+/cgsRVOEw2cXh8Y0bUv3/yDeLBa5+pca/5rVT18evECXelsuW4jvf/3l/MP7PfPNP9XkSv8gOKb86eGz5EB80OMsV08PX7w7PPqR+fT05UGzc9G2F9q2F9q2F9q2F9r99UJ7WFIbeTNrtgZowZ198OMnMVbUGVoWk5kuzZ/7Ez2fE0f5LIHEtJ0Gtr8TCcfWzmI+oc/tCcJdHnAWwE2S7NHczWynezs3u0Sjx2cXS9Y27uRRR5BBWYKKiX/6TAoDWOaZM+3CpvgTX7wbL8+zS0gCOF2XSxVDN2PhNw1YPf6kJvaQa/642DiSv/OPAWdpHm1TdNzFGFljfKosnVu2eXDqRfIOHzE8e3THQpVpmnEFWpzdMYE235Hw8N00nsOQmiBbr28G15DlSQvS4SxomsiWdLQn0SX8Dpk/Atopdm3AnTK6FjokCa0JVZXYHNOdfsZETDnPTJ4tAhPstyJL7eqd5HqZ+oV6jD+tUYcyBSWXMujg9Ad+as7jk+jTCkYIjr3AgkrTC3rhwoK0Rcl1GS7laNT0QbIoNUTfmwOcFuIn+1921gpDeNzlTyCPnG5DIwYJQnQgz+Zoz9NGLefZvhxP0sNnz4/WYz8BBHHy1tkYaFRuKlg2vxNvICb0ks7TUB1YgsC4xLGE5meDnHW+vFbOAhyWQF8kYj0aN6AsvS2mAUungWvo+gmwcUr5RaBg1iPjD5Lgg6G4eAPL8qxeXQzYNtZ/NRQry/jQiWutr6F4Sor2H4QjerUTvtVHKaoNl14hvbV/dywv8wwtsupmQi8/w7quYB65MPsfWhDmlQqOKwbfvlNGPccKR1b37hh+En7GO2IYetjNrIBh3Z90Mq0HFTTOzbHhq3C7uyHWxpfDkN4eXS7HKq+E+E6c//7295/EL/ozzJRzuYCSrdTPAdiO49SGI9Uafe51uiEhsZKL/dzLLVrBd0vtCc5DgbTytoDPbb2LJBBQ/N4pnrxvoMeFPS/D+ejysdWkSlbzPOH3TNNWBJVgHyx0se+/bJiWDenrJb1/aiL7rwUx1jpXshjI3qnnCEXm+2lv49VVMl5meRtle0bd7r17+OPbw4PXu8PI+f1MEIbQCt1NCEwVnetgHS1VXap6MhtOjMViHCzFykng1XIMM4RJF2Y5/DX8rQOuf+4Oe/HJzQP1J7aNWtV/tFGz+lc3ylyT4wudJgPZvYajAQcWOiWq2pMLVMssvTdMpzoVH0/ethHh/1YLOVH3hspDbCNDhu69crCwxro2MlaXf7uzYg4eX8zlYoH2SkbL7P5t98YU80Yyl4s2yZRYwG0oHhvdAW3dxJeKWilUKrrEevLbBA5D7OH2THSqFrleUeTkvSL2cHsQ4yAI/+K9DzkA3IPa71D3itiB3Yi2+9B3d7wGLm8wrMv97sId4rq3Fts+zu0r7lLbtQ942DfbBNSXocdOxpC0Grp2HT15xJ90rq8yuS+XtU6zaqKvw8vJv5un4i0/WYnwPWcLGWI96QAV7sJMhwOZ9HCR30uMiSk2F3eJRAdd+GctwVx/RU8dAWwZ7ceZpTdH9w5VXOhzijWS3qvOtWs45k1ltp8UmJCKdIkoOVwAy3q5iIy3dBCGi4AKFjjrJzAjqEzOFcLDdSnGCiBo3lRNR3IT+kQ/4E8TuZelRFqlrqmqJeLEKxOthrY5QZM9kaV7eHWGY1pMEiIXqJUh2SS7WMj5GItSp8tJfXNGnnN1ELN2GQyOiW5s69DeWlwitE8q5+L4PsD8wwbURarL22E231pW++EHslC56oJZ0U2HTWu5MXYEi85w+URkvUHH0kqUrGP6ZOkj9ruuST1Y/+Vi+e34ENhtRZyvlHKJbn01eimxHxUx3latNf0zu8f0g5gpWdYwZdsA992G7upRO/x2r/LuGQlj5a8ZsrtahYhCZIEhrm++1uC082aRBg6CEMndt/EASTQ73Tt5lt4jNoT6iU96DLOzrBCbQ4Z4N7sd401ZOQZA29PYouFc1zK3eKFHa2SndcBqzmWIehlu1Z1x7p243zIWaHrOEKVere2xRYHOm04JSJppfdA8HfSQFE/JG86jQqoJxy6HMaRiVE/QXXNU5xX+H8KYRyZrjf53NUraQ2Ej09CBNJK+bjmQ0Dtqw7HMtskzr9LEVlyZZ1VFWTGZfzcLiRP+I8jkyWnHKLNFa4zZYhitJ6drqTwJqYopsf64vQgeNpERutCRBNvdxTXw1Pm1qbRik+acl4frkgJqxwhxo4jkHgFxWanS1rzcQhecFCn0vi6x8/MYuY7stcwztO2OC2NqzBy7HXLVQe5kpiZXF01VcAvS3ohaX6F1sjngIXsRjeOXeS0LRQ0LRVZc6yuV2ljcqUFeIcmDE7XIk0wZVT4OEx1cYdykl+0eKCCKuRJvfztDFahylezYHbBazueyXAVb4AdmFD8ZuPN5OJ4jIZ8iXuyexvl1uaxqDmqwta75SCvNqDkK380hfgvTrouUyo8hWbayOcKGO8gmGc11Spb/fJTs7nQrYjuOjolFtvWlKodN7PnMlX3UU08Yt91cTiZK+RAVjzbVn4sHRDyVWa5SN+m8YINJh2qjGq/LxcAJ9zAGTLgnNUDEYDdFQDxaVX/f+tqrTnQDtwqUSor3qc+ybrMmnIT+w4LVs1B6PJWcQE1uQ7sJ2MnpmqAHPbtY9aQnV9WLQFDPfj/+9ewFrr5fBqsmC6ObRz2TEiJCWhFVz4pZEP91h1mJReH8/ZnI5Qo1IKgvfF1mCxP5MnQ2uDJE9KyPkA3E4B+FzoQCg64TYxQVETKofyauM2nZVmunglrgXE14t9OGQGptYdAch0PuG/ZaQVwrjDcQSKs76zzwKu1irlQxKVf0vZm2gWJZu+bN7YnpmZBAMiKBTHa6mWNRoZt0NsVZSF0Uur6g08/FWE192oWnIw2r+jdIeSfLPMPVBuIoZM1mqXoWTuGTKkRojiOEMRlIGJUsuBFd72V9j1R98/WLmi/VTF6F4+0n5TYreJoVWL4QKIfMN2uQealkugoCTznfvwXY8zccW9/4vspC5XWKq2OwUH85Pz+1e/fABcoQuhnfw3BCE+8WyU43Q5qFZIYdb4KEhFsfbrjzE45LzibCrEla5N1xMRBD7rYa7F0tethHSouc/2k8FF50OEkctTmrmgqF45rFU0h5WXzm+1ziUkIpdy1opaoWuqjs8ZCt3+YqzZTrMgmQNhZZC2Br0QVKLNlpvW7SxbOpR2ZzxgEeJI11ukICCfVrkGJRqmn2Bbb6hj3C/8f3CNtCinJZV74KB2nWmkxeUGuiiO808X90vpSmeREoCWe9b+a/ip7oQsY8VBeg9N7lTYeTFGpjzBSEy+G3ZhWSrBZAELeVhIeVBCx5dcFq4FaSsFYOKu5KgXl2BWhCzdOhMVoAe7fpSGM8YiZbCb+YKekjs+/I5visY3V8yHDykzZnAcxvweLJoLUZ7BJ0RebZirQ/5sPPXAtcU/f/dWcO9l94uVtIexHeYM5oy0D1jWtuesfYHGmCaUu6iaOt6VbCtE57h+TxLu8EJ6xRmIgzyFdFtscWuLCu5vnxaTDfAq0A54s6Ee+K1HzNxducPm9BS7PUGEijDeMx7w2P7ZrwSS/LQoX2b1rX/PMNbwweWLfI9ZyTP8B4v1/VaiEi5De7R+D7nSaPmgK/wYAMGJhBXdY25gbFoq6D3Me+SbNkZEWqbCJSwxTaMmdvoAj/DveN0lzoKrObZ20pZfcB8yvppKfhwF7nrxlAznHsvw6pCVadxY2fq53NfFiD9Ddn0g9c5wQ3Eb9jqSIWzxZqMT4YO18tcuxBI724V8IIWMPBcXPibud4j2k6W87tjFhwqCMIz48w0W4bmRfBY1qHCf7ttsI1GqqJwDhxLu5tndOMUcZ0uIp8NP2wYX+l9W4Gzwuti46vss4jKngHySbz0NB0cvzhdOB2wV92z2IPgSenAoHeDC/Z6Z4gi4HPr/ez1LOpwODEu8lM/2HLheMInezc3WrkIAsGTWfuP9QiXzUNRwGI5rgfcj3ydNeTcLZxhLuR0X+y2OnjSc8MAIW9HbAh7UbngqCc5hADY+P1OxkYAYuPifchIzGS8+O7Ghb57B096yNkAzG9N4XQVdY47zdvgy2A/nbovcPh2PrGt3YVrF0Jw1fDThcyvlyonS50d+Got5zhL2wC3o5KVUGhEmP2PhZG/e8APyjSeg=="
 }
@@ -18,6 +18,7 @@
 package http
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -117,7 +118,7 @@ func checkHeaders(headers map[string]string) RespCheck {
 
 func checkBody(body []match.Matcher) RespCheck {
 	return func(r *http.Response) error {
-		content, err := ioutil.ReadAll(r.Body)
+		content, err := readBody(r)
 		if err != nil {
 			return err
 		}
@@ -147,11 +148,14 @@ func checkJSON(checks []*jsonResponseCheck) (RespCheck, error) {
 	}
 
 	return func(r *http.Response) error {
-		decoded := &common.MapStr{}
-		err := json.NewDecoder(r.Body).Decode(decoded)
+		body, err := readBody(r)
+		if err != nil {
+			return err
+		}
 
+		decoded := &common.MapStr{}
+		err = json.NewDecoder(bytes.NewReader(body)).Decode(decoded)
 		if err != nil {
-			body, _ := ioutil.ReadAll(r.Body)
 			return pkgerrors.Wrapf(err, "could not parse JSON for body check with condition. Source: %s", body)
 		}
 
@@ -175,3 +179,14 @@ func checkJSON(checks []*jsonResponseCheck) (RespCheck, error) {
 		return nil
 	}, nil
 }
+
+// readBody reads the complete response body and replaces it with an
+// in-memory copy, so other checks can read the body again.
+func readBody(r *http.Response) ([]byte, error) {
+	content, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	r.Body = ioutil.NopCloser(bytes.NewReader(content))
+	return content, nil
+}
@@ -0,0 +1,367 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/cookiejar"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/heartbeat/eventext"
+	"github.com/elastic/beats/heartbeat/look"
+	"github.com/elastic/beats/heartbeat/monitors"
+	"github.com/elastic/beats/heartbeat/monitors/jobs"
+	"github.com/elastic/beats/heartbeat/monitors/wrappers"
+	"github.com/elastic/beats/heartbeat/reason"
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/outputs"
+)
+
+func init() {
+	monitors.RegisterActive("http_journey", createJourney)
+}
+
+// variablePattern matches the {{name}} placeholders in step requests.
+var variablePattern = regexp.MustCompile(`\{\{\s*([\w.-]+)\s*\}\}`)
+
+// journey runs a sequence of HTTP requests. Every step is executed as a
+// continuation of the previous step and reported as its own event. A final
+// continuation reports the result of the whole journey.
+type journey struct {
+	steps        []*journeyStep
+	variables    map[string]string
+	transport    http.RoundTripper
+	timeout      time.Duration
+	maxRedirects int
+}
+
+type journeyStep struct {
+	index     int
+	name      string
+	request   journeyRequestConfig
+	validator RespCheck
+	extract   map[string]extractor
+}
+
+// journeyState is shared by the jobs of a single journey run.
+type journeyState struct {
+	client    *http.Client
+	vars      map[string]string
+	duration  time.Duration
+	completed int
+	failed    *journeyStep
+	err       error
+}
+
+// extractor reads the value of a variable from a step response.
+type extractor func(resp *http.Response, body []byte) (string, error)
+
+func createJourney(
+	name string,
+	cfg *common.Config,
+) (js []jobs.Job, endpoints int, err error) {
+	config := defaultJourneyConfig
+	if err := cfg.Unpack(&config); err != nil {
+		return nil, 0, err
+	}
+
+	tls, err := outputs.LoadTLSConfig(config.TLS)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	transport, err := newRoundTripper(&Config{ProxyURL: config.ProxyURL, Timeout: config.Timeout}, tls)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	j := &journey{
+		variables:    config.Variables,
+		transport:    transport,
+		timeout:      config.Timeout,
+		maxRedirects: config.MaxRedirects,
+	}
+	for i, stepConfig := range config.Steps {
+		step, err := newJourneyStep(i+1, stepConfig)
+		if err != nil {
+			return nil, 0, errors.Wrapf(err, "invalid journey step %d", i+1)
+		}
+		j.steps = append(j.steps, step)
+	}
+
+	return []jobs.Job{j.start}, 1, nil
+}
+
+func newJourneyStep(index int, config journeyStepConfig) (*journeyStep, error) {
+	validator, err := makeValidateResponse(&config.Response)
+	if err != nil {
+		return nil, err
+	}
+
+	step := &journeyStep{
+		index:     index,
+		name:      config.Name,
+		request:   config.Request,
+		validator: validator,
+		extract:   map[string]extractor{},
+	}
+	if step.name == "" {
+		step.name = fmt.Sprintf("step %d", index)
+	}
+	if step.request.Method == "" {
+		step.request.Method = "GET"
+	}
+
+	for name, e := range config.Extract {
+		step.extract[name] = makeExtractor(e)
+	}
+	return step, nil
+}
+
+// start begins a new run of the journey with a fresh set of variables and
+// cookies.
+func (j *journey) start(event *beat.Event) ([]jobs.Job, error) {
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	state := &journeyState{
+		client: &http.Client{
+			CheckRedirect: makeCheckRedirect(j.maxRedirects),
+			Transport:     j.transport,
+			Timeout:       j.timeout,
+			Jar:           jar,
+		},
+		vars: map[string]string{},
+	}
+	for k, v := range j.variables {
+		state.vars[k] = v
+	}
+
+	return j.stepJob(state, 0)(event)
+}
+
+func (j *journey) stepJob(state *journeyState, i int) jobs.Job {
+	return func(event *beat.Event) ([]jobs.Job, error) {
+		step := j.steps[i]
+		if err := j.runStep(event, state, step); err != nil {
+			state.failed = step
+			state.err = err
+			return []jobs.Job{j.summaryJob(state)}, err
+		}
+
+		state.completed++
+		if i+1 < len(j.steps) {
+			return []jobs.Job{j.stepJob(state, i+1)}, nil
+		}
+		return []jobs.Job{j.summaryJob(state)}, nil
+	}
+}
+
+func (j *journey) runStep(event *beat.Event, state *journeyState, step *journeyStep) error {
+	eventext.MergeEventFields(event, common.MapStr{
+		"journey": common.MapStr{
+			"step": common.MapStr{
+				"index": step.index,
+				"name":  step.name,
+			},
+		},
+	})
+
+	req, body, err := step.buildRequest(state.vars)
+	if err != nil {
+		return reason.ValidateFailed(err)
+	}
+
+	// URLFields hides the password of the URL it is given, use a copy.
+	u := *req.URL
+	eventext.MergeEventFields(event, common.MapStr{"url": wrappers.URLFields(&u)})
+
+	ctx, cancel := context.WithTimeout(context.Background(), j.timeout)
+	defer cancel()
+	req = attachRequestBody(&ctx, req, body)
+
+	start := time.Now()
+	resp, err := state.client.Do(req)
+	if err != nil {
+		state.duration += time.Since(start)
+		return reason.IOFailed(err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := readBody(resp)
+	end := time.Now()
+	state.duration += end.Sub(start)
+	if err != nil {
+		return reason.IOFailed(err)
+	}
+
+	eventext.MergeEventFields(event, common.MapStr{"http": common.MapStr{
+		"response": common.MapStr{"status_code": resp.StatusCode},
+		"rtt": common.MapStr{
+			"total": look.RTT(end.Sub(start)),
+		},
+	}})
+
+	if err := step.validator(resp); err != nil {
+		return reason.ValidateFailed(err)
+	}
+
+	for name, extract := range step.extract {
+		value, err := extract(resp, respBody)
+		if err != nil {
+			return reason.ValidateFailed(errors.Wrapf(err, "could not extract variable '%s'", name))
+		}
+		state.vars[name] = value
+	}
+	return nil
+}
+
+func (j *journey) summaryJob(state *journeyState) jobs.Job {
+	return func(event *beat.Event) ([]jobs.Job, error) {
+		fields := common.MapStr{
+			"steps":           len(j.steps),
+			"completed_steps": state.completed,
+			"duration":        look.RTT(state.duration),
+		}
+		if state.failed != nil {
+			fields["failed_step"] = common.MapStr{
+				"index": state.failed.index,
+				"name":  state.failed.name,
+			}
+		}
+		eventext.MergeEventFields(event, common.MapStr{"journey": fields})
+		return nil, state.err
+	}
+}
+
+// buildRequest creates the step request, replacing all variables in the URL,
+// headers and body.
+func (s *journeyStep) buildRequest(vars map[string]string) (*http.Request, []byte, error) {
+	addr, err := expandVariables(s.request.URL, vars)
+	if err != nil {
+		return nil, nil, err
+	}
+	body, err := expandVariables(s.request.Body, vars)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request, err := http.NewRequest(strings.ToUpper(s.request.Method), addr, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	request.Close = true
+
+	if s.request.Username != "" {
+		password, err := expandVariables(s.request.Password, vars)
+		if err != nil {
+			return nil, nil, err
+		}
+		request.SetBasicAuth(s.request.Username, password)
+	}
+	for k, v := range s.request.Headers {
+		value, err := expandVariables(v, vars)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// defining the Host header isn't enough. See https://github.com/golang/go/issues/7682
+		if k == "Host" {
+			request.Host = value
+		}
+		request.Header.Add(k, value)
+	}
+
+	return request, []byte(body), nil
+}
+
+// expandVariables replaces all {{name}} placeholders in s. Referencing an
+// undefined variable is an error.
+func expandVariables(s string, vars map[string]string) (string, error) {
+	var missing []string
+	expanded := variablePattern.ReplaceAllStringFunc(s, func(placeholder string) string {
+		name := variablePattern.FindStringSubmatch(placeholder)[1]
+		value, found := vars[name]
+		if !found {
+			missing = append(missing, name)
+		}
+		return value
+	})
+	if len(missing) > 0 {
+		return "", fmt.Errorf("undefined variables: %s", strings.Join(missing, ", "))
+	}
+	return expanded, nil
+}
+
+func makeExtractor(config extractConfig) extractor {
+	switch {
+	case config.Header != "":
+		name := config.Header
+		return func(resp *http.Response, _ []byte) (string, error) {
+			values, found := resp.Header[http.CanonicalHeaderKey(name)]
+			if !found || len(values) == 0 {
+				return "", fmt.Errorf("header '%s' not found", name)
+			}
+			return values[0], nil
+		}
+
+	case config.JSON != "":
+		path := config.JSON
+		return func(_ *http.Response, body []byte) (string, error) {
+			decoded := common.MapStr{}
+			if err := json.Unmarshal(body, &decoded); err != nil {
+				return "", errors.Wrap(err, "could not parse JSON body")
+			}
+			value, err := decoded.GetValue(path)
+			if err != nil {
+				return "", fmt.Errorf("JSON path '%s' not found", path)
+			}
+			if s, ok := value.(string); ok {
+				return s, nil
+			}
+			encoded, err := json.Marshal(value)
+			if err != nil {
+				return "", err
+			}
+			return string(encoded), nil
+		}
+
+	default:
+		re := regexp.MustCompile(config.Regexp)
+		return func(_ *http.Response, body []byte) (string, error) {
+			match := re.FindSubmatch(body)
+			if match == nil {
+				return "", fmt.Errorf("body does not match '%s'", config.Regexp)
+			}
+			if len(match) > 1 {
+				return string(match[1]), nil
+			}
+			return string(match[0]), nil
+		}
+	}
+}
@@ -0,0 +1,106 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package http
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/libbeat/common/transport/tlscommon"
+)
+
+type journeyConfig struct {
+	ProxyURL     string        `config:"proxy_url"`
+	Timeout      time.Duration `config:"timeout"`
+	MaxRedirects int           `config:"max_redirects"`
+
+	// configure tls (if not configured HTTPS will use system defaults)
+	TLS *tlscommon.Config `config:"ssl"`
+
+	// Variables available to all steps before any value is extracted.
+	Variables map[string]string `config:"variables"`
+
+	Steps []journeyStepConfig `config:"steps" validate:"required"`
+}
+
+type journeyStepConfig struct {
+	Name     string                   `config:"name"`
+	Request  journeyRequestConfig     `config:"request" validate:"required"`
+	Response responseParameters       `config:"response"`
+	Extract  map[string]extractConfig `config:"extract"`
+}
+
+type journeyRequestConfig struct {
+	Method   string            `config:"method"`
+	URL      string            `config:"url" validate:"required"`
+	Headers  map[string]string `config:"headers"`
+	Body     string            `config:"body"`
+	Username string            `config:"username"`
+	Password string            `config:"password"`
+}
+
+// extractConfig defines where the value of a variable is read from. Exactly
+// one source must be configured.
+type extractConfig struct {
+	// JSON is the dotted path of a value in the JSON response body.
+	JSON string `config:"json"`
+	// Header is the name of a response header.
+	Header string `config:"header"`
+	// Regexp is matched against the response body. The first capture group
+	// is used if present, the whole match otherwise.
+	Regexp string `config:"regexp"`
+}
+
+var defaultJourneyConfig = journeyConfig{
+	Timeout:      16 * time.Second,
+	MaxRedirects: 10,
+}
+
+var errNoExtractSource = errors.New("one of 'json', 'header' or 'regexp' must be set")
+
+func (r *journeyRequestConfig) Validate() error {
+	switch strings.ToUpper(r.Method) {
+	case "", "HEAD", "GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS":
+	default:
+		return fmt.Errorf("HTTP method '%v' not supported", r.Method)
+	}
+
+	return nil
+}
+
+func (e *extractConfig) Validate() error {
+	sources := 0
+	for _, s := range []string{e.JSON, e.Header, e.Regexp} {
+		if s != "" {
+			sources++
+		}
+	}
+	if sources != 1 {
+		return errNoExtractSource
+	}
+
+	if e.Regexp != "" {
+		if _, err := regexp.Compile(e.Regexp); err != nil {
+			return fmt.Errorf("invalid regexp '%v': %v", e.Regexp, err)
+		}
+	}
+	return nil
+}
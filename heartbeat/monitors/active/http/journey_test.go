@@ -0,0 +1,277 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package http
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/heartbeat/hbtest"
+	"github.com/elastic/beats/heartbeat/monitors/jobs"
+	"github.com/elastic/beats/heartbeat/monitors/wrappers"
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/go-lookslike"
+	"github.com/elastic/go-lookslike/isdef"
+	"github.com/elastic/go-lookslike/testslike"
+)
+
+func journeyServer(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/login", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		var creds map[string]string
+		if r.Method != "POST" || json.Unmarshal(body, &creds) != nil || creds["password"] != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		http.SetCookie(w, &http.Cookie{Name: "session", Value: "s1"})
+		w.Header().Set("X-Request-Id", "r-42")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"auth": {"token": "t0k3n", "expires": 3600}}`))
+	})
+	mux.HandleFunc("/profile", func(w http.ResponseWriter, r *http.Request) {
+		cookie, err := r.Cookie("session")
+		if r.Header.Get("Authorization") != "Bearer t0k3n" || err != nil || cookie.Value != "s1" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"name": "admin", "request": "` + r.URL.Query().Get("request") + `"}`))
+	})
+	return httptest.NewServer(mux)
+}
+
+func runJourney(t *testing.T, config map[string]interface{}) []*beat.Event {
+	cfg, err := common.NewConfigFrom(config)
+	require.NoError(t, err)
+
+	js, endpoints, err := createJourney("journey", cfg)
+	require.NoError(t, err)
+	require.Equal(t, 1, endpoints)
+
+	events, err := jobs.ExecJobsAndConts(t, wrappers.WrapCommon(js, "journey", "", "http_journey"))
+	require.NoError(t, err)
+	return events
+}
+
+func journeySteps(server *httptest.Server) []map[string]interface{} {
+	return []map[string]interface{}{
+		{
+			"name": "login",
+			"request": map[string]interface{}{
+				"method": "POST",
+				"url":    server.URL + "/login",
+				"body":   `{"user": "{{user}}", "password": "{{ password }}"}`,
+			},
+			"extract": map[string]interface{}{
+				"token":      map[string]interface{}{"json": "auth.token"},
+				"expires":    map[string]interface{}{"json": "auth.expires"},
+				"request_id": map[string]interface{}{"header": "x-request-id"},
+			},
+		},
+		{
+			"name": "profile",
+			"request": map[string]interface{}{
+				"url":     server.URL + "/profile?request={{request_id}}",
+				"headers": map[string]interface{}{"Authorization": "Bearer {{token}}"},
+			},
+			"response": map[string]interface{}{
+				"status": 200,
+				"json": []map[string]interface{}{
+					{
+						"description": "user name",
+						"condition": map[string]interface{}{
+							"equals": map[string]interface{}{"name": "admin", "request": "r-42"},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestJourneyUp(t *testing.T) {
+	server := journeyServer(t)
+	defer server.Close()
+
+	events := runJourney(t, map[string]interface{}{
+		"timeout":   "1s",
+		"variables": map[string]interface{}{"user": "admin", "password": "secret"},
+		"steps":     journeySteps(server),
+	})
+	require.Len(t, events, 3)
+
+	for i, name := range []string{"login", "profile"} {
+		testslike.Test(
+			t,
+			lookslike.Compose(
+				hbtest.BaseChecks("", "up", "http_journey"),
+				lookslike.MustCompile(map[string]interface{}{
+					"journey.step.index":        i + 1,
+					"journey.step.name":         name,
+					"http.response.status_code": 200,
+					"http.rtt.total.us":         isdef.IsDuration,
+					"url.full":                  isdef.IsStringContaining(server.URL + "/" + name),
+				}),
+			),
+			events[i].Fields,
+		)
+	}
+
+	testslike.Test(
+		t,
+		lookslike.Strict(lookslike.Compose(
+			hbtest.BaseChecks("", "up", "http_journey"),
+			hbtest.SummaryChecks(3, 0),
+			lookslike.MustCompile(map[string]interface{}{
+				"journey.steps":           2,
+				"journey.completed_steps": 2,
+				"journey.duration.us":     isdef.IsDuration,
+			}),
+		)),
+		events[2].Fields,
+	)
+}
+
+func TestJourneyStepFailure(t *testing.T) {
+	server := journeyServer(t)
+	defer server.Close()
+
+	events := runJourney(t, map[string]interface{}{
+		"timeout":   "1s",
+		"variables": map[string]interface{}{"user": "admin", "password": "wrong"},
+		"steps":     journeySteps(server),
+	})
+
+	// the profile step is not executed
+	require.Len(t, events, 2)
+
+	testslike.Test(
+		t,
+		lookslike.Compose(
+			hbtest.BaseChecks("", "down", "http_journey"),
+			hbtest.ErrorChecks("401", "validate"),
+			lookslike.MustCompile(map[string]interface{}{
+				"journey.step.name":         "login",
+				"http.response.status_code": 401,
+			}),
+		),
+		events[0].Fields,
+	)
+	testslike.Test(
+		t,
+		lookslike.Compose(
+			hbtest.BaseChecks("", "down", "http_journey"),
+			hbtest.SummaryChecks(0, 2),
+			hbtest.ErrorChecks("401", "validate"),
+			lookslike.MustCompile(map[string]interface{}{
+				"journey.steps":             2,
+				"journey.completed_steps":   0,
+				"journey.failed_step.index": 1,
+				"journey.failed_step.name":  "login",
+			}),
+		),
+		events[1].Fields,
+	)
+}
+
+func TestJourneyExtractFailure(t *testing.T) {
+	server := journeyServer(t)
+	defer server.Close()
+
+	steps := journeySteps(server)
+	steps[0]["extract"] = map[string]interface{}{
+		"token": map[string]interface{}{"regexp": `"session":"([^"]+)"`},
+	}
+	events := runJourney(t, map[string]interface{}{
+		"timeout":   "1s",
+		"variables": map[string]interface{}{"user": "admin", "password": "secret"},
+		"steps":     steps,
+	})
+	require.Len(t, events, 2)
+	testslike.Test(t, hbtest.ErrorChecks("could not extract variable 'token'", "validate"), events[0].Fields)
+}
+
+func TestJourneyUndefinedVariable(t *testing.T) {
+	server := journeyServer(t)
+	defer server.Close()
+
+	events := runJourney(t, map[string]interface{}{
+		"timeout": "1s",
+		"steps":   journeySteps(server),
+	})
+	require.Len(t, events, 2)
+	testslike.Test(t, hbtest.ErrorChecks("undefined variables: user, password", "validate"), events[0].Fields)
+}
+
+func TestJourneyConfigValidation(t *testing.T) {
+	tests := map[string]map[string]interface{}{
+		"no steps": {},
+		"missing url": {
+			"steps": []map[string]interface{}{{"name": "a"}},
+		},
+		"invalid method": {
+			"steps": []map[string]interface{}{{
+				"request": map[string]interface{}{"url": "http://localhost", "method": "TRACE"},
+			}},
+		},
+		"multiple extract sources": {
+			"steps": []map[string]interface{}{{
+				"request": map[string]interface{}{"url": "http://localhost"},
+				"extract": map[string]interface{}{
+					"a": map[string]interface{}{"json": "a", "header": "b"},
+				},
+			}},
+		},
+		"invalid regexp": {
+			"steps": []map[string]interface{}{{
+				"request": map[string]interface{}{"url": "http://localhost"},
+				"extract": map[string]interface{}{
+					"a": map[string]interface{}{"regexp": "("},
+				},
+			}},
+		},
+	}
+
+	for name, config := range tests {
+		t.Run(name, func(t *testing.T) {
+			cfg, err := common.NewConfigFrom(config)
+			require.NoError(t, err)
+			_, _, err = createJourney("journey", cfg)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestExpandVariables(t *testing.T) {
+	vars := map[string]string{"a": "1", "b.c": "2"}
+
+	s, err := expandVariables("x={{a}}&y={{ b.c }}&z={a}", vars)
+	require.NoError(t, err)
+	assert.Equal(t, "x=1&y=2&z={a}", s)
+
+	_, err = expandVariables("{{a}}{{d}}{{e}}", vars)
+	assert.EqualError(t, err, "undefined variables: d, e")
+}
@@ -0,0 +1,167 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package config
+
+import (
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// Filter selects journal entries by systemd unit, syslog identifier, priority
+// and transport. Entries must match all configured conditions. A condition
+// with several values matches if any of the values matches.
+//
+// Or lists alternative groups of conditions. A group matches if all of its
+// conditions match. An included entry must match at least one group, an entry
+// matching any group is excluded.
+type Filter struct {
+	// Units are systemd unit names or glob patterns (e.g. "nginx", "docker-*.scope").
+	Units []string `config:"units"`
+	// SyslogIdentifiers are the syslog identifiers (tags) of the entries.
+	SyslogIdentifiers []string `config:"syslog_identifiers"`
+	// Priority is the range of syslog priorities of the entries.
+	Priority PriorityRange `config:"priority"`
+	// Transports are the ways entries were received by the journal.
+	Transports []string `config:"transports"`
+	// Or are groups of conditions, any of which must match.
+	Or []Filter `config:"or"`
+}
+
+// PriorityRange is a range of syslog priorities. The zero value matches no
+// priority and means the range is not configured.
+type PriorityRange struct {
+	// From is the most important priority of the range.
+	From int
+	// To is the least important priority of the range.
+	To int
+
+	set bool
+}
+
+var (
+	priorities = map[string]int{
+		"emerg":   0,
+		"alert":   1,
+		"crit":    2,
+		"err":     3,
+		"warning": 4,
+		"notice":  5,
+		"info":    6,
+		"debug":   7,
+	}
+
+	// transports are the values of the _TRANSPORT field of the journal.
+	transports = map[string]struct{}{
+		"audit":   {},
+		"driver":  {},
+		"syslog":  {},
+		"journal": {},
+		"stdout":  {},
+		"kernel":  {},
+	}
+)
+
+// Validate checks the patterns and that the configured transports exist.
+// Groups must not contain groups.
+func (f *Filter) Validate() error {
+	for _, group := range f.Or {
+		if len(group.Or) > 0 {
+			return fmt.Errorf("'or' groups cannot contain 'or' groups")
+		}
+	}
+	for _, values := range [][]string{f.Units, f.SyslogIdentifiers} {
+		for _, v := range values {
+			if _, err := path.Match(v, ""); err != nil {
+				return fmt.Errorf("invalid pattern '%s': %v", v, err)
+			}
+		}
+	}
+	for _, t := range f.Transports {
+		if _, ok := transports[t]; !ok {
+			return fmt.Errorf("invalid transport '%s'", t)
+		}
+	}
+	return nil
+}
+
+// IsEmpty returns true if no condition is configured.
+func (f *Filter) IsEmpty() bool {
+	return len(f.Units) == 0 && len(f.SyslogIdentifiers) == 0 &&
+		!f.Priority.IsSet() && len(f.Transports) == 0 && len(f.Or) == 0
+}
+
+// Unpack parses a priority range. A single priority selects that priority and
+// all the more important ones, like `journalctl -p`. A range is written as
+// "FROM..TO". Priorities are given by name (e.g. "err") or by number.
+func (p *PriorityRange) Unpack(value interface{}) error {
+	var s string
+	switch v := value.(type) {
+	case string:
+		s = v
+	case int64:
+		s = strconv.FormatInt(v, 10)
+	case uint64:
+		s = strconv.FormatUint(v, 10)
+	default:
+		return fmt.Errorf("invalid priority '%v'", value)
+	}
+
+	r := PriorityRange{From: 0, set: true}
+	var err error
+	if parts := strings.SplitN(s, "..", 2); len(parts) == 2 {
+		if r.From, err = parsePriority(parts[0]); err != nil {
+			return err
+		}
+		if r.To, err = parsePriority(parts[1]); err != nil {
+			return err
+		}
+	} else if r.To, err = parsePriority(s); err != nil {
+		return err
+	}
+
+	if r.From > r.To {
+		r.From, r.To = r.To, r.From
+	}
+	*p = r
+	return nil
+}
+
+// IsSet returns true if the range is configured.
+func (p PriorityRange) IsSet() bool {
+	return p.set
+}
+
+// Contains returns true if the priority is part of the range.
+func (p PriorityRange) Contains(priority int) bool {
+	return p.set && p.From <= priority && priority <= p.To
+}
+
+func parsePriority(s string) (int, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if p, ok := priorities[s]; ok {
+		return p, nil
+	}
+
+	p, err := strconv.Atoi(s)
+	if err != nil || p < 0 || p > 7 {
+		return 0, fmt.Errorf("invalid priority '%s', expected a name or a number between 0 and 7", s)
+	}
+	return p, nil
+}
@@ -0,0 +1,137 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func TestPriorityRangeUnpack(t *testing.T) {
+	tests := map[string]struct {
+		value    interface{}
+		expected PriorityRange
+		err      bool
+	}{
+		"name":           {value: "err", expected: PriorityRange{From: 0, To: 3, set: true}},
+		"number":         {value: uint64(4), expected: PriorityRange{From: 0, To: 4, set: true}},
+		"numeric string": {value: "6", expected: PriorityRange{From: 0, To: 6, set: true}},
+		"range":          {value: "crit..warning", expected: PriorityRange{From: 2, To: 4, set: true}},
+		"reversed range": {value: "7..notice", expected: PriorityRange{From: 5, To: 7, set: true}},
+		"unknown name":   {value: "fatal", err: true},
+		"out of range":   {value: "8", err: true},
+		"invalid type":   {value: true, err: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var r PriorityRange
+			err := r.Unpack(test.value)
+			if test.err {
+				assert.Error(t, err)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, test.expected, r)
+			}
+		})
+	}
+}
+
+func TestPriorityRangeContains(t *testing.T) {
+	var r PriorityRange
+	assert.False(t, r.Contains(0))
+
+	if err := r.Unpack("err..warning"); err != nil {
+		t.Fatal(err)
+	}
+	assert.False(t, r.Contains(2))
+	assert.True(t, r.Contains(3))
+	assert.True(t, r.Contains(4))
+	assert.False(t, r.Contains(5))
+}
+
+func TestFilterConfig(t *testing.T) {
+	tests := map[string]struct {
+		config map[string]interface{}
+		err    bool
+	}{
+		"all conditions": {
+			config: map[string]interface{}{
+				"units":              []string{"nginx", "docker-*.scope"},
+				"syslog_identifiers": []string{"sudo"},
+				"priority":           "warning",
+				"transports":         []string{"kernel", "syslog"},
+			},
+		},
+		"invalid transport": {
+			config: map[string]interface{}{"transports": []string{"udp"}},
+			err:    true,
+		},
+		"invalid pattern": {
+			config: map[string]interface{}{"units": []string{"nginx["}},
+			err:    true,
+		},
+		"invalid priority": {
+			config: map[string]interface{}{"priority": "loud"},
+			err:    true,
+		},
+		"or groups": {
+			config: map[string]interface{}{
+				"or": []map[string]interface{}{
+					{"units": []string{"sshd"}, "priority": "err"},
+					{"transports": []string{"kernel"}},
+				},
+			},
+		},
+		"invalid condition in or group": {
+			config: map[string]interface{}{
+				"or": []map[string]interface{}{{"transports": []string{"udp"}}},
+			},
+			err: true,
+		},
+		"nested or groups": {
+			config: map[string]interface{}{
+				"or": []map[string]interface{}{
+					{"or": []map[string]interface{}{{"units": []string{"sshd"}}}},
+				},
+			},
+			err: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var f Filter
+			err := common.MustNewConfigFrom(test.config).Unpack(&f)
+			if test.err {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.False(t, f.IsEmpty())
+			}
+		})
+	}
+
+	assert.True(t, (&Filter{}).IsEmpty())
+}
@@ -40,6 +40,10 @@ type Config struct {
 	CursorSeekFallback config.SeekMode `config:"cursor_seek_fallback"`
 	// Matches store the key value pairs to match entries.
 	Matches []string `config:"include_matches"`
+	// Include selects entries by unit, syslog identifier, priority and transport.
+	Include config.Filter `config:"include"`
+	// Exclude drops entries by unit, syslog identifier, priority and transport.
+	Exclude config.Filter `config:"exclude"`
 	// SaveRemoteHostname defines if the original source of the entry needs to be saved.
 	SaveRemoteHostname bool `config:"save_remote_hostname"`
 
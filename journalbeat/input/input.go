@@ -73,6 +73,8 @@ func New(
 			Seek:               config.Seek,
 			CursorSeekFallback: config.CursorSeekFallback,
 			Matches:            config.Matches,
+			Include:            config.Include,
+			Exclude:            config.Exclude,
 			SaveRemoteHostname: config.SaveRemoteHostname,
 		}
 
@@ -92,6 +94,8 @@ func New(
 			Seek:               config.Seek,
 			CursorSeekFallback: config.CursorSeekFallback,
 			Matches:            config.Matches,
+			Include:            config.Include,
+			Exclude:            config.Exclude,
 			SaveRemoteHostname: config.SaveRemoteHostname,
 		}
 		state := states[p]
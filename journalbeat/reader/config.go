@@ -39,6 +39,10 @@ type Config struct {
 	Backoff time.Duration
 	// Matches store the key value pairs to match entries.
 	Matches []string
+	// Include selects the entries to read.
+	Include config.Filter
+	// Exclude drops entries from the selected ones.
+	Exclude config.Filter
 	// SaveRemoteHostname defines if the original source of the entry needs to be saved.
 	SaveRemoteHostname bool
 }
@@ -0,0 +1,267 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package reader
+
+import (
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/elastic/beats/journalbeat/config"
+)
+
+// Journal fields used by filters.
+const (
+	unitField             = "_SYSTEMD_UNIT"
+	syslogIdentifierField = "SYSLOG_IDENTIFIER"
+	priorityField         = "PRIORITY"
+	transportField        = "_TRANSPORT"
+)
+
+// filter selects journal entries based on the include and exclude options.
+// Conditions the journal can evaluate are turned into match expressions, the
+// others are evaluated after an entry is read.
+type filter struct {
+	// matches are groups of "FIELD=value" expressions added to the journal.
+	// Expressions of a group are alternatives, all groups must match.
+	matches [][]string
+	// include lists the conditions an entry must all match.
+	include []condition
+	// exclude lists the conditions dropping an entry if any matches.
+	exclude []condition
+	// includeGroups are alternatives, an entry must match at least one.
+	includeGroups []group
+	// excludeGroups drop an entry if any of them matches.
+	excludeGroups []group
+}
+
+// group is an `or` alternative of a filter. It matches if all of its
+// conditions match.
+type group struct {
+	conditions []condition
+	// matches are the conditions as journal match expressions, nil if the
+	// conditions can't be evaluated by the journal.
+	matches [][]string
+}
+
+// condition matches the value of a journal field.
+type condition struct {
+	field string
+	match func(value string) bool
+}
+
+func newFilter(include, exclude config.Filter) *filter {
+	f := &filter{}
+
+	// The journal can only match exact values of fields.
+	if units := unitNames(include.Units); hasPattern(units) {
+		f.include = append(f.include, patternCondition(unitField, units))
+	} else if len(units) > 0 {
+		f.matches = append(f.matches, fieldMatches(unitField, units))
+	}
+	if ids := include.SyslogIdentifiers; hasPattern(ids) {
+		f.include = append(f.include, patternCondition(syslogIdentifierField, ids))
+	} else if len(ids) > 0 {
+		f.matches = append(f.matches, fieldMatches(syslogIdentifierField, ids))
+	}
+	if include.Priority.IsSet() {
+		f.matches = append(f.matches, fieldMatches(priorityField, priorityValues(include.Priority)))
+	}
+	if len(include.Transports) > 0 {
+		f.matches = append(f.matches, fieldMatches(transportField, include.Transports))
+	}
+
+	// The journal cannot negate matches, so exclusions are always evaluated
+	// after reading an entry.
+	if units := unitNames(exclude.Units); len(units) > 0 {
+		f.exclude = append(f.exclude, patternCondition(unitField, units))
+	}
+	if len(exclude.SyslogIdentifiers) > 0 {
+		f.exclude = append(f.exclude, patternCondition(syslogIdentifierField, exclude.SyslogIdentifiers))
+	}
+	if exclude.Priority.IsSet() {
+		f.exclude = append(f.exclude, priorityCondition(exclude.Priority))
+	}
+	if len(exclude.Transports) > 0 {
+		f.exclude = append(f.exclude, patternCondition(transportField, exclude.Transports))
+	}
+
+	for _, c := range include.Or {
+		f.includeGroups = append(f.includeGroups, newGroup(c))
+	}
+	for _, c := range exclude.Or {
+		f.excludeGroups = append(f.excludeGroups, newGroup(c))
+	}
+
+	return f
+}
+
+func newGroup(c config.Filter) group {
+	var g group
+
+	units := unitNames(c.Units)
+	if len(units) > 0 {
+		g.conditions = append(g.conditions, patternCondition(unitField, units))
+	}
+	if len(c.SyslogIdentifiers) > 0 {
+		g.conditions = append(g.conditions, patternCondition(syslogIdentifierField, c.SyslogIdentifiers))
+	}
+	if c.Priority.IsSet() {
+		g.conditions = append(g.conditions, priorityCondition(c.Priority))
+	}
+	if len(c.Transports) > 0 {
+		g.conditions = append(g.conditions, patternCondition(transportField, c.Transports))
+	}
+
+	// The journal can only match exact values of fields.
+	if hasPattern(units) || hasPattern(c.SyslogIdentifiers) {
+		return g
+	}
+	if len(units) > 0 {
+		g.matches = append(g.matches, fieldMatches(unitField, units))
+	}
+	if len(c.SyslogIdentifiers) > 0 {
+		g.matches = append(g.matches, fieldMatches(syslogIdentifierField, c.SyslogIdentifiers))
+	}
+	if c.Priority.IsSet() {
+		g.matches = append(g.matches, fieldMatches(priorityField, priorityValues(c.Priority)))
+	}
+	if len(c.Transports) > 0 {
+		g.matches = append(g.matches, fieldMatches(transportField, c.Transports))
+	}
+	return g
+}
+
+// disjunction returns the match expressions of the include groups, to be
+// added to the journal as alternatives. It returns nil if any group can't be
+// evaluated by the journal.
+func (f *filter) disjunction() [][][]string {
+	var groups [][][]string
+	for _, g := range f.includeGroups {
+		if g.matches == nil {
+			return nil
+		}
+		groups = append(groups, g.matches)
+	}
+	return groups
+}
+
+// matchesEntry returns true if the entry must be published.
+func (f *filter) matchesEntry(fields map[string]string) bool {
+	for _, c := range f.include {
+		if v, ok := fields[c.field]; !ok || !c.match(v) {
+			return false
+		}
+	}
+	// Groups are evaluated even if the journal evaluated them already, as
+	// a single group might not be expressible by the journal.
+	if len(f.includeGroups) > 0 && !anyGroupMatches(f.includeGroups, fields) {
+		return false
+	}
+	for _, c := range f.exclude {
+		if v, ok := fields[c.field]; ok && c.match(v) {
+			return false
+		}
+	}
+	return !anyGroupMatches(f.excludeGroups, fields)
+}
+
+func anyGroupMatches(groups []group, fields map[string]string) bool {
+	for _, g := range groups {
+		if g.matchesEntry(fields) {
+			return true
+		}
+	}
+	return false
+}
+
+func (g group) matchesEntry(fields map[string]string) bool {
+	for _, c := range g.conditions {
+		if v, ok := fields[c.field]; !ok || !c.match(v) {
+			return false
+		}
+	}
+	return true
+}
+
+func fieldMatches(field string, values []string) []string {
+	matches := make([]string, len(values))
+	for i, v := range values {
+		matches[i] = field + "=" + v
+	}
+	return matches
+}
+
+// patternCondition matches a field against values that can be glob patterns.
+func patternCondition(field string, patterns []string) condition {
+	return condition{
+		field: field,
+		match: func(value string) bool {
+			for _, p := range patterns {
+				if matched, _ := path.Match(p, value); matched {
+					return true
+				}
+			}
+			return false
+		},
+	}
+}
+
+func priorityValues(r config.PriorityRange) []string {
+	var priorities []string
+	for p := r.From; p <= r.To; p++ {
+		priorities = append(priorities, strconv.Itoa(p))
+	}
+	return priorities
+}
+
+func priorityCondition(r config.PriorityRange) condition {
+	return condition{
+		field: priorityField,
+		match: func(value string) bool {
+			p, err := strconv.Atoi(value)
+			return err == nil && r.Contains(p)
+		},
+	}
+}
+
+// unitNames completes unit names without a type with ".service", as
+// journalctl does.
+func unitNames(units []string) []string {
+	names := make([]string, len(units))
+	for i, u := range units {
+		if !strings.Contains(u, ".") && !isPattern(u) {
+			u += ".service"
+		}
+		names[i] = u
+	}
+	return names
+}
+
+func hasPattern(values []string) bool {
+	for _, v := range values {
+		if isPattern(v) {
+			return true
+		}
+	}
+	return false
+}
+
+func isPattern(value string) bool {
+	return strings.ContainsAny(value, "*?[")
+}
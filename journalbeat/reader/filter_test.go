@@ -0,0 +1,216 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package reader
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/journalbeat/config"
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func TestNewFilterMatches(t *testing.T) {
+	include := mustFilter(t, map[string]interface{}{
+		"units":              []string{"nginx", "sshd.socket"},
+		"syslog_identifiers": []string{"sudo"},
+		"priority":           "crit",
+		"transports":         []string{"syslog"},
+	})
+
+	f := newFilter(include, config.Filter{})
+	assert.Equal(t, [][]string{
+		{"_SYSTEMD_UNIT=nginx.service", "_SYSTEMD_UNIT=sshd.socket"},
+		{"SYSLOG_IDENTIFIER=sudo"},
+		{"PRIORITY=0", "PRIORITY=1", "PRIORITY=2"},
+		{"_TRANSPORT=syslog"},
+	}, f.matches)
+	assert.Empty(t, f.include)
+	assert.Empty(t, f.exclude)
+}
+
+func TestNewFilterDisjunction(t *testing.T) {
+	include := mustFilter(t, map[string]interface{}{
+		"transports": []string{"syslog"},
+		"or": []map[string]interface{}{
+			{"units": []string{"sshd"}, "priority": "err"},
+			{"syslog_identifiers": []string{"sudo"}},
+		},
+	})
+
+	f := newFilter(include, config.Filter{})
+	assert.Equal(t, [][]string{{"_TRANSPORT=syslog"}}, f.matches)
+	assert.Equal(t, [][][]string{
+		{
+			{"_SYSTEMD_UNIT=sshd.service"},
+			{"PRIORITY=0", "PRIORITY=1", "PRIORITY=2", "PRIORITY=3"},
+		},
+		{
+			{"SYSLOG_IDENTIFIER=sudo"},
+		},
+	}, f.disjunction())
+
+	// Groups with patterns are only evaluated after reading an entry.
+	include = mustFilter(t, map[string]interface{}{
+		"or": []map[string]interface{}{
+			{"units": []string{"sshd"}},
+			{"units": []string{"docker-*.scope"}},
+		},
+	})
+	f = newFilter(include, config.Filter{})
+	assert.Nil(t, f.disjunction())
+}
+
+func TestFilterMatchesEntry(t *testing.T) {
+	tests := map[string]struct {
+		include  map[string]interface{}
+		exclude  map[string]interface{}
+		fields   map[string]string
+		expected bool
+	}{
+		"no filter": {
+			fields:   map[string]string{unitField: "nginx.service"},
+			expected: true,
+		},
+		"included unit pattern": {
+			include:  map[string]interface{}{"units": []string{"docker-*.scope"}},
+			fields:   map[string]string{unitField: "docker-1234.scope"},
+			expected: true,
+		},
+		"not included unit pattern": {
+			include:  map[string]interface{}{"units": []string{"docker-*.scope", "nginx"}},
+			fields:   map[string]string{unitField: "sshd.service"},
+			expected: false,
+		},
+		"included unit pattern and exact name": {
+			include:  map[string]interface{}{"units": []string{"docker-*.scope", "nginx"}},
+			fields:   map[string]string{unitField: "nginx.service"},
+			expected: true,
+		},
+		"missing field for included unit pattern": {
+			include:  map[string]interface{}{"units": []string{"docker-*"}},
+			fields:   map[string]string{transportField: "kernel"},
+			expected: false,
+		},
+		"excluded unit": {
+			exclude:  map[string]interface{}{"units": []string{"systemd-*", "cron"}},
+			fields:   map[string]string{unitField: "cron.service"},
+			expected: false,
+		},
+		"not excluded unit": {
+			exclude:  map[string]interface{}{"units": []string{"systemd-*", "cron"}},
+			fields:   map[string]string{unitField: "nginx.service"},
+			expected: true,
+		},
+		"excluded syslog identifier": {
+			exclude:  map[string]interface{}{"syslog_identifiers": []string{"CRON"}},
+			fields:   map[string]string{syslogIdentifierField: "CRON"},
+			expected: false,
+		},
+		"excluded priority": {
+			exclude:  map[string]interface{}{"priority": "info..debug"},
+			fields:   map[string]string{priorityField: "7"},
+			expected: false,
+		},
+		"not excluded priority": {
+			exclude:  map[string]interface{}{"priority": "info..debug"},
+			fields:   map[string]string{priorityField: "3"},
+			expected: true,
+		},
+		"excluded transport": {
+			exclude:  map[string]interface{}{"transports": []string{"kernel"}},
+			fields:   map[string]string{transportField: "kernel"},
+			expected: false,
+		},
+		"missing field for exclude": {
+			exclude:  map[string]interface{}{"units": []string{"*"}},
+			fields:   map[string]string{transportField: "kernel"},
+			expected: true,
+		},
+		"included by first or group": {
+			include:  sshdErrorsOrKernel,
+			fields:   map[string]string{transportField: "syslog", unitField: "sshd.service", priorityField: "3"},
+			expected: true,
+		},
+		"included by second or group": {
+			include:  sshdErrorsOrKernel,
+			fields:   map[string]string{transportField: "kernel", priorityField: "6"},
+			expected: true,
+		},
+		"not all conditions of or group match": {
+			include:  sshdErrorsOrKernel,
+			fields:   map[string]string{transportField: "syslog", unitField: "sshd.service", priorityField: "6"},
+			expected: false,
+		},
+		"or group matches but not the other conditions": {
+			include: map[string]interface{}{
+				"syslog_identifiers": []string{"ssh*"},
+				"or":                 sshdErrorsOrKernel["or"],
+			},
+			fields:   map[string]string{transportField: "kernel", syslogIdentifierField: "kernel"},
+			expected: false,
+		},
+		"or group with pattern": {
+			include: map[string]interface{}{
+				"or": []map[string]interface{}{{"units": []string{"docker-*.scope"}}},
+			},
+			fields:   map[string]string{unitField: "docker-1234.scope"},
+			expected: true,
+		},
+		"excluded by or group": {
+			exclude: map[string]interface{}{
+				"or": []map[string]interface{}{{"units": []string{"sshd"}, "priority": "info..debug"}},
+			},
+			fields:   map[string]string{unitField: "sshd.service", priorityField: "7"},
+			expected: false,
+		},
+		"not excluded by or group": {
+			exclude: map[string]interface{}{
+				"or": []map[string]interface{}{{"units": []string{"sshd"}, "priority": "info..debug"}},
+			},
+			fields:   map[string]string{unitField: "sshd.service", priorityField: "3"},
+			expected: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newFilter(mustFilter(t, test.include), mustFilter(t, test.exclude))
+			assert.Equal(t, test.expected, f.matchesEntry(test.fields))
+		})
+	}
+}
+
+var sshdErrorsOrKernel = map[string]interface{}{
+	"or": []map[string]interface{}{
+		{"units": []string{"sshd"}, "priority": "err"},
+		{"transports": []string{"kernel"}},
+	},
+}
+
+func mustFilter(t *testing.T, c map[string]interface{}) config.Filter {
+	var f config.Filter
+	if c == nil {
+		return f
+	}
+	if err := common.MustNewConfigFrom(c).Unpack(&f); err != nil {
+		t.Fatal(err)
+	}
+	return f
+}
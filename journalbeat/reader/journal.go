@@ -44,6 +44,7 @@ import (
 type Reader struct {
 	journal *sdjournal.Journal
 	config  Config
+	filter  *filter
 	done    chan struct{}
 	logger  *logp.Logger
 	backoff backoff.Backoff
@@ -95,9 +96,16 @@ func newReader(logger *logp.Logger, done chan struct{}, c Config, journal *sdjou
 		return nil, err
 	}
 
+	f := newFilter(c.Include, c.Exclude)
+	err = setupFilterMatches(journal, f.matches, f.disjunction(), len(c.Matches) > 0)
+	if err != nil {
+		return nil, err
+	}
+
 	r := &Reader{
 		journal: journal,
 		config:  c,
+		filter:  f,
 		done:    done,
 		logger:  logger,
 		backoff: backoff.NewExpBackoff(done, c.Backoff, c.MaxBackoff),
@@ -143,6 +151,64 @@ func setupMatches(j *sdjournal.Journal, matches []string) error {
 	return nil
 }
 
+// setupFilterMatches adds the match expressions of the include filter. The
+// expressions of a group are alternatives of the same field, which the journal
+// ORs, while different fields are ANDed. The groups of the disjunction are
+// ORed and ANDed with the other matches. If include_matches are configured,
+// the filter is ANDed with them.
+func setupFilterMatches(j *sdjournal.Journal, matches [][]string, disjunction [][][]string, conjunction bool) error {
+	if len(matches) == 0 && len(disjunction) == 0 {
+		return nil
+	}
+
+	if conjunction {
+		err := j.AddConjunction()
+		if err != nil {
+			return fmt.Errorf("error adding conjunction to journal: %v", err)
+		}
+	}
+
+	if err := addFilterMatches(j, matches); err != nil {
+		return err
+	}
+	if len(disjunction) == 0 {
+		return nil
+	}
+
+	if len(matches) > 0 {
+		err := j.AddConjunction()
+		if err != nil {
+			return fmt.Errorf("error adding conjunction to journal: %v", err)
+		}
+	}
+	for i, group := range disjunction {
+		if i > 0 {
+			err := j.AddDisjunction()
+			if err != nil {
+				return fmt.Errorf("error adding disjunction to journal: %v", err)
+			}
+		}
+		if err := addFilterMatches(j, group); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func addFilterMatches(j *sdjournal.Journal, matches [][]string) error {
+	for _, group := range matches {
+		for _, m := range group {
+			logp.Debug("journal", "Added filter expression: %s", m)
+
+			err := j.AddMatch(m)
+			if err != nil {
+				return fmt.Errorf("error adding match to journal %v", err)
+			}
+		}
+	}
+	return nil
+}
+
 // seek seeks to the position determined by the coniguration and cursor state.
 func (r *Reader) seek(cursor string) {
 	switch r.config.Seek {
@@ -216,9 +282,13 @@ func (r *Reader) Next() (*beat.Event, error) {
 		if err != nil {
 			return nil, err
 		}
-		event := r.toEvent(entry)
 		r.backoff.Reset()
 
+		if !r.filter.matchesEntry(entry.Fields) {
+			continue
+		}
+		event := r.toEvent(entry)
+
 		return event, nil
 	}
 }
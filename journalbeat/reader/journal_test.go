@@ -125,3 +125,27 @@ func TestSetupMatches(t *testing.T) {
 		}
 	}
 }
+
+func TestSetupFilterMatches(t *testing.T) {
+	journal, err := sdjournal.NewJournal()
+	if err != nil {
+		t.Fatalf("error while creating test journal: %v", err)
+	}
+	defer journal.Close()
+
+	err = setupMatches(journal, []string{"systemd.unit=nginx"})
+	if err != nil {
+		t.Fatalf("unexpected error adding matches: %v", err)
+	}
+
+	matches := [][]string{
+		{"_SYSTEMD_UNIT=nginx.service", "_SYSTEMD_UNIT=sshd.service"},
+		{"PRIORITY=0", "PRIORITY=1"},
+	}
+	disjunction := [][][]string{
+		{{"SYSLOG_IDENTIFIER=sudo"}, {"PRIORITY=5"}},
+		{{"_TRANSPORT=kernel"}},
+	}
+	err = setupFilterMatches(journal, matches, disjunction, true)
+	assert.NoError(t, err)
+}
@@ -44,6 +44,7 @@ func Start(cfg *common.Config) {
 		mux.HandleFunc("/state", stateHandler)
 		mux.HandleFunc("/stats", statsHandler)
 		mux.HandleFunc("/dataset", datasetHandler)
+		mux.HandleFunc("/metrics", metricsHandler)
 
 		url := config.Host + ":" + strconv.Itoa(config.Port)
 		logp.Info("Metrics endpoint listening on: %s", url)
@@ -94,6 +95,33 @@ func datasetHandler(w http.ResponseWriter, r *http.Request) {
 	print(w, data, r.URL)
 }
 
+// metricsHandler reports the stats and dataset metrics in the Prometheus text
+// exposition format.
+func metricsHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+
+	prefix := "beat"
+	if name, ok := monitoring.GetNamespace("info").GetRegistry().Get("beat").(*monitoring.String); ok && name.Get() != "" {
+		prefix = name.Get()
+	}
+
+	err := monitoring.WritePrometheus(w, monitoring.GetNamespace("stats").GetRegistry(), monitoring.Full,
+		monitoring.PrometheusOptions{
+			Prefix:          prefix,
+			LabelRegistries: map[string]string{"libbeat.outputs": "output"},
+		})
+	if err == nil {
+		err = monitoring.WritePrometheus(w, monitoring.GetNamespace("dataset").GetRegistry(), monitoring.Full,
+			monitoring.PrometheusOptions{
+				Prefix:          prefix + "_dataset",
+				LabelRegistries: map[string]string{"": "id"},
+			})
+	}
+	if err != nil {
+		logp.Err("Failed to write Prometheus metrics: %v", err)
+	}
+}
+
 func print(w http.ResponseWriter, data common.MapStr, u *url.URL) {
 	query := u.Query()
 	if _, ok := query["pretty"]; ok {
@@ -18,6 +18,7 @@
 package kubernetes
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/elastic/beats/libbeat/autodiscover/template"
@@ -29,6 +30,7 @@ type Config struct {
 	KubeConfig     string        `config:"kube_config"`
 	Host           string        `config:"host"`
 	Namespace      string        `config:"namespace"`
+	Resource       string        `config:"resource"`
 	SyncPeriod     time.Duration `config:"sync_period"`
 	CleanupTimeout time.Duration `config:"cleanup_timeout" validate:"positive"`
 
@@ -43,14 +45,22 @@ func defaultConfig() *Config {
 	return &Config{
 		SyncPeriod:     10 * time.Minute,
 		CleanupTimeout: 60 * time.Second,
+		Resource:       "pod",
 		Prefix:         "co.elastic",
 	}
 }
 
 // Validate ensures correctness of config
-func (c *Config) Validate() {
+func (c *Config) Validate() error {
 	// Make sure that prefix doesn't ends with a '.'
-	if c.Prefix[len(c.Prefix)-1] == '.' && c.Prefix != "." {
-		c.Prefix = c.Prefix[:len(c.Prefix)-2]
+	if c.Prefix != "" && c.Prefix[len(c.Prefix)-1] == '.' && c.Prefix != "." {
+		c.Prefix = c.Prefix[:len(c.Prefix)-1]
+	}
+
+	switch c.Resource {
+	case "pod", "node", "service":
+		return nil
+	default:
+		return fmt.Errorf("unsupported autodiscover resource %s", c.Resource)
 	}
 }
@@ -38,7 +38,7 @@ func init() {
 	autodiscover.Registry.AddProvider("kubernetes", AutodiscoverBuilder)
 }
 
-// Provider implements autodiscover provider for docker containers
+// Provider implements autodiscover provider for kubernetes pods, nodes and services
 type Provider struct {
 	config    *Config
 	bus       bus.Bus
@@ -72,13 +72,23 @@ func AutodiscoverBuilder(bus bus.Bus, uuid uuid.UUID, c *common.Config) (autodis
 
 	config.Host = kubernetes.DiscoverKubernetesNode(config.Host, kubernetes.IsInCluster(config.KubeConfig), client)
 
-	watcher, err := kubernetes.NewWatcher(client, &kubernetes.Pod{}, kubernetes.WatchOptions{
+	var resource kubernetes.Resource
+	switch config.Resource {
+	case "node":
+		resource = &kubernetes.Node{}
+	case "service":
+		resource = &kubernetes.Service{}
+	default:
+		resource = &kubernetes.Pod{}
+	}
+
+	watcher, err := kubernetes.NewWatcher(client, resource, kubernetes.WatchOptions{
 		SyncTimeout: config.SyncPeriod,
 		Node:        config.Host,
 		Namespace:   config.Namespace,
 	})
 	if err != nil {
-		return nil, fmt.Errorf("kubernetes: Couldn't create watcher for %T due to error %+v", &kubernetes.Pod{}, err)
+		return nil, fmt.Errorf("kubernetes: Couldn't create watcher for %T due to error %+v", resource, err)
 	}
 
 	mapper, err := template.NewConfigMapper(config.Templates)
@@ -110,17 +120,17 @@ func AutodiscoverBuilder(bus bus.Bus, uuid uuid.UUID, c *common.Config) (autodis
 
 	watcher.AddEventHandler(kubernetes.ResourceEventHandlerFuncs{
 		AddFunc: func(obj interface{}) {
-			p.logger.Debugf("Watcher Pod add: %+v", obj)
-			p.emit(obj.(*kubernetes.Pod), "start")
+			p.logger.Debugf("Watcher %s add: %+v", config.Resource, obj)
+			p.emitResource(obj, "start")
 		},
 		UpdateFunc: func(obj interface{}) {
-			p.logger.Debugf("Watcher Pod update: %+v", obj)
-			p.emit(obj.(*kubernetes.Pod), "stop")
-			p.emit(obj.(*kubernetes.Pod), "start")
+			p.logger.Debugf("Watcher %s update: %+v", config.Resource, obj)
+			p.emitResource(obj, "stop")
+			p.emitResource(obj, "start")
 		},
 		DeleteFunc: func(obj interface{}) {
-			p.logger.Debugf("Watcher Pod delete: %+v", obj)
-			time.AfterFunc(config.CleanupTimeout, func() { p.emit(obj.(*kubernetes.Pod), "stop") })
+			p.logger.Debugf("Watcher %s delete: %+v", config.Resource, obj)
+			time.AfterFunc(config.CleanupTimeout, func() { p.emitResource(obj, "stop") })
 		},
 	})
 
@@ -134,6 +144,20 @@ func (p *Provider) Start() {
 	}
 }
 
+// emitResource emits the events for the watched kubernetes object
+func (p *Provider) emitResource(obj interface{}, flag string) {
+	switch o := obj.(type) {
+	case *kubernetes.Pod:
+		p.emit(o, flag)
+	case *kubernetes.Node:
+		p.emitNode(o, flag)
+	case *kubernetes.Service:
+		p.emitService(o, flag)
+	default:
+		p.logger.Errorf("Unexpected kubernetes object %T", obj)
+	}
+}
+
 func (p *Provider) emit(pod *kubernetes.Pod, flag string) {
 	// Emit events for all containers
 	p.emitEvents(pod, flag, pod.Spec.Containers, pod.Status.ContainerStatuses)
@@ -191,11 +215,7 @@ func (p *Provider) emitEvents(pod *kubernetes.Pod, flag string, containers []kub
 		kubemeta["container"] = cmeta
 
 		// Pass annotations to all events so that it can be used in templating and by annotation builders.
-		annotations := common.MapStr{}
-		for k, v := range pod.GetObjectMeta().GetAnnotations() {
-			safemapstr.Put(annotations, k, v)
-		}
-		kubemeta["annotations"] = annotations
+		kubemeta["annotations"] = objectAnnotations(pod.GetObjectMeta().GetAnnotations())
 
 		// Without this check there would be overlapping configurations with and without ports.
 		if len(c.Ports) == 0 {
@@ -287,6 +307,15 @@ func (p *Provider) generateHints(event bus.Event) bus.Event {
 	return e
 }
 
+// objectAnnotations returns the annotations of a kubernetes object as a nested map
+func objectAnnotations(annotations map[string]string) common.MapStr {
+	result := common.MapStr{}
+	for k, v := range annotations {
+		safemapstr.Put(result, k, v)
+	}
+	return result
+}
+
 // Stop signals the stop channel to force the watch loop routine to stop.
 func (p *Provider) Stop() {
 	p.watcher.Stop()
@@ -0,0 +1,72 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kubernetes
+
+import (
+	"k8s.io/api/core/v1"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/bus"
+	"github.com/elastic/beats/libbeat/common/kubernetes"
+)
+
+func (p *Provider) emitNode(node *kubernetes.Node, flag string) {
+	host := nodeAddress(node)
+
+	// Nodes without an address can't be monitored, an update will arrive
+	// once it is known. If stopping, emit the event in any case to ensure cleanup.
+	if host == "" && flag != "stop" {
+		return
+	}
+
+	meta := p.metagen.NodeMetadata(node)
+
+	// Pass annotations to all events so that it can be used in templating and by annotation builders.
+	kubemeta := meta.Clone()
+	kubemeta["annotations"] = objectAnnotations(node.GetObjectMeta().GetAnnotations())
+
+	event := bus.Event{
+		"provider":   p.uuid,
+		"id":         string(node.GetObjectMeta().GetUID()),
+		flag:         true,
+		"host":       host,
+		"kubernetes": kubemeta,
+		"meta": common.MapStr{
+			"kubernetes": meta,
+		},
+	}
+
+	// Expose the kubelet port so it can be used in templates
+	if port := node.Status.DaemonEndpoints.KubeletEndpoint.Port; port != 0 {
+		event["port"] = port
+	}
+
+	p.publish(event)
+}
+
+// nodeAddress returns the address to reach the node, internal addresses are preferred.
+func nodeAddress(node *kubernetes.Node) string {
+	for _, addressType := range []v1.NodeAddressType{v1.NodeInternalIP, v1.NodeExternalIP, v1.NodeHostName} {
+		for _, address := range node.Status.Addresses {
+			if address.Type == addressType && address.Address != "" {
+				return address.Address
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,150 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kubernetes
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/elastic/beats/libbeat/autodiscover/template"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/bus"
+	"github.com/elastic/beats/libbeat/common/kubernetes"
+	"github.com/elastic/beats/libbeat/logp"
+)
+
+func TestEmitNodeEvent(t *testing.T) {
+	name := "node1"
+	uid := "005f3b90-4b9d-12f8-acf0-31020a840133"
+	UUID, err := uuid.NewV4()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		Message  string
+		Flag     string
+		Node     *kubernetes.Node
+		Expected bus.Event
+	}{
+		{
+			Message: "Test node start",
+			Flag:    "start",
+			Node: &kubernetes.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        name,
+					UID:         types.UID(uid),
+					Labels:      map[string]string{"role": "worker"},
+					Annotations: map[string]string{"co.elastic.metrics/module": "kubernetes"},
+				},
+				Status: v1.NodeStatus{
+					Addresses: []v1.NodeAddress{
+						{Type: v1.NodeHostName, Address: "node1.local"},
+						{Type: v1.NodeInternalIP, Address: "10.0.0.1"},
+					},
+					DaemonEndpoints: v1.NodeDaemonEndpoints{
+						KubeletEndpoint: v1.DaemonEndpoint{Port: 10250},
+					},
+				},
+			},
+			Expected: bus.Event{
+				"start":    true,
+				"host":     "10.0.0.1",
+				"port":     int32(10250),
+				"id":       uid,
+				"provider": UUID,
+				"kubernetes": common.MapStr{
+					"node": common.MapStr{
+						"name": name,
+						"uid":  uid,
+					},
+					"labels": common.MapStr{"role": "worker"},
+					"annotations": common.MapStr{
+						"co": common.MapStr{"elastic": common.MapStr{"metrics/module": "kubernetes"}},
+					},
+				},
+				"meta": common.MapStr{
+					"kubernetes": common.MapStr{
+						"node": common.MapStr{
+							"name": name,
+							"uid":  uid,
+						},
+						"labels": common.MapStr{"role": "worker"},
+					},
+				},
+				"config": []*common.Config{},
+			},
+		},
+		{
+			Message: "Test node without address",
+			Flag:    "start",
+			Node: &kubernetes.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: name,
+					UID:  types.UID(uid),
+				},
+			},
+			Expected: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Message, func(t *testing.T) {
+			p := newTestProvider(t, UUID)
+			listener := p.bus.Subscribe()
+
+			p.emitResource(test.Node, test.Flag)
+
+			select {
+			case event := <-listener.Events():
+				assert.Equal(t, test.Expected, event, test.Message)
+			case <-time.After(2 * time.Second):
+				if test.Expected != nil {
+					t.Fatal("Timeout while waiting for event")
+				}
+			}
+		})
+	}
+}
+
+func newTestProvider(t *testing.T, UUID uuid.UUID) *Provider {
+	mapper, err := template.NewConfigMapper(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	metaGen, err := kubernetes.NewMetaGenerator(common.NewConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &Provider{
+		config:    defaultConfig(),
+		bus:       bus.New("test"),
+		metagen:   metaGen,
+		templates: mapper,
+		uuid:      UUID,
+		logger:    logp.NewLogger("kubernetes"),
+	}
+}
@@ -0,0 +1,85 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kubernetes
+
+import (
+	"k8s.io/api/core/v1"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/bus"
+	"github.com/elastic/beats/libbeat/common/kubernetes"
+)
+
+func (p *Provider) emitService(svc *kubernetes.Service, flag string) {
+	host := serviceHost(svc)
+
+	// Headless services don't have a cluster IP to be probed. If stopping,
+	// emit the event in any case to ensure cleanup.
+	if host == "" && flag != "stop" {
+		return
+	}
+
+	meta := p.metagen.ServiceMetadata(svc)
+
+	// Pass annotations to all events so that it can be used in templating and by annotation builders.
+	kubemeta := meta.Clone()
+	kubemeta["annotations"] = objectAnnotations(svc.GetObjectMeta().GetAnnotations())
+
+	eventID := string(svc.GetObjectMeta().GetUID())
+
+	// Without this check there would be overlapping configurations with and without ports.
+	if len(svc.Spec.Ports) == 0 {
+		event := bus.Event{
+			"provider":   p.uuid,
+			"id":         eventID,
+			flag:         true,
+			"host":       host,
+			"kubernetes": kubemeta,
+			"meta": common.MapStr{
+				"kubernetes": meta,
+			},
+		}
+		p.publish(event)
+	}
+
+	for _, port := range svc.Spec.Ports {
+		event := bus.Event{
+			"provider":   p.uuid,
+			"id":         eventID,
+			flag:         true,
+			"host":       host,
+			"port":       port.Port,
+			"kubernetes": kubemeta,
+			"meta": common.MapStr{
+				"kubernetes": meta,
+			},
+		}
+		p.publish(event)
+	}
+}
+
+// serviceHost returns the address to reach the service
+func serviceHost(svc *kubernetes.Service) string {
+	if svc.Spec.Type == v1.ServiceTypeExternalName {
+		return svc.Spec.ExternalName
+	}
+	if svc.Spec.ClusterIP == v1.ClusterIPNone {
+		return ""
+	}
+	return svc.Spec.ClusterIP
+}
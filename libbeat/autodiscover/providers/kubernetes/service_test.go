@@ -0,0 +1,165 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kubernetes
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/bus"
+	"github.com/elastic/beats/libbeat/common/kubernetes"
+)
+
+func TestEmitServiceEvent(t *testing.T) {
+	name := "nginx"
+	namespace := "default"
+	uid := "005f3b90-4b9d-12f8-acf0-31020a840133"
+	UUID, err := uuid.NewV4()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	meta := common.MapStr{
+		"service": common.MapStr{
+			"name": name,
+			"uid":  uid,
+		},
+		"namespace": namespace,
+	}
+	kubemeta := meta.Clone()
+	kubemeta["annotations"] = common.MapStr{}
+
+	tests := []struct {
+		Message  string
+		Flag     string
+		Service  *kubernetes.Service
+		Expected []bus.Event
+	}{
+		{
+			Message: "Test service start",
+			Flag:    "start",
+			Service: &kubernetes.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					UID:       types.UID(uid),
+					Namespace: namespace,
+				},
+				Spec: v1.ServiceSpec{
+					ClusterIP: "10.96.0.10",
+					Ports: []v1.ServicePort{
+						{Name: "http", Port: 80},
+						{Name: "https", Port: 443},
+					},
+				},
+			},
+			Expected: []bus.Event{
+				{
+					"start":      true,
+					"host":       "10.96.0.10",
+					"port":       int32(80),
+					"id":         uid,
+					"provider":   UUID,
+					"kubernetes": kubemeta,
+					"meta":       common.MapStr{"kubernetes": meta},
+					"config":     []*common.Config{},
+				},
+				{
+					"start":      true,
+					"host":       "10.96.0.10",
+					"port":       int32(443),
+					"id":         uid,
+					"provider":   UUID,
+					"kubernetes": kubemeta,
+					"meta":       common.MapStr{"kubernetes": meta},
+					"config":     []*common.Config{},
+				},
+			},
+		},
+		{
+			Message: "Test external name service start",
+			Flag:    "start",
+			Service: &kubernetes.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					UID:       types.UID(uid),
+					Namespace: namespace,
+				},
+				Spec: v1.ServiceSpec{
+					Type:         v1.ServiceTypeExternalName,
+					ExternalName: "example.com",
+				},
+			},
+			Expected: []bus.Event{
+				{
+					"start":      true,
+					"host":       "example.com",
+					"id":         uid,
+					"provider":   UUID,
+					"kubernetes": kubemeta,
+					"meta":       common.MapStr{"kubernetes": meta},
+					"config":     []*common.Config{},
+				},
+			},
+		},
+		{
+			Message: "Test headless service start",
+			Flag:    "start",
+			Service: &kubernetes.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					UID:       types.UID(uid),
+					Namespace: namespace,
+				},
+				Spec: v1.ServiceSpec{
+					ClusterIP: v1.ClusterIPNone,
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Message, func(t *testing.T) {
+			p := newTestProvider(t, UUID)
+			listener := p.bus.Subscribe()
+
+			go p.emitResource(test.Service, test.Flag)
+
+			for _, expected := range test.Expected {
+				select {
+				case event := <-listener.Events():
+					assert.Equal(t, expected, event, test.Message)
+				case <-time.After(2 * time.Second):
+					t.Fatal("Timeout while waiting for event")
+				}
+			}
+
+			select {
+			case event := <-listener.Events():
+				t.Fatalf("Unexpected event %+v", event)
+			case <-time.After(100 * time.Millisecond):
+			}
+		})
+	}
+}
@@ -102,7 +102,8 @@ type beatConfig struct {
 	Keystore      *common.Config `config:"keystore"`
 
 	// output/publishing related configurations
-	Pipeline pipeline.Config `config:",inline"`
+	Pipeline pipeline.Config         `config:",inline"`
+	Outputs  []pipeline.OutputConfig `config:"outputs"`
 
 	// monitoring settings
 	MonitoringBeatConfig monitoring.BeatConfig `config:",inline"`
@@ -316,7 +317,7 @@ func (b *Beat) createBeater(bt beat.Creator) (beat.Beater, error) {
 	monitoring.NewBool(mgmt, "enabled").Set(b.ConfigManager.Enabled())
 
 	debugf("Initializing output plugins")
-	outputEnabled := b.Config.Output.IsSet() && b.Config.Output.Config().Enabled()
+	outputEnabled := len(b.Config.Outputs) > 0 || (b.Config.Output.IsSet() && b.Config.Output.Config().Enabled())
 	if !outputEnabled {
 		if b.ConfigManager.Enabled() {
 			logp.Info("Output is configured through Central Management")
@@ -327,28 +328,35 @@ func (b *Beat) createBeater(bt beat.Creator) (beat.Beater, error) {
 		}
 	}
 
-	pipeline, err := pipeline.Load(b.Info,
-		pipeline.Monitors{
-			Metrics:   reg,
-			Telemetry: monitoring.GetNamespace("state").GetRegistry(),
-			Logger:    logp.L().Named("publisher"),
-		},
-		b.Config.Pipeline,
-		b.processing,
-		b.makeOutputFactory(b.Config.Output),
-	)
+	monitors := pipeline.Monitors{
+		Metrics:   reg,
+		Telemetry: monitoring.GetNamespace("state").GetRegistry(),
+		Logger:    logp.L().Named("publisher"),
+	}
+
+	var publisher *pipeline.Pipeline
+	if len(b.Config.Outputs) > 0 {
+		var routes []pipeline.OutputRoute
+		routes, err = pipeline.MakeOutputRoutes(b.Config.Outputs, b.makeOutputFactory)
+		if err == nil {
+			publisher, err = pipeline.LoadRouted(b.Info, monitors, b.Config.Pipeline, b.processing, routes)
+		}
+	} else {
+		publisher, err = pipeline.Load(b.Info, monitors, b.Config.Pipeline, b.processing,
+			b.makeOutputFactory(b.Config.Output))
+	}
 
 	if err != nil {
 		return nil, fmt.Errorf("error initializing publisher: %+v", err)
 	}
 
-	reload.Register.MustRegister("output", b.makeOutputReloader(pipeline.OutputReloader()))
+	reload.Register.MustRegister("output", b.makeOutputReloader(publisher.OutputReloader()))
 
 	// TODO: some beats race on shutdown with publisher.Stop -> do not call Stop yet,
 	//       but refine publisher to disconnect clients on stop automatically
 	// defer pipeline.Close()
 
-	b.Publisher = pipeline
+	b.Publisher = publisher
 	beater, err := bt(&b.Beat, sub)
 	if err != nil {
 		return nil, err
@@ -592,6 +600,16 @@ func (b *Beat) configure(settings Settings) error {
 		return fmt.Errorf("error unpacking config data: %v", err)
 	}
 
+	if len(b.Config.Outputs) > 0 {
+		if b.Config.Output.IsSet() {
+			return errors.New("'output' and 'outputs' can not be configured at the same time")
+		}
+
+		// Index management and other setup tasks use the first Elasticsearch
+		// output, if events are routed to multiple outputs.
+		b.Config.Output = primaryOutput(b.Config.Outputs)
+	}
+
 	b.Beat.Config = &b.Config.BeatConfig
 
 	if name := b.Config.Name; name != "" {
@@ -809,13 +827,25 @@ func (b *Beat) makeOutputReloader(outReloader pipeline.OutputReloader) reload.Re
 
 func (b *Beat) makeOutputFactory(
 	cfg common.ConfigNamespace,
-) func(outputs.Observer) (string, outputs.Group, error) {
+) pipeline.OutputFactory {
 	return func(outStats outputs.Observer) (string, outputs.Group, error) {
 		out, err := b.createOutput(outStats, cfg)
 		return cfg.Name(), out, err
 	}
 }
 
+// primaryOutput selects the output used for setup tasks if events are routed to
+// multiple outputs: the first Elasticsearch output, or the first output if none
+// of the outputs is an Elasticsearch output.
+func primaryOutput(configs []pipeline.OutputConfig) common.ConfigNamespace {
+	for _, config := range configs {
+		if config.Output.Name() == "elasticsearch" {
+			return config.Output
+		}
+	}
+	return configs[0].Output
+}
+
 func (b *Beat) createOutput(stats outputs.Observer, cfg common.ConfigNamespace) (outputs.Group, error) {
 	if !cfg.IsSet() {
 		return outputs.Group{}, nil
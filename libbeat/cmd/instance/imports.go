@@ -33,9 +33,13 @@ import (
 	_ "github.com/elastic/beats/libbeat/processors/add_process_metadata"
 	_ "github.com/elastic/beats/libbeat/processors/communityid"
 	_ "github.com/elastic/beats/libbeat/processors/convert"
+	_ "github.com/elastic/beats/libbeat/processors/decode_cef"
+	_ "github.com/elastic/beats/libbeat/processors/decode_logfmt"
 	_ "github.com/elastic/beats/libbeat/processors/dissect"
 	_ "github.com/elastic/beats/libbeat/processors/dns"
 	_ "github.com/elastic/beats/libbeat/processors/extract_array"
+	_ "github.com/elastic/beats/libbeat/processors/fingerprint"
+	_ "github.com/elastic/beats/libbeat/processors/ratelimit"
 	_ "github.com/elastic/beats/libbeat/processors/registered_domain"
 	_ "github.com/elastic/beats/libbeat/publisher/includes" // Register publisher pipeline modules
 )
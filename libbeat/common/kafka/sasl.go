@@ -0,0 +1,104 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kafka
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Shopify/sarama"
+)
+
+// SaslConfig configures the SASL mechanism used to authenticate with
+// username and password.
+type SaslConfig struct {
+	SaslMechanism string `config:"mechanism"`
+}
+
+const (
+	saslTypePlaintext   = sarama.SASLTypePlaintext
+	saslTypeSCRAMSHA256 = sarama.SASLTypeSCRAMSHA256
+	saslTypeSCRAMSHA512 = sarama.SASLTypeSCRAMSHA512
+)
+
+// saslMinVersions contains the minimum kafka version supporting each mechanism.
+// SASL/PLAIN requires the SASL handshake introduced in 0.10.0, SCRAM is
+// authenticated with SaslAuthenticate requests introduced in 1.0.0.
+var saslMinVersions = map[string]sarama.KafkaVersion{
+	saslTypePlaintext:   sarama.V0_10_0_0,
+	saslTypeSCRAMSHA256: sarama.V1_0_0_0,
+	saslTypeSCRAMSHA512: sarama.V1_0_0_0,
+}
+
+// Mechanism returns the normalized name of the configured mechanism.
+// SASL/PLAIN is used if no mechanism is configured.
+func (c *SaslConfig) Mechanism() string {
+	if c.SaslMechanism == "" {
+		return saslTypePlaintext
+	}
+	return strings.ToUpper(c.SaslMechanism)
+}
+
+// Validate checks that the configured mechanism is supported.
+func (c *SaslConfig) Validate() error {
+	if _, ok := saslMinVersions[c.Mechanism()]; !ok {
+		return fmt.Errorf("unknown/unsupported sasl mechanism '%v', expected one of %v, %v or %v",
+			c.SaslMechanism, saslTypePlaintext, saslTypeSCRAMSHA256, saslTypeSCRAMSHA512)
+	}
+	return nil
+}
+
+// ValidateVersion checks that the configured mechanism can be used with
+// the given kafka version.
+func (c *SaslConfig) ValidateVersion(v Version) error {
+	version, ok := v.Get()
+	if !ok {
+		return fmt.Errorf("unknown/unsupported kafka version '%v'", v)
+	}
+
+	minVersion, ok := saslMinVersions[c.Mechanism()]
+	if !ok {
+		return c.Validate()
+	}
+	if !version.IsAtLeast(minVersion) {
+		return fmt.Errorf("sasl mechanism '%v' requires kafka version %v or newer, but version is '%v'",
+			c.Mechanism(), minVersion, v)
+	}
+	return nil
+}
+
+// ConfigureSarama enables SASL authentication with the given credentials
+// and the configured mechanism.
+func (c *SaslConfig) ConfigureSarama(config *sarama.Config, username, password string) {
+	config.Net.SASL.Enable = true
+	config.Net.SASL.User = username
+	config.Net.SASL.Password = password
+
+	mechanism := c.Mechanism()
+	config.Net.SASL.Mechanism = sarama.SASLMechanism(mechanism)
+	switch mechanism {
+	case saslTypeSCRAMSHA256:
+		config.Net.SASL.SCRAMClientGeneratorFunc = func() sarama.SCRAMClient {
+			return &scramClient{HashGeneratorFcn: scramSHA256}
+		}
+	case saslTypeSCRAMSHA512:
+		config.Net.SASL.SCRAMClientGeneratorFunc = func() sarama.SCRAMClient {
+			return &scramClient{HashGeneratorFcn: scramSHA512}
+		}
+	}
+}
@@ -26,7 +26,7 @@ import (
 	"github.com/elastic/beats/libbeat/common/safemapstr"
 )
 
-// MetaGenerator builds metadata objects for pods and containers
+// MetaGenerator builds metadata objects for pods, containers, nodes and services
 type MetaGenerator interface {
 	// ResourceMetadata generates metadata for the given kubernetes object taking to account certain filters
 	ResourceMetadata(obj Resource) common.MapStr
@@ -36,6 +36,12 @@ type MetaGenerator interface {
 
 	// Containermetadata generates metadata for the given container of a pod
 	ContainerMetadata(pod *Pod, container string) common.MapStr
+
+	// NodeMetadata generates metadata for the given node taking to account certain filters
+	NodeMetadata(node *Node) common.MapStr
+
+	// ServiceMetadata generates metadata for the given service taking to account certain filters
+	ServiceMetadata(svc *Service) common.MapStr
 }
 
 // MetaGeneratorConfig settings
@@ -151,6 +157,26 @@ func (g *metaGenerator) ContainerMetadata(pod *Pod, container string) common.Map
 	return podMeta
 }
 
+// NodeMetadata generates metadata for the given node taking to account certain filters
+func (g *metaGenerator) NodeMetadata(node *Node) common.MapStr {
+	nodeMeta := g.ResourceMetadata(node)
+
+	safemapstr.Put(nodeMeta, "node.uid", string(node.GetObjectMeta().GetUID()))
+	safemapstr.Put(nodeMeta, "node.name", node.GetObjectMeta().GetName())
+
+	return nodeMeta
+}
+
+// ServiceMetadata generates metadata for the given service taking to account certain filters
+func (g *metaGenerator) ServiceMetadata(svc *Service) common.MapStr {
+	svcMeta := g.ResourceMetadata(svc)
+
+	safemapstr.Put(svcMeta, "service.uid", string(svc.GetObjectMeta().GetUID()))
+	safemapstr.Put(svcMeta, "service.name", svc.GetObjectMeta().GetName())
+
+	return svcMeta
+}
+
 func generateMapSubset(input map[string]string, keys []string, dedot bool) common.MapStr {
 	output := common.MapStr{}
 	if input == nil {
@@ -266,3 +266,56 @@ func TestPodMetadataDeDot(t *testing.T) {
 		assert.Equal(t, metaGen.PodMetadata(test.pod), test.meta)
 	}
 }
+
+func TestNodeMetadata(t *testing.T) {
+	UID := "005f3b90-4b9d-12f8-acf0-31020a840133"
+	node := &Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "node1",
+			UID:         types.UID(UID),
+			Labels:      map[string]string{"kubernetes.io/role": "worker"},
+			Annotations: map[string]string{"b": "baz"},
+		},
+	}
+
+	config, err := common.NewConfigFrom(map[string]interface{}{
+		"include_annotations": []string{"b"},
+	})
+	assert.NoError(t, err)
+
+	metaGen, err := NewMetaGenerator(config)
+	assert.NoError(t, err)
+
+	assert.Equal(t, common.MapStr{
+		"node": common.MapStr{
+			"name": "node1",
+			"uid":  UID,
+		},
+		"labels":      common.MapStr{"kubernetes_io/role": "worker"},
+		"annotations": common.MapStr{"b": "baz"},
+	}, metaGen.NodeMetadata(node))
+}
+
+func TestServiceMetadata(t *testing.T) {
+	UID := "005f3b90-4b9d-12f8-acf0-31020a840133"
+	svc := &Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "nginx",
+			UID:       types.UID(UID),
+			Namespace: "default",
+			Labels:    map[string]string{"app": "nginx"},
+		},
+	}
+
+	metaGen, err := NewMetaGenerator(common.NewConfig())
+	assert.NoError(t, err)
+
+	assert.Equal(t, common.MapStr{
+		"service": common.MapStr{
+			"name": "nginx",
+			"uid":  UID,
+		},
+		"namespace": "default",
+		"labels":    common.MapStr{"app": "nginx"},
+	}, metaGen.ServiceMetadata(svc))
+}
@@ -46,6 +46,9 @@ type PodStatus = v1.PodStatus
 // Node data
 type Node = v1.Node
 
+// Service data
+type Service = v1.Service
+
 // Container data
 type Container = v1.Container
 
@@ -61,7 +61,8 @@ type Watcher interface {
 type WatchOptions struct {
 	// SyncTimeout is a timeout for listing historical resources
 	SyncTimeout time.Duration
-	// Node is used for filtering watched resource to given node, use "" for all nodes
+	// Node is used for filtering watched resource to given node, use "" for all nodes.
+	// When watching nodes, only the node with this name is watched.
 	Node string
 	// Namespace is used for filtering watched resource to given namespace, use "" for all namespaces
 	Namespace string
@@ -89,6 +90,12 @@ func tweakOptions(options *metav1.ListOptions, opt WatchOptions) {
 	}
 }
 
+func tweakNodeOptions(options *metav1.ListOptions, opt WatchOptions) {
+	if opt.Node != "" {
+		options.FieldSelector = "metadata.name=" + opt.Node
+	}
+}
+
 // NewWatcher initializes the watcher client to provide a events handler for
 // resource from the cluster (filtered to the given node)
 func NewWatcher(client kubernetes.Interface, resource Resource, opts WatchOptions) (Watcher, error) {
@@ -129,14 +136,28 @@ func NewWatcher(client kubernetes.Interface, resource Resource, opts WatchOption
 		n := client.CoreV1().Nodes()
 		listwatch = &cache.ListWatch{
 			ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+				tweakNodeOptions(&options, opts)
 				return n.List(options)
 			},
 			WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+				tweakNodeOptions(&options, opts)
 				return n.Watch(options)
 			},
 		}
 
 		objType = "node"
+	case *Service:
+		svc := client.CoreV1().Services(opts.Namespace)
+		listwatch = &cache.ListWatch{
+			ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+				return svc.List(options)
+			},
+			WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+				return svc.Watch(options)
+			},
+		}
+
+		objType = "service"
 	case *Deployment:
 		d := client.AppsV1().Deployments(opts.Namespace)
 		listwatch = &cache.ListWatch{
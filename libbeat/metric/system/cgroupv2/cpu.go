@@ -0,0 +1,73 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package cgroupv2
+
+import (
+	"fmt"
+	"strings"
+)
+
+// CPUStats contains the data of the cpu controller, read from cpu.stat and
+// cpu.max. Times are in microseconds as reported by the kernel.
+type CPUStats struct {
+	UsageMicros  uint64 `json:"usage_usec"`
+	UserMicros   uint64 `json:"user_usec"`
+	SystemMicros uint64 `json:"system_usec"`
+
+	// Bandwidth throttling, only reported when the cpu controller is enabled.
+	Periods          uint64 `json:"nr_periods"`
+	ThrottledPeriods uint64 `json:"nr_throttled"`
+	ThrottledMicros  uint64 `json:"throttled_usec"`
+
+	// Bandwidth limit from cpu.max. A quota of 0 means unlimited.
+	QuotaMicros  uint64 `json:"quota_usec"`
+	PeriodMicros uint64 `json:"period_usec"`
+}
+
+func (cpu *CPUStats) get(path string) (bool, error) {
+	stat, err := parseKeyValueFile(path, "cpu.stat")
+	if err != nil || stat == nil {
+		return false, err
+	}
+
+	cpu.UsageMicros = stat["usage_usec"]
+	cpu.UserMicros = stat["user_usec"]
+	cpu.SystemMicros = stat["system_usec"]
+	cpu.Periods = stat["nr_periods"]
+	cpu.ThrottledPeriods = stat["nr_throttled"]
+	cpu.ThrottledMicros = stat["throttled_usec"]
+
+	// Format: $MAX $PERIOD
+	// Example: max 100000
+	max, found, err := readFile(path, "cpu.max")
+	if err != nil || !found {
+		return true, err
+	}
+	fields := strings.Fields(max)
+	if len(fields) != 2 {
+		return true, fmt.Errorf("invalid cpu.max content '%v'", max)
+	}
+	if cpu.QuotaMicros, err = parseLimit(fields[0]); err != nil {
+		return true, fmt.Errorf("invalid cpu.max quota '%v': %v", fields[0], err)
+	}
+	if cpu.PeriodMicros, err = parseLimit(fields[1]); err != nil {
+		return true, fmt.Errorf("invalid cpu.max period '%v': %v", fields[1], err)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,105 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package cgroupv2
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// IOStats contains the data of the io controller, read from io.stat.
+type IOStats struct {
+	Devices []IODevice `json:"devices,omitempty"`
+	Total   IOCounters `json:"total"`
+}
+
+// IODevice contains the io counters of a single block device.
+type IODevice struct {
+	Major uint64 `json:"major"`
+	Minor uint64 `json:"minor"`
+	IOCounters
+}
+
+// IOCounters contains the bytes and operations read and written.
+type IOCounters struct {
+	ReadBytes  uint64 `json:"rbytes"`
+	WriteBytes uint64 `json:"wbytes"`
+	ReadIOs    uint64 `json:"rios"`
+	WriteIOs   uint64 `json:"wios"`
+}
+
+func (io *IOStats) get(path string) (bool, error) {
+	content, err := ioutil.ReadFile(filepath.Join(path, "io.stat"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	sc := bufio.NewScanner(bytes.NewReader(content))
+	for sc.Scan() {
+		// Format: $MAJ:$MIN key=value...
+		// Example: 8:0 rbytes=90112 wbytes=4096 rios=22 wios=1 dbytes=0 dios=0
+		fields := strings.Fields(sc.Text())
+		if len(fields) == 0 {
+			continue
+		}
+
+		var dev IODevice
+		if _, err := fmt.Sscanf(fields[0], "%d:%d", &dev.Major, &dev.Minor); err != nil {
+			return true, fmt.Errorf("invalid io.stat device '%v': %v", fields[0], err)
+		}
+
+		for _, field := range fields[1:] {
+			parts := strings.SplitN(field, "=", 2)
+			if len(parts) != 2 {
+				return true, ErrInvalidFormat
+			}
+			value, err := strconv.ParseUint(parts[1], 10, 64)
+			if err != nil {
+				return true, fmt.Errorf("unable to convert param value (%q) to uint64: %v", parts[1], err)
+			}
+
+			switch parts[0] {
+			case "rbytes":
+				dev.ReadBytes = value
+			case "wbytes":
+				dev.WriteBytes = value
+			case "rios":
+				dev.ReadIOs = value
+			case "wios":
+				dev.WriteIOs = value
+			}
+		}
+
+		io.Devices = append(io.Devices, dev)
+		io.Total.ReadBytes += dev.ReadBytes
+		io.Total.WriteBytes += dev.WriteBytes
+		io.Total.ReadIOs += dev.ReadIOs
+		io.Total.WriteIOs += dev.WriteIOs
+	}
+
+	return true, sc.Err()
+}
@@ -0,0 +1,61 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package cgroupv2
+
+import "fmt"
+
+// MemoryStats contains the data of the memory controller.
+type MemoryStats struct {
+	// Current memory usage in bytes, from memory.current.
+	Current uint64 `json:"current"`
+	// Memory usage hard limit in bytes, from memory.max. 0 means unlimited.
+	Max uint64 `json:"max"`
+	// Statistics from memory.stat (e.g. anon, file, pgfault).
+	Stats map[string]uint64 `json:"stats,omitempty"`
+	// Event counters from memory.events (e.g. max, oom, oom_kill).
+	Events map[string]uint64 `json:"events,omitempty"`
+}
+
+func (mem *MemoryStats) get(path string) (bool, error) {
+	current, found, err := readFile(path, "memory.current")
+	if err != nil || !found {
+		return false, err
+	}
+	if mem.Current, err = parseLimit(current); err != nil {
+		return true, fmt.Errorf("invalid memory.current '%v': %v", current, err)
+	}
+
+	max, found, err := readFile(path, "memory.max")
+	if err != nil {
+		return true, err
+	}
+	if found {
+		if mem.Max, err = parseLimit(max); err != nil {
+			return true, fmt.Errorf("invalid memory.max '%v': %v", max, err)
+		}
+	}
+
+	if mem.Stats, err = parseKeyValueFile(path, "memory.stat"); err != nil {
+		return true, err
+	}
+	if mem.Events, err = parseKeyValueFile(path, "memory.events"); err != nil {
+		return true, err
+	}
+
+	return true, nil
+}
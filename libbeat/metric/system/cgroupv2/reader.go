@@ -0,0 +1,122 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package cgroupv2
+
+import (
+	"path/filepath"
+)
+
+// Stats contains the metrics of a cgroup v2. Controllers that are not enabled
+// for the cgroup are nil.
+type Stats struct {
+	Metadata
+	CPU    *CPUStats    `json:"cpu"`
+	Memory *MemoryStats `json:"memory"`
+	IO     *IOStats     `json:"io"`
+}
+
+// Metadata contains metadata associated with a cgroup.
+type Metadata struct {
+	ID   string `json:"id,omitempty"`   // ID of the cgroup.
+	Path string `json:"path,omitempty"` // Path to the cgroup relative to the hierarchy's mountpoint.
+}
+
+// Reader reads cgroup v2 metrics and limits.
+type Reader struct {
+	// Mountpoint of the root filesystem. Defaults to / if not set. This can be
+	// useful for example if you mount / as /rootfs inside of a container.
+	rootfsMountpoint  string
+	ignoreRootCgroups bool   // Ignore a cgroup when its path is "/".
+	mountpoint        string // Mountpoint of the cgroup v2 hierarchy.
+}
+
+// NewReader creates and returns a new Reader. ErrUnifiedMissing is returned
+// if the host does not have a cgroup v2 hierarchy.
+func NewReader(rootfsMountpoint string, ignoreRootCgroups bool) (*Reader, error) {
+	if rootfsMountpoint == "" {
+		rootfsMountpoint = "/"
+	}
+
+	mountpoint, err := UnifiedMountpoint(rootfsMountpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Reader{
+		rootfsMountpoint:  rootfsMountpoint,
+		ignoreRootCgroups: ignoreRootCgroups,
+		mountpoint:        mountpoint,
+	}, nil
+}
+
+// GetStatsForProcess returns cgroup v2 metrics for the cgroup of the given
+// process. nil is returned if the process is not part of a cgroup v2 or if
+// no metrics are available.
+func (r *Reader) GetStatsForProcess(pid int) (*Stats, error) {
+	path, err := ProcessCgroupPath(r.rootfsMountpoint, pid)
+	if err != nil {
+		return nil, err
+	}
+
+	if path == "" || (path == "/" && r.ignoreRootCgroups) {
+		return nil, nil
+	}
+
+	return r.GetStatsForPath(path)
+}
+
+// GetStatsForPath returns cgroup v2 metrics for the cgroup at the given path,
+// relative to the mountpoint of the hierarchy. nil is returned if no metrics
+// are available.
+func (r *Reader) GetStatsForPath(path string) (*Stats, error) {
+	fullPath := filepath.Join(r.mountpoint, path)
+	stats := Stats{
+		Metadata: Metadata{
+			ID:   filepath.Base(path),
+			Path: path,
+		},
+	}
+
+	cpu := &CPUStats{}
+	if found, err := cpu.get(fullPath); err != nil {
+		return nil, err
+	} else if found {
+		stats.CPU = cpu
+	}
+
+	memory := &MemoryStats{}
+	if found, err := memory.get(fullPath); err != nil {
+		return nil, err
+	} else if found {
+		stats.Memory = memory
+	}
+
+	io := &IOStats{}
+	if found, err := io.get(fullPath); err != nil {
+		return nil, err
+	} else if found {
+		stats.IO = io
+	}
+
+	// Return nil if no metrics were collected.
+	if stats.CPU == nil && stats.Memory == nil && stats.IO == nil {
+		return nil, nil
+	}
+
+	return &stats, nil
+}
@@ -0,0 +1,138 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package cgroupv2
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	dockerRootfs = "testdata/docker"
+	dockerPath   = "/system.slice/docker-b29faf21b7ef.scope"
+)
+
+func TestUnifiedMountpoint(t *testing.T) {
+	mountpoint, err := UnifiedMountpoint(dockerRootfs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, filepath.Join(dockerRootfs, "sys/fs/cgroup"), mountpoint)
+
+	_, err = UnifiedMountpoint("testdata/missing")
+	assert.Equal(t, ErrUnifiedMissing, err)
+}
+
+func TestProcessCgroupPath(t *testing.T) {
+	path, err := ProcessCgroupPath(dockerRootfs, 1000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, dockerPath, path)
+
+	// Processes only in v1 hierarchies have no v2 path.
+	path, err = ProcessCgroupPath(dockerRootfs, 2000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Empty(t, path)
+}
+
+func TestReaderGetStatsForProcess(t *testing.T) {
+	reader, err := NewReader(dockerRootfs, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stats, err := reader.GetStatsForProcess(1000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats == nil {
+		t.Fatal("no cgroup stats found")
+	}
+
+	assert.Equal(t, "docker-b29faf21b7ef.scope", stats.ID)
+	assert.Equal(t, dockerPath, stats.Path)
+
+	if assert.NotNil(t, stats.CPU) {
+		assert.Equal(t, CPUStats{
+			UsageMicros:      3553484,
+			UserMicros:       2112047,
+			SystemMicros:     1441437,
+			Periods:          120,
+			ThrottledPeriods: 12,
+			ThrottledMicros:  96044,
+			QuotaMicros:      50000,
+			PeriodMicros:     100000,
+		}, *stats.CPU)
+	}
+
+	if assert.NotNil(t, stats.Memory) {
+		assert.EqualValues(t, 32911360, stats.Memory.Current)
+		assert.EqualValues(t, 0, stats.Memory.Max)
+		assert.EqualValues(t, 20172800, stats.Memory.Stats["anon"])
+		assert.EqualValues(t, 66, stats.Memory.Stats["pgmajfault"])
+		assert.EqualValues(t, 3, stats.Memory.Events["max"])
+	}
+
+	if assert.NotNil(t, stats.IO) {
+		assert.Len(t, stats.IO.Devices, 2)
+		assert.Equal(t, IODevice{
+			Major: 253,
+			Minor: 0,
+			IOCounters: IOCounters{
+				ReadBytes:  1048576,
+				WriteBytes: 8192,
+				ReadIOs:    8,
+				WriteIOs:   2,
+			},
+		}, stats.IO.Devices[1])
+		assert.Equal(t, IOCounters{
+			ReadBytes:  12455936,
+			WriteBytes: 12288,
+			ReadIOs:    320,
+			WriteIOs:   3,
+		}, stats.IO.Total)
+	}
+}
+
+func TestReaderIgnoreRootCgroup(t *testing.T) {
+	reader, err := NewReader(dockerRootfs, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stats, err := reader.GetStatsForProcess(1)
+	assert.NoError(t, err)
+	assert.Nil(t, stats)
+}
+
+func TestReaderMissingControllers(t *testing.T) {
+	reader, err := NewReader(dockerRootfs, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The root cgroup of the fixture has no controller files.
+	stats, err := reader.GetStatsForProcess(1)
+	assert.NoError(t, err)
+	assert.Nil(t, stats)
+}
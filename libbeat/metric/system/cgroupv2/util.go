@@ -0,0 +1,194 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package cgroupv2
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrUnifiedMissing indicates that no cgroup v2 hierarchy could be found
+	// under the rootfs mountpoint.
+	ErrUnifiedMissing = errors.New("cgroup v2 hierarchy not found")
+
+	// ErrInvalidFormat indicates a malformed key/value pair on a line.
+	ErrInvalidFormat = errors.New("error invalid key/value format")
+)
+
+// unlimited is the value written by the kernel in limit files (e.g.
+// memory.max) when no limit is set.
+const unlimited = "max"
+
+// UnifiedMountpoint returns the mountpoint of the cgroup v2 hierarchy. The
+// mount table in /proc/self/mountinfo is searched first for a cgroup2
+// filesystem mounted below rootfsMountpoint. If none is found then the default
+// location, /sys/fs/cgroup, is used if it contains a cgroup v2 hierarchy.
+func UnifiedMountpoint(rootfsMountpoint string) (string, error) {
+	if rootfsMountpoint == "" {
+		rootfsMountpoint = "/"
+	}
+
+	mountpoint, err := findUnifiedMount(rootfsMountpoint)
+	if err != nil {
+		return "", err
+	}
+	if mountpoint != "" {
+		return mountpoint, nil
+	}
+
+	mountpoint = filepath.Join(rootfsMountpoint, "sys", "fs", "cgroup")
+	if _, err := os.Stat(filepath.Join(mountpoint, "cgroup.controllers")); err == nil {
+		return mountpoint, nil
+	}
+	return "", ErrUnifiedMissing
+}
+
+func findUnifiedMount(rootfsMountpoint string) (string, error) {
+	mountinfo, err := os.Open(filepath.Join(rootfsMountpoint, "proc", "self", "mountinfo"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	defer mountinfo.Close()
+
+	sc := bufio.NewScanner(mountinfo)
+	for sc.Scan() {
+		// https://www.kernel.org/doc/Documentation/filesystems/proc.txt
+		// Example:
+		// 30 23 0:26 / /sys/fs/cgroup rw,nosuid,nodev,noexec,relatime shared:4 - cgroup2 cgroup2 rw,nsdelegate
+		fields := strings.Fields(sc.Text())
+		if len(fields) < 10 {
+			continue
+		}
+
+		var separatorIndex int
+		for i, value := range fields {
+			if value == "-" {
+				separatorIndex = i
+				break
+			}
+		}
+		if separatorIndex == 0 || separatorIndex+1 >= len(fields) {
+			continue
+		}
+
+		mountpoint, fsType := fields[4], fields[separatorIndex+1]
+		if fsType == "cgroup2" && strings.HasPrefix(mountpoint, rootfsMountpoint) {
+			return mountpoint, nil
+		}
+	}
+
+	return "", sc.Err()
+}
+
+// ProcessCgroupPath returns the path of the cgroup v2 to which a process
+// belongs, relative to the mountpoint of the hierarchy. An empty path is
+// returned if the process does not belong to a cgroup v2.
+func ProcessCgroupPath(rootfsMountpoint string, pid int) (string, error) {
+	if rootfsMountpoint == "" {
+		rootfsMountpoint = "/"
+	}
+
+	cgroup, err := os.Open(filepath.Join(rootfsMountpoint, "proc", strconv.Itoa(pid), "cgroup"))
+	if err != nil {
+		return "", err
+	}
+	defer cgroup.Close()
+
+	sc := bufio.NewScanner(cgroup)
+	for sc.Scan() {
+		// http://man7.org/linux/man-pages/man7/cgroups.7.html
+		// The cgroup v2 entry always has hierarchy ID 0 and an empty
+		// controller list.
+		// Example:
+		// 0::/system.slice/docker-b29faf21b7ef.scope
+		line := sc.Text()
+		if strings.HasPrefix(line, "0::") {
+			return line[3:], nil
+		}
+	}
+
+	return "", sc.Err()
+}
+
+// parseKeyValueFile parses files made of "key value" lines, like cpu.stat or
+// memory.stat. A nil map is returned if the file does not exist.
+func parseKeyValueFile(path ...string) (map[string]uint64, error) {
+	content, err := ioutil.ReadFile(filepath.Join(path...))
+	if err != nil {
+		// Files only exist for the controllers enabled in the cgroup.
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	values := map[string]uint64{}
+	sc := bufio.NewScanner(bytes.NewReader(content))
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" {
+			continue
+		}
+
+		parts := strings.Fields(line)
+		if len(parts) != 2 {
+			return nil, ErrInvalidFormat
+		}
+
+		value, err := strconv.ParseUint(parts[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("unable to convert param value (%q) to uint64: %v", parts[1], err)
+		}
+		values[parts[0]] = value
+	}
+
+	return values, sc.Err()
+}
+
+// parseLimit parses a single limit value that is either a number or "max".
+// Unlimited values are returned as 0.
+func parseLimit(value string) (uint64, error) {
+	value = strings.TrimSpace(value)
+	if value == unlimited {
+		return 0, nil
+	}
+	return strconv.ParseUint(value, 10, 64)
+}
+
+// readFile returns the trimmed content of a file and whether it exists.
+func readFile(path ...string) (string, bool, error) {
+	content, err := ioutil.ReadFile(filepath.Join(path...))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", false, nil
+		}
+		return "", false, err
+	}
+	return strings.TrimSpace(string(content)), true, nil
+}
@@ -23,8 +23,20 @@ type Option func(options) options
 type options struct {
 	publishExpvar bool
 	mode          Mode
+	metricType    metricType
 }
 
+// metricType tells exporters how the value of a variable evolves over time.
+type metricType uint8
+
+const (
+	// gaugeMetric values can go up and down. Variables are gauges by default.
+	gaugeMetric metricType = iota
+
+	// counterMetric values only increase, until the process is restarted.
+	counterMetric
+)
+
 var defaultOptions = options{
 	publishExpvar: false,
 	mode:          Full,
@@ -53,6 +65,21 @@ func DoNotReport(o options) options {
 	return o
 }
 
+// Counter marks a variable as monotonically increasing counter. Counters are
+// exported with the counter type to Prometheus. The option has no effect on
+// registries.
+func Counter(o options) options {
+	o.metricType = counterMetric
+	return o
+}
+
+// Gauge marks a variable as gauge, whose value can go up and down. Variables
+// not marked as counter are gauges.
+func Gauge(o options) options {
+	o.metricType = gaugeMetric
+	return o
+}
+
 func varOpts(regOpts *options, opts []Option) *options {
 	if regOpts != nil && len(opts) == 0 {
 		return regOpts
@@ -78,6 +105,18 @@ func applyOpts(in *options, opts []Option) *options {
 	for _, opt := range opts {
 		tmp = opt(tmp)
 	}
+	return registryOpts(&tmp)
+}
+
+// registryOpts returns the options to be used by a registry. The metric type
+// of a variable is not inherited by variables added to the same registry.
+func registryOpts(in *options) *options {
+	if in.metricType == gaugeMetric {
+		return in
+	}
+
+	tmp := *in
+	tmp.metricType = gaugeMetric
 	return &tmp
 }
 
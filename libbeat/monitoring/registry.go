@@ -39,6 +39,7 @@ type Registry struct {
 type entry struct {
 	Var
 	Mode
+	metricType
 }
 
 // Var interface required for every metric to implement.
@@ -80,6 +81,9 @@ func (r *Registry) doVisit(mode Mode, vs Visitor) {
 		}
 
 		vs.OnKey(key)
+		if tv, ok := vs.(varTypeVisitor); ok {
+			tv.onVar(v.metricType)
+		}
 		v.Var.Visit(mode, vs)
 	}
 }
@@ -169,7 +173,7 @@ func (r *Registry) addNames(names []string, v Var, opts *options) error {
 			return fmt.Errorf("name %v already used", name)
 		}
 
-		r.entries[name] = entry{v, opts.mode}
+		r.entries[name] = entry{v, opts.mode, opts.metricType}
 		return nil
 	}
 
@@ -183,12 +187,12 @@ func (r *Registry) addNames(names []string, v Var, opts *options) error {
 	}
 
 	sub := NewRegistry()
-	sub.opts = opts
+	sub.opts = registryOpts(opts)
 	if err := sub.addNames(names[1:], v, opts); err != nil {
 		return err
 	}
 
-	r.entries[name] = entry{sub, sub.opts.mode}
+	r.entries[name] = entry{sub, sub.opts.mode, gaugeMetric}
 	return nil
 }
 
@@ -199,7 +203,7 @@ func (r *Registry) find(name string) (entry, error) {
 func (r *Registry) findNames(names []string) (entry, error) {
 	switch len(names) {
 	case 0:
-		return entry{r, r.opts.mode}, nil
+		return entry{r, r.opts.mode, gaugeMetric}, nil
 	case 1:
 		r.mu.RLock()
 		defer r.mu.RUnlock()
@@ -0,0 +1,271 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package monitoring
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// PrometheusOptions configures how a registry is exported in the Prometheus
+// text exposition format.
+type PrometheusOptions struct {
+	// Prefix is prepended to all metric names.
+	Prefix string
+
+	// LabelRegistries maps registry paths (relative to the exported registry,
+	// "" for the exported registry itself) to label names. The names of the
+	// sub-registries of a listed registry are reported as label values,
+	// instead of being part of the metric name.
+	LabelRegistries map[string]string
+}
+
+type prometheusType uint8
+
+const (
+	prometheusGauge prometheusType = iota
+	prometheusCounter
+)
+
+func (t prometheusType) String() string {
+	if t == prometheusCounter {
+		return "counter"
+	}
+	return "gauge"
+}
+
+type prometheusSample struct {
+	path  []string
+	typ   prometheusType
+	value float64
+}
+
+// prometheusVisitor collects all numeric metrics of a registry. Strings
+// named `type` or `name` are collected as well, as they are used as labels
+// for the metrics in the same registry.
+type prometheusVisitor struct {
+	level   []string
+	typ     prometheusType
+	samples []prometheusSample
+	labels  map[string]string
+}
+
+// varTypeVisitor is implemented by visitors that need to know the metric type
+// of the variable that is reported next.
+type varTypeVisitor interface {
+	onVar(typ metricType)
+}
+
+// WritePrometheus writes all numeric metrics of the registry in the
+// Prometheus text exposition format. Variables registered with the Counter
+// option are reported as counters, all other numeric variables as gauges.
+func WritePrometheus(w io.Writer, r *Registry, mode Mode, opts PrometheusOptions) error {
+	if r == nil {
+		r = Default
+	}
+
+	vs := &prometheusVisitor{labels: map[string]string{}}
+	r.Visit(mode, vs)
+
+	type series struct {
+		labels string
+		value  float64
+	}
+	type family struct {
+		typ    prometheusType
+		series []series
+	}
+
+	families := map[string]*family{}
+	for _, sample := range vs.samples {
+		name, labels := vs.nameAndLabels(sample, opts)
+		if sample.typ == prometheusCounter && !strings.HasSuffix(name, "_total") {
+			name += "_total"
+		}
+
+		f := families[name]
+		if f == nil {
+			f = &family{typ: sample.typ}
+			families[name] = f
+		}
+		f.series = append(f.series, series{labels: labels, value: sample.value})
+	}
+
+	names := make([]string, 0, len(families))
+	for name := range families {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	buf := bufio.NewWriter(w)
+	for _, name := range names {
+		f := families[name]
+		sort.Slice(f.series, func(i, j int) bool {
+			return f.series[i].labels < f.series[j].labels
+		})
+
+		fmt.Fprintf(buf, "# TYPE %s %v\n", name, f.typ)
+		for _, s := range f.series {
+			fmt.Fprintf(buf, "%s%s %s\n", name, s.labels, formatPrometheusValue(s.value))
+		}
+	}
+	return buf.Flush()
+}
+
+// nameAndLabels builds the sanitized metric name and the label set of a
+// sample.
+func (vs *prometheusVisitor) nameAndLabels(sample prometheusSample, opts PrometheusOptions) (string, string) {
+	var nameParts []string
+	if opts.Prefix != "" {
+		nameParts = append(nameParts, opts.Prefix)
+	}
+
+	labels := map[string]string{}
+	for i := 0; i < len(sample.path); i++ {
+		parent := strings.Join(sample.path[:i], ".")
+		if label, ok := opts.LabelRegistries[parent]; ok && i < len(sample.path)-1 {
+			labels[label] = sample.path[i]
+			continue
+		}
+		nameParts = append(nameParts, sample.path[i])
+
+		// Registries with a `type` or `name` string add a label named after
+		// the registry to all metrics they contain.
+		if i > 0 && i < len(sample.path)-1 {
+			registry := strings.Join(sample.path[:i+1], ".")
+			for _, key := range []string{"name", "type"} {
+				if v, ok := vs.labels[registry+"."+key]; ok && v != "" {
+					labels[sample.path[i]] = v
+					break
+				}
+			}
+		}
+	}
+
+	return sanitizePrometheusName(strings.Join(nameParts, "_")), formatPrometheusLabels(labels)
+}
+
+func (vs *prometheusVisitor) onVar(typ metricType) {
+	if typ == counterMetric {
+		vs.typ = prometheusCounter
+	} else {
+		vs.typ = prometheusGauge
+	}
+}
+
+func (vs *prometheusVisitor) OnRegistryStart() {}
+
+func (vs *prometheusVisitor) OnRegistryFinished() {
+	if len(vs.level) > 0 {
+		vs.dropName()
+	}
+}
+
+func (vs *prometheusVisitor) OnKey(name string) {
+	vs.level = append(vs.level, name)
+}
+
+func (vs *prometheusVisitor) dropName() {
+	vs.level = vs.level[:len(vs.level)-1]
+}
+
+func (vs *prometheusVisitor) add(value float64) {
+	defer vs.dropName()
+
+	path := make([]string, len(vs.level))
+	copy(path, vs.level)
+	vs.samples = append(vs.samples, prometheusSample{path: path, typ: vs.typ, value: value})
+	vs.typ = prometheusGauge
+}
+
+func (vs *prometheusVisitor) OnString(s string) {
+	defer vs.dropName()
+
+	if name := vs.level[len(vs.level)-1]; name == "type" || name == "name" {
+		vs.labels[strings.Join(vs.level, ".")] = s
+	}
+}
+
+func (vs *prometheusVisitor) OnBool(b bool) {
+	if b {
+		vs.add(1)
+	} else {
+		vs.add(0)
+	}
+}
+
+func (vs *prometheusVisitor) OnInt(i int64)            { vs.add(float64(i)) }
+func (vs *prometheusVisitor) OnFloat(f float64)        { vs.add(f) }
+func (vs *prometheusVisitor) OnStringSlice(f []string) { vs.dropName() }
+
+// sanitizePrometheusName replaces all characters not allowed in Prometheus
+// metric and label names with an underscore. Names must not start with a digit.
+func sanitizePrometheusName(name string) string {
+	b := []byte(name)
+	for i, c := range b {
+		valid := c == '_' || c == ':' ||
+			(c >= 'a' && c <= 'z') ||
+			(c >= 'A' && c <= 'Z') ||
+			(c >= '0' && c <= '9')
+		if !valid {
+			b[i] = '_'
+		}
+	}
+	if len(b) > 0 && b[0] >= '0' && b[0] <= '9' {
+		return "_" + string(b)
+	}
+	return string(b)
+}
+
+var prometheusLabelEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+
+func formatPrometheusLabels(labels map[string]string) string {
+	if len(labels) == 0 {
+		return ""
+	}
+
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, len(keys))
+	for i, k := range keys {
+		parts[i] = fmt.Sprintf(`%s="%s"`, sanitizePrometheusName(k), prometheusLabelEscaper.Replace(labels[k]))
+	}
+	return "{" + strings.Join(parts, ",") + "}"
+}
+
+func formatPrometheusValue(f float64) string {
+	switch {
+	case math.IsInf(f, 1):
+		return "+Inf"
+	case math.IsInf(f, -1):
+		return "-Inf"
+	case math.IsNaN(f):
+		return "NaN"
+	default:
+		return strconv.FormatFloat(f, 'g', -1, 64)
+	}
+}
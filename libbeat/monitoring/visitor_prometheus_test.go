@@ -0,0 +1,114 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package monitoring
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWritePrometheus(t *testing.T) {
+	r := NewRegistry()
+
+	pipeline := r.NewRegistry("libbeat.pipeline")
+	NewUint(pipeline, "events.published", Counter).Set(10)
+	NewUint(pipeline, "events.active").Set(1)
+	NewInt(pipeline, "clients").Set(2)
+
+	output := r.NewRegistry("libbeat.output", Counter)
+	NewString(output, "type").Set("elasticsearch")
+	NewUint(output, "events.acked", Counter).Set(8)
+	NewUint(output, "events.total", Counter).Set(9)
+	NewUint(output, "events.active", Gauge).Set(1)
+
+	NewFloat(r, "system.load.1").Set(0.5)
+	NewBool(r, "ready").Set(true)
+	NewString(r, "ignored").Set("value")
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, WritePrometheus(buf, r, Full, PrometheusOptions{Prefix: "testbeat"}))
+
+	expected := `# TYPE testbeat_libbeat_output_events_acked_total counter
+testbeat_libbeat_output_events_acked_total{output="elasticsearch"} 8
+# TYPE testbeat_libbeat_output_events_active gauge
+testbeat_libbeat_output_events_active{output="elasticsearch"} 1
+# TYPE testbeat_libbeat_output_events_total counter
+testbeat_libbeat_output_events_total{output="elasticsearch"} 9
+# TYPE testbeat_libbeat_pipeline_clients gauge
+testbeat_libbeat_pipeline_clients 2
+# TYPE testbeat_libbeat_pipeline_events_active gauge
+testbeat_libbeat_pipeline_events_active 1
+# TYPE testbeat_libbeat_pipeline_events_published_total counter
+testbeat_libbeat_pipeline_events_published_total 10
+# TYPE testbeat_ready gauge
+testbeat_ready 1
+# TYPE testbeat_system_load_1 gauge
+testbeat_system_load_1 0.5
+`
+	assert.Equal(t, expected, buf.String())
+}
+
+func TestWritePrometheusLabelRegistries(t *testing.T) {
+	r := NewRegistry()
+	NewUint(r, "a-1.events", Counter).Set(1)
+	NewUint(r, "b.2.events", Counter).Set(2)
+	NewInt(r, "b.2.nested.value").Set(3)
+
+	buf := &bytes.Buffer{}
+	opts := PrometheusOptions{
+		Prefix:          "dataset",
+		LabelRegistries: map[string]string{"": "id", "b": "input"},
+	}
+	require.NoError(t, WritePrometheus(buf, r, Full, opts))
+
+	expected := `# TYPE dataset_events_total counter
+dataset_events_total{id="a-1"} 1
+dataset_events_total{id="b",input="2"} 2
+# TYPE dataset_nested_value gauge
+dataset_nested_value{id="b",input="2"} 3
+`
+	assert.Equal(t, expected, buf.String())
+}
+
+func TestWritePrometheusDefaultsToGauge(t *testing.T) {
+	r := NewRegistry()
+	NewUint(r, "sub.counter", Counter).Set(1)
+	NewUint(r, "sub.value").Set(2)
+	NewInt(r, "sub.int").Set(3)
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, WritePrometheus(buf, r, Full, PrometheusOptions{}))
+
+	expected := `# TYPE sub_counter_total counter
+sub_counter_total 1
+# TYPE sub_int gauge
+sub_int 3
+# TYPE sub_value gauge
+sub_value 2
+`
+	assert.Equal(t, expected, buf.String())
+}
+
+func TestSanitizePrometheusName(t *testing.T) {
+	assert.Equal(t, "beat_cpu_total", sanitizePrometheusName("beat.cpu-total"))
+	assert.Equal(t, "_1m", sanitizePrometheusName("1m"))
+	assert.Equal(t, `{a="x\"y\\z\n"}`, formatPrometheusLabels(map[string]string{"a": "x\"y\\z\n"}))
+}
@@ -45,6 +45,7 @@ type Client struct {
 
 	index    outputs.IndexSelector
 	pipeline *outil.Selector
+	idField  string
 	params   map[string]string
 	timeout  time.Duration
 
@@ -59,6 +60,9 @@ type Client struct {
 	proxyURL         *url.URL
 
 	observer outputs.Observer
+
+	// destination for events rejected by Elasticsearch, nil if disabled
+	deadLetter *deadLetter
 }
 
 // ClientSettings contains the settings for a client.
@@ -73,9 +77,12 @@ type ClientSettings struct {
 	Headers            map[string]string
 	Index              outputs.IndexSelector
 	Pipeline           *outil.Selector
+	IDField            string
 	Timeout            time.Duration
 	CompressionLevel   int
 	Observer           outputs.Observer
+
+	deadLetter *deadLetter
 }
 
 // ConnectCallback defines the type for the function to be called when the Elasticsearch client successfully connects to the cluster
@@ -219,6 +226,7 @@ func NewClient(
 		tlsConfig: s.TLS,
 		index:     s.Index,
 		pipeline:  pipeline,
+		idField:   s.IDField,
 		params:    params,
 		timeout:   s.Timeout,
 
@@ -227,6 +235,7 @@ func NewClient(
 		compressionLevel: compression,
 		proxyURL:         s.Proxy,
 		observer:         s.Observer,
+		deadLetter:       s.deadLetter,
 	}
 
 	client.Connection.onConnectCallback = func() error {
@@ -281,6 +290,8 @@ func (client *Client) Clone() *Client {
 			Headers:          client.Headers,
 			Timeout:          client.http.Timeout,
 			CompressionLevel: client.compressionLevel,
+			IDField:          client.idField,
+			deadLetter:       client.deadLetter,
 		},
 		nil, // XXX: do not pass connection callback?
 	)
@@ -327,7 +338,7 @@ func (client *Client) publishEvents(
 	}
 
 	origCount := len(data)
-	data = bulkEncodePublishRequest(body, client.index, client.pipeline, eventType, data)
+	data = bulkEncodePublishRequest(body, client.index, client.pipeline, client.idField, eventType, data)
 	newCount := len(data)
 	if st != nil && origCount > newCount {
 		st.Dropped(origCount - newCount)
@@ -350,24 +361,42 @@ func (client *Client) publishEvents(
 
 	// check response for transient errors
 	var failedEvents []publisher.Event
+	var rejected []rejectedEvent
 	var stats bulkResultStats
 	if status != 200 {
 		failedEvents = data
 		stats.fails = len(failedEvents)
 	} else {
 		client.json.init(result.raw)
-		failedEvents, stats = bulkCollectPublishFails(&client.json, data)
+		failedEvents, rejected, stats = bulkCollectPublishFails(&client.json, data)
+	}
+
+	// events rejected by Elasticsearch are sent to the dead letter destination.
+	// If the destination is not available, the events are retried.
+	deadLetters := 0
+	if len(rejected) > 0 && client.deadLetter != nil {
+		var retry []publisher.Event
+		var err error
+		retry, deadLetters, err = client.publishDeadLetters(rejected)
+		if len(retry) > 0 {
+			failedEvents = append(failedEvents, retry...)
+			stats.nonIndexable -= len(retry)
+			if sendErr == nil {
+				sendErr = err
+			}
+		}
 	}
 
 	failed := len(failedEvents)
 	if st := client.observer; st != nil {
-		dropped := stats.nonIndexable
+		dropped := stats.nonIndexable - deadLetters
 		duplicates := stats.duplicates
-		acked := len(data) - failed - dropped - duplicates
+		acked := len(data) - failed - stats.nonIndexable - duplicates
 
 		st.Acked(acked)
 		st.Failed(failed)
 		st.Dropped(dropped)
+		st.DeadLetter(deadLetters)
 		st.Duplicate(duplicates)
 		st.ErrTooMany(stats.tooMany)
 	}
@@ -387,13 +416,14 @@ func bulkEncodePublishRequest(
 	body bulkWriter,
 	index outputs.IndexSelector,
 	pipeline *outil.Selector,
+	idField string,
 	eventType string,
 	data []publisher.Event,
 ) []publisher.Event {
 	okEvents := data[:0]
 	for i := range data {
 		event := &data[i].Content
-		meta, err := createEventBulkMeta(index, pipeline, eventType, event)
+		meta, err := createEventBulkMeta(index, pipeline, idField, eventType, event)
 		if err != nil {
 			logp.Err("Failed to encode event meta data: %s", err)
 			continue
@@ -411,6 +441,7 @@ func bulkEncodePublishRequest(
 func createEventBulkMeta(
 	indexSel outputs.IndexSelector,
 	pipelineSel *outil.Selector,
+	idField string,
 	eventType string,
 	event *beat.Event,
 ) (interface{}, error) {
@@ -426,16 +457,7 @@ func createEventBulkMeta(
 		return nil, err
 	}
 
-	var id string
-	if m := event.Meta; m != nil {
-		if tmp := m["id"]; tmp != nil {
-			if s, ok := tmp.(string); ok {
-				id = s
-			} else {
-				logp.Err("Event ID '%v' is no string value", id)
-			}
-		}
-	}
+	id := getEventID(event, idField)
 
 	meta := bulkEventMeta{
 		Index:    index,
@@ -450,6 +472,27 @@ func createEventBulkMeta(
 	return bulkIndexAction{meta}, nil
 }
 
+// getEventID returns the document ID for the event. The value of idField is
+// used if configured and present, else the ID is read from `@metadata.id`.
+func getEventID(event *beat.Event, idField string) string {
+	var tmp interface{}
+	if idField != "" {
+		tmp, _ = event.GetValue(idField)
+	}
+	if tmp == nil && event.Meta != nil {
+		tmp = event.Meta["id"]
+	}
+	if tmp == nil {
+		return ""
+	}
+
+	id, ok := tmp.(string)
+	if !ok {
+		logp.Err("Event ID '%v' is no string value", tmp)
+	}
+	return id
+}
+
 func getPipeline(event *beat.Event, pipelineSel *outil.Selector) (string, error) {
 	if event.Meta != nil {
 		if pipeline, exists := event.Meta["pipeline"]; exists {
@@ -469,14 +512,14 @@ func getPipeline(event *beat.Event, pipelineSel *outil.Selector) (string, error)
 // bulkCollectPublishFails checks per item errors returning all events
 // to be tried again due to error code returned for that items. If indexing an
 // event failed due to some error in the event itself (e.g. does not respect mapping),
-// the event will be dropped.
+// the event is returned in the list of rejected events.
 func bulkCollectPublishFails(
 	reader *jsonReader,
 	data []publisher.Event,
-) ([]publisher.Event, bulkResultStats) {
+) ([]publisher.Event, []rejectedEvent, bulkResultStats) {
 	if err := reader.expectDict(); err != nil {
 		logp.Err("Failed to parse bulk response: expected JSON object")
-		return nil, bulkResultStats{}
+		return nil, nil, bulkResultStats{}
 	}
 
 	// find 'items' field in response
@@ -484,12 +527,12 @@ func bulkCollectPublishFails(
 		kind, name, err := reader.nextFieldName()
 		if err != nil {
 			logp.Err("Failed to parse bulk response")
-			return nil, bulkResultStats{}
+			return nil, nil, bulkResultStats{}
 		}
 
 		if kind == dictEnd {
 			logp.Err("Failed to parse bulk response: no 'items' field in response")
-			return nil, bulkResultStats{}
+			return nil, nil, bulkResultStats{}
 		}
 
 		// found items array -> continue
@@ -503,16 +546,17 @@ func bulkCollectPublishFails(
 	// check items field is an array
 	if err := reader.expectArray(); err != nil {
 		logp.Err("Failed to parse bulk response: expected items array")
-		return nil, bulkResultStats{}
+		return nil, nil, bulkResultStats{}
 	}
 
 	count := len(data)
 	failed := data[:0]
+	var rejected []rejectedEvent
 	stats := bulkResultStats{}
 	for i := 0; i < count; i++ {
 		status, msg, err := itemStatus(reader)
 		if err != nil {
-			return nil, bulkResultStats{}
+			return nil, nil, bulkResultStats{}
 		}
 
 		if status < 300 {
@@ -534,6 +578,11 @@ func bulkCollectPublishFails(
 				// hard failure, don't collect
 				logp.Warn("Cannot index event %#v (status=%v): %s", data[i], status, msg)
 				stats.nonIndexable++
+				rejected = append(rejected, rejectedEvent{
+					event:  data[i],
+					status: status,
+					reason: string(msg),
+				})
 				continue
 			}
 		}
@@ -543,7 +592,7 @@ func bulkCollectPublishFails(
 		failed = append(failed, data[i])
 	}
 
-	return failed, stats
+	return failed, rejected, stats
 }
 
 func itemStatus(reader *jsonReader) (int, []byte, error) {
@@ -691,6 +740,16 @@ func (client *Client) Connect() error {
 	return client.Connection.Connect()
 }
 
+// Close closes the client's connection and the dead letter file.
+func (client *Client) Close() error {
+	if client.deadLetter != nil {
+		if err := client.deadLetter.Close(); err != nil {
+			logp.Err("Failed to close dead letter file: %v", err)
+		}
+	}
+	return client.Connection.Close()
+}
+
 // Connect connects the client. It runs a GET request against the root URL of
 // the configured host, updates the known Elasticsearch version and calls
 // globally configured handlers.
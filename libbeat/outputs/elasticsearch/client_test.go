@@ -103,7 +103,7 @@ func TestCollectPublishFailsNone(t *testing.T) {
 	}
 
 	reader := newJSONReader(response)
-	res, _ := bulkCollectPublishFails(reader, events)
+	res, _, _ := bulkCollectPublishFails(reader, events)
 	assert.Equal(t, 0, len(res))
 }
 
@@ -121,7 +121,7 @@ func TestCollectPublishFailMiddle(t *testing.T) {
 	events := []publisher.Event{event, eventFail, event}
 
 	reader := newJSONReader(response)
-	res, stats := bulkCollectPublishFails(reader, events)
+	res, _, stats := bulkCollectPublishFails(reader, events)
 	assert.Equal(t, 1, len(res))
 	if len(res) == 1 {
 		assert.Equal(t, eventFail, res[0])
@@ -142,7 +142,7 @@ func TestCollectPublishFailAll(t *testing.T) {
 	events := []publisher.Event{event, event, event}
 
 	reader := newJSONReader(response)
-	res, stats := bulkCollectPublishFails(reader, events)
+	res, _, stats := bulkCollectPublishFails(reader, events)
 	assert.Equal(t, 3, len(res))
 	assert.Equal(t, events, res)
 	assert.Equal(t, stats, bulkResultStats{fails: 3, tooMany: 3})
@@ -184,7 +184,7 @@ func TestCollectPipelinePublishFail(t *testing.T) {
 	events := []publisher.Event{event}
 
 	reader := newJSONReader(response)
-	res, _ := bulkCollectPublishFails(reader, events)
+	res, _, _ := bulkCollectPublishFails(reader, events)
 	assert.Equal(t, 1, len(res))
 	assert.Equal(t, events, res)
 }
@@ -204,7 +204,7 @@ func BenchmarkCollectPublishFailsNone(b *testing.B) {
 	reader := newJSONReader(nil)
 	for i := 0; i < b.N; i++ {
 		reader.init(response)
-		res, _ := bulkCollectPublishFails(reader, events)
+		res, _, _ := bulkCollectPublishFails(reader, events)
 		if len(res) != 0 {
 			b.Fail()
 		}
@@ -227,7 +227,7 @@ func BenchmarkCollectPublishFailMiddle(b *testing.B) {
 	reader := newJSONReader(nil)
 	for i := 0; i < b.N; i++ {
 		reader.init(response)
-		res, _ := bulkCollectPublishFails(reader, events)
+		res, _, _ := bulkCollectPublishFails(reader, events)
 		if len(res) != 1 {
 			b.Fail()
 		}
@@ -249,7 +249,7 @@ func BenchmarkCollectPublishFailAll(b *testing.B) {
 	reader := newJSONReader(nil)
 	for i := 0; i < b.N; i++ {
 		reader.init(response)
-		res, _ := bulkCollectPublishFails(reader, events)
+		res, _, _ := bulkCollectPublishFails(reader, events)
 		if len(res) != 3 {
 			b.Fail()
 		}
@@ -297,6 +297,17 @@ func TestClientWithHeaders(t *testing.T) {
 	assert.Equal(t, 2, requestCount)
 }
 
+func TestClientCloneKeepsIDField(t *testing.T) {
+	client, err := NewClient(ClientSettings{
+		URL:     "http://localhost:9200",
+		Index:   outil.MakeSelector(outil.ConstSelectorExpr("test")),
+		IDField: "@metadata._id",
+	}, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, "@metadata._id", client.Clone().idField)
+}
+
 func TestAddToURL(t *testing.T) {
 	type Test struct {
 		url      string
@@ -343,6 +354,51 @@ func TestAddToURL(t *testing.T) {
 	}
 }
 
+func TestGetEventID(t *testing.T) {
+	cases := map[string]struct {
+		idField  string
+		meta     common.MapStr
+		fields   common.MapStr
+		expected string
+	}{
+		"no id": {
+			expected: "",
+		},
+		"metadata id": {
+			meta:     common.MapStr{"id": "abc"},
+			expected: "abc",
+		},
+		"id field in metadata": {
+			idField:  "@metadata._id",
+			meta:     common.MapStr{"id": "abc", "_id": "def"},
+			expected: "def",
+		},
+		"id field in event": {
+			idField:  "fingerprint",
+			fields:   common.MapStr{"fingerprint": "def"},
+			expected: "def",
+		},
+		"id field missing falls back to metadata id": {
+			idField:  "@metadata._id",
+			meta:     common.MapStr{"id": "abc"},
+			expected: "abc",
+		},
+		"non string id": {
+			idField:  "fingerprint",
+			fields:   common.MapStr{"fingerprint": 42},
+			expected: "",
+		},
+	}
+
+	for name, test := range cases {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			event := &beat.Event{Meta: test.meta, Fields: test.fields}
+			assert.Equal(t, test.expected, getEventID(event, test.idField))
+		})
+	}
+}
+
 type testBulkRecorder struct {
 	data     []interface{}
 	inAction bool
@@ -388,7 +444,7 @@ func TestBulkEncodeEvents(t *testing.T) {
 
 			recorder := &testBulkRecorder{}
 
-			encoded := bulkEncodePublishRequest(recorder, index, pipeline, test.docType, events)
+			encoded := bulkEncodePublishRequest(recorder, index, pipeline, "", test.docType, events)
 			assert.Equal(t, len(events), len(encoded), "all events should have been encoded")
 			assert.False(t, recorder.inAction, "incomplete bulk")
 
@@ -40,6 +40,8 @@ type elasticsearchConfig struct {
 	MaxRetries       int               `config:"max_retries"`
 	Timeout          time.Duration     `config:"timeout"`
 	Backoff          Backoff           `config:"backoff"`
+	DeadLetter       *deadLetterConfig `config:"dead_letter"`
+	IDField          string            `config:"id_field"`
 }
 
 type Backoff struct {
@@ -0,0 +1,198 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package elasticsearch
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/file"
+	"github.com/elastic/beats/libbeat/common/fmtstr"
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/libbeat/outputs"
+	"github.com/elastic/beats/libbeat/outputs/fileout"
+	"github.com/elastic/beats/libbeat/outputs/outil"
+	"github.com/elastic/beats/libbeat/publisher"
+)
+
+// deadLetterConfig configures where events rejected by Elasticsearch with a
+// non-retryable error are sent to. Exactly one of index or file must be set.
+type deadLetterConfig struct {
+	Index *fmtstr.EventFormatString `config:"index"`
+	File  *common.Config            `config:"file"`
+}
+
+// deadLetter sends rejected events to a separate index or writes them to a
+// local file. It is shared by all clients of the output.
+type deadLetter struct {
+	index outputs.IndexSelector
+	file  *fileout.Writer
+}
+
+// rejectedEvent is an event Elasticsearch failed to index due to an error in
+// the event itself.
+type rejectedEvent struct {
+	event  publisher.Event
+	status int
+	reason string
+}
+
+func (c *deadLetterConfig) Validate() error {
+	if (c.Index == nil) == (c.File == nil) {
+		return errors.New("dead_letter requires either index or file to be set")
+	}
+	return nil
+}
+
+func newDeadLetter(beat beat.Info, config *deadLetterConfig) (*deadLetter, error) {
+	if config == nil {
+		return nil, nil
+	}
+
+	if config.Index != nil {
+		sel := outil.MakeSelector(outil.FmtSelectorExpr(config.Index, ""))
+		return &deadLetter{index: sel}, nil
+	}
+
+	// Clients close the file when they are closed, which happens on every
+	// connection error. Append to the existing file when it's reopened.
+	writer, err := fileout.NewWriter(beat, config.File, file.RotateOnStartup(false))
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize dead letter file: %v", err)
+	}
+	return &deadLetter{file: writer}, nil
+}
+
+// Close closes the dead letter file if any. It is reopened on the next write.
+func (dl *deadLetter) Close() error {
+	if dl.file != nil {
+		return dl.file.Close()
+	}
+	return nil
+}
+
+// makeDeadLetterEvent creates the event stored in the dead letter
+// destination. The original event is stored JSON encoded in the message
+// field, such that it can be indexed no matter the mapping conflict.
+func makeDeadLetterEvent(index outputs.IndexSelector, rejected *rejectedEvent) (beat.Event, error) {
+	orig := &rejected.event.Content
+
+	targetIndex, err := index.Select(orig)
+	if err != nil {
+		return beat.Event{}, fmt.Errorf("failed to select event index: %v", err)
+	}
+
+	fields := orig.Fields.Clone()
+	fields["@timestamp"] = common.Time(orig.Timestamp)
+	if len(orig.Meta) > 0 {
+		fields["@metadata"] = orig.Meta
+	}
+	message, err := json.Marshal(fields)
+	if err != nil {
+		return beat.Event{}, fmt.Errorf("failed to encode event: %v", err)
+	}
+
+	return beat.Event{
+		Timestamp: orig.Timestamp,
+		Fields: common.MapStr{
+			"message": string(message),
+			"dead_letter": common.MapStr{
+				"index":  targetIndex,
+				"status": rejected.status,
+				"reason": rejected.reason,
+			},
+		},
+	}, nil
+}
+
+// publishDeadLetters sends the rejected events to the dead letter
+// destination. It returns the original events that could not be stored due to
+// a temporary error and need to be retried, plus the number of events stored.
+func (client *Client) publishDeadLetters(rejected []rejectedEvent) ([]publisher.Event, int, error) {
+	dl := client.deadLetter
+
+	// Private holds the index of the rejected event, so failed dead letter
+	// events can be mapped back to the original events.
+	events := make([]publisher.Event, 0, len(rejected))
+	for i := range rejected {
+		event, err := makeDeadLetterEvent(client.index, &rejected[i])
+		if err != nil {
+			logp.Err("Failed to create dead letter event: %v", err)
+			continue
+		}
+		event.Private = i
+		events = append(events, publisher.Event{Content: event})
+	}
+
+	var retry []publisher.Event
+	if dl.file != nil {
+		for i := range events {
+			if _, err := dl.file.Write(&events[i].Content); err != nil {
+				logp.Err("Failed to write event to dead letter file %v: %v", dl.file, err)
+				retry = append(retry, rejected[events[i].Content.Private.(int)].event)
+			}
+		}
+		if len(retry) > 0 {
+			return retry, len(events) - len(retry), errTempBulkFailure
+		}
+		return nil, len(events), nil
+	}
+
+	body := client.encoder
+	body.Reset()
+
+	eventType := ""
+	if client.GetVersion().Major < 7 {
+		eventType = defaultEventType
+	}
+	events = bulkEncodePublishRequest(body, dl.index, nil, "", eventType, events)
+	if len(events) == 0 {
+		return nil, 0, nil
+	}
+
+	requ := client.bulkRequ
+	requ.Reset(body)
+	status, result, err := client.sendBulkRequest(requ)
+	if err == nil && status != 200 {
+		err = fmt.Errorf("dead letter bulk request failed with status %v", status)
+	}
+	if err != nil {
+		logp.Err("Failed to index events into the dead letter index: %v", err)
+		for i := range events {
+			retry = append(retry, rejected[events[i].Content.Private.(int)].event)
+		}
+		return retry, 0, err
+	}
+
+	client.json.init(result.raw)
+	count := len(events)
+	failed, dropped, _ := bulkCollectPublishFails(&client.json, events)
+	for _, d := range dropped {
+		logp.Warn("Cannot index event into the dead letter index (status=%v): %s", d.status, d.reason)
+	}
+	for i := range failed {
+		retry = append(retry, rejected[failed[i].Content.Private.(int)].event)
+	}
+	if len(retry) > 0 {
+		err = errTempBulkFailure
+	}
+	return retry, count - len(failed) - len(dropped), err
+}
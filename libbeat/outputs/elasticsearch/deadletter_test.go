@@ -0,0 +1,294 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package elasticsearch
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/fmtstr"
+	_ "github.com/elastic/beats/libbeat/outputs/codec/json"
+	"github.com/elastic/beats/libbeat/outputs/outil"
+	"github.com/elastic/beats/libbeat/publisher"
+)
+
+func TestCollectPublishFailRejected(t *testing.T) {
+	response := []byte(`
+    { "items": [
+      {"create": {"status": 200}},
+      {"create": {"status": 400, "error": {"type": "mapper_parsing_exception"}}},
+      {"create": {"status": 429, "error": "ups"}}
+    ]}
+  `)
+
+	event := publisher.Event{Content: beat.Event{Fields: common.MapStr{"field": 1}}}
+	eventRejected := publisher.Event{Content: beat.Event{Fields: common.MapStr{"field": 2}}}
+	eventFail := publisher.Event{Content: beat.Event{Fields: common.MapStr{"field": 3}}}
+	events := []publisher.Event{event, eventRejected, eventFail}
+
+	reader := newJSONReader(response)
+	res, rejected, stats := bulkCollectPublishFails(reader, events)
+	assert.Equal(t, []publisher.Event{eventFail}, res)
+	assert.Equal(t, []rejectedEvent{{
+		event:  eventRejected,
+		status: 400,
+		reason: `{"type": "mapper_parsing_exception"}`,
+	}}, rejected)
+	assert.Equal(t, bulkResultStats{acked: 1, fails: 1, nonIndexable: 1, tooMany: 1}, stats)
+}
+
+func TestDeadLetterConfigValidate(t *testing.T) {
+	tests := map[string]struct {
+		settings map[string]interface{}
+		err      bool
+	}{
+		"index": {settings: map[string]interface{}{"index": "deadletter"}},
+		"file":  {settings: map[string]interface{}{"file.path": "/tmp"}},
+		"none":  {settings: map[string]interface{}{}, err: true},
+		"both": {
+			settings: map[string]interface{}{"index": "deadletter", "file.path": "/tmp"},
+			err:      true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var config deadLetterConfig
+			err := common.MustNewConfigFrom(test.settings).Unpack(&config)
+			if test.err {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMakeDeadLetterEvent(t *testing.T) {
+	ts := time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC)
+	rejected := rejectedEvent{
+		event: publisher.Event{Content: beat.Event{
+			Timestamp: ts,
+			Meta:      common.MapStr{"pipeline": "test"},
+			Fields:    common.MapStr{"field": "value"},
+		}},
+		status: 400,
+		reason: "mapping conflict",
+	}
+
+	index := outil.MakeSelector(outil.ConstSelectorExpr("test-index"))
+	event, err := makeDeadLetterEvent(index, &rejected)
+	require.NoError(t, err)
+
+	assert.Equal(t, ts, event.Timestamp)
+	assert.Equal(t, common.MapStr{
+		"index":  "test-index",
+		"status": 400,
+		"reason": "mapping conflict",
+	}, event.Fields["dead_letter"])
+
+	var orig map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(event.Fields["message"].(string)), &orig))
+	assert.Equal(t, map[string]interface{}{
+		"@timestamp": "2019-10-01T12:00:00.000Z",
+		"@metadata":  map[string]interface{}{"pipeline": "test"},
+		"field":      "value",
+	}, orig)
+}
+
+func TestPublishDeadLetterIndex(t *testing.T) {
+	var requests []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		requests = append(requests, string(body))
+		if len(requests) == 1 {
+			fmt.Fprintln(w, `{"items": [{"index": {"status": 400, "error": "mapping conflict"}}]}`)
+		} else {
+			fmt.Fprintln(w, `{"items": [{"index": {"status": 201}}]}`)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(ClientSettings{
+		URL:   ts.URL,
+		Index: outil.MakeSelector(outil.ConstSelectorExpr("test")),
+		deadLetter: &deadLetter{
+			index: outil.MakeSelector(outil.FmtSelectorExpr(fmtstr.MustCompileEvent("deadletter"), "")),
+		},
+	}, nil)
+	require.NoError(t, err)
+
+	events := []publisher.Event{{Content: beat.Event{Fields: common.MapStr{"field": 1}}}}
+	rest, err := client.publishEvents(events)
+	assert.NoError(t, err)
+	assert.Empty(t, rest)
+
+	require.Len(t, requests, 2)
+	assert.Contains(t, requests[1], `"_index":"deadletter"`)
+	assert.Contains(t, requests[1], `"index":"test"`)
+	assert.Contains(t, requests[1], `"reason":"\"mapping conflict\""`)
+}
+
+func TestPublishDeadLetterIndexUnavailable(t *testing.T) {
+	requests := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			fmt.Fprintln(w, `{"items": [{"index": {"status": 400, "error": "mapping conflict"}}]}`)
+		} else {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(ClientSettings{
+		URL:   ts.URL,
+		Index: outil.MakeSelector(outil.ConstSelectorExpr("test")),
+		deadLetter: &deadLetter{
+			index: outil.MakeSelector(outil.ConstSelectorExpr("deadletter")),
+		},
+	}, nil)
+	require.NoError(t, err)
+
+	events := []publisher.Event{{Content: beat.Event{Fields: common.MapStr{"field": 1}}}}
+	rest, err := client.publishEvents(events)
+	assert.Error(t, err)
+	assert.Equal(t, events, rest)
+}
+
+func TestPublishDeadLetterFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "es-deadletter")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, `{"items": [{"index": {"status": 201}}, {"index": {"status": 400, "error": "mapping conflict"}}]}`)
+	}))
+	defer ts.Close()
+
+	dl, err := newDeadLetter(beat.Info{Beat: "testbeat"}, &deadLetterConfig{
+		File: common.MustNewConfigFrom(map[string]interface{}{
+			"path":     dir,
+			"filename": "deadletter",
+		}),
+	})
+	require.NoError(t, err)
+	defer dl.file.Close()
+
+	client, err := NewClient(ClientSettings{
+		URL:        ts.URL,
+		Index:      outil.MakeSelector(outil.ConstSelectorExpr("test")),
+		deadLetter: dl,
+	}, nil)
+	require.NoError(t, err)
+
+	events := []publisher.Event{
+		{Content: beat.Event{Fields: common.MapStr{"field": 1}}},
+		{Content: beat.Event{Fields: common.MapStr{"field": 2}}},
+	}
+	rest, err := client.publishEvents(events)
+	assert.NoError(t, err)
+	assert.Empty(t, rest)
+
+	content, err := ioutil.ReadFile(filepath.Join(dir, "deadletter"))
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	require.Len(t, lines, 1)
+
+	var doc map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &doc))
+	assert.Equal(t, map[string]interface{}{
+		"index":  "test",
+		"status": float64(400),
+		"reason": `"mapping conflict"`,
+	}, doc["dead_letter"])
+	assert.Contains(t, doc["message"], `"field":2`)
+}
+
+func TestPublishDeadLetterFileConcurrentClients(t *testing.T) {
+	const clients, batches = 4, 20
+
+	dir, err := ioutil.TempDir("", "es-deadletter")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, `{"items": [{"index": {"status": 400, "error": "mapping conflict"}}, {"index": {"status": 400, "error": "mapping conflict"}}]}`)
+	}))
+	defer ts.Close()
+
+	dl, err := newDeadLetter(beat.Info{Beat: "testbeat"}, &deadLetterConfig{
+		File: common.MustNewConfigFrom(map[string]interface{}{
+			"path":     dir,
+			"filename": "deadletter",
+		}),
+	})
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < clients; i++ {
+		client, err := NewClient(ClientSettings{
+			URL:        ts.URL,
+			Index:      outil.MakeSelector(outil.ConstSelectorExpr("test")),
+			deadLetter: dl,
+		}, nil)
+		require.NoError(t, err)
+
+		wg.Add(1)
+		go func(client *Client, id int) {
+			defer wg.Done()
+			defer client.Close()
+
+			for j := 0; j < batches; j++ {
+				events := []publisher.Event{
+					{Content: beat.Event{Fields: common.MapStr{"client": id, "batch": j, "n": 1}}},
+					{Content: beat.Event{Fields: common.MapStr{"client": id, "batch": j, "n": 2}}},
+				}
+				rest, err := client.publishEvents(events)
+				assert.NoError(t, err)
+				assert.Empty(t, rest)
+			}
+		}(client, i)
+	}
+	wg.Wait()
+
+	content, err := ioutil.ReadFile(filepath.Join(dir, "deadletter"))
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	require.Len(t, lines, clients*batches*2)
+	for _, line := range lines {
+		var doc map[string]interface{}
+		assert.NoError(t, json.Unmarshal([]byte(line), &doc), line)
+	}
+}
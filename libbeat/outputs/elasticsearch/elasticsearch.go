@@ -180,6 +180,11 @@ func makeES(
 		params = nil
 	}
 
+	deadLetter, err := newDeadLetter(beat, config.DeadLetter)
+	if err != nil {
+		return outputs.Fail(err)
+	}
+
 	clients := make([]outputs.NetworkClient, len(hosts))
 	for i, host := range hosts {
 		esURL, err := common.MakeURL(config.Protocol, config.Path, host, 9200)
@@ -193,6 +198,7 @@ func makeES(
 			URL:              esURL,
 			Index:            index,
 			Pipeline:         pipeline,
+			IDField:          config.IDField,
 			Proxy:            proxyURL,
 			ProxyDisable:     config.ProxyDisable,
 			TLS:              tlsConfig,
@@ -204,6 +210,7 @@ func makeES(
 			CompressionLevel: config.CompressionLevel,
 			Observer:         observer,
 			EscapeHTML:       config.EscapeHTML,
+			deadLetter:       deadLetter,
 		}, &connectCallbackRegistry)
 		if err != nil {
 			return outputs.Fail(err)
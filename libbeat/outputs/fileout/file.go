@@ -20,6 +20,7 @@ package fileout
 import (
 	"os"
 	"path/filepath"
+	"sync"
 
 	"github.com/elastic/beats/libbeat/beat"
 	"github.com/elastic/beats/libbeat/common"
@@ -35,11 +36,20 @@ func init() {
 }
 
 type fileOutput struct {
-	filePath string
 	beat     beat.Info
 	observer outputs.Observer
+	writer   *Writer
+}
+
+// Writer writes encoded events to a rotated file, one event per line. It is
+// safe for concurrent use.
+type Writer struct {
+	beat     beat.Info
+	filePath string
 	rotator  *file.Rotator
-	codec    codec.Codec
+
+	mutex sync.Mutex // Protects the codec, which reuses its buffer.
+	codec codec.Codec
 }
 
 // makeFileout instantiates a new file output instance.
@@ -57,54 +67,90 @@ func makeFileout(
 	// disable bulk support in publisher pipeline
 	cfg.SetInt("bulk_max_size", -1, -1)
 
+	writer, err := newWriter(beat, config)
+	if err != nil {
+		return outputs.Fail(err)
+	}
+
 	fo := &fileOutput{
 		beat:     beat,
 		observer: observer,
-	}
-	if err := fo.init(beat, config); err != nil {
-		return outputs.Fail(err)
+		writer:   writer,
 	}
 
 	return outputs.Success(-1, 0, fo)
 }
 
-func (out *fileOutput) init(beat beat.Info, c config) error {
+// NewWriter creates a new Writer using the file output settings. The given
+// options override the rotation settings.
+func NewWriter(beat beat.Info, cfg *common.Config, options ...file.RotatorOption) (*Writer, error) {
+	config := defaultConfig
+	if err := cfg.Unpack(&config); err != nil {
+		return nil, err
+	}
+	return newWriter(beat, config, options...)
+}
+
+func newWriter(beat beat.Info, c config, options ...file.RotatorOption) (*Writer, error) {
 	var path string
 	if c.Filename != "" {
 		path = filepath.Join(c.Path, c.Filename)
 	} else {
-		path = filepath.Join(c.Path, out.beat.Beat)
+		path = filepath.Join(c.Path, beat.Beat)
 	}
 
-	out.filePath = path
-
-	var err error
-	out.rotator, err = file.NewFileRotator(
-		path,
-		file.MaxSizeBytes(c.RotateEveryKb*1024),
+	rotator, err := file.NewFileRotator(path, append([]file.RotatorOption{
+		file.MaxSizeBytes(c.RotateEveryKb * 1024),
 		file.MaxBackups(c.NumberOfFiles),
 		file.Permissions(os.FileMode(c.Permissions)),
 		file.WithLogger(logp.NewLogger("rotator").With(logp.Namespace("rotator"))),
-	)
+	}, options...)...)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	out.codec, err = codec.CreateEncoder(beat, c.Codec)
+	enc, err := codec.CreateEncoder(beat, c.Codec)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	logp.Info("Initialized file output. "+
 		"path=%v max_size_bytes=%v max_backups=%v permissions=%v",
 		path, c.RotateEveryKb*1024, c.NumberOfFiles, os.FileMode(c.Permissions))
 
-	return nil
+	return &Writer{
+		beat:     beat,
+		filePath: path,
+		rotator:  rotator,
+		codec:    enc,
+	}, nil
+}
+
+// Write encodes the event and appends it to the file. It returns the number
+// of bytes written.
+func (w *Writer) Write(event *beat.Event) (int, error) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	serializedEvent, err := w.codec.Encode(w.beat.Beat, event)
+	if err != nil {
+		return 0, err
+	}
+	return w.rotator.Write(append(serializedEvent, '\n'))
+}
+
+// Close closes the underlying file. It is reopened by the next Write.
+func (w *Writer) Close() error {
+	return w.rotator.Close()
+}
+
+func (w *Writer) String() string {
+	return "file(" + w.filePath + ")"
 }
 
 // Implement Outputer
 func (out *fileOutput) Close() error {
-	return out.rotator.Close()
+	return out.writer.Close()
 }
 
 func (out *fileOutput) Publish(
@@ -120,7 +166,7 @@ func (out *fileOutput) Publish(
 	for i := range events {
 		event := &events[i]
 
-		serializedEvent, err := out.codec.Encode(out.beat.Beat, &event.Content)
+		serializedEvent, err := out.writer.codec.Encode(out.beat.Beat, &event.Content)
 		if err != nil {
 			if event.Guaranteed() {
 				logp.Critical("Failed to serialize the event: %v", err)
@@ -133,7 +179,7 @@ func (out *fileOutput) Publish(
 			continue
 		}
 
-		if _, err = out.rotator.Write(append(serializedEvent, '\n')); err != nil {
+		if _, err = out.writer.rotator.Write(append(serializedEvent, '\n')); err != nil {
 			st.WriteError(err)
 
 			if event.Guaranteed() {
@@ -156,5 +202,5 @@ func (out *fileOutput) Publish(
 }
 
 func (out *fileOutput) String() string {
-	return "file(" + out.filePath + ")"
+	return out.writer.String()
 }
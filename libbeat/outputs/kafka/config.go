@@ -57,6 +57,7 @@ type kafkaConfig struct {
 	ChanBufferSize     int                       `config:"channel_buffer_size" validate:"min=1"`
 	Username           string                    `config:"username"`
 	Password           string                    `config:"password"`
+	Sasl               kafka.SaslConfig          `config:"sasl"`
 	Codec              codec.Config              `config:"codec"`
 }
 
@@ -135,6 +136,12 @@ func (c *kafkaConfig) Validate() error {
 		return fmt.Errorf("password must be set when username is configured")
 	}
 
+	if c.Username != "" {
+		if err := c.Sasl.ValidateVersion(c.Version); err != nil {
+			return err
+		}
+	}
+
 	if c.Compression == "gzip" {
 		lvl := c.CompressionLevel
 		if lvl != sarama.CompressionLevelDefault && !(0 <= lvl && lvl <= 9) {
@@ -172,9 +179,7 @@ func newSaramaConfig(config *kafkaConfig) (*sarama.Config, error) {
 	}
 
 	if config.Username != "" {
-		k.Net.SASL.Enable = true
-		k.Net.SASL.User = config.Username
-		k.Net.SASL.Password = config.Password
+		config.Sasl.ConfigureSarama(k, config.Username, config.Password)
 	}
 
 	// configure metadata update properties
@@ -34,6 +34,22 @@ func TestConfigAcceptValid(t *testing.T) {
 			"compression": "lz4",
 			"version":     "1.0.0",
 		},
+		"sasl plain by default": common.MapStr{
+			"username": "user",
+			"password": "secret",
+		},
+		"sasl scram-sha-256 with 1.0": common.MapStr{
+			"username":       "user",
+			"password":       "secret",
+			"sasl.mechanism": "SCRAM-SHA-256",
+			"version":        "1.0.0",
+		},
+		"sasl scram-sha-512 with 2.0": common.MapStr{
+			"username":       "user",
+			"password":       "secret",
+			"sasl.mechanism": "scram-sha-512",
+			"version":        "2.0.0",
+		},
 	}
 
 	for name, test := range tests {
@@ -51,3 +67,36 @@ func TestConfigAcceptValid(t *testing.T) {
 		})
 	}
 }
+
+func TestConfigInvalid(t *testing.T) {
+	tests := map[string]common.MapStr{
+		"unknown sasl mechanism": common.MapStr{
+			"username":       "user",
+			"password":       "secret",
+			"sasl.mechanism": "GSSAPI",
+		},
+		"sasl scram with 0.11": common.MapStr{
+			"username":       "user",
+			"password":       "secret",
+			"sasl.mechanism": "SCRAM-SHA-512",
+			"version":        "0.11",
+		},
+		"sasl plain with 0.9": common.MapStr{
+			"username": "user",
+			"password": "secret",
+			"version":  "0.9",
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			c := common.MustNewConfigFrom(test)
+			c.SetString("hosts", 0, "localhost")
+			_, err := readConfig(c)
+			if err == nil {
+				t.Fatalf("Can create test configuration from invalid input")
+			}
+		})
+	}
+}
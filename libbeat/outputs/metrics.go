@@ -34,6 +34,7 @@ type Stats struct {
 	duplicates *monitoring.Uint // events sent and waiting for ACK/fail from output
 	dropped    *monitoring.Uint // total number of invalid events dropped by the output
 	tooMany    *monitoring.Uint // total number of too many requests replies from output
+	deadLetter *monitoring.Uint // total number of rejected events sent to the dead letter destination
 
 	//
 	// Output network connection stats
@@ -50,20 +51,21 @@ type Stats struct {
 // The registry must not be null.
 func NewStats(reg *monitoring.Registry) *Stats {
 	return &Stats{
-		batches:    monitoring.NewUint(reg, "events.batches"),
-		events:     monitoring.NewUint(reg, "events.total"),
-		acked:      monitoring.NewUint(reg, "events.acked"),
-		failed:     monitoring.NewUint(reg, "events.failed"),
-		dropped:    monitoring.NewUint(reg, "events.dropped"),
-		duplicates: monitoring.NewUint(reg, "events.duplicates"),
+		batches:    monitoring.NewUint(reg, "events.batches", monitoring.Counter),
+		events:     monitoring.NewUint(reg, "events.total", monitoring.Counter),
+		acked:      monitoring.NewUint(reg, "events.acked", monitoring.Counter),
+		failed:     monitoring.NewUint(reg, "events.failed", monitoring.Counter),
+		dropped:    monitoring.NewUint(reg, "events.dropped", monitoring.Counter),
+		duplicates: monitoring.NewUint(reg, "events.duplicates", monitoring.Counter),
 		active:     monitoring.NewUint(reg, "events.active"),
-		tooMany:    monitoring.NewUint(reg, "events.toomany"),
+		tooMany:    monitoring.NewUint(reg, "events.toomany", monitoring.Counter),
+		deadLetter: monitoring.NewUint(reg, "events.dead_letter", monitoring.Counter),
 
-		writeBytes:  monitoring.NewUint(reg, "write.bytes"),
-		writeErrors: monitoring.NewUint(reg, "write.errors"),
+		writeBytes:  monitoring.NewUint(reg, "write.bytes", monitoring.Counter),
+		writeErrors: monitoring.NewUint(reg, "write.errors", monitoring.Counter),
 
-		readBytes:  monitoring.NewUint(reg, "read.bytes"),
-		readErrors: monitoring.NewUint(reg, "read.errors"),
+		readBytes:  monitoring.NewUint(reg, "read.bytes", monitoring.Counter),
+		readErrors: monitoring.NewUint(reg, "read.errors", monitoring.Counter),
 	}
 }
 
@@ -112,6 +114,16 @@ func (s *Stats) Dropped(n int) {
 	}
 }
 
+// DeadLetter updates the active and dead letter event metrics. Events are
+// reported as dead letter if they have been rejected by the output, but could
+// be stored in the dead letter destination.
+func (s *Stats) DeadLetter(n int) {
+	if s != nil {
+		s.deadLetter.Add(uint64(n))
+		s.active.Sub(uint64(n))
+	}
+}
+
 // Cancelled updates the active event metrics.
 func (s *Stats) Cancelled(n int) {
 	if s != nil {
@@ -153,3 +165,45 @@ func (s *Stats) ReadBytes(n int) {
 		s.readBytes.Add(uint64(n))
 	}
 }
+
+// PipelineStats collects the publisher pipeline metrics of a single output,
+// if the pipeline routes events to multiple outputs.
+type PipelineStats struct {
+	routed  *monitoring.Uint // total number of events routed to the output
+	retry   *monitoring.Uint // total number of events retried by the pipeline
+	dropped *monitoring.Uint // total number of events dropped by the pipeline
+}
+
+// NewPipelineStats creates a new PipelineStats instance using a backing
+// monitoring registry. The metrics are registered with the pipeline.events
+// namespace of the registry passed. The registry must not be null.
+func NewPipelineStats(reg *monitoring.Registry) *PipelineStats {
+	return &PipelineStats{
+		routed:  monitoring.NewUint(reg, "pipeline.events.routed", monitoring.Counter),
+		retry:   monitoring.NewUint(reg, "pipeline.events.retry", monitoring.Counter),
+		dropped: monitoring.NewUint(reg, "pipeline.events.dropped", monitoring.Counter),
+	}
+}
+
+// Routed updates the number of events routed to the output.
+func (s *PipelineStats) Routed(n int) {
+	if s != nil {
+		s.routed.Add(uint64(n))
+	}
+}
+
+// Retry updates the number of events scheduled for retry.
+func (s *PipelineStats) Retry(n int) {
+	if s != nil {
+		s.retry.Add(uint64(n))
+	}
+}
+
+// Dropped updates the number of events dropped by the pipeline, because the
+// output failed to publish them too many times, or too many events are pending
+// for the output.
+func (s *PipelineStats) Dropped(n int) {
+	if s != nil {
+		s.dropped.Add(uint64(n))
+	}
+}
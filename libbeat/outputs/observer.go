@@ -31,6 +31,7 @@ type Observer interface {
 	ReadError(error)  // report an I/O error on read
 	ReadBytes(int)    // report number of bytes being read
 	ErrTooMany(int)   // report too many requests response
+	DeadLetter(int)   // report number of events sent to the dead letter destination
 }
 
 type emptyObserver struct{}
@@ -53,3 +54,4 @@ func (*emptyObserver) WriteBytes(int)   {}
 func (*emptyObserver) ReadError(error)  {}
 func (*emptyObserver) ReadBytes(int)    {}
 func (*emptyObserver) ErrTooMany(int)   {}
+func (*emptyObserver) DeadLetter(int)   {}
@@ -0,0 +1,171 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package decode_cef
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/joeshaw/multierror"
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/libbeat/processors"
+)
+
+const (
+	procName = "decode_cef"
+	logName  = "processor." + procName
+)
+
+func init() {
+	processors.RegisterPlugin(procName, New)
+}
+
+type processor struct {
+	config
+	log *logp.Logger
+}
+
+// New constructs a new processor built from ucfg config.
+func New(cfg *common.Config) (processors.Processor, error) {
+	c := defaultConfig()
+	if err := cfg.Unpack(&c); err != nil {
+		return nil, errors.Wrap(err, "fail to unpack the "+procName+" processor configuration")
+	}
+
+	return newDecodeCEF(c)
+}
+
+func newDecodeCEF(c config) (*processor, error) {
+	cfgwarn.Beta("The " + procName + " processor is beta.")
+
+	log := logp.NewLogger(logName)
+	if c.ID != "" {
+		log = log.With("instance_id", c.ID)
+	}
+
+	return &processor{config: c, log: log}, nil
+}
+
+func (p *processor) String() string {
+	json, _ := json.Marshal(p.config)
+	return procName + "=" + string(json)
+}
+
+func (p *processor) Run(event *beat.Event) (*beat.Event, error) {
+	v, err := event.GetValue(p.Field)
+	if err != nil {
+		if p.IgnoreMissing || p.IgnoreFailure {
+			return event, nil
+		}
+		return event, errors.Wrapf(err, "decode_cef source field [%v] not found", p.Field)
+	}
+
+	text, ok := v.(string)
+	if !ok {
+		if p.IgnoreFailure {
+			return event, nil
+		}
+		return event, errors.Errorf("decode_cef source field [%v] is not a string", p.Field)
+	}
+
+	msg, err := parseCEF(text)
+	if err != nil {
+		if p.IgnoreFailure {
+			return event, nil
+		}
+		return event, errors.Wrapf(err, "failed to parse CEF message from field [%v]", p.Field)
+	}
+
+	cef, ecs, errs := p.decode(msg)
+
+	if _, err = event.PutValue(p.TargetField, cef); err != nil {
+		errs = append(errs, errors.Wrapf(err, "failed to write CEF fields to target field [%v]", p.TargetField))
+	}
+	for field, value := range ecs {
+		if _, err = event.PutValue(field, value); err != nil {
+			errs = append(errs, errors.Wrapf(err, "failed to write field [%v]", field))
+		}
+	}
+
+	if len(errs) > 0 && !p.IgnoreFailure {
+		return event, errs.Err()
+	}
+	return event, nil
+}
+
+// decode builds the fields of the CEF message and the ECS fields mapped from
+// it. Extension values that can't be converted to the type required by the
+// specification are skipped.
+func (p *processor) decode(msg *message) (common.MapStr, map[string]interface{}, multierror.Errors) {
+	var errs multierror.Errors
+
+	extensions := common.MapStr{}
+	var ecs map[string]interface{}
+	if p.ECS {
+		ecs = map[string]interface{}{
+			"observer.vendor":  msg.DeviceVendor,
+			"observer.product": msg.DeviceProduct,
+			"observer.version": msg.DeviceVersion,
+			"event.code":       msg.DeviceEventClassID,
+		}
+		if severity, err := strconv.Atoi(msg.Severity); err == nil {
+			ecs["event.severity"] = severity
+		}
+	}
+
+	for _, ext := range msg.Extensions {
+		key := lookupKey(ext.Key)
+		value, err := key.Type.convert(ext.Value)
+		if err != nil {
+			errs = append(errs, errors.Wrapf(err, "invalid value for CEF extension [%v]", ext.Key))
+			continue
+		}
+
+		extensions[key.Name] = value
+
+		if field, ok := ecsMapping[key.Name]; ok && ecs != nil {
+			switch field {
+			case "network.transport", "network.protocol":
+				value = strings.ToLower(ext.Value)
+			}
+			ecs[field] = value
+		}
+	}
+
+	cef := common.MapStr{
+		"version": msg.Version,
+		"device": common.MapStr{
+			"vendor":         msg.DeviceVendor,
+			"product":        msg.DeviceProduct,
+			"version":        msg.DeviceVersion,
+			"event_class_id": msg.DeviceEventClassID,
+		},
+		"name":     msg.Name,
+		"severity": msg.Severity,
+	}
+	if len(extensions) > 0 {
+		cef["extensions"] = extensions
+	}
+	return cef, ecs, errs
+}
@@ -0,0 +1,178 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package decode_cef
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func TestDecodeCEF(t *testing.T) {
+	input := "CEF:0|Trend Micro|Deep Security Agent|10.0|4000030|Mail Threat Detected|6|" +
+		"src=10.50.10.1 spt=52134 dst=192.168.1.5 dpt=25 proto=TCP in=1024 " +
+		"suser=alice smac=00:0d:60:af:1b:61 rt=1569058934000 act=blocked msg=Malware found cn1=42 cn1Label=risk score"
+
+	p, err := New(common.NewConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	evt := &beat.Event{Fields: common.MapStr{"message": input}}
+	evt, err = p.Run(evt)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, common.MapStr{
+		"cef": common.MapStr{
+			"version": "0",
+			"device": common.MapStr{
+				"vendor":         "Trend Micro",
+				"product":        "Deep Security Agent",
+				"version":        "10.0",
+				"event_class_id": "4000030",
+			},
+			"name":     "Mail Threat Detected",
+			"severity": "6",
+			"extensions": common.MapStr{
+				"sourceAddress":            "10.50.10.1",
+				"sourcePort":               int32(52134),
+				"destinationAddress":       "192.168.1.5",
+				"destinationPort":          int32(25),
+				"transportProtocol":        "TCP",
+				"bytesIn":                  int32(1024),
+				"sourceUserName":           "alice",
+				"sourceMacAddress":         "00-0D-60-AF-1B-61",
+				"deviceReceiptTime":        time.Date(2019, 9, 21, 9, 42, 14, 0, time.UTC),
+				"deviceAction":             "blocked",
+				"message":                  "Malware found",
+				"deviceCustomNumber1":      int64(42),
+				"deviceCustomNumber1Label": "risk score",
+			},
+		},
+		"observer": common.MapStr{
+			"vendor":  "Trend Micro",
+			"product": "Deep Security Agent",
+			"version": "10.0",
+		},
+		"event": common.MapStr{
+			"code":     "4000030",
+			"severity": 6,
+			"action":   "blocked",
+		},
+		"source": common.MapStr{
+			"ip":    "10.50.10.1",
+			"port":  int32(52134),
+			"bytes": int32(1024),
+			"mac":   "00-0D-60-AF-1B-61",
+			"user":  common.MapStr{"name": "alice"},
+		},
+		"destination": common.MapStr{
+			"ip":   "192.168.1.5",
+			"port": int32(25),
+		},
+		"network": common.MapStr{
+			"transport": "tcp",
+		},
+		"message": "Malware found",
+	}, evt.Fields)
+}
+
+func TestDecodeCEFWithoutECS(t *testing.T) {
+	input := "CEF:0|Security|threatmanager|1.0|100|worm successfully stopped|10|src=10.0.0.1 custom.key=value"
+
+	p, err := New(common.MustNewConfigFrom(map[string]interface{}{
+		"field":        "log",
+		"target_field": "decoded",
+		"ecs":          false,
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	evt := &beat.Event{Fields: common.MapStr{"log": input}}
+	evt, err = p.Run(evt)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, common.MapStr{
+		"log": input,
+		"decoded": common.MapStr{
+			"version": "0",
+			"device": common.MapStr{
+				"vendor":         "Security",
+				"product":        "threatmanager",
+				"version":        "1.0",
+				"event_class_id": "100",
+			},
+			"name":     "worm successfully stopped",
+			"severity": "10",
+			"extensions": common.MapStr{
+				"sourceAddress": "10.0.0.1",
+				"custom.key":    "value",
+			},
+		},
+	}, evt.Fields)
+}
+
+func TestDecodeCEFInvalidValue(t *testing.T) {
+	input := "CEF:0|Security|threatmanager|1.0|100|worm successfully stopped|10|src=not-an-ip dst=10.0.0.2"
+
+	p, err := New(common.MustNewConfigFrom(map[string]interface{}{
+		"ecs": false,
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	evt := &beat.Event{Fields: common.MapStr{"message": input}}
+	evt, err = p.Run(evt)
+	assert.Error(t, err)
+
+	// Valid values are decoded in any case
+	extensions, err := evt.GetValue("cef.extensions")
+	if assert.NoError(t, err) {
+		assert.Equal(t, common.MapStr{"destinationAddress": "10.0.0.2"}, extensions)
+	}
+}
+
+func TestDecodeCEFMissingField(t *testing.T) {
+	p, err := New(common.MustNewConfigFrom(map[string]interface{}{
+		"ignore_missing": true,
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	evt := &beat.Event{Fields: common.MapStr{"other": "value"}}
+	_, err = p.Run(evt)
+	assert.NoError(t, err)
+
+	p, err = New(common.NewConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = p.Run(evt)
+	assert.Error(t, err)
+}
@@ -0,0 +1,305 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package decode_cef
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// dataType is the type of a CEF extension value.
+type dataType uint8
+
+const (
+	stringType dataType = iota
+	integerType
+	longType
+	floatType
+	ipType
+	macType
+	timestampType
+)
+
+// extensionKey describes a key defined by the CEF specification.
+type extensionKey struct {
+	// Name is the full name of the key, used as field name.
+	Name string
+	Type dataType
+}
+
+// extensionKeys contains the keys defined by the CEF specification by their
+// short name. Keys can be used by their full name too.
+var extensionKeys = map[string]extensionKey{
+	"act":                          {"deviceAction", stringType},
+	"agentDnsDomain":               {"agentDnsDomain", stringType},
+	"agentNtDomain":                {"agentNtDomain", stringType},
+	"agt":                          {"agentAddress", ipType},
+	"ahost":                        {"agentHostName", stringType},
+	"aid":                          {"agentId", stringType},
+	"amac":                         {"agentMacAddress", macType},
+	"app":                          {"applicationProtocol", stringType},
+	"art":                          {"agentReceiptTime", timestampType},
+	"at":                           {"agentType", stringType},
+	"atz":                          {"agentTimeZone", stringType},
+	"av":                           {"agentVersion", stringType},
+	"c6a1":                         {"deviceCustomIPv6Address1", ipType},
+	"c6a1Label":                    {"deviceCustomIPv6Address1Label", stringType},
+	"c6a2":                         {"deviceCustomIPv6Address2", ipType},
+	"c6a2Label":                    {"deviceCustomIPv6Address2Label", stringType},
+	"c6a3":                         {"deviceCustomIPv6Address3", ipType},
+	"c6a3Label":                    {"deviceCustomIPv6Address3Label", stringType},
+	"c6a4":                         {"deviceCustomIPv6Address4", ipType},
+	"c6a4Label":                    {"deviceCustomIPv6Address4Label", stringType},
+	"cat":                          {"deviceEventCategory", stringType},
+	"cfp1":                         {"deviceCustomFloatingPoint1", floatType},
+	"cfp1Label":                    {"deviceCustomFloatingPoint1Label", stringType},
+	"cfp2":                         {"deviceCustomFloatingPoint2", floatType},
+	"cfp2Label":                    {"deviceCustomFloatingPoint2Label", stringType},
+	"cfp3":                         {"deviceCustomFloatingPoint3", floatType},
+	"cfp3Label":                    {"deviceCustomFloatingPoint3Label", stringType},
+	"cfp4":                         {"deviceCustomFloatingPoint4", floatType},
+	"cfp4Label":                    {"deviceCustomFloatingPoint4Label", stringType},
+	"cn1":                          {"deviceCustomNumber1", longType},
+	"cn1Label":                     {"deviceCustomNumber1Label", stringType},
+	"cn2":                          {"deviceCustomNumber2", longType},
+	"cn2Label":                     {"deviceCustomNumber2Label", stringType},
+	"cn3":                          {"deviceCustomNumber3", longType},
+	"cn3Label":                     {"deviceCustomNumber3Label", stringType},
+	"cnt":                          {"baseEventCount", integerType},
+	"cs1":                          {"deviceCustomString1", stringType},
+	"cs1Label":                     {"deviceCustomString1Label", stringType},
+	"cs2":                          {"deviceCustomString2", stringType},
+	"cs2Label":                     {"deviceCustomString2Label", stringType},
+	"cs3":                          {"deviceCustomString3", stringType},
+	"cs3Label":                     {"deviceCustomString3Label", stringType},
+	"cs4":                          {"deviceCustomString4", stringType},
+	"cs4Label":                     {"deviceCustomString4Label", stringType},
+	"cs5":                          {"deviceCustomString5", stringType},
+	"cs5Label":                     {"deviceCustomString5Label", stringType},
+	"cs6":                          {"deviceCustomString6", stringType},
+	"cs6Label":                     {"deviceCustomString6Label", stringType},
+	"destinationDnsDomain":         {"destinationDnsDomain", stringType},
+	"destinationServiceName":       {"destinationServiceName", stringType},
+	"destinationTranslatedAddress": {"destinationTranslatedAddress", ipType},
+	"destinationTranslatedPort":    {"destinationTranslatedPort", integerType},
+	"deviceCustomDate1":            {"deviceCustomDate1", timestampType},
+	"deviceCustomDate1Label":       {"deviceCustomDate1Label", stringType},
+	"deviceCustomDate2":            {"deviceCustomDate2", timestampType},
+	"deviceCustomDate2Label":       {"deviceCustomDate2Label", stringType},
+	"deviceDirection":              {"deviceDirection", integerType},
+	"deviceDnsDomain":              {"deviceDnsDomain", stringType},
+	"deviceExternalId":             {"deviceExternalId", stringType},
+	"deviceFacility":               {"deviceFacility", stringType},
+	"deviceInboundInterface":       {"deviceInboundInterface", stringType},
+	"deviceNtDomain":               {"deviceNtDomain", stringType},
+	"deviceOutboundInterface":      {"deviceOutboundInterface", stringType},
+	"devicePayloadId":              {"devicePayloadId", stringType},
+	"deviceProcessName":            {"deviceProcessName", stringType},
+	"deviceTranslatedAddress":      {"deviceTranslatedAddress", ipType},
+	"dhost":                        {"destinationHostName", stringType},
+	"dmac":                         {"destinationMacAddress", macType},
+	"dntdom":                       {"destinationNtDomain", stringType},
+	"dpid":                         {"destinationProcessId", integerType},
+	"dpriv":                        {"destinationUserPrivileges", stringType},
+	"dproc":                        {"destinationProcessName", stringType},
+	"dpt":                          {"destinationPort", integerType},
+	"dst":                          {"destinationAddress", ipType},
+	"dtz":                          {"deviceTimeZone", stringType},
+	"duid":                         {"destinationUserId", stringType},
+	"duser":                        {"destinationUserName", stringType},
+	"dvc":                          {"deviceAddress", ipType},
+	"dvchost":                      {"deviceHostName", stringType},
+	"dvcmac":                       {"deviceMacAddress", macType},
+	"dvcpid":                       {"deviceProcessId", integerType},
+	"end":                          {"endTime", timestampType},
+	"externalId":                   {"externalId", stringType},
+	"fileCreateTime":               {"fileCreateTime", timestampType},
+	"fileHash":                     {"fileHash", stringType},
+	"fileId":                       {"fileId", stringType},
+	"fileModificationTime":         {"fileModificationTime", timestampType},
+	"filePath":                     {"filePath", stringType},
+	"filePermission":               {"filePermission", stringType},
+	"fileType":                     {"fileType", stringType},
+	"flexDate1":                    {"flexDate1", timestampType},
+	"flexDate1Label":               {"flexDate1Label", stringType},
+	"flexString1":                  {"flexString1", stringType},
+	"flexString1Label":             {"flexString1Label", stringType},
+	"flexString2":                  {"flexString2", stringType},
+	"flexString2Label":             {"flexString2Label", stringType},
+	"fname":                        {"fileName", stringType},
+	"fsize":                        {"fileSize", integerType},
+	"in":                           {"bytesIn", integerType},
+	"msg":                          {"message", stringType},
+	"oldFileCreateTime":            {"oldFileCreateTime", timestampType},
+	"oldFileHash":                  {"oldFileHash", stringType},
+	"oldFileId":                    {"oldFileId", stringType},
+	"oldFileModificationTime":      {"oldFileModificationTime", timestampType},
+	"oldFileName":                  {"oldFileName", stringType},
+	"oldFilePath":                  {"oldFilePath", stringType},
+	"oldFilePermission":            {"oldFilePermission", stringType},
+	"oldFileSize":                  {"oldFileSize", integerType},
+	"oldFileType":                  {"oldFileType", stringType},
+	"out":                          {"bytesOut", integerType},
+	"outcome":                      {"eventOutcome", stringType},
+	"proto":                        {"transportProtocol", stringType},
+	"reason":                       {"Reason", stringType},
+	"request":                      {"requestUrl", stringType},
+	"requestClientApplication":     {"requestClientApplication", stringType},
+	"requestContext":               {"requestContext", stringType},
+	"requestCookies":               {"requestCookies", stringType},
+	"requestMethod":                {"requestMethod", stringType},
+	"rt":                           {"deviceReceiptTime", timestampType},
+	"shost":                        {"sourceHostName", stringType},
+	"smac":                         {"sourceMacAddress", macType},
+	"sntdom":                       {"sourceNtDomain", stringType},
+	"sourceDnsDomain":              {"sourceDnsDomain", stringType},
+	"sourceServiceName":            {"sourceServiceName", stringType},
+	"sourceTranslatedAddress":      {"sourceTranslatedAddress", ipType},
+	"sourceTranslatedPort":         {"sourceTranslatedPort", integerType},
+	"spid":                         {"sourceProcessId", integerType},
+	"spriv":                        {"sourceUserPrivileges", stringType},
+	"sproc":                        {"sourceProcessName", stringType},
+	"spt":                          {"sourcePort", integerType},
+	"src":                          {"sourceAddress", ipType},
+	"start":                        {"startTime", timestampType},
+	"suid":                         {"sourceUserId", stringType},
+	"suser":                        {"sourceUserName", stringType},
+	"type":                         {"type", integerType},
+}
+
+// fullNameKeys contains the keys defined by the CEF specification by their full name.
+var fullNameKeys = func() map[string]extensionKey {
+	keys := make(map[string]extensionKey, len(extensionKeys))
+	for _, key := range extensionKeys {
+		keys[key.Name] = key
+	}
+	return keys
+}()
+
+// lookupKey returns the definition of an extension key. Custom keys not
+// defined by the specification are returned as strings with their own name.
+func lookupKey(key string) extensionKey {
+	if k, ok := extensionKeys[key]; ok {
+		return k
+	}
+	if k, ok := fullNameKeys[key]; ok {
+		return k
+	}
+	return extensionKey{Name: key, Type: stringType}
+}
+
+// timeLayouts are the formats of timestamps allowed by the CEF specification,
+// timestamps can also be given in milliseconds since epoch.
+var timeLayouts = []string{
+	"Jan _2 2006 15:04:05.000 MST",
+	"Jan _2 2006 15:04:05 MST",
+	"Jan _2 2006 15:04:05.000",
+	"Jan _2 2006 15:04:05",
+	"Jan _2 15:04:05.000 MST",
+	"Jan _2 15:04:05 MST",
+	"Jan _2 15:04:05.000",
+	"Jan _2 15:04:05",
+}
+
+// convert converts the value of an extension to its data type.
+func (t dataType) convert(value string) (interface{}, error) {
+	switch t {
+	case integerType:
+		v, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		return int32(v), nil
+	case longType:
+		return strconv.ParseInt(value, 10, 64)
+	case floatType:
+		return strconv.ParseFloat(value, 64)
+	case ipType:
+		ip := net.ParseIP(value)
+		if ip == nil {
+			return nil, fmt.Errorf("invalid IP address '%s'", value)
+		}
+		return ip.String(), nil
+	case macType:
+		mac, err := net.ParseMAC(value)
+		if err != nil {
+			return nil, err
+		}
+		return strings.ToUpper(strings.Replace(mac.String(), ":", "-", -1)), nil
+	case timestampType:
+		return parseTimestamp(value)
+	default:
+		return value, nil
+	}
+}
+
+func parseTimestamp(value string) (time.Time, error) {
+	if millis, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Unix(0, millis*int64(time.Millisecond)).UTC(), nil
+	}
+
+	for _, layout := range timeLayouts {
+		ts, err := time.Parse(layout, value)
+		if err != nil {
+			continue
+		}
+		if ts.Year() == 0 {
+			// Timestamps without year are assumed to be from the current year.
+			ts = ts.AddDate(time.Now().Year(), 0, 0)
+		}
+		return ts.UTC(), nil
+	}
+	return time.Time{}, fmt.Errorf("invalid timestamp '%s'", value)
+}
+
+// ecsMapping contains the ECS fields set from extensions by their full name.
+var ecsMapping = map[string]string{
+	"applicationProtocol":      "network.protocol",
+	"bytesIn":                  "source.bytes",
+	"bytesOut":                 "destination.bytes",
+	"destinationAddress":       "destination.ip",
+	"destinationHostName":      "destination.domain",
+	"destinationMacAddress":    "destination.mac",
+	"destinationPort":          "destination.port",
+	"destinationUserId":        "destination.user.id",
+	"destinationUserName":      "destination.user.name",
+	"deviceAction":             "event.action",
+	"deviceAddress":            "observer.ip",
+	"deviceHostName":           "observer.hostname",
+	"deviceMacAddress":         "observer.mac",
+	"endTime":                  "event.end",
+	"eventOutcome":             "event.outcome",
+	"fileName":                 "file.name",
+	"filePath":                 "file.path",
+	"fileSize":                 "file.size",
+	"message":                  "message",
+	"requestClientApplication": "user_agent.original",
+	"requestMethod":            "http.request.method",
+	"requestUrl":               "url.original",
+	"sourceAddress":            "source.ip",
+	"sourceHostName":           "source.domain",
+	"sourceMacAddress":         "source.mac",
+	"sourcePort":               "source.port",
+	"sourceUserId":             "source.user.id",
+	"sourceUserName":           "source.user.name",
+	"startTime":                "event.start",
+	"transportProtocol":        "network.transport",
+}
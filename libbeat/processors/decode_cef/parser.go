@@ -0,0 +1,215 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package decode_cef
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const cefPrefix = "CEF:"
+
+// message is a parsed CEF message.
+type message struct {
+	Version            string
+	DeviceVendor       string
+	DeviceProduct      string
+	DeviceVersion      string
+	DeviceEventClassID string
+	Name               string
+	Severity           string
+
+	// Extensions contains the extension values by key as found in the message.
+	Extensions []extension
+}
+
+type extension struct {
+	Key   string
+	Value string
+}
+
+var errNoCEF = errors.New("message is not in CEF format")
+
+// parseCEF parses a CEF message. Any content before the "CEF:" prefix, like
+// a syslog header, is ignored.
+//
+//   CEF:Version|Device Vendor|Device Product|Device Version|Device Event Class ID|Name|Severity|[Extension]
+func parseCEF(data string) (*message, error) {
+	start := strings.Index(data, cefPrefix)
+	if start < 0 {
+		return nil, errNoCEF
+	}
+	data = data[start+len(cefPrefix):]
+
+	header, rest, err := splitHeader(data)
+	if err != nil {
+		return nil, err
+	}
+
+	extensions, err := parseExtensions(rest)
+	if err != nil {
+		return nil, err
+	}
+
+	return &message{
+		Version:            header[0],
+		DeviceVendor:       header[1],
+		DeviceProduct:      header[2],
+		DeviceVersion:      header[3],
+		DeviceEventClassID: header[4],
+		Name:               header[5],
+		Severity:           header[6],
+		Extensions:         extensions,
+	}, nil
+}
+
+const headerFields = 7
+
+// splitHeader splits the pipe separated header fields and returns the
+// unescaped header values and the remaining extension.
+func splitHeader(data string) ([]string, string, error) {
+	header := make([]string, 0, headerFields)
+
+	var value strings.Builder
+	for i := 0; i < len(data); i++ {
+		switch c := data[i]; c {
+		case '\\':
+			if i+1 < len(data) && (data[i+1] == '\\' || data[i+1] == '|') {
+				i++
+				value.WriteByte(data[i])
+			} else {
+				value.WriteByte(c)
+			}
+		case '|':
+			header = append(header, strings.TrimSpace(value.String()))
+			value.Reset()
+			if len(header) == headerFields {
+				return header, data[i+1:], nil
+			}
+		default:
+			value.WriteByte(c)
+		}
+	}
+
+	// The extension is optional, but the pipe after the severity is required.
+	return nil, "", fmt.Errorf("CEF header is incomplete, found %d of %d fields", len(header), headerFields)
+}
+
+// parseExtensions parses the space separated key=value pairs of the
+// extension. Values may contain spaces, a value ends where the next key
+// starts. Equal signs in values should be escaped, unescaped ones that don't
+// follow a space separated key are kept in the value.
+func parseExtensions(data string) ([]extension, error) {
+	type delimiter struct {
+		keyStart, equal int
+	}
+
+	var delimiters []delimiter
+	for i := 0; i < len(data); i++ {
+		switch data[i] {
+		case '\\':
+			// skip escaped character
+			i++
+		case '=':
+			keyStart := i
+			for keyStart > 0 && data[keyStart-1] != ' ' {
+				keyStart--
+			}
+
+			isKey := keyStart < i && isValidKey(data[keyStart:i])
+			if len(delimiters) == 0 {
+				if !isKey {
+					return nil, fmt.Errorf("CEF extension has an invalid key at position %d", keyStart)
+				}
+			} else if !isKey || keyStart <= delimiters[len(delimiters)-1].equal {
+				// Part of the previous value.
+				continue
+			}
+			delimiters = append(delimiters, delimiter{keyStart: keyStart, equal: i})
+		}
+	}
+
+	if len(delimiters) == 0 {
+		if strings.TrimSpace(data) != "" {
+			return nil, errors.New("CEF extension does not contain any key=value pair")
+		}
+		return nil, nil
+	}
+	if strings.TrimSpace(data[:delimiters[0].keyStart]) != "" {
+		return nil, errors.New("CEF extension does not start with a key")
+	}
+
+	extensions := make([]extension, 0, len(delimiters))
+	for n, d := range delimiters {
+		end := len(data)
+		if n+1 < len(delimiters) {
+			end = delimiters[n+1].keyStart
+		}
+		extensions = append(extensions, extension{
+			Key:   data[d.keyStart:d.equal],
+			Value: unescapeValue(strings.TrimRight(data[d.equal+1:end], " ")),
+		})
+	}
+	return extensions, nil
+}
+
+// isValidKey returns true if key only contains the characters allowed in
+// extension keys.
+func isValidKey(key string) bool {
+	for i := 0; i < len(key); i++ {
+		c := key[i]
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9':
+		case c == '_', c == '.', c == '-', c == '[', c == ']':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// unescapeValue removes the escaping of extension values.
+func unescapeValue(value string) string {
+	if !strings.Contains(value, `\`) {
+		return value
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		if c != '\\' || i+1 == len(value) {
+			b.WriteByte(c)
+			continue
+		}
+
+		i++
+		switch value[i] {
+		case 'n':
+			b.WriteByte('\n')
+		case 'r':
+			b.WriteByte('\r')
+		case '=', '\\', '|':
+			b.WriteByte(value[i])
+		default:
+			b.WriteByte(c)
+			b.WriteByte(value[i])
+		}
+	}
+	return b.String()
+}
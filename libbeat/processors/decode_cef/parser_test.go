@@ -0,0 +1,150 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package decode_cef
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCEF(t *testing.T) {
+	tests := map[string]struct {
+		input    string
+		expected *message
+	}{
+		"header only": {
+			input: "CEF:0|Security|threatmanager|1.0|100|worm successfully stopped|10|",
+			expected: &message{
+				Version:            "0",
+				DeviceVendor:       "Security",
+				DeviceProduct:      "threatmanager",
+				DeviceVersion:      "1.0",
+				DeviceEventClassID: "100",
+				Name:               "worm successfully stopped",
+				Severity:           "10",
+			},
+		},
+		"syslog prefix and extensions": {
+			input: "Sep 19 08:26:10 host CEF:0|Security|threatmanager|1.0|100|worm successfully stopped|10|src=10.0.0.1 dst=2.1.2.2 spt=1232",
+			expected: &message{
+				Version:            "0",
+				DeviceVendor:       "Security",
+				DeviceProduct:      "threatmanager",
+				DeviceVersion:      "1.0",
+				DeviceEventClassID: "100",
+				Name:               "worm successfully stopped",
+				Severity:           "10",
+				Extensions: []extension{
+					{"src", "10.0.0.1"},
+					{"dst", "2.1.2.2"},
+					{"spt", "1232"},
+				},
+			},
+		},
+		"escaped header": {
+			input: `CEF:0|security|threat\|manager|1.0|100|detected a \\ in message|10|`,
+			expected: &message{
+				Version:            "0",
+				DeviceVendor:       "security",
+				DeviceProduct:      "threat|manager",
+				DeviceVersion:      "1.0",
+				DeviceEventClassID: "100",
+				Name:               `detected a \ in message`,
+				Severity:           "10",
+			},
+		},
+		"extension values with spaces and escapes": {
+			input: `CEF:0|security|threatmanager|1.0|100|detected|10|msg=Detected a threat.\nNo action needed. act=blocked a \= sign cs1=a|b cs1Label=with pipe`,
+			expected: &message{
+				Version:            "0",
+				DeviceVendor:       "security",
+				DeviceProduct:      "threatmanager",
+				DeviceVersion:      "1.0",
+				DeviceEventClassID: "100",
+				Name:               "detected",
+				Severity:           "10",
+				Extensions: []extension{
+					{"msg", "Detected a threat.\nNo action needed."},
+					{"act", "blocked a = sign"},
+					{"cs1", "a|b"},
+					{"cs1Label", "with pipe"},
+				},
+			},
+		},
+		"empty extension value": {
+			input: "CEF:1|vendor|product|1|id|name|Low|suser= duser=bob",
+			expected: &message{
+				Version:            "1",
+				DeviceVendor:       "vendor",
+				DeviceProduct:      "product",
+				DeviceVersion:      "1",
+				DeviceEventClassID: "id",
+				Name:               "name",
+				Severity:           "Low",
+				Extensions: []extension{
+					{"suser", ""},
+					{"duser", "bob"},
+				},
+			},
+		},
+		"unescaped equal signs in values": {
+			input: "CEF:0|vendor|product|1|id|name|Low|request=http://x?a=b src=1.2.3.4 msg=x=1 y?=2",
+			expected: &message{
+				Version:            "0",
+				DeviceVendor:       "vendor",
+				DeviceProduct:      "product",
+				DeviceVersion:      "1",
+				DeviceEventClassID: "id",
+				Name:               "name",
+				Severity:           "Low",
+				Extensions: []extension{
+					{"request", "http://x?a=b"},
+					{"src", "1.2.3.4"},
+					{"msg", "x=1 y?=2"},
+				},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			msg, err := parseCEF(test.input)
+			if assert.NoError(t, err) {
+				assert.Equal(t, test.expected, msg)
+			}
+		})
+	}
+}
+
+func TestParseCEFErrors(t *testing.T) {
+	tests := map[string]string{
+		"no CEF":             "this is not a CEF message",
+		"incomplete header":  "CEF:0|Security|threatmanager|1.0|100|worm successfully stopped|10",
+		"extension no pairs": "CEF:0|Security|threatmanager|1.0|100|name|10|garbage",
+		"extension no key":   "CEF:0|Security|threatmanager|1.0|100|name|10|=value",
+		"text before key":    "CEF:0|Security|threatmanager|1.0|100|name|10|garbage src=10.0.0.1",
+	}
+
+	for name, input := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := parseCEF(input)
+			assert.Error(t, err)
+		})
+	}
+}
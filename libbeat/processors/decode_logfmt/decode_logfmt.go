@@ -0,0 +1,120 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package decode_logfmt
+
+import (
+	"encoding/json"
+
+	"github.com/joeshaw/multierror"
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/libbeat/processors"
+)
+
+const (
+	procName = "decode_logfmt"
+	logName  = "processor." + procName
+)
+
+func init() {
+	processors.RegisterPlugin(procName, New)
+}
+
+type processor struct {
+	config
+	log *logp.Logger
+}
+
+// New constructs a new processor built from ucfg config.
+func New(cfg *common.Config) (processors.Processor, error) {
+	c := defaultConfig()
+	if err := cfg.Unpack(&c); err != nil {
+		return nil, errors.Wrap(err, "fail to unpack the "+procName+" processor configuration")
+	}
+
+	return newDecodeLogfmt(c)
+}
+
+func newDecodeLogfmt(c config) (*processor, error) {
+	cfgwarn.Beta("The " + procName + " processor is beta.")
+
+	log := logp.NewLogger(logName)
+	if c.ID != "" {
+		log = log.With("instance_id", c.ID)
+	}
+
+	return &processor{config: c, log: log}, nil
+}
+
+func (p *processor) String() string {
+	json, _ := json.Marshal(p.config)
+	return procName + "=" + string(json)
+}
+
+func (p *processor) Run(event *beat.Event) (*beat.Event, error) {
+	v, err := event.GetValue(p.Field)
+	if err != nil {
+		if p.IgnoreMissing || p.IgnoreFailure {
+			return event, nil
+		}
+		return event, errors.Wrapf(err, "decode_logfmt source field [%v] not found", p.Field)
+	}
+
+	text, ok := v.(string)
+	if !ok {
+		if p.IgnoreFailure {
+			return event, nil
+		}
+		return event, errors.Errorf("decode_logfmt source field [%v] is not a string", p.Field)
+	}
+
+	pairs, err := parseLogfmt(text)
+	if err != nil {
+		if p.IgnoreFailure {
+			return event, nil
+		}
+		return event, errors.Wrapf(err, "failed to parse logfmt from field [%v]", p.Field)
+	}
+
+	var errs multierror.Errors
+	for _, pair := range pairs {
+		field := pair.Key
+		if p.TargetField != "" {
+			field = p.TargetField + "." + pair.Key
+		}
+
+		if !p.OverwriteKeys {
+			if _, err = event.GetValue(field); err == nil {
+				errs = append(errs, errors.Errorf("target field [%v] already has a value, set the overwrite_keys flag or drop/rename the field first", field))
+				continue
+			}
+		}
+		if _, err = event.PutValue(field, pair.Value); err != nil {
+			errs = append(errs, errors.Wrapf(err, "failed to write field [%v]", field))
+		}
+	}
+
+	if len(errs) > 0 && !p.IgnoreFailure {
+		return event, errs.Err()
+	}
+	return event, nil
+}
@@ -0,0 +1,140 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package decode_logfmt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func TestDecodeLogfmt(t *testing.T) {
+	tests := map[string]struct {
+		config   map[string]interface{}
+		input    common.MapStr
+		expected common.MapStr
+		fail     bool
+	}{
+		"decode to root": {
+			input: common.MapStr{
+				"message": `level=info msg="user logged in" user.name=alice`,
+			},
+			expected: common.MapStr{
+				"message": `level=info msg="user logged in" user.name=alice`,
+				"level":   "info",
+				"msg":     "user logged in",
+				"user":    common.MapStr{"name": "alice"},
+			},
+		},
+		"decode to target field": {
+			config: map[string]interface{}{
+				"field":        "log.original",
+				"target_field": "logfmt",
+			},
+			input: common.MapStr{
+				"log": common.MapStr{"original": "level=warn retry"},
+			},
+			expected: common.MapStr{
+				"log":    common.MapStr{"original": "level=warn retry"},
+				"logfmt": common.MapStr{"level": "warn", "retry": true},
+			},
+		},
+		"existing keys are kept": {
+			input: common.MapStr{
+				"message": "message=replaced level=info",
+			},
+			expected: common.MapStr{
+				"message": "message=replaced level=info",
+				"level":   "info",
+			},
+			fail: true,
+		},
+		"overwrite keys": {
+			config: map[string]interface{}{
+				"overwrite_keys": true,
+			},
+			input: common.MapStr{
+				"message": "message=replaced level=info",
+			},
+			expected: common.MapStr{
+				"message": "replaced",
+				"level":   "info",
+			},
+		},
+		"invalid logfmt": {
+			input: common.MapStr{
+				"message": `msg="unterminated`,
+			},
+			expected: common.MapStr{
+				"message": `msg="unterminated`,
+			},
+			fail: true,
+		},
+		"ignore failure": {
+			config: map[string]interface{}{
+				"ignore_failure": true,
+			},
+			input: common.MapStr{
+				"message": `msg="unterminated`,
+			},
+			expected: common.MapStr{
+				"message": `msg="unterminated`,
+			},
+		},
+		"missing field": {
+			input: common.MapStr{
+				"other": "level=info",
+			},
+			expected: common.MapStr{
+				"other": "level=info",
+			},
+			fail: true,
+		},
+		"ignore missing field": {
+			config: map[string]interface{}{
+				"ignore_missing": true,
+			},
+			input: common.MapStr{
+				"other": "level=info",
+			},
+			expected: common.MapStr{
+				"other": "level=info",
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			p, err := New(common.MustNewConfigFrom(test.config))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			evt, err := p.Run(&beat.Event{Fields: test.input})
+			if test.fail {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.expected, evt.Fields)
+		})
+	}
+}
@@ -0,0 +1,111 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package decode_logfmt
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// pair is a key and its value as found in a logfmt line. Keys without value
+// have a true boolean value.
+type pair struct {
+	Key   string
+	Value interface{}
+}
+
+// parseLogfmt parses a line of logfmt formatted text. Pairs are separated by
+// spaces and have the form key=value, values containing spaces must be
+// quoted. Quoted values support the same escape sequences as Go strings.
+func parseLogfmt(data string) ([]pair, error) {
+	var pairs []pair
+
+	for i := 0; i < len(data); {
+		if isSpace(data[i]) {
+			i++
+			continue
+		}
+
+		start := i
+		for i < len(data) && isKeyChar(data[i]) {
+			i++
+		}
+		key := data[start:i]
+		if key == "" {
+			return nil, errors.Errorf("unexpected character '%c' at position %d, expected a key", data[i], i)
+		}
+
+		if i == len(data) || isSpace(data[i]) {
+			pairs = append(pairs, pair{Key: key, Value: true})
+			continue
+		}
+		if data[i] != '=' {
+			return nil, errors.Errorf("unexpected character '%c' at position %d in key [%v]", data[i], i, key)
+		}
+		i++
+
+		if i < len(data) && data[i] == '"' {
+			end, err := quotedValueEnd(data, i)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid value for key [%v]", key)
+			}
+			value, err := strconv.Unquote(data[i:end])
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid value for key [%v]", key)
+			}
+			pairs = append(pairs, pair{Key: key, Value: value})
+			i = end
+			continue
+		}
+
+		start = i
+		for i < len(data) && !isSpace(data[i]) {
+			if data[i] == '"' {
+				return nil, errors.Errorf("unexpected quote at position %d in value for key [%v]", i, key)
+			}
+			i++
+		}
+		pairs = append(pairs, pair{Key: key, Value: data[start:i]})
+	}
+
+	return pairs, nil
+}
+
+// quotedValueEnd returns the position after the closing quote of the quoted
+// value starting at start.
+func quotedValueEnd(data string, start int) (int, error) {
+	for i := start + 1; i < len(data); i++ {
+		switch data[i] {
+		case '\\':
+			i++
+		case '"':
+			return i + 1, nil
+		}
+	}
+	return 0, errors.Errorf("unterminated quoted value starting at position %d", start)
+}
+
+func isSpace(c byte) bool {
+	return strings.IndexByte(" \t\r\n", c) >= 0
+}
+
+func isKeyChar(c byte) bool {
+	return c > ' ' && c != '=' && c != '"' && c != 0x7f
+}
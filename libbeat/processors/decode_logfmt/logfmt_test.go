@@ -0,0 +1,108 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package decode_logfmt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLogfmt(t *testing.T) {
+	tests := map[string]struct {
+		input    string
+		expected []pair
+	}{
+		"empty": {
+			input: "",
+		},
+		"simple pairs": {
+			input: "level=info msg=started port=8080",
+			expected: []pair{
+				{"level", "info"},
+				{"msg", "started"},
+				{"port", "8080"},
+			},
+		},
+		"quoted values": {
+			input: `msg="request completed" path="/a b" err="file \"x\" not found\n"`,
+			expected: []pair{
+				{"msg", "request completed"},
+				{"path", "/a b"},
+				{"err", "file \"x\" not found\n"},
+			},
+		},
+		"keys without value": {
+			input: "debug verbose level=debug",
+			expected: []pair{
+				{"debug", true},
+				{"verbose", true},
+				{"level", "debug"},
+			},
+		},
+		"empty values": {
+			input: `a= b="" c=1`,
+			expected: []pair{
+				{"a", ""},
+				{"b", ""},
+				{"c", "1"},
+			},
+		},
+		"extra whitespace": {
+			input: "  \tts=2019-10-01T10:00:00Z   caller=main.go:42\t",
+			expected: []pair{
+				{"ts", "2019-10-01T10:00:00Z"},
+				{"caller", "main.go:42"},
+			},
+		},
+		"dotted keys": {
+			input: "http.method=GET http.status=200",
+			expected: []pair{
+				{"http.method", "GET"},
+				{"http.status", "200"},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			pairs, err := parseLogfmt(test.input)
+			if assert.NoError(t, err) {
+				assert.Equal(t, test.expected, pairs)
+			}
+		})
+	}
+}
+
+func TestParseLogfmtErrors(t *testing.T) {
+	tests := map[string]string{
+		"missing key":       "=value",
+		"unterminated":      `msg="not closed`,
+		"escaped end quote": `msg="not closed\"`,
+		"quote in key":      `ke"y=value`,
+		"quote in value":    `key=va"lue"`,
+		"invalid escape":    `msg="bad \q escape"`,
+	}
+
+	for name, input := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := parseLogfmt(input)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,100 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fingerprint
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"strings"
+
+	"github.com/OneOfOne/xxhash"
+)
+
+type config struct {
+	Fields        []string `config:"fields" validate:"required"`
+	TargetField   string   `config:"target_field"`
+	Method        method   `config:"method"`
+	Encoding      encoding `config:"encoding"`
+	Key           string   `config:"key"`
+	IgnoreMissing bool     `config:"ignore_missing"`
+}
+
+// method is the hash function used to compute the fingerprint.
+type method struct {
+	name string
+	new  func() hash.Hash
+}
+
+// encoding is the function used to encode the raw hash as string.
+type encoding struct {
+	name   string
+	encode func([]byte) string
+}
+
+var methods = map[string]func() hash.Hash{
+	"md5":    md5.New,
+	"sha1":   sha1.New,
+	"sha256": sha256.New,
+	"xxhash": func() hash.Hash { return xxhash.New64() },
+}
+
+var encodings = map[string]func([]byte) string{
+	"hex":    hex.EncodeToString,
+	"base32": base32.StdEncoding.EncodeToString,
+	"base64": base64.StdEncoding.EncodeToString,
+}
+
+func defaultConfig() config {
+	return config{
+		TargetField: "fingerprint",
+		Method:      method{name: "sha256", new: sha256.New},
+		Encoding:    encoding{name: "hex", encode: hex.EncodeToString},
+	}
+}
+
+// Unpack selects the hash function by name.
+func (m *method) Unpack(s string) error {
+	name := strings.ToLower(s)
+	fn, found := methods[name]
+	if !found {
+		return fmt.Errorf("invalid fingerprint method '%v', expected one of md5, sha1, sha256, xxhash", s)
+	}
+	*m = method{name: name, new: fn}
+	return nil
+}
+
+func (m method) String() string { return m.name }
+
+// Unpack selects the encoding by name.
+func (e *encoding) Unpack(s string) error {
+	name := strings.ToLower(s)
+	fn, found := encodings[name]
+	if !found {
+		return fmt.Errorf("invalid fingerprint encoding '%v', expected one of hex, base32, base64", s)
+	}
+	*e = encoding{name: name, encode: fn}
+	return nil
+}
+
+func (e encoding) String() string { return e.name }
@@ -0,0 +1,132 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fingerprint
+
+import (
+	"crypto/hmac"
+	"fmt"
+	"hash"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/libbeat/processors"
+)
+
+const procName = "fingerprint"
+
+func init() {
+	processors.RegisterPlugin(procName, New)
+}
+
+// processor computes a hash of the values of the configured fields. The
+// result is deterministic for the same field values, which makes it useful
+// as document ID for idempotent indexing.
+type processor struct {
+	config
+	fields  []string
+	newHash func() hash.Hash
+}
+
+// New constructs a new fingerprint processor.
+func New(cfg *common.Config) (processors.Processor, error) {
+	c := defaultConfig()
+	if err := cfg.Unpack(&c); err != nil {
+		return nil, errors.Wrap(err, "fail to unpack the "+procName+" processor configuration")
+	}
+
+	return newFingerprint(c), nil
+}
+
+func newFingerprint(c config) *processor {
+	cfgwarn.Beta("The " + procName + " processor is beta.")
+
+	// Sort fields so the order in the configuration does not change the
+	// resulting hash.
+	fields := make([]string, len(c.Fields))
+	copy(fields, c.Fields)
+	sort.Strings(fields)
+
+	newHash := c.Method.new
+	if c.Key != "" {
+		key := []byte(c.Key)
+		newHash = func() hash.Hash { return hmac.New(c.Method.new, key) }
+	}
+
+	return &processor{
+		config:  c,
+		fields:  fields,
+		newHash: newHash,
+	}
+}
+
+func (p *processor) String() string {
+	return fmt.Sprintf("%v=[method=%v, encoding=%v, fields=[%v], target_field=%v, keyed=%v]",
+		procName, p.Method, p.Encoding, strings.Join(p.fields, ","), p.TargetField, p.Key != "")
+}
+
+// Run writes the encoded hash of the configured fields to the target field.
+func (p *processor) Run(event *beat.Event) (*beat.Event, error) {
+	h := p.newHash()
+	if err := p.writeFields(h, event); err != nil {
+		return event, errors.Wrap(err, "failed to compute fingerprint")
+	}
+
+	if _, err := event.PutValue(p.TargetField, p.Encoding.encode(h.Sum(nil))); err != nil {
+		return event, errors.Wrapf(err, "failed to set fingerprint in field '%v'", p.TargetField)
+	}
+	return event, nil
+}
+
+// writeFields writes each field as `|name|value` to the hash. Field names are
+// included so that moving a value to another field changes the fingerprint.
+func (p *processor) writeFields(w io.Writer, event *beat.Event) error {
+	for _, field := range p.fields {
+		v, err := event.GetValue(field)
+		if err != nil {
+			if p.IgnoreMissing {
+				continue
+			}
+			return errors.Wrapf(err, "failed to get field '%v'", field)
+		}
+
+		fmt.Fprintf(w, "|%v|%v", field, formatValue(v))
+	}
+	io.WriteString(w, "|")
+	return nil
+}
+
+// formatValue returns a stable string representation of v.
+func formatValue(v interface{}) string {
+	switch t := v.(type) {
+	case string:
+		return t
+	case time.Time:
+		return t.UTC().Format(time.RFC3339Nano)
+	case common.Time:
+		return time.Time(t).UTC().Format(time.RFC3339Nano)
+	default:
+		return fmt.Sprint(v)
+	}
+}
@@ -0,0 +1,144 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fingerprint
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func newTestProcessor(t *testing.T, settings map[string]interface{}) *processor {
+	p, err := New(common.MustNewConfigFrom(settings))
+	require.NoError(t, err)
+	return p.(*processor)
+}
+
+func runFingerprint(t *testing.T, settings map[string]interface{}, fields common.MapStr) string {
+	p := newTestProcessor(t, settings)
+	out, err := p.Run(&beat.Event{Fields: fields})
+	require.NoError(t, err)
+
+	v, err := out.GetValue(p.TargetField)
+	require.NoError(t, err)
+	return v.(string)
+}
+
+func TestFingerprintMethods(t *testing.T) {
+	fields := common.MapStr{"message": "hello", "source": "a.log"}
+
+	// The hashed input is "|message|hello|source|a.log|".
+	tests := map[string]string{
+		"md5":    "302940fe65b7ff298ee4fadc15c6afd4",
+		"sha1":   "97d434efc1da9355f689131afcf93a3f701fa49c",
+		"sha256": "3a40cae97203e1dbe4a4ab217fc455535aaffb1a20a28d95ab377f705bb2ed7a",
+		"xxhash": "7ca7bf44de6a6403",
+	}
+
+	for method, expected := range tests {
+		method, expected := method, expected
+		t.Run(method, func(t *testing.T) {
+			v := runFingerprint(t, map[string]interface{}{
+				"fields": []string{"source", "message"},
+				"method": method,
+			}, fields.Clone())
+			assert.Equal(t, expected, v)
+		})
+	}
+}
+
+func TestFingerprintFieldOrder(t *testing.T) {
+	fields := common.MapStr{"message": "hello", "source": "a.log"}
+
+	a := runFingerprint(t, map[string]interface{}{"fields": []string{"message", "source"}}, fields.Clone())
+	b := runFingerprint(t, map[string]interface{}{"fields": []string{"source", "message"}}, fields.Clone())
+	assert.Equal(t, a, b)
+}
+
+func TestFingerprintFieldNameChangesHash(t *testing.T) {
+	settings := map[string]interface{}{"fields": []string{"a", "b"}, "ignore_missing": true}
+
+	a := runFingerprint(t, settings, common.MapStr{"a": "x"})
+	b := runFingerprint(t, settings, common.MapStr{"b": "x"})
+	assert.NotEqual(t, a, b)
+}
+
+func TestFingerprintHMAC(t *testing.T) {
+	v := runFingerprint(t, map[string]interface{}{
+		"fields": []string{"message", "source"},
+		"key":    "secret",
+	}, common.MapStr{"message": "hello", "source": "a.log"})
+	assert.Equal(t, "19259735f0473c0a3f3e856b874e189f76e8443ae3ed29a0c33e3efcdfa7ca4b", v)
+}
+
+func TestFingerprintEncoding(t *testing.T) {
+	v := runFingerprint(t, map[string]interface{}{
+		"fields":   []string{"message", "source"},
+		"encoding": "base64",
+	}, common.MapStr{"message": "hello", "source": "a.log"})
+	assert.Equal(t, "OkDK6XID4dvkpKshf8RVU1qv+xogoo2Vqzd/cFuy7Xo=", v)
+}
+
+func TestFingerprintMetadataTarget(t *testing.T) {
+	p := newTestProcessor(t, map[string]interface{}{
+		"fields":       []string{"message"},
+		"target_field": "@metadata._id",
+	})
+
+	out, err := p.Run(&beat.Event{Fields: common.MapStr{"message": "hello"}})
+	require.NoError(t, err)
+
+	id, err := out.Meta.GetValue("_id")
+	require.NoError(t, err)
+	assert.Len(t, id, 64)
+	assert.NotContains(t, out.Fields, "fingerprint")
+}
+
+func TestFingerprintTimestamp(t *testing.T) {
+	ts := time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC)
+	p := newTestProcessor(t, map[string]interface{}{"fields": []string{"@timestamp"}})
+
+	a, err := p.Run(&beat.Event{Timestamp: ts, Fields: common.MapStr{}})
+	require.NoError(t, err)
+	b, err := p.Run(&beat.Event{Timestamp: ts.In(time.FixedZone("X", 3600)), Fields: common.MapStr{}})
+	require.NoError(t, err)
+	assert.Equal(t, a.Fields["fingerprint"], b.Fields["fingerprint"])
+}
+
+func TestFingerprintMissingField(t *testing.T) {
+	p := newTestProcessor(t, map[string]interface{}{"fields": []string{"message", "missing"}})
+
+	_, err := p.Run(&beat.Event{Fields: common.MapStr{"message": "hello"}})
+	assert.Error(t, err)
+}
+
+func TestFingerprintInvalidConfig(t *testing.T) {
+	for name, settings := range map[string]map[string]interface{}{
+		"no fields":        {"method": "sha1"},
+		"invalid method":   {"fields": []string{"a"}, "method": "crc32"},
+		"invalid encoding": {"fields": []string{"a"}, "encoding": "base16"},
+	} {
+		_, err := New(common.MustNewConfigFrom(settings))
+		assert.Error(t, err, name)
+	}
+}
@@ -0,0 +1,110 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ratelimit
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+type config struct {
+	Limit           rate          `config:"limit" validate:"required"`
+	Fields          []string      `config:"fields"`
+	BurstMultiplier float64       `config:"burst_multiplier" validate:"min=1"`
+	Summary         summaryConfig `config:"summary"`
+	ID              string        `config:"id"`
+}
+
+type summaryConfig struct {
+	Enabled  bool          `config:"enabled"`
+	Interval time.Duration `config:"interval" validate:"positive"`
+}
+
+// rate is a number of events per time unit, configured as `<value>/<unit>`.
+// Supported units are s, m and h.
+type rate struct {
+	value float64
+	unit  time.Duration
+}
+
+func defaultConfig() config {
+	return config{
+		BurstMultiplier: 1,
+		Summary: summaryConfig{
+			Interval: time.Minute,
+		},
+	}
+}
+
+func (c *config) Validate() error {
+	if c.Limit.value*c.BurstMultiplier < 1 {
+		return errors.New("limit multiplied by burst_multiplier must be at least 1")
+	}
+	return nil
+}
+
+// Unpack parses a rate like `100/s`.
+func (r *rate) Unpack(s string) error {
+	parts := strings.Split(s, "/")
+	if len(parts) != 2 {
+		return fmt.Errorf("invalid limit '%v', expected format <value>/<unit>", s)
+	}
+
+	value, err := strconv.ParseFloat(strings.TrimSpace(parts[0]), 64)
+	if err != nil {
+		return errors.Wrapf(err, "invalid limit value in '%v'", s)
+	}
+	if value <= 0 {
+		return fmt.Errorf("limit value must be positive, got '%v'", s)
+	}
+
+	var unit time.Duration
+	switch strings.TrimSpace(parts[1]) {
+	case "s":
+		unit = time.Second
+	case "m":
+		unit = time.Minute
+	case "h":
+		unit = time.Hour
+	default:
+		return fmt.Errorf("invalid limit unit in '%v', expected one of s, m, h", s)
+	}
+
+	*r = rate{value: value, unit: unit}
+	return nil
+}
+
+// perSecond returns the rate in events per second.
+func (r rate) perSecond() float64 {
+	return r.value / r.unit.Seconds()
+}
+
+func (r rate) String() string {
+	unit := "s"
+	switch r.unit {
+	case time.Minute:
+		unit = "m"
+	case time.Hour:
+		unit = "h"
+	}
+	return strconv.FormatFloat(r.value, 'f', -1, 64) + "/" + unit
+}
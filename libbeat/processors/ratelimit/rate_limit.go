@@ -0,0 +1,236 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ratelimit
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/atomic"
+	"github.com/elastic/beats/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/libbeat/monitoring"
+	"github.com/elastic/beats/libbeat/processors"
+)
+
+const (
+	procName = "rate_limit"
+	logName  = "processor." + procName
+
+	// suppressedField holds the number of events dropped for the key of the
+	// summary event since the last summary.
+	suppressedField = "rate_limit.suppressed"
+)
+
+// instanceID is used to assign each instance a unique monitoring namespace.
+var instanceID = atomic.MakeUint32(0)
+
+func init() {
+	processors.RegisterPlugin(procName, New)
+}
+
+// processor drops events exceeding the configured rate. Events are grouped
+// by the values of the configured fields, every group has its own token
+// bucket.
+type processor struct {
+	config
+	log *logp.Logger
+
+	mu       sync.Mutex
+	buckets  map[string]*bucket
+	lastGC   time.Time
+	capacity float64
+	clock    func() time.Time
+
+	dropped *monitoring.Uint
+	keys    *monitoring.Int
+}
+
+type bucket struct {
+	tokens      float64
+	lastRefill  time.Time
+	suppressed  uint64
+	lastSummary time.Time
+}
+
+// New constructs a new rate_limit processor.
+func New(cfg *common.Config) (processors.Processor, error) {
+	c := defaultConfig()
+	if err := cfg.Unpack(&c); err != nil {
+		return nil, errors.Wrap(err, "fail to unpack the "+procName+" processor configuration")
+	}
+
+	return newRateLimit(c, time.Now), nil
+}
+
+func newRateLimit(c config, clock func() time.Time) *processor {
+	cfgwarn.Beta("The " + procName + " processor is beta.")
+
+	// Logging and metrics (each processor instance has a unique ID).
+	var (
+		id      = int(instanceID.Inc())
+		log     = logp.NewLogger(logName).With("instance_id", id)
+		metrics = monitoring.Default.NewRegistry(logName+"."+strconv.Itoa(id), monitoring.DoNotReport)
+	)
+	if c.ID != "" {
+		log = log.With("id", c.ID)
+	}
+
+	return &processor{
+		config:   c,
+		log:      log,
+		buckets:  map[string]*bucket{},
+		lastGC:   clock(),
+		capacity: c.Limit.value * c.BurstMultiplier,
+		clock:    clock,
+		dropped:  monitoring.NewUint(metrics, "dropped", monitoring.Counter),
+		keys:     monitoring.NewInt(metrics, "keys"),
+	}
+}
+
+func (p *processor) String() string {
+	return fmt.Sprintf("%v=[limit=%v, fields=[%v], burst_multiplier=%v, summary=%v]",
+		procName, p.Limit, strings.Join(p.Fields, ","), p.BurstMultiplier, p.Summary.Enabled)
+}
+
+// Run drops the event if the rate limit for its key is exceeded. If summaries
+// are enabled, the first event dropped after the summary interval is replaced
+// by a summary event, containing the number of events dropped for the same key.
+func (p *processor) Run(event *beat.Event) (*beat.Event, error) {
+	key := p.key(event)
+	now := p.clock()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.gc(now)
+
+	b := p.buckets[key]
+	if b == nil {
+		b = &bucket{tokens: p.capacity, lastRefill: now, lastSummary: now}
+		p.buckets[key] = b
+		p.keys.Set(int64(len(p.buckets)))
+	}
+	p.refill(b, now)
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return event, nil
+	}
+
+	b.suppressed++
+	p.dropped.Inc()
+	if p.Summary.Enabled && now.Sub(b.lastSummary) >= p.Summary.Interval {
+		summary := p.summary(event, b.suppressed, now)
+		b.suppressed = 0
+		b.lastSummary = now
+		return summary, nil
+	}
+	return nil, nil
+}
+
+// summary creates the summary event for the key of the dropped event. The
+// summary contains the key fields and the metadata of the dropped event.
+func (p *processor) summary(dropped *beat.Event, suppressed uint64, now time.Time) *beat.Event {
+	fields := common.MapStr{
+		"message": fmt.Sprintf("%v events dropped by the %v processor", suppressed, procName),
+	}
+	for _, field := range p.Fields {
+		if v, err := dropped.GetValue(field); err == nil {
+			fields.Put(field, v)
+		}
+	}
+	fields.Put(suppressedField, suppressed)
+
+	var meta common.MapStr
+	if dropped.Meta != nil {
+		meta = dropped.Meta.Clone()
+	}
+	return &beat.Event{Timestamp: now, Meta: meta, Fields: fields}
+}
+
+// key builds the bucket key from the values of the configured fields.
+// Missing fields are treated as empty values.
+func (p *processor) key(event *beat.Event) string {
+	if len(p.Fields) == 0 {
+		return ""
+	}
+
+	values := make([]string, len(p.Fields))
+	for i, field := range p.Fields {
+		if v, err := event.GetValue(field); err == nil {
+			values[i] = fmt.Sprint(v)
+		}
+	}
+	return strings.Join(values, "\x00")
+}
+
+// refill adds the tokens accumulated since the last refill to the bucket.
+func (p *processor) refill(b *bucket, now time.Time) {
+	elapsed := now.Sub(b.lastRefill)
+	if elapsed <= 0 {
+		return
+	}
+
+	b.tokens += elapsed.Seconds() * p.Limit.perSecond()
+	if b.tokens > p.capacity {
+		b.tokens = p.capacity
+	}
+	b.lastRefill = now
+}
+
+// gc removes buckets which have not been used for a full gc interval and
+// would be completely refilled by now. The number of events suppressed for a
+// removed key is logged. If summaries are enabled, the number of events
+// suppressed for a key is also logged if no summary event has been created
+// for two summary intervals, because no more events of the key were dropped.
+func (p *processor) gc(now time.Time) {
+	interval := p.Limit.unit
+	if p.Summary.Enabled && p.Summary.Interval > interval {
+		interval = p.Summary.Interval
+	}
+	if now.Sub(p.lastGC) < interval {
+		return
+	}
+	p.lastGC = now
+
+	idle := time.Duration(p.capacity / p.Limit.perSecond() * float64(time.Second))
+	if interval > idle {
+		idle = interval
+	}
+	for key, b := range p.buckets {
+		expired := now.Sub(b.lastRefill) >= idle
+		overdue := p.Summary.Enabled && now.Sub(b.lastSummary) >= 2*p.Summary.Interval
+		if b.suppressed > 0 && (expired || overdue) {
+			p.log.Infow("Rate limit exceeded", "key", strings.Split(key, "\x00"), "suppressed", b.suppressed)
+			b.suppressed = 0
+			b.lastSummary = now
+		}
+		if expired {
+			delete(p.buckets, key)
+		}
+	}
+	p.keys.Set(int64(len(p.buckets)))
+}
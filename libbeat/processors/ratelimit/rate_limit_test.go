@@ -0,0 +1,245 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+)
+
+type testClock struct {
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time { return c.now }
+
+func (c *testClock) Advance(d time.Duration) { c.now = c.now.Add(d) }
+
+func newTestProcessor(t *testing.T, settings map[string]interface{}) (*processor, *testClock) {
+	c := defaultConfig()
+	require.NoError(t, common.MustNewConfigFrom(settings).Unpack(&c))
+
+	clock := &testClock{now: time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)}
+	return newRateLimit(c, clock.Now), clock
+}
+
+func newEvent(fields common.MapStr) *beat.Event {
+	return &beat.Event{Fields: fields}
+}
+
+func countPassed(t *testing.T, p *processor, n int, fields common.MapStr) int {
+	passed := 0
+	for i := 0; i < n; i++ {
+		out, err := p.Run(newEvent(fields.Clone()))
+		require.NoError(t, err)
+		if out != nil {
+			passed++
+		}
+	}
+	return passed
+}
+
+func TestConfig(t *testing.T) {
+	tests := map[string]struct {
+		limit string
+		rate  rate
+		err   bool
+	}{
+		"per second":    {limit: "10/s", rate: rate{10, time.Second}},
+		"per minute":    {limit: "100/m", rate: rate{100, time.Minute}},
+		"per hour":      {limit: "0.5/h", err: true},
+		"fraction":      {limit: "2.5/h", rate: rate{2.5, time.Hour}},
+		"invalid unit":  {limit: "10/d", err: true},
+		"invalid value": {limit: "x/s", err: true},
+		"no unit":       {limit: "10", err: true},
+		"negative":      {limit: "-1/s", err: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := defaultConfig()
+			err := common.MustNewConfigFrom(map[string]interface{}{"limit": test.limit}).Unpack(&c)
+			if test.err {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.rate, c.Limit)
+		})
+	}
+
+	t.Run("limit required", func(t *testing.T) {
+		c := defaultConfig()
+		err := common.MustNewConfigFrom(map[string]interface{}{"fields": []string{"a"}}).Unpack(&c)
+		assert.Error(t, err)
+	})
+}
+
+func TestRateLimit(t *testing.T) {
+	p, clock := newTestProcessor(t, map[string]interface{}{"limit": "10/s"})
+
+	// bucket starts full
+	assert.Equal(t, 10, countPassed(t, p, 20, common.MapStr{"message": "a"}))
+	assert.Equal(t, uint64(10), p.dropped.Get())
+
+	// tokens are refilled over time
+	clock.Advance(500 * time.Millisecond)
+	assert.Equal(t, 5, countPassed(t, p, 20, common.MapStr{"message": "a"}))
+
+	// refill is capped by the bucket capacity
+	clock.Advance(time.Hour)
+	assert.Equal(t, 10, countPassed(t, p, 20, common.MapStr{"message": "a"}))
+}
+
+func TestRateLimitBurst(t *testing.T) {
+	p, _ := newTestProcessor(t, map[string]interface{}{
+		"limit":            "10/s",
+		"burst_multiplier": 3,
+	})
+	assert.Equal(t, 30, countPassed(t, p, 50, common.MapStr{}))
+}
+
+func TestRateLimitPerKey(t *testing.T) {
+	p, _ := newTestProcessor(t, map[string]interface{}{
+		"limit":  "2/m",
+		"fields": []string{"host.name", "log.level"},
+	})
+
+	hostA := common.MapStr{"host": common.MapStr{"name": "a"}, "log": common.MapStr{"level": "info"}}
+	hostAErr := common.MapStr{"host": common.MapStr{"name": "a"}, "log": common.MapStr{"level": "error"}}
+	hostB := common.MapStr{"host": common.MapStr{"name": "b"}, "log": common.MapStr{"level": "info"}}
+	missing := common.MapStr{"message": "no key fields"}
+
+	assert.Equal(t, 2, countPassed(t, p, 5, hostA))
+	assert.Equal(t, 2, countPassed(t, p, 5, hostAErr))
+	assert.Equal(t, 2, countPassed(t, p, 5, hostB))
+	assert.Equal(t, 2, countPassed(t, p, 5, missing))
+	assert.Equal(t, int64(4), p.keys.Get())
+	assert.Equal(t, uint64(12), p.dropped.Get())
+}
+
+func TestRateLimitSummary(t *testing.T) {
+	p, clock := newTestProcessor(t, map[string]interface{}{
+		"limit":            "1/s",
+		"summary.enabled":  true,
+		"summary.interval": "10s",
+	})
+
+	// Two events per second, one of them is dropped. The first event dropped
+	// after the summary interval is replaced by the summary.
+	summaries := map[int]interface{}{}
+	passed := 0
+	for sec := 0; sec < 25; sec++ {
+		for i := 0; i < 2; i++ {
+			out, err := p.Run(newEvent(common.MapStr{"message": "event"}))
+			require.NoError(t, err)
+			if out == nil {
+				continue
+			}
+			if v, err := out.GetValue(suppressedField); err == nil {
+				summaries[sec] = v
+			} else {
+				passed++
+			}
+		}
+		clock.Advance(time.Second)
+	}
+
+	assert.Equal(t, map[int]interface{}{10: uint64(11), 20: uint64(10)}, summaries)
+	assert.Equal(t, 25, passed)
+	assert.Equal(t, uint64(25), p.dropped.Get())
+}
+
+func TestRateLimitSummaryEvent(t *testing.T) {
+	p, clock := newTestProcessor(t, map[string]interface{}{
+		"limit":            "1/m",
+		"fields":           []string{"host.name"},
+		"summary.enabled":  true,
+		"summary.interval": "10s",
+	})
+
+	event := func() *beat.Event {
+		return &beat.Event{
+			Meta: common.MapStr{"index": "logs"},
+			Fields: common.MapStr{
+				"host":    common.MapStr{"name": "a"},
+				"message": "chatty",
+			},
+		}
+	}
+
+	// The summary is published, although no event passes the limit.
+	assert.Equal(t, 1, countPassed(t, p, 5, common.MapStr{"host": common.MapStr{"name": "a"}}))
+	clock.Advance(10 * time.Second)
+
+	out, err := p.Run(event())
+	require.NoError(t, err)
+	require.NotNil(t, out)
+	assert.Equal(t, clock.Now(), out.Timestamp)
+	assert.Equal(t, common.MapStr{"index": "logs"}, out.Meta)
+	assert.Equal(t, common.MapStr{
+		"host":       common.MapStr{"name": "a"},
+		"message":    "5 events dropped by the rate_limit processor",
+		"rate_limit": common.MapStr{"suppressed": uint64(5)},
+	}, out.Fields)
+
+	out, err = p.Run(event())
+	require.NoError(t, err)
+	assert.Nil(t, out)
+}
+
+func TestRateLimitSummaryOverdue(t *testing.T) {
+	p, clock := newTestProcessor(t, map[string]interface{}{
+		"limit":            "1/s",
+		"fields":           []string{"id"},
+		"summary.enabled":  true,
+		"summary.interval": "10s",
+	})
+
+	assert.Equal(t, 1, countPassed(t, p, 3, common.MapStr{"id": "a"}))
+
+	// Events of the key keep passing the limit, so no summary event is
+	// created. The count is logged and reset by the gc instead.
+	for sec := 0; sec < 20; sec++ {
+		clock.Advance(time.Second)
+		assert.Equal(t, 1, countPassed(t, p, 1, common.MapStr{"id": "a"}))
+	}
+	assert.Equal(t, uint64(0), p.buckets["a"].suppressed)
+}
+
+func TestRateLimitGC(t *testing.T) {
+	p, clock := newTestProcessor(t, map[string]interface{}{
+		"limit":  "10/s",
+		"fields": []string{"id"},
+	})
+
+	for i := 0; i < 5; i++ {
+		countPassed(t, p, 1, common.MapStr{"id": i})
+	}
+	assert.Equal(t, int64(5), p.keys.Get())
+
+	clock.Advance(2 * time.Second)
+	countPassed(t, p, 1, common.MapStr{"id": "new"})
+	assert.Equal(t, int64(1), p.keys.Get())
+}
@@ -20,9 +20,11 @@ package pipeline
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/elastic/beats/libbeat/beat"
 	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/conditions"
 	"github.com/elastic/beats/libbeat/processors"
 )
 
@@ -36,6 +38,65 @@ type Config struct {
 	Queue common.ConfigNamespace `config:"queue"`
 }
 
+// OutputConfig configures one of the named outputs of a pipeline routing
+// events to multiple outputs.
+type OutputConfig struct {
+	Name   string                 `config:"name" validate:"required"`
+	When   *conditions.Config     `config:"when"`
+	Output common.ConfigNamespace `config:"output"`
+
+	// MaxPendingEvents limits the number of events buffered for the output,
+	// isolating it from the queue. See OutputRoute.MaxPending. If 0, the
+	// events waiting for the output are kept in the queue.
+	MaxPendingEvents int `config:"max_pending_events" validate:"min=0"`
+}
+
+// Validate checks the output name can be used in metric names.
+func (c *OutputConfig) Validate() error {
+	if strings.Contains(c.Name, ".") {
+		return fmt.Errorf("output name '%v' must not contain dots", c.Name)
+	}
+	return nil
+}
+
+// MakeOutputRoutes creates the output routes for the outputs configured.
+// Disabled outputs are ignored. The factory creates the OutputFactory used to
+// load the output of a route.
+func MakeOutputRoutes(
+	configs []OutputConfig,
+	factory func(common.ConfigNamespace) OutputFactory,
+) ([]OutputRoute, error) {
+	var routes []OutputRoute
+	names := map[string]bool{}
+	for _, config := range configs {
+		if names[config.Name] {
+			return nil, fmt.Errorf("output name '%v' is used multiple times", config.Name)
+		}
+		names[config.Name] = true
+
+		if !config.Output.IsSet() {
+			continue
+		}
+
+		var condition conditions.Condition
+		if config.When != nil {
+			var err error
+			condition, err = conditions.NewCondition(config.When)
+			if err != nil {
+				return nil, fmt.Errorf("invalid condition for output '%v': %v", config.Name, err)
+			}
+		}
+
+		routes = append(routes, OutputRoute{
+			Name:       config.Name,
+			Condition:  condition,
+			Factory:    factory(config.Output),
+			MaxPending: config.MaxPendingEvents,
+		})
+	}
+	return routes, nil
+}
+
 // validateClientConfig checks a ClientConfig can be used with (*Pipeline).ConnectWith.
 func validateClientConfig(c *beat.ClientConfig) error {
 	withDrop := false
@@ -18,6 +18,8 @@
 package pipeline
 
 import (
+	"sync"
+
 	"github.com/elastic/beats/libbeat/common/atomic"
 	"github.com/elastic/beats/libbeat/logp"
 	"github.com/elastic/beats/libbeat/publisher/queue"
@@ -30,6 +32,7 @@ import (
 type eventConsumer struct {
 	logger *logp.Logger
 	done   chan struct{}
+	wg     sync.WaitGroup
 
 	ctx *batchContext
 
@@ -37,12 +40,19 @@ type eventConsumer struct {
 	wait  atomic.Bool
 	sig   chan consumerSignal
 
-	queue    queue.Queue
+	queue    eventSource
 	consumer queue.Consumer
 
 	out *outputGroup
 }
 
+// eventSource creates the queue consumers the eventConsumer reads batches
+// from. The source is either the pipeline queue or, if events are routed to
+// multiple outputs, the events routed to a single output.
+type eventSource interface {
+	Consumer() queue.Consumer
+}
+
 type consumerSignal struct {
 	tag      consumerEventTag
 	consumer queue.Consumer
@@ -59,7 +69,7 @@ const (
 
 func newEventConsumer(
 	log *logp.Logger,
-	queue queue.Queue,
+	queue eventSource,
 	ctx *batchContext,
 ) *eventConsumer {
 	c := &eventConsumer{
@@ -74,13 +84,21 @@ func newEventConsumer(
 	}
 
 	c.pause.Store(true)
-	go c.loop(c.consumer)
+	c.wg.Add(1)
+	consumer := c.consumer
+	go func() {
+		defer c.wg.Done()
+		c.loop(consumer)
+	}()
 	return c
 }
 
+// close stops the consumer and waits for it to stop forwarding batches to
+// the work queue.
 func (c *eventConsumer) close() {
 	c.consumer.Close()
 	close(c.done)
+	c.wg.Wait()
 }
 
 func (c *eventConsumer) sigWait() {
@@ -22,7 +22,6 @@ import (
 	"github.com/elastic/beats/libbeat/common"
 	"github.com/elastic/beats/libbeat/common/reload"
 	"github.com/elastic/beats/libbeat/outputs"
-	"github.com/elastic/beats/libbeat/publisher/queue"
 )
 
 // outputController manages the pipelines output capabilities, like:
@@ -34,7 +33,7 @@ type outputController struct {
 	monitors Monitors
 	observer outputObserver
 
-	queue queue.Queue
+	queue eventSource
 
 	retryer  *retryer
 	consumer *eventConsumer
@@ -62,7 +61,7 @@ func newOutputController(
 	beat beat.Info,
 	monitors Monitors,
 	observer outputObserver,
-	b queue.Queue,
+	b eventSource,
 ) *outputController {
 	c := &outputController{
 		beat:     beat,
@@ -84,6 +83,7 @@ func newOutputController(
 
 func (c *outputController) Close() error {
 	c.consumer.sigPause()
+	c.consumer.close()
 
 	if c.out != nil {
 		for _, out := range c.out.outputs {
@@ -92,7 +92,6 @@ func (c *outputController) Close() error {
 		close(c.out.workQueue)
 	}
 
-	c.consumer.close()
 	c.retryer.close()
 
 	return nil
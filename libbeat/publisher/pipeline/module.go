@@ -21,8 +21,11 @@ import (
 	"flag"
 	"fmt"
 
+	"github.com/pkg/errors"
+
 	"github.com/elastic/beats/libbeat/beat"
 	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/conditions"
 	"github.com/elastic/beats/libbeat/logp"
 	"github.com/elastic/beats/libbeat/monitoring"
 	"github.com/elastic/beats/libbeat/outputs"
@@ -50,6 +53,22 @@ type Monitors struct {
 // eventually block.
 type OutputFactory func(outputs.Observer) (string, outputs.Group, error)
 
+// OutputRoute configures one of the named outputs of a pipeline routing events
+// to multiple outputs. Events are published to the output named in the
+// `@metadata.output` field of the event, or else to the first output whose
+// Condition matches the event. An output without Condition matches all events.
+//
+// If MaxPending > 0, the events routed to the output are removed from the queue
+// once buffered for the output, so a failing output does not block the queue
+// shared by all outputs. New events are dropped while MaxPending events are
+// waiting to be published by the output.
+type OutputRoute struct {
+	Name       string
+	Condition  conditions.Condition
+	Factory    OutputFactory
+	MaxPending int
+}
+
 func init() {
 	flag.BoolVar(&publishDisabled, "N", false, "Disable actual publishing for testing")
 }
@@ -98,15 +117,92 @@ func Load(
 	return p, err
 }
 
-func loadOutput(
+// LoadRouted uses a Config object to create a new complete Pipeline instance
+// with configured queue, routing events to multiple named outputs.
+// Metrics of the outputs are reported per output in the outputs.<name> namespace.
+// The output types are reported in the outputs.routes.<name> namespace of the
+// telemetry registry, as outputs.elasticsearch is used for the cluster UUID.
+func LoadRouted(
+	beatInfo beat.Info,
 	monitors Monitors,
-	makeOutput OutputFactory,
-) (outputs.Group, error) {
+	config Config,
+	processors processing.Supporter,
+	routes []OutputRoute,
+) (*Pipeline, error) {
 	log := monitors.Logger
 	if log == nil {
 		log = logp.L()
 	}
 
+	if len(routes) == 0 {
+		return nil, errors.New("no outputs configured")
+	}
+
+	if publishDisabled {
+		log.Info("Dry run mode. All output types except the file based one are disabled.")
+	}
+
+	settings := Settings{
+		WaitClose:     0,
+		WaitCloseMode: NoWaitOnClose,
+		Processors:    processors,
+	}
+
+	queueBuilder, err := createQueueBuilder(config.Queue, monitors)
+	if err != nil {
+		return nil, err
+	}
+
+	outs := make([]routedOutput, len(routes))
+	for i, route := range routes {
+		name := "outputs." + route.Name
+		group, err := loadNamedOutput(monitors, name, "outputs.routes."+route.Name, route.Factory)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to load output '%v'", route.Name)
+		}
+
+		var stats *outputs.PipelineStats
+		if monitors.Metrics != nil {
+			reg := monitors.Metrics.GetRegistry(name)
+			if reg == nil {
+				reg = monitors.Metrics.NewRegistry(name)
+			}
+			stats = outputs.NewPipelineStats(reg)
+		}
+
+		outs[i] = routedOutput{
+			name:       route.Name,
+			condition:  route.Condition,
+			group:      group,
+			stats:      stats,
+			maxPending: route.MaxPending,
+		}
+	}
+
+	p, err := newRouted(beatInfo, monitors, queueBuilder, outs, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Beat name: %s", beatInfo.Name)
+	return p, err
+}
+
+func loadOutput(
+	monitors Monitors,
+	makeOutput OutputFactory,
+) (outputs.Group, error) {
+	return loadNamedOutput(monitors, "output", "output", makeOutput)
+}
+
+// loadNamedOutput creates an output, reporting its metrics in the metrics
+// registry with the given name, and its type in the telemetry registry with
+// the given telemetry name.
+func loadNamedOutput(
+	monitors Monitors,
+	name, telemetryName string,
+	makeOutput OutputFactory,
+) (outputs.Group, error) {
 	if publishDisabled {
 		return outputs.Group{}, nil
 	}
@@ -115,17 +211,9 @@ func loadOutput(
 		return outputs.Group{}, nil
 	}
 
-	var (
-		metrics  *monitoring.Registry
-		outStats outputs.Observer
-	)
-	if monitors.Metrics != nil {
-		metrics = monitors.Metrics.GetRegistry("output")
-		if metrics != nil {
-			metrics.Clear()
-		} else {
-			metrics = monitors.Metrics.NewRegistry("output")
-		}
+	var outStats outputs.Observer
+	metrics := resetRegistry(monitors.Metrics, name)
+	if metrics != nil {
 		outStats = outputs.NewStats(metrics)
 	}
 
@@ -137,19 +225,29 @@ func loadOutput(
 	if metrics != nil {
 		monitoring.NewString(metrics, "type").Set(outName)
 	}
-	if monitors.Telemetry != nil {
-		telemetry := monitors.Telemetry.GetRegistry("output")
-		if telemetry != nil {
-			telemetry.Clear()
-		} else {
-			telemetry = monitors.Telemetry.NewRegistry("output")
-		}
+	if telemetry := resetRegistry(monitors.Telemetry, telemetryName); telemetry != nil {
 		monitoring.NewString(telemetry, "name").Set(outName)
 	}
 
 	return out, nil
 }
 
+// resetRegistry returns the cleared sub-registry of parent with the given name,
+// creating it if it does not exist yet. It returns nil if parent is nil.
+func resetRegistry(parent *monitoring.Registry, name string) *monitoring.Registry {
+	if parent == nil {
+		return nil
+	}
+
+	reg := parent.GetRegistry(name)
+	if reg != nil {
+		reg.Clear()
+	} else {
+		reg = parent.NewRegistry(name)
+	}
+	return reg
+}
+
 func createQueueBuilder(
 	config common.ConfigNamespace,
 	monitors Monitors,
@@ -85,14 +85,14 @@ func newMetricsObserver(metrics *monitoring.Registry) *metricsObserver {
 		metrics: metrics,
 		clients: monitoring.NewUint(reg, "clients"),
 
-		events:    monitoring.NewUint(reg, "events.total"),
-		filtered:  monitoring.NewUint(reg, "events.filtered"),
-		published: monitoring.NewUint(reg, "events.published"),
-		failed:    monitoring.NewUint(reg, "events.failed"),
-		dropped:   monitoring.NewUint(reg, "events.dropped"),
-		retry:     monitoring.NewUint(reg, "events.retry"),
-
-		ackedQueue: monitoring.NewUint(reg, "queue.acked"),
+		events:    monitoring.NewUint(reg, "events.total", monitoring.Counter),
+		filtered:  monitoring.NewUint(reg, "events.filtered", monitoring.Counter),
+		published: monitoring.NewUint(reg, "events.published", monitoring.Counter),
+		failed:    monitoring.NewUint(reg, "events.failed", monitoring.Counter),
+		dropped:   monitoring.NewUint(reg, "events.dropped", monitoring.Counter),
+		retry:     monitoring.NewUint(reg, "events.retry", monitoring.Counter),
+
+		ackedQueue: monitoring.NewUint(reg, "queue.acked", monitoring.Counter),
 
 		activeEvents: monitoring.NewUint(reg, "events.active"),
 	}
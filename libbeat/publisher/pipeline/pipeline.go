@@ -48,6 +48,8 @@ import (
 // The output controller configures a (potentially reloadable) set of load
 // balanced output clients. Events will be pulled from the queue and pushed to
 // the output clients using a shared work queue for the active outputs.Group.
+// If the pipeline publishes to multiple named outputs, an output router
+// distributes the events between the output controllers of all outputs.
 // Processors in the pipeline are executed in the clients go-routine, before
 // entering the queue. No filtering/processing will occur on the output side.
 //
@@ -60,6 +62,7 @@ type Pipeline struct {
 
 	queue  queue.Queue
 	output *outputController
+	router *outputRouter
 
 	observer observer
 
@@ -147,6 +150,42 @@ func New(
 	queueFactory queueFactory,
 	out outputs.Group,
 	settings Settings,
+) (*Pipeline, error) {
+	p, err := newPipeline(beat, monitors, queueFactory, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	p.output = newOutputController(beat, p.monitors, p.observer, p.queue)
+	p.output.Set(out)
+
+	return p, nil
+}
+
+// newRouted creates a new Pipeline instance routing events to multiple named
+// outputs.
+func newRouted(
+	beat beat.Info,
+	monitors Monitors,
+	queueFactory queueFactory,
+	outs []routedOutput,
+	settings Settings,
+) (*Pipeline, error) {
+	p, err := newPipeline(beat, monitors, queueFactory, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	p.router = newOutputRouter(beat, p.monitors, p.observer, p.queue, outs)
+
+	return p, nil
+}
+
+func newPipeline(
+	beat beat.Info,
+	monitors Monitors,
+	queueFactory queueFactory,
+	settings Settings,
 ) (*Pipeline, error) {
 	var err error
 
@@ -195,9 +234,6 @@ func New(
 	}
 	p.eventSema = newSema(maxEvents)
 
-	p.output = newOutputController(beat, monitors, p.observer, p.queue)
-	p.output.Set(out)
-
 	return p, nil
 }
 
@@ -269,7 +305,11 @@ func (p *Pipeline) Close() error {
 	// TODO: close/disconnect still active clients
 
 	// close output before shutting down queue
-	p.output.Close()
+	if p.router != nil {
+		p.router.Close()
+	} else {
+		p.output.Close()
+	}
 
 	// shutdown queue
 	err := p.queue.Close()
@@ -495,5 +535,8 @@ func (e *waitCloser) wait() {
 
 // OutputReloader returns a reloadable object for the output section of this pipeline
 func (p *Pipeline) OutputReloader() OutputReloader {
+	if p.router != nil {
+		return p.router
+	}
 	return p.output
 }
@@ -0,0 +1,425 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pipeline
+
+import (
+	"errors"
+	"io"
+	"sync"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/atomic"
+	"github.com/elastic/beats/libbeat/common/reload"
+	"github.com/elastic/beats/libbeat/conditions"
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/libbeat/outputs"
+	"github.com/elastic/beats/libbeat/publisher"
+	"github.com/elastic/beats/libbeat/publisher/queue"
+)
+
+// outputRouter distributes the events read from the pipeline queue between
+// multiple named outputs. Each output is managed by its own outputController,
+// with its own work queue, retryer and output workers. Failures and retries of
+// one output do not block publishing to the other outputs, until the queue is
+// filled up with events waiting for the failing output. As the queue ACKs
+// events in order, this happens even if the other outputs ACK their events.
+//
+// Outputs configured with a limit of pending events are isolated from the
+// queue. The events routed to such an output are ACKed to the queue as soon as
+// they are buffered by the output, and new events are dropped while the limit
+// is reached.
+//
+// An event is routed to the output named in its `@metadata.output` field, or
+// else to the first output whose condition matches the event. Events that
+// can not be routed to any output are dropped.
+type outputRouter struct {
+	logger   *logp.Logger
+	observer outputObserver
+
+	consumer  queue.Consumer
+	batchSize int
+
+	routes []*outputRoute
+	byName map[string]*outputRoute
+
+	done chan struct{}
+}
+
+type outputRoute struct {
+	name      string
+	condition conditions.Condition
+	stats     *outputs.PipelineStats
+	observer  *routeObserver
+
+	source     *routeSource
+	controller *outputController
+}
+
+// routedOutput is an output group loaded for an OutputRoute.
+type routedOutput struct {
+	name      string
+	condition conditions.Condition
+	group     outputs.Group
+	stats     *outputs.PipelineStats
+
+	// maxPending limits the number of events waiting for the output.
+	// Unlimited if 0.
+	maxPending int
+}
+
+// routeObserver reports the pipeline events of a single output to the
+// pipeline observer and to the outputs pipeline metrics.
+type routeObserver struct {
+	outputObserver
+	stats *outputs.PipelineStats
+}
+
+// routeSource buffers the events routed to an output, until they are
+// consumed by the outputs eventConsumer. The number of events pushed, but not
+// yet ACKed by the output, is bounded by maxPending, or else by the size of the
+// pipeline queue. If maxPending is set, the source owns the events pushed,
+// and does not ACK the batches read from the queue.
+type routeSource struct {
+	mutex      sync.Mutex
+	pending    []routedEvents
+	active     int // number of events pushed, but not yet ACKed
+	maxPending int
+
+	sig  chan struct{}
+	done chan struct{}
+}
+
+type routedEvents struct {
+	parent *routedBatch
+	events []publisher.Event
+}
+
+// routedBatch is a batch read from the pipeline queue. It is ACKed, once all
+// outputs have ACKed the events routed to them.
+type routedBatch struct {
+	original queue.Batch
+	pending  atomic.Int64
+}
+
+// routeConsumer implements queue.Consumer for the events routed to a single
+// output.
+type routeConsumer struct {
+	source *routeSource
+	closed atomic.Bool
+	done   chan struct{}
+}
+
+// routeBatch implements queue.Batch for the events routed to a single output.
+type routeBatch struct {
+	source  *routeSource
+	count   int
+	events  []publisher.Event
+	parents []routedCount
+}
+
+type routedCount struct {
+	batch *routedBatch
+	count int
+}
+
+var errNoRouteReload = errors.New("output reloading is not supported if events are routed to multiple outputs")
+
+func newOutputRouter(
+	beat beat.Info,
+	monitors Monitors,
+	observer outputObserver,
+	q queue.Queue,
+	outs []routedOutput,
+) *outputRouter {
+	r := &outputRouter{
+		logger:   monitors.Logger,
+		observer: observer,
+		consumer: q.Consumer(),
+		byName:   map[string]*outputRoute{},
+		done:     make(chan struct{}),
+	}
+
+	for _, out := range outs {
+		route := &outputRoute{
+			name:      out.name,
+			condition: out.condition,
+			stats:     out.stats,
+			observer:  &routeObserver{outputObserver: observer, stats: out.stats},
+			source: &routeSource{
+				maxPending: out.maxPending,
+				sig:        make(chan struct{}, 1),
+				done:       make(chan struct{}),
+			},
+		}
+		route.controller = newOutputController(beat, monitors, route.observer, route.source)
+		route.controller.Set(out.group)
+
+		if out.group.BatchSize > r.batchSize {
+			r.batchSize = out.group.BatchSize
+		}
+
+		r.routes = append(r.routes, route)
+		r.byName[route.name] = route
+	}
+
+	go r.run()
+	return r
+}
+
+// Close stops routing events and closes all outputs.
+func (r *outputRouter) Close() error {
+	r.consumer.Close()
+	<-r.done
+
+	for _, route := range r.routes {
+		route.source.close()
+		route.controller.Close()
+	}
+	return nil
+}
+
+// Reload returns an error, as the outputs of a router can not be reloaded.
+func (r *outputRouter) Reload(
+	cfg *reload.ConfigWithMeta,
+	outFactory func(outputs.Observer, common.ConfigNamespace) (outputs.Group, error),
+) error {
+	return errNoRouteReload
+}
+
+func (r *outputRouter) run() {
+	defer close(r.done)
+
+	r.logger.Debug("start pipeline event router")
+	for {
+		batch, err := r.consumer.Get(r.batchSize)
+		if err != nil {
+			r.logger.Debug("stop pipeline event router")
+			return
+		}
+		r.dispatch(batch)
+	}
+}
+
+// dispatch splits a batch read from the queue and forwards the events to the
+// outputs they are routed to.
+func (r *outputRouter) dispatch(batch queue.Batch) {
+	events := batch.Events()
+	if len(events) == 0 {
+		batch.ACK()
+		return
+	}
+
+	parent := &routedBatch{original: batch}
+	parent.pending.Store(int64(len(events)))
+
+	routed := make(map[*outputRoute][]publisher.Event, len(r.routes))
+	dropped := 0
+	for i := range events {
+		route := r.route(&events[i])
+		if route == nil {
+			dropped++
+			continue
+		}
+		routed[route] = append(routed[route], events[i])
+	}
+
+	for route, events := range routed {
+		n := route.source.push(parent, events)
+		route.stats.Routed(n)
+		if full := len(events) - n; full > 0 {
+			r.logger.Debugf("Dropping %v events, too many events pending for output '%v'", full, route.name)
+			route.observer.eventsDropped(full)
+		}
+
+		if route.source.maxPending > 0 {
+			// The output owns the events buffered, and dropped events are not
+			// published anymore.
+			parent.done(len(events))
+		}
+	}
+
+	if dropped > 0 {
+		r.observer.eventsDropped(dropped)
+		parent.done(dropped)
+	}
+}
+
+// route selects the output an event is published to. It returns nil if the
+// event can not be routed to any output.
+func (r *outputRouter) route(event *publisher.Event) *outputRoute {
+	if name, ok := event.Content.Meta["output"].(string); ok && name != "" {
+		route := r.byName[name]
+		if route == nil {
+			r.logger.Debugf("Dropping event routed to unknown output '%v'", name)
+		}
+		return route
+	}
+
+	for _, route := range r.routes {
+		if route.condition == nil || route.condition.Check(&event.Content) {
+			return route
+		}
+	}
+
+	r.logger.Debug("Dropping event not matching the conditions of any output")
+	return nil
+}
+
+func (o *routeObserver) eventsDropped(n int) {
+	o.outputObserver.eventsDropped(n)
+	o.stats.Dropped(n)
+}
+
+func (o *routeObserver) eventsRetry(n int) {
+	o.outputObserver.eventsRetry(n)
+	o.stats.Retry(n)
+}
+
+func (s *routeSource) Consumer() queue.Consumer {
+	return &routeConsumer{source: s, done: make(chan struct{})}
+}
+
+func (s *routeSource) close() {
+	close(s.done)
+}
+
+// push buffers the events routed to the output. If maxPending is set, only the
+// events not exceeding the limit of pending events are buffered, and parent is
+// not ACKed by the output. push returns the number of events buffered.
+func (s *routeSource) push(parent *routedBatch, events []publisher.Event) int {
+	s.mutex.Lock()
+	if s.maxPending > 0 {
+		parent = nil
+
+		free := s.maxPending - s.active
+		if free < 0 {
+			free = 0
+		}
+		if len(events) > free {
+			events = events[:free]
+		}
+	}
+	if len(events) > 0 {
+		s.active += len(events)
+		s.pending = append(s.pending, routedEvents{parent: parent, events: events})
+	}
+	s.mutex.Unlock()
+
+	if len(events) > 0 {
+		s.signal()
+	}
+	return len(events)
+}
+
+// release marks n events as ACKed by the output.
+func (s *routeSource) release(n int) {
+	s.mutex.Lock()
+	s.active -= n
+	s.mutex.Unlock()
+}
+
+// signal wakes up a consumer waiting for events.
+func (s *routeSource) signal() {
+	select {
+	case s.sig <- struct{}{}:
+	default:
+	}
+}
+
+// get removes up to sz buffered events from the source. All events are
+// returned if sz <= 0. It returns nil if no events are buffered.
+func (s *routeSource) get(sz int) *routeBatch {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if len(s.pending) == 0 {
+		return nil
+	}
+
+	b := &routeBatch{source: s}
+	for len(s.pending) > 0 && (sz <= 0 || len(b.events) < sz) {
+		next := &s.pending[0]
+
+		count := len(next.events)
+		if sz > 0 && len(b.events)+count > sz {
+			count = sz - len(b.events)
+		}
+
+		b.events = append(b.events, next.events[:count]...)
+		b.count += count
+		if next.parent != nil {
+			b.parents = append(b.parents, routedCount{batch: next.parent, count: count})
+		}
+
+		next.events = next.events[count:]
+		if len(next.events) == 0 {
+			s.pending[0] = routedEvents{}
+			s.pending = s.pending[1:]
+		}
+	}
+
+	if len(s.pending) > 0 {
+		s.signal()
+	}
+	return b
+}
+
+func (c *routeConsumer) Get(sz int) (queue.Batch, error) {
+	for {
+		if b := c.source.get(sz); b != nil {
+			return b, nil
+		}
+
+		select {
+		case <-c.source.sig:
+		case <-c.done:
+			return nil, io.EOF
+		case <-c.source.done:
+			return nil, io.EOF
+		}
+	}
+}
+
+func (c *routeConsumer) Close() error {
+	if c.closed.Swap(true) {
+		return errors.New("already closed")
+	}
+
+	close(c.done)
+	return nil
+}
+
+func (b *routeBatch) Events() []publisher.Event {
+	return b.events
+}
+
+func (b *routeBatch) ACK() {
+	for _, p := range b.parents {
+		p.batch.done(p.count)
+	}
+	b.source.release(b.count)
+}
+
+// done marks n events of the batch as ACKed by an output. The batch read from
+// the queue is ACKed once all its events have been ACKed.
+func (b *routedBatch) done(n int) {
+	if b.pending.Sub(int64(n)) == 0 {
+		b.original.ACK()
+	}
+}
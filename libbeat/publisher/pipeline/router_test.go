@@ -0,0 +1,415 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pipeline
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/conditions"
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/libbeat/monitoring"
+	"github.com/elastic/beats/libbeat/outputs"
+	"github.com/elastic/beats/libbeat/publisher"
+	"github.com/elastic/beats/libbeat/publisher/queue"
+	"github.com/elastic/beats/libbeat/publisher/queue/memqueue"
+)
+
+// recordingClient records the value of the `id` field of all events
+// published. Batches are ACKed, unless the client is blocked.
+type recordingClient struct {
+	mutex   sync.Mutex
+	ids     []int
+	block   bool
+	blocked []publisher.Batch
+}
+
+func (c *recordingClient) Close() error   { return nil }
+func (c *recordingClient) String() string { return "recording" }
+
+func (c *recordingClient) Publish(batch publisher.Batch) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for _, event := range batch.Events() {
+		id, _ := event.Content.Fields["id"].(int)
+		c.ids = append(c.ids, id)
+	}
+
+	if c.block {
+		c.blocked = append(c.blocked, batch)
+		return nil
+	}
+	batch.ACK()
+	return nil
+}
+
+func (c *recordingClient) events() []int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return append([]int(nil), c.ids...)
+}
+
+func (c *recordingClient) waitEvents(t *testing.T, n int) []int {
+	for start := time.Now(); time.Since(start) < 5*time.Second; time.Sleep(10 * time.Millisecond) {
+		if ids := c.events(); len(ids) >= n {
+			return ids
+		}
+	}
+	t.Fatalf("timeout waiting for %v events, got %v", n, c.events())
+	return nil
+}
+
+func makeRoutedPipeline(t *testing.T, routes ...routedOutput) *Pipeline {
+	if testing.Verbose() {
+		logp.TestingSetup()
+	}
+
+	p, err := newRouted(beat.Info{},
+		Monitors{},
+		func(eventer queue.Eventer) (queue.Queue, error) {
+			return memqueue.NewBroker(logp.L(), memqueue.Settings{
+				Eventer: eventer,
+				Events:  64,
+			}), nil
+		},
+		routes,
+		Settings{},
+	)
+	require.NoError(t, err)
+	return p
+}
+
+func makeRoute(t *testing.T, name string, when map[string]interface{}, client outputs.Client) routedOutput {
+	out := routedOutput{
+		name:  name,
+		group: outputs.Group{Clients: []outputs.Client{client}, BatchSize: 4},
+	}
+	if when != nil {
+		var config conditions.Config
+		require.NoError(t, common.MustNewConfigFrom(when).Unpack(&config))
+		condition, err := conditions.NewCondition(&config)
+		require.NoError(t, err)
+		out.condition = condition
+	}
+	return out
+}
+
+func publishTestEvents(t *testing.T, p *Pipeline, events ...beat.Event) {
+	client, err := p.Connect()
+	require.NoError(t, err)
+	defer client.Close()
+
+	for _, event := range events {
+		client.Publish(event)
+	}
+}
+
+func TestOutputRouter(t *testing.T) {
+	security := &recordingClient{}
+	other := &recordingClient{}
+
+	p := makeRoutedPipeline(t,
+		makeRoute(t, "security", map[string]interface{}{"equals.type": "security"}, security),
+		makeRoute(t, "other", nil, other),
+	)
+	defer p.Close()
+
+	publishTestEvents(t, p,
+		beat.Event{Fields: common.MapStr{"id": 1, "type": "security"}},
+		beat.Event{Fields: common.MapStr{"id": 2, "type": "metrics"}},
+		beat.Event{Fields: common.MapStr{"id": 3}},
+		beat.Event{
+			Meta:   common.MapStr{"output": "other"},
+			Fields: common.MapStr{"id": 4, "type": "security"},
+		},
+		beat.Event{
+			Meta:   common.MapStr{"output": "security"},
+			Fields: common.MapStr{"id": 5},
+		},
+		beat.Event{
+			Meta:   common.MapStr{"output": "unknown"},
+			Fields: common.MapStr{"id": 6},
+		},
+		beat.Event{Fields: common.MapStr{"id": 7, "type": "security"}},
+	)
+
+	assert.Equal(t, []int{1, 5, 7}, security.waitEvents(t, 3))
+	assert.Equal(t, []int{2, 3, 4}, other.waitEvents(t, 3))
+
+	// the event routed to an unknown output is dropped
+	time.Sleep(50 * time.Millisecond)
+	assert.Len(t, security.events(), 3)
+	assert.Len(t, other.events(), 3)
+}
+
+func TestOutputRouterNoDefault(t *testing.T) {
+	security := &recordingClient{}
+
+	p := makeRoutedPipeline(t,
+		makeRoute(t, "security", map[string]interface{}{"equals.type": "security"}, security),
+	)
+	defer p.Close()
+
+	var events []beat.Event
+	for i := 0; i < 100; i++ {
+		events = append(events, beat.Event{Fields: common.MapStr{"id": i}})
+	}
+	events = append(events, beat.Event{Fields: common.MapStr{"id": 100, "type": "security"}})
+
+	// Events not routed to any output are dropped, without blocking the queue.
+	publishTestEvents(t, p, events...)
+	assert.Equal(t, []int{100}, security.waitEvents(t, 1))
+}
+
+func TestOutputRouterBlockedOutput(t *testing.T) {
+	blocked := &recordingClient{block: true}
+	other := &recordingClient{}
+
+	p := makeRoutedPipeline(t,
+		makeRoute(t, "blocked", map[string]interface{}{"equals.type": "blocked"}, blocked),
+		makeRoute(t, "other", nil, other),
+	)
+	defer p.Close()
+
+	var events []beat.Event
+	var expected []int
+	for i := 0; i < 20; i++ {
+		events = append(events, beat.Event{Fields: common.MapStr{"id": i, "type": "blocked"}})
+		events = append(events, beat.Event{Fields: common.MapStr{"id": 100 + i}})
+		expected = append(expected, 100+i)
+	}
+
+	// Events are published to the other output, while the blocked output
+	// does not ACK its events, as long as the queue is not full.
+	publishTestEvents(t, p, events...)
+	assert.Equal(t, expected, other.waitEvents(t, 20))
+}
+
+func TestOutputRouterMaxPending(t *testing.T) {
+	blocked := &recordingClient{block: true}
+	other := &recordingClient{}
+
+	limited := makeRoute(t, "blocked", map[string]interface{}{"equals.type": "blocked"}, blocked)
+	limited.maxPending = 4
+	p := makeRoutedPipeline(t, limited, makeRoute(t, "other", nil, other))
+	defer p.Close()
+
+	var events []beat.Event
+	var expected []int
+	for i := 0; i < 100; i++ {
+		events = append(events, beat.Event{Fields: common.MapStr{"id": i, "type": "blocked"}})
+	}
+	for i := 0; i < 20; i++ {
+		events = append(events, beat.Event{Fields: common.MapStr{"id": 100 + i}})
+		expected = append(expected, 100+i)
+	}
+
+	// The events pending for the blocked output do not block the queue, and
+	// events exceeding the limit of pending events are dropped.
+	publishTestEvents(t, p, events...)
+	assert.Equal(t, expected, other.waitEvents(t, 20))
+	assert.Equal(t, []int{0, 1, 2, 3}, blocked.events())
+}
+
+func TestLoadRoutedTelemetry(t *testing.T) {
+	telemetry := monitoring.NewRegistry()
+	clusterUUID := monitoring.NewString(telemetry.NewRegistry("outputs.elasticsearch"), "cluster_uuid")
+	clusterUUID.Set("uuid")
+
+	p, err := LoadRouted(beat.Info{},
+		Monitors{Metrics: monitoring.NewRegistry(), Telemetry: telemetry},
+		Config{},
+		nil,
+		[]OutputRoute{{
+			Name: "elasticsearch",
+			Factory: func(outputs.Observer) (string, outputs.Group, error) {
+				return "elasticsearch", outputs.Group{Clients: []outputs.Client{&recordingClient{}}}, nil
+			},
+		}},
+	)
+	require.NoError(t, err)
+	defer p.Close()
+
+	// A route named elasticsearch must not clear the cluster UUID reported
+	// by monitoring.
+	snapshot := monitoring.CollectFlatSnapshot(telemetry, monitoring.Full, false)
+	assert.Equal(t, "uuid", snapshot.Strings["outputs.elasticsearch.cluster_uuid"])
+	assert.Equal(t, "elasticsearch", snapshot.Strings["outputs.routes.elasticsearch.name"])
+}
+
+func TestRouteSource(t *testing.T) {
+	var acked []int
+	makeBatch := func(id int, n int) *routedBatch {
+		b := &routedBatch{original: &ackRecorder{id: id, acked: &acked}}
+		b.pending.Store(int64(n))
+		return b
+	}
+	makeEvents := func(n int) []publisher.Event {
+		return make([]publisher.Event, n)
+	}
+
+	source := &routeSource{sig: make(chan struct{}, 1), done: make(chan struct{})}
+	b1, b2 := makeBatch(1, 3), makeBatch(2, 4)
+	source.push(b1, makeEvents(3))
+	source.push(b2, makeEvents(2))
+
+	consumer := source.Consumer()
+
+	// batches read from the source span multiple batches read from the queue
+	first, err := consumer.Get(4)
+	require.NoError(t, err)
+	assert.Len(t, first.Events(), 4)
+	second, err := consumer.Get(4)
+	require.NoError(t, err)
+	assert.Len(t, second.Events(), 1)
+
+	second.ACK()
+	assert.Empty(t, acked)
+	first.ACK()
+	assert.Equal(t, []int{1}, acked)
+
+	// the remaining events of b2 are ACKed by another output
+	b2.done(2)
+	assert.Equal(t, []int{1, 2}, acked)
+
+	consumer.Close()
+	_, err = consumer.Get(4)
+	assert.Error(t, err)
+}
+
+func TestRouteSourceMaxPending(t *testing.T) {
+	var acked []int
+	makeBatch := func(id int, n int) *routedBatch {
+		b := &routedBatch{original: &ackRecorder{id: id, acked: &acked}}
+		b.pending.Store(int64(n))
+		return b
+	}
+
+	source := &routeSource{maxPending: 4, sig: make(chan struct{}, 1), done: make(chan struct{})}
+	assert.Equal(t, 3, source.push(makeBatch(1, 3), make([]publisher.Event, 3)))
+	assert.Equal(t, 1, source.push(makeBatch(2, 3), make([]publisher.Event, 3)))
+	assert.Equal(t, 0, source.push(makeBatch(3, 1), make([]publisher.Event, 1)))
+
+	consumer := source.Consumer()
+	batch, err := consumer.Get(2)
+	require.NoError(t, err)
+	assert.Len(t, batch.Events(), 2)
+
+	// events read, but not yet ACKed, are still pending
+	assert.Equal(t, 0, source.push(makeBatch(4, 1), make([]publisher.Event, 1)))
+
+	// batches read from the queue are not ACKed by the source
+	batch.ACK()
+	assert.Empty(t, acked)
+	assert.Equal(t, 2, source.push(makeBatch(5, 3), make([]publisher.Event, 3)))
+}
+
+type ackRecorder struct {
+	id    int
+	acked *[]int
+}
+
+func (b *ackRecorder) Events() []publisher.Event { return nil }
+func (b *ackRecorder) ACK()                      { *b.acked = append(*b.acked, b.id) }
+
+func TestMakeOutputRoutes(t *testing.T) {
+	type config struct {
+		Outputs []OutputConfig `config:"outputs"`
+	}
+	makeConfigs := func(t *testing.T, outputs ...map[string]interface{}) []OutputConfig {
+		var c config
+		err := common.MustNewConfigFrom(map[string]interface{}{"outputs": outputs}).Unpack(&c)
+		require.NoError(t, err)
+		return c.Outputs
+	}
+	factory := func(cfg common.ConfigNamespace) OutputFactory {
+		return func(outputs.Observer) (string, outputs.Group, error) {
+			return cfg.Name(), outputs.Group{}, nil
+		}
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		configs := makeConfigs(t,
+			map[string]interface{}{
+				"name":             "security",
+				"when.equals.type": "security",
+				"output.console":   map[string]interface{}{"pretty": true},
+			},
+			map[string]interface{}{
+				"name":                 "disabled",
+				"output.file.enabled":  false,
+				"output.file.filename": "beat",
+			},
+			map[string]interface{}{
+				"name":                 "default",
+				"output.file.filename": "beat",
+				"max_pending_events":   1000,
+			},
+		)
+
+		routes, err := MakeOutputRoutes(configs, factory)
+		require.NoError(t, err)
+		require.Len(t, routes, 2)
+
+		assert.Equal(t, "security", routes[0].Name)
+		assert.NotNil(t, routes[0].Condition)
+		name, _, _ := routes[0].Factory(nil)
+		assert.Equal(t, "console", name)
+
+		assert.Equal(t, "default", routes[1].Name)
+		assert.Nil(t, routes[1].Condition)
+		assert.Equal(t, 1000, routes[1].MaxPending)
+		name, _, _ = routes[1].Factory(nil)
+		assert.Equal(t, "file", name)
+	})
+
+	t.Run("duplicate names", func(t *testing.T) {
+		configs := makeConfigs(t,
+			map[string]interface{}{"name": "default", "output.console.pretty": true},
+			map[string]interface{}{"name": "default", "output.file.filename": "beat"},
+		)
+
+		_, err := MakeOutputRoutes(configs, factory)
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid configs", func(t *testing.T) {
+		for name, output := range map[string]map[string]interface{}{
+			"missing name": {"output.console.pretty": true},
+			"dotted name":  {"name": "a.b", "output.console.pretty": true},
+			"negative max pending": {
+				"name": "default", "output.console.pretty": true, "max_pending_events": -1,
+			},
+		} {
+			t.Run(name, func(t *testing.T) {
+				var c config
+				err := common.MustNewConfigFrom(map[string]interface{}{
+					"outputs": []interface{}{output},
+				}).Unpack(&c)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
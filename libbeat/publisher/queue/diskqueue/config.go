@@ -0,0 +1,83 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package diskqueue
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/joeshaw/multierror"
+
+	"github.com/elastic/beats/libbeat/common/cfgtype"
+)
+
+type config struct {
+	Path        string           `config:"path"`
+	Permissions os.FileMode      `config:"permissions"`
+	MaxSize     cfgtype.ByteSize `config:"max_size"`
+	SegmentSize cfgtype.ByteSize `config:"segment_size"`
+	Flush       flushConfig      `config:"flush"`
+}
+
+type flushConfig struct {
+	Events  int           `config:"events" validate:"min=0"`
+	Timeout time.Duration `config:"timeout" validate:"min=0"`
+}
+
+func defaultConfig() config {
+	return config{
+		Path:        "",
+		Permissions: 0600,
+		MaxSize:     1 * humanize.GiByte,
+		SegmentSize: 64 * humanize.MiByte,
+		Flush: flushConfig{
+			Events:  1024,
+			Timeout: 1 * time.Second,
+		},
+	}
+}
+
+func (c *config) Validate() error {
+	var errs multierror.Errors
+
+	if c.SegmentSize < humanize.MiByte {
+		errs = append(errs, errors.New("segment_size must be at least 1MiB"))
+	}
+
+	// Segments are only deleted after all events in a segment have been ACKed.
+	// Require room for at least 2 segments, so the writer can make progress
+	// while the oldest segment is still being consumed.
+	if c.MaxSize < 2*c.SegmentSize {
+		errs = append(errs, fmt.Errorf("max_size (%v) must be at least twice the segment_size (%v)",
+			c.MaxSize, c.SegmentSize))
+	}
+
+	if !c.Permissions.IsRegular() {
+		errs = append(errs, fmt.Errorf("permissions %v are not regular file permissions", c.Permissions.String()))
+	} else {
+		m := c.Permissions.Perm()
+		if (m & 0600) != 0600 {
+			errs = append(errs, errors.New("files must be readable and writable by current user"))
+		}
+	}
+
+	return errs.Err()
+}
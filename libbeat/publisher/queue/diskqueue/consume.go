@@ -0,0 +1,65 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package diskqueue
+
+import (
+	"github.com/elastic/beats/libbeat/publisher"
+	"github.com/elastic/beats/libbeat/publisher/queue"
+)
+
+type consumer struct {
+	queue  *Queue
+	closed bool // protected by queue mutex
+}
+
+type batch struct {
+	queue  *Queue
+	events []publisher.Event
+
+	count    uint64   // number of events read, including events failing to decode
+	end      position // read position after the last event in the batch
+	acked    bool     // protected by queue mutex
+	reported bool
+}
+
+func newConsumer(q *Queue) *consumer {
+	return &consumer{queue: q}
+}
+
+func (c *consumer) Get(sz int) (queue.Batch, error) {
+	return c.queue.get(c, sz)
+}
+
+func (c *consumer) Close() error {
+	return c.queue.closeConsumer(c)
+}
+
+func (b *batch) Events() []publisher.Event {
+	if b.reported {
+		panic("Get Events from inactive batch")
+	}
+	return b.events
+}
+
+func (b *batch) ACK() {
+	if b.reported {
+		panic("Can not acknowledge already acknowledged batch")
+	}
+	b.reported = true
+	b.queue.ack(b)
+}
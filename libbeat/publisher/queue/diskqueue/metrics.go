@@ -0,0 +1,54 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package diskqueue
+
+import "github.com/elastic/beats/libbeat/monitoring"
+
+// metrics reports the queue fill level.
+type metrics struct {
+	events   *monitoring.Uint // number of events not yet ACKed
+	bytes    *monitoring.Uint // total size of all segment files
+	maxBytes *monitoring.Uint
+	segments *monitoring.Uint
+	fill     *monitoring.Float // ratio of bytes to maxBytes
+
+	corrupted *monitoring.Uint // number of events dropped due to corrupted records
+}
+
+func newMetrics(reg *monitoring.Registry, maxBytes uint64) *metrics {
+	m := &metrics{
+		events:   monitoring.NewUint(reg, "events.count"),
+		bytes:    monitoring.NewUint(reg, "bytes.used"),
+		maxBytes: monitoring.NewUint(reg, "bytes.max"),
+		segments: monitoring.NewUint(reg, "segments"),
+		fill:     monitoring.NewFloat(reg, "fill.pct"),
+
+		corrupted: monitoring.NewUint(reg, "events.corrupted", monitoring.Counter),
+	}
+	m.maxBytes.Set(maxBytes)
+	return m
+}
+
+func (m *metrics) update(events, bytes uint64, segments int) {
+	m.events.Set(events)
+	m.bytes.Set(bytes)
+	m.segments.Set(uint64(segments))
+	if max := m.maxBytes.Get(); max > 0 {
+		m.fill.Set(float64(bytes) / float64(max))
+	}
+}
@@ -0,0 +1,96 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package diskqueue
+
+import (
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/publisher"
+	"github.com/elastic/beats/libbeat/publisher/queue"
+)
+
+// producer forwards events to the disk queue. If the producer has been
+// configured with an ACK callback, ACKs are reported per producer.
+type producer struct {
+	queue *Queue
+	state produceState
+}
+
+// produceState holds the per producer callbacks. Fields are protected by the
+// queue mutex.
+type produceState struct {
+	ackCB     func(count int)
+	dropCB    func(beat.Event)
+	cancelled bool
+}
+
+type ackCount struct {
+	state *produceState
+	count int
+}
+
+func newProducer(q *Queue, ackCB func(int), dropCB func(beat.Event)) queue.Producer {
+	p := &producer{queue: q}
+	p.state.ackCB = ackCB
+	p.state.dropCB = dropCB
+	return p
+}
+
+func (p *producer) Publish(event publisher.Event) bool {
+	return p.queue.publish(&p.state, &event, true)
+}
+
+func (p *producer) TryPublish(event publisher.Event) bool {
+	return p.queue.publish(&p.state, &event, false)
+}
+
+// Cancel disconnects the producer from the queue. Events already written are
+// kept in the queue, as they are already persisted to disk.
+func (p *producer) Cancel() int {
+	p.queue.cancel(&p.state)
+	return 0
+}
+
+// collectACKs computes the number of ACKed events per active producer.
+// Events of the same producer are stored consecutively in most cases, so the
+// last entry is checked first.
+func collectACKs(states []*produceState) []ackCount {
+	var acks []ackCount
+	for _, st := range states {
+		if st == nil || st.ackCB == nil || st.cancelled {
+			continue
+		}
+
+		if n := len(acks); n > 0 && acks[n-1].state == st {
+			acks[n-1].count++
+			continue
+		}
+
+		found := false
+		for i := range acks {
+			if acks[i].state == st {
+				acks[i].count++
+				found = true
+				break
+			}
+		}
+		if !found {
+			acks = append(acks, ackCount{state: st, count: 1})
+		}
+	}
+	return acks
+}
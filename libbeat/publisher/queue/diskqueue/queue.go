@@ -0,0 +1,691 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package diskqueue
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/libbeat/monitoring"
+	"github.com/elastic/beats/libbeat/publisher"
+	"github.com/elastic/beats/libbeat/publisher/queue"
+)
+
+// Queue implements a segmented write-ahead-log queue.Queue.
+//
+// Producers append events to the newest segment file. The consumer reads
+// events sequentially, starting at the oldest not yet ACKed event. Batches
+// can be ACKed out of order, but the ACK position only advances over the
+// longest sequence of ACKed batches. Segments older than the ACK position are
+// deleted.
+type Queue struct {
+	logger   logger
+	dir      string
+	settings Settings
+	metrics  *metrics
+	registry *monitoring.Registry
+
+	// mu protects all fields below, cond signals changes in
+	// available events, free space or shutdown.
+	mu     sync.Mutex
+	cond   *sync.Cond
+	closed bool
+
+	segments []segment // segments on disk, oldest first. The last segment is the write segment.
+	used     uint64    // total size of segments in bytes
+
+	writer   *os.File
+	unsynced int
+	encoder  *encoder
+	writeBuf []byte
+
+	// Event indexes are counted from queue startup. Events in
+	// [ackIndex, readIndex) are in flight, events in [readIndex, writeIndex)
+	// are waiting to be read.
+	ackIndex, readIndex, writeIndex uint64
+
+	// Number of events recovered from disk on startup. These events have not been
+	// published by the current process and are not reported to the eventer.
+	restored uint64
+
+	// producer states of all events not yet ACKed, starting at ackIndex.
+	// Events recovered from disk have no producer state.
+	states []*produceState
+
+	ackPos  position
+	pending []*batch // batches in read order, waiting for ACK
+
+	// ackMu serializes ACK callbacks, so producers observe ACKs in order.
+	ackMu sync.Mutex
+
+	// readMu serializes consumers reading from the segments.
+	readMu  sync.Mutex
+	reader  *segmentReader
+	decoder *decoder
+
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+// Settings configure a new disk queue.
+type Settings struct {
+	Eventer queue.Eventer
+
+	// file permissions of segment and state files
+	Mode os.FileMode
+
+	// Upper bound for the total size of all segment files. Producers block if
+	// the queue is full.
+	MaxSize uint64
+
+	// Maximum size of a segment file. A new segment is created once the
+	// current segment is full.
+	SegmentSize uint64
+
+	// Fsync the current segment after FlushEvents events have been written.
+	// A value <= 0 disables the event count based fsync.
+	FlushEvents int
+
+	// Fsync the current segment periodically if it contains unsynced events.
+	// A value <= 0 disables the timer based fsync.
+	FlushTimeout time.Duration
+
+	// Registry used to report the queue fill level. Metrics are reported
+	// in the 'queue.disk' namespace.
+	Registry *monitoring.Registry
+}
+
+const (
+	// maximum number of events returned to a consumer requesting all
+	// available events.
+	defaultBatchSize = 2048
+
+	metricsNamespace = "queue.disk"
+)
+
+// NewQueue opens or creates a disk queue in the directory at path. Events
+// found in existing segments are recovered and are made available to the
+// consumer. Corrupted or partially written records at the end of a segment are
+// truncated.
+func NewQueue(logger logger, path string, settings Settings) (*Queue, error) {
+	mode := settings.Mode
+	if mode == 0 {
+		mode = 0600
+	}
+	settings.Mode = mode
+
+	if err := os.MkdirAll(path, 0750); err != nil {
+		return nil, errors.Wrapf(err, "disk queue: failed to create directory '%v'", path)
+	}
+
+	q := &Queue{
+		logger:   logger,
+		dir:      path,
+		settings: settings,
+		encoder:  newEncoder(),
+		decoder:  newDecoder(),
+		done:     make(chan struct{}),
+	}
+	q.cond = sync.NewCond(&q.mu)
+
+	if err := q.recover(); err != nil {
+		q.closeFiles()
+		return nil, errors.Wrapf(err, "disk queue: failed to open queue at '%v'", path)
+	}
+
+	reg := settings.Registry
+	if reg != nil {
+		reg.Remove(metricsNamespace)
+		reg = reg.NewRegistry(metricsNamespace)
+		q.registry = settings.Registry
+	} else {
+		reg = monitoring.NewRegistry()
+	}
+	q.metrics = newMetrics(reg, settings.MaxSize)
+	q.updateMetrics()
+
+	if settings.FlushTimeout > 0 {
+		q.wg.Add(1)
+		go q.syncLoop(settings.FlushTimeout)
+	}
+
+	return q, nil
+}
+
+// recover scans existing segments, removes already ACKed segments and
+// truncates corrupted segments. Recovered events are put back into the queue.
+func (q *Queue) recover() error {
+	ackPos, err := readState(q.dir)
+	if err != nil {
+		q.logger.Errorf("Failed to read disk queue state, reading all segments: %v", err)
+		ackPos = position{}
+	}
+
+	// segment to create if no segment is found, such that segment IDs stay
+	// monotonic in case of the state file still pointing to a removed segment.
+	firstID := ackPos.segment
+	if firstID == 0 {
+		firstID = 1
+	}
+
+	ids, err := listSegments(q.dir)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		path := segmentPath(q.dir, id)
+		if id < ackPos.segment {
+			if err := os.Remove(path); err != nil {
+				return err
+			}
+			continue
+		}
+
+		from := int64(segmentHeaderSize)
+		if id == ackPos.segment {
+			from = ackPos.offset
+		}
+
+		size, events, err := scanSegment(path, from)
+		if err != nil {
+			q.logger.Errorf("Removing invalid segment file '%v': %v", path, err)
+			if err := os.Remove(path); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if info, err := os.Stat(path); err != nil {
+			return err
+		} else if info.Size() > size {
+			q.logger.Errorf("Truncating segment '%v' from %v to %v bytes due to corrupted or incomplete record",
+				path, info.Size(), size)
+			if err := os.Truncate(path, size); err != nil {
+				return err
+			}
+		}
+
+		q.segments = append(q.segments, segment{id: id, size: size, events: uint64(events)})
+		q.used += uint64(size)
+		q.restored += uint64(events)
+	}
+
+	if err := q.openWriter(firstID); err != nil {
+		return err
+	}
+
+	// Normalize ACK position to an existing segment. The ACK position might
+	// point past the end of a segment if the segment was not synced to disk
+	// before a crash.
+	first := q.segments[0]
+	if first.id != ackPos.segment {
+		ackPos = position{segment: first.id, offset: segmentHeaderSize}
+	} else if ackPos.offset > first.size {
+		ackPos.offset = first.size
+	} else if ackPos.offset < segmentHeaderSize {
+		ackPos.offset = segmentHeaderSize
+	}
+
+	q.ackPos = ackPos
+	q.writeIndex = q.restored
+	q.states = make([]*produceState, q.restored)
+	q.reader = newSegmentReader(q.dir, ackPos, q.nextSegment)
+
+	if q.restored > 0 {
+		q.logger.Infof("Disk queue recovered %v events from %v segments", q.restored, len(q.segments))
+	}
+	return nil
+}
+
+// openWriter opens the newest segment for appending events. A new segment
+// is created if no segment exists or if the newest segment is full.
+func (q *Queue) openWriter(id segmentID) error {
+	if n := len(q.segments); n > 0 {
+		last := q.segments[n-1]
+		if uint64(last.size) < q.settings.SegmentSize {
+			f, err := os.OpenFile(segmentPath(q.dir, last.id), os.O_WRONLY|os.O_APPEND, q.settings.Mode)
+			if err != nil {
+				return err
+			}
+			q.writer = f
+			return nil
+		}
+		id = last.id + 1
+	}
+
+	return q.createSegment(id)
+}
+
+func (q *Queue) createSegment(id segmentID) error {
+	f, err := createSegment(q.dir, id, q.settings.Mode)
+	if err != nil {
+		return err
+	}
+
+	q.writer = f
+	q.segments = append(q.segments, segment{id: id, size: segmentHeaderSize})
+	q.used += segmentHeaderSize
+	return nil
+}
+
+// Close shuts down the queue. Pending writes are synced to disk.
+func (q *Queue) Close() error {
+	q.mu.Lock()
+	if q.closed {
+		q.mu.Unlock()
+		return nil
+	}
+	q.closed = true
+	close(q.done)
+	q.cond.Broadcast()
+	q.mu.Unlock()
+
+	q.wg.Wait()
+
+	// wait for active consumer reads to finish
+	q.readMu.Lock()
+	defer q.readMu.Unlock()
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	err := q.closeFiles()
+	if q.registry != nil {
+		q.registry.Remove(metricsNamespace)
+	}
+	return err
+}
+
+func (q *Queue) closeFiles() error {
+	var err error
+	if q.writer != nil {
+		err = q.writer.Sync()
+		if cerr := q.writer.Close(); err == nil {
+			err = cerr
+		}
+		q.writer = nil
+	}
+	if q.reader != nil {
+		q.reader.Close()
+	}
+	return err
+}
+
+// BufferConfig returns the queue initial buffer settings.
+func (q *Queue) BufferConfig() queue.BufferConfig {
+	return queue.BufferConfig{Events: -1}
+}
+
+// Producer creates a new queue producer for publishing events.
+func (q *Queue) Producer(cfg queue.ProducerConfig) queue.Producer {
+	return newProducer(q, cfg.ACK, cfg.OnDrop)
+}
+
+// Consumer creates a new queue consumer for consuming and acking events.
+func (q *Queue) Consumer() queue.Consumer {
+	return newConsumer(q)
+}
+
+// publish appends an event to the write segment. If block is set, publish
+// waits for free space if the queue is full.
+func (q *Queue) publish(st *produceState, event *publisher.Event, block bool) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed || st.cancelled {
+		return false
+	}
+
+	payload, err := q.encoder.encode(event)
+	if err != nil {
+		q.logger.Errorf("Dropping event, failed to encode event: %v", err)
+		return false
+	}
+
+	sz := uint64(recordHeaderSize + len(payload))
+	for q.isFull(sz) {
+		if !block {
+			q.logger.Debugf("Dropping event, queue is full")
+			return false
+		}
+
+		q.cond.Wait()
+		if q.closed || st.cancelled {
+			return false
+		}
+
+		// the encoder buffer might have been overwritten by another producer
+		if payload, err = q.encoder.encode(event); err != nil {
+			return false
+		}
+	}
+
+	if err := q.write(payload); err != nil {
+		q.logger.Errorf("Dropping event, failed to write to disk queue: %v", err)
+		return false
+	}
+
+	q.states = append(q.states, st)
+	q.writeIndex++
+	q.cond.Broadcast()
+	q.updateMetrics()
+	return true
+}
+
+func (q *Queue) isFull(sz uint64) bool {
+	// always accept events into an empty queue, so to not block forever on
+	// events bigger than the configured max size.
+	if q.writeIndex == q.ackIndex {
+		return false
+	}
+	return q.settings.MaxSize > 0 && q.used+sz > q.settings.MaxSize
+}
+
+func (q *Queue) write(payload []byte) error {
+	sz := int64(recordHeaderSize + len(payload))
+
+	last := &q.segments[len(q.segments)-1]
+	if last.size > segmentHeaderSize && uint64(last.size+sz) > q.settings.SegmentSize {
+		if err := q.rollSegment(); err != nil {
+			return err
+		}
+		last = &q.segments[len(q.segments)-1]
+	}
+
+	var err error
+	q.writeBuf, err = writeRecord(q.writer, q.writeBuf, payload)
+	if err != nil {
+		// try to remove partially written record, so to not corrupt the segment
+		q.writer.Truncate(last.size)
+		return err
+	}
+
+	last.size += sz
+	last.events++
+	q.used += uint64(sz)
+
+	q.unsynced++
+	if n := q.settings.FlushEvents; n > 0 && q.unsynced >= n {
+		return q.sync()
+	}
+	return nil
+}
+
+func (q *Queue) rollSegment() error {
+	if err := q.sync(); err != nil {
+		return err
+	}
+	if err := q.writer.Close(); err != nil {
+		return err
+	}
+
+	q.writer = nil
+	return q.createSegment(q.segments[len(q.segments)-1].id + 1)
+}
+
+func (q *Queue) sync() error {
+	if q.unsynced == 0 {
+		return nil
+	}
+	q.unsynced = 0
+	return q.writer.Sync()
+}
+
+func (q *Queue) syncLoop(interval time.Duration) {
+	defer q.wg.Done()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-q.done:
+			return
+		case <-ticker.C:
+		}
+
+		q.mu.Lock()
+		if err := q.sync(); err != nil {
+			q.logger.Errorf("Failed to sync disk queue segment: %v", err)
+		}
+		q.mu.Unlock()
+	}
+}
+
+// nextSegment returns the segment following id.
+func (q *Queue) nextSegment(id segmentID) (segmentID, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for _, seg := range q.segments {
+		if seg.id > id {
+			return seg.id, true
+		}
+	}
+	return 0, false
+}
+
+// get reads up to sz events from disk. get blocks until at least one event is
+// available, or the consumer or queue are closed.
+func (q *Queue) get(c *consumer, sz int) (*batch, error) {
+	q.readMu.Lock()
+	defer q.readMu.Unlock()
+
+	q.mu.Lock()
+	for !q.closed && !c.closed && q.readIndex == q.writeIndex {
+		q.cond.Wait()
+	}
+	if q.closed || c.closed {
+		q.mu.Unlock()
+		return nil, io.EOF
+	}
+
+	if sz <= 0 {
+		sz = defaultBatchSize
+	}
+	count := q.writeIndex - q.readIndex
+	if count > uint64(sz) {
+		count = uint64(sz)
+	}
+	q.mu.Unlock()
+
+	// Only events known to be written are read, such that no lock is required
+	// while reading from disk. Corrupted records are dropped, but still count
+	// as read, so to keep the read index in sync with the reader.
+	var read uint64
+	events := make([]publisher.Event, 0, count)
+	for read < count {
+		payload, err := q.reader.Read()
+		if err == errInvalidChecksum {
+			q.logger.Errorf("Dropping event, corrupted record in disk queue segment: %v", err)
+			q.metrics.corrupted.Inc()
+			read++
+			continue
+		}
+		if err != nil {
+			skipped := q.skipSegment()
+			if skipped == 0 {
+				return nil, errors.Wrap(err, "disk queue: failed to read event")
+			}
+			q.logger.Errorf("Dropping %v events, failed to read disk queue segment: %v", skipped, err)
+			q.metrics.corrupted.Add(skipped)
+			read += skipped
+			continue
+		}
+		read++
+
+		event, err := q.decoder.decode(payload)
+		if err != nil {
+			q.logger.Errorf("Dropping event, failed to decode event: %v", err)
+			continue
+		}
+		events = append(events, event)
+	}
+
+	b := &batch{
+		queue:  q,
+		events: events,
+		count:  read,
+		end:    q.reader.Position(),
+	}
+
+	q.mu.Lock()
+	q.readIndex += read
+	q.pending = append(q.pending, b)
+	q.mu.Unlock()
+
+	return b, nil
+}
+
+// skipSegment moves the reader past all remaining records of the segment it
+// is reading from, if the segment can not be read anymore. It returns the
+// number of records skipped.
+func (q *Queue) skipSegment() uint64 {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	pos := q.reader.Position()
+	for i, seg := range q.segments {
+		if seg.id != pos.segment {
+			continue
+		}
+		if seg.events <= q.reader.records {
+			return 0
+		}
+
+		skipped := seg.events - q.reader.records
+		if i < len(q.segments)-1 {
+			q.reader.Seek(position{segment: q.segments[i+1].id, offset: segmentHeaderSize}, 0)
+		} else {
+			// continue reading events appended to the write segment
+			q.reader.Seek(position{segment: seg.id, offset: seg.size}, seg.events)
+		}
+		return skipped
+	}
+	return 0
+}
+
+// ack marks a batch as ACKed. The ACK position is advanced over all
+// consecutively ACKed batches and segments not required anymore are deleted.
+func (q *Queue) ack(b *batch) {
+	q.mu.Lock()
+
+	b.acked = true
+	var (
+		count uint64
+		pos   position
+	)
+	for len(q.pending) > 0 && q.pending[0].acked {
+		head := q.pending[0]
+		q.pending[0] = nil
+		q.pending = q.pending[1:]
+		count += head.count
+		pos = head.end
+	}
+
+	if count == 0 {
+		q.mu.Unlock()
+		return
+	}
+
+	acks := collectACKs(q.states[:count])
+	for i := range q.states[:count] {
+		q.states[i] = nil
+	}
+	q.states = q.states[count:]
+
+	// Do not report events recovered on startup to the eventer. The pipeline
+	// has never seen these events.
+	reported := count
+	if q.ackIndex < q.restored {
+		if restored := q.restored - q.ackIndex; restored < reported {
+			reported -= restored
+		} else {
+			reported = 0
+		}
+	}
+	q.ackIndex += count
+
+	if !q.closed {
+		q.ackPos = pos
+		if err := writeState(q.dir, pos, q.settings.Mode); err != nil {
+			q.logger.Errorf("Failed to update disk queue state: %v", err)
+		}
+		q.removeSegments(pos.segment)
+	}
+
+	q.cond.Broadcast()
+	q.updateMetrics()
+
+	q.ackMu.Lock()
+	defer q.ackMu.Unlock()
+	q.mu.Unlock()
+
+	if reported > 0 && q.settings.Eventer != nil {
+		q.settings.Eventer.OnACK(int(reported))
+	}
+	for _, a := range acks {
+		a.state.ackCB(a.count)
+	}
+}
+
+// removeSegments deletes all segments older than the segment at id.
+func (q *Queue) removeSegments(id segmentID) {
+	i := 0
+	for ; i < len(q.segments)-1 && q.segments[i].id < id; i++ {
+		seg := q.segments[i]
+		if err := os.Remove(segmentPath(q.dir, seg.id)); err != nil {
+			q.logger.Errorf("Failed to remove disk queue segment: %v", err)
+			break
+		}
+		q.used -= uint64(seg.size)
+	}
+	q.segments = q.segments[i:]
+}
+
+func (q *Queue) updateMetrics() {
+	q.metrics.update(q.writeIndex-q.ackIndex, q.used, len(q.segments))
+}
+
+// cancel disconnects a producer. Blocked publish calls of the producer
+// return and no more ACKs will be reported to the producer.
+func (q *Queue) cancel(st *produceState) {
+	q.mu.Lock()
+	st.cancelled = true
+	q.cond.Broadcast()
+	q.mu.Unlock()
+}
+
+// closeConsumer wakes up all blocked get calls.
+func (q *Queue) closeConsumer(c *consumer) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if c.closed {
+		return fmt.Errorf("already closed")
+	}
+	c.closed = true
+	q.cond.Broadcast()
+	return nil
+}
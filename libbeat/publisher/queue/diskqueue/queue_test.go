@@ -0,0 +1,371 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package diskqueue
+
+import (
+	"bufio"
+	"flag"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/libbeat/monitoring"
+	"github.com/elastic/beats/libbeat/publisher"
+	"github.com/elastic/beats/libbeat/publisher/queue"
+	"github.com/elastic/beats/libbeat/publisher/queue/queuetest"
+)
+
+var seed int64
+
+type testQueue struct {
+	*Queue
+	teardown func()
+}
+
+func init() {
+	flag.Int64Var(&seed, "seed", time.Now().UnixNano(), "test random seed")
+}
+
+func TestProduceConsumer(t *testing.T) {
+	maxEvents := 1024
+	minEvents := 32
+
+	rand.Seed(seed)
+	events := rand.Intn(maxEvents-minEvents) + minEvents
+	batchSize := rand.Intn(events-8) + 4
+
+	t.Log("seed: ", seed)
+	t.Log("events: ", events)
+	t.Log("batchSize: ", batchSize)
+
+	testWith := func(factory queuetest.QueueFactory) func(t *testing.T) {
+		return func(t *testing.T) {
+			t.Run("single", func(t *testing.T) {
+				queuetest.TestSingleProducerConsumer(t, events, batchSize, factory)
+			})
+			t.Run("multi", func(t *testing.T) {
+				queuetest.TestMultiProducerConsumer(t, events, batchSize, factory)
+			})
+		}
+	}
+
+	t.Run("large segments", testWith(makeTestQueue(Settings{
+		MaxSize:     4 * 1024 * 1024,
+		SegmentSize: 1024 * 1024,
+	})))
+	t.Run("small segments", testWith(makeTestQueue(Settings{
+		MaxSize:      16 * 1024,
+		SegmentSize:  1024,
+		FlushEvents:  16,
+		FlushTimeout: 10 * time.Millisecond,
+	})))
+}
+
+func TestRecoverAfterRestart(t *testing.T) {
+	dir := setupDir(t)
+	defer os.RemoveAll(dir)
+
+	settings := Settings{MaxSize: 64 * 1024, SegmentSize: 1024}
+
+	q := openQueue(t, dir, settings)
+	publishEvents(t, q, 100)
+
+	// consume and ACK the first 40 events only
+	consumer := q.Consumer()
+	batch, err := consumer.Get(40)
+	require.NoError(t, err)
+	require.Len(t, batch.Events(), 40)
+	batch.ACK()
+
+	// read, but do not ACK the next 10 events
+	batch, err = consumer.Get(10)
+	require.NoError(t, err)
+	require.Len(t, batch.Events(), 10)
+	require.NoError(t, q.Close())
+
+	q = openQueue(t, dir, settings)
+	defer q.Close()
+
+	events := consumeAll(t, q, 60)
+	for i, event := range events {
+		assert.EqualValues(t, 40+i, event.Content.Fields["value"])
+	}
+}
+
+func TestRemoveACKedSegments(t *testing.T) {
+	dir := setupDir(t)
+	defer os.RemoveAll(dir)
+
+	q := openQueue(t, dir, Settings{MaxSize: 64 * 1024, SegmentSize: 1024})
+	defer q.Close()
+
+	publishEvents(t, q, 200)
+	ids, err := listSegments(dir)
+	require.NoError(t, err)
+	require.True(t, len(ids) > 2, "expected multiple segments, found %v", len(ids))
+
+	consumeAll(t, q, 200)
+
+	// all but the active write segment must have been removed
+	ids, err = listSegments(dir)
+	require.NoError(t, err)
+	assert.Len(t, ids, 1)
+	assert.Equal(t, uint64(0), q.metrics.events.Get())
+}
+
+func TestTruncateCorruptedSegment(t *testing.T) {
+	dir := setupDir(t)
+	defer os.RemoveAll(dir)
+
+	settings := Settings{MaxSize: 64 * 1024, SegmentSize: 64 * 1024}
+
+	q := openQueue(t, dir, settings)
+	publishEvents(t, q, 10)
+	require.NoError(t, q.Close())
+
+	// simulate a partially written record at the end of the segment
+	ids, err := listSegments(dir)
+	require.NoError(t, err)
+	require.Len(t, ids, 1)
+	f, err := os.OpenFile(segmentPath(dir, ids[0]), os.O_WRONLY|os.O_APPEND, 0600)
+	require.NoError(t, err)
+	_, err = f.Write([]byte{20, 0, 0, 0, 1, 2, 3, 4, 'a', 'b'})
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	q = openQueue(t, dir, settings)
+	defer q.Close()
+
+	// new events must be readable after the truncated record
+	publishEvents(t, q, 1)
+	events := consumeAll(t, q, 11)
+	assert.EqualValues(t, 0, events[10].Content.Fields["value"])
+}
+
+func TestSkipCorruptedRecord(t *testing.T) {
+	dir := setupDir(t)
+	defer os.RemoveAll(dir)
+
+	q := openQueue(t, dir, Settings{MaxSize: 64 * 1024, SegmentSize: 64 * 1024})
+	defer q.Close()
+
+	publishEvents(t, q, 10)
+
+	// flip a payload byte of the 4th record
+	ids, err := listSegments(dir)
+	require.NoError(t, err)
+	require.Len(t, ids, 1)
+	offsets := recordOffsets(t, segmentPath(dir, ids[0]))
+	corruptSegment(t, segmentPath(dir, ids[0]), offsets[3]+recordHeaderSize)
+
+	events := consumeAll(t, q, 9)
+	for i, event := range events {
+		value := i
+		if i >= 3 {
+			value++
+		}
+		assert.EqualValues(t, value, event.Content.Fields["value"])
+	}
+	assert.Equal(t, uint64(1), q.metrics.corrupted.Get())
+
+	// the queue must stay usable
+	publishEvents(t, q, 1)
+	events = consumeAll(t, q, 1)
+	assert.EqualValues(t, 0, events[0].Content.Fields["value"])
+	assert.Equal(t, uint64(0), q.metrics.events.Get())
+}
+
+func TestSkipUnreadableSegment(t *testing.T) {
+	dir := setupDir(t)
+	defer os.RemoveAll(dir)
+
+	q := openQueue(t, dir, Settings{MaxSize: 64 * 1024, SegmentSize: 1024})
+	defer q.Close()
+
+	publishEvents(t, q, 100)
+	ids, err := listSegments(dir)
+	require.NoError(t, err)
+	require.True(t, len(ids) > 2, "expected multiple segments, found %v", len(ids))
+
+	// overwrite the length of the 2nd record in the first segment, such that
+	// the remainder of the segment can not be read
+	path := segmentPath(dir, ids[0])
+	offsets := recordOffsets(t, path)
+	f, err := os.OpenFile(path, os.O_WRONLY, 0600)
+	require.NoError(t, err)
+	_, err = f.WriteAt([]byte{0, 0, 0, 0}, offsets[1])
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	skipped := len(offsets) - 1
+	events := consumeAll(t, q, 100-skipped)
+	assert.EqualValues(t, 0, events[0].Content.Fields["value"])
+	for i, event := range events[1:] {
+		assert.EqualValues(t, len(offsets)+i, event.Content.Fields["value"])
+	}
+	assert.Equal(t, uint64(skipped), q.metrics.corrupted.Get())
+	assert.Equal(t, uint64(0), q.metrics.events.Get())
+}
+
+func TestTryPublishOnFullQueue(t *testing.T) {
+	dir := setupDir(t)
+	defer os.RemoveAll(dir)
+
+	q := openQueue(t, dir, Settings{MaxSize: 2048, SegmentSize: 1024})
+	defer q.Close()
+
+	producer := q.Producer(queue.ProducerConfig{})
+	published := 0
+	for producer.TryPublish(makeEvent(published)) {
+		published++
+		require.True(t, published < 1000, "queue did not report being full")
+	}
+
+	consumeAll(t, q, published)
+	assert.True(t, producer.TryPublish(makeEvent(0)))
+}
+
+func TestReportMetrics(t *testing.T) {
+	dir := setupDir(t)
+	defer os.RemoveAll(dir)
+
+	reg := monitoring.NewRegistry()
+	q := openQueue(t, dir, Settings{MaxSize: 64 * 1024, SegmentSize: 1024, Registry: reg})
+
+	publishEvents(t, q, 5)
+	snapshot := monitoring.CollectFlatSnapshot(reg, monitoring.Full, false)
+	assert.Equal(t, int64(5), snapshot.Ints["queue.disk.events.count"])
+	assert.Equal(t, int64(64*1024), snapshot.Ints["queue.disk.bytes.max"])
+	assert.True(t, snapshot.Floats["queue.disk.fill.pct"] > 0)
+
+	require.NoError(t, q.Close())
+	assert.Nil(t, reg.Get("queue.disk"))
+}
+
+func makeTestQueue(settings Settings) queuetest.QueueFactory {
+	return func(t *testing.T) queue.Queue {
+		dir := setupDir(t)
+		q, err := NewQueue(logp.NewLogger("diskqueue"), dir, settings)
+		if err != nil {
+			os.RemoveAll(dir)
+			t.Fatal(err)
+		}
+		return &testQueue{Queue: q, teardown: func() { os.RemoveAll(dir) }}
+	}
+}
+
+func (t *testQueue) Close() error {
+	err := t.Queue.Close()
+	t.teardown()
+	return err
+}
+
+func setupDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "diskqueue")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func openQueue(t *testing.T, dir string, settings Settings) *Queue {
+	q, err := NewQueue(logp.NewLogger("diskqueue"), dir, settings)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return q
+}
+
+func makeEvent(i int) publisher.Event {
+	return publisher.Event{
+		Content: beat.Event{
+			Timestamp: time.Now(),
+			Fields:    common.MapStr{"value": i, "message": "test message"},
+		},
+	}
+}
+
+// recordOffsets returns the offsets of all records in a segment file.
+func recordOffsets(t *testing.T, path string) []int64 {
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	rd := bufio.NewReader(f)
+	require.NoError(t, checkSegmentHeader(rd))
+
+	var (
+		offsets []int64
+		buf     []byte
+	)
+	offset := int64(segmentHeaderSize)
+	for {
+		_, n, err := readRecord(rd, &buf)
+		if err == io.EOF {
+			return offsets
+		}
+		require.NoError(t, err)
+		offsets = append(offsets, offset)
+		offset += int64(n)
+	}
+}
+
+func corruptSegment(t *testing.T, path string, offset int64) {
+	f, err := os.OpenFile(path, os.O_RDWR, 0600)
+	require.NoError(t, err)
+	defer f.Close()
+
+	b := make([]byte, 1)
+	_, err = f.ReadAt(b, offset)
+	require.NoError(t, err)
+	b[0] ^= 0xff
+	_, err = f.WriteAt(b, offset)
+	require.NoError(t, err)
+}
+
+func publishEvents(t *testing.T, q *Queue, n int) {
+	producer := q.Producer(queue.ProducerConfig{})
+	for i := 0; i < n; i++ {
+		if !producer.Publish(makeEvent(i)) {
+			t.Fatalf("failed to publish event %v", i)
+		}
+	}
+}
+
+func consumeAll(t *testing.T, q *Queue, n int) []publisher.Event {
+	var events []publisher.Event
+	consumer := q.Consumer()
+	defer consumer.Close()
+
+	for len(events) < n {
+		batch, err := consumer.Get(n - len(events))
+		require.NoError(t, err)
+		events = append(events, batch.Events()...)
+		batch.ACK()
+	}
+	return events
+}
@@ -0,0 +1,318 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package diskqueue
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// On disk layout:
+//
+// Each segment file starts with an 8 byte header (magic + format version),
+// followed by a sequence of records. Every record is prefixed with the payload
+// length and the CRC32 (Castagnoli) checksum of the payload:
+//
+//   segment: | magic (4) | version (4) | record | record | ... |
+//   record:  | length (4) | checksum (4) | payload (length) |
+//
+// All integers are stored in little endian byte order. Segments are named by
+// their ID, which is incremented by one for every new segment.
+
+type segmentID uint64
+
+// position identifies a record boundary within the queue.
+type position struct {
+	segment segmentID
+	offset  int64
+}
+
+// segment tracks a segment file known to the queue.
+type segment struct {
+	id     segmentID
+	size   int64
+	events uint64 // number of records, not counting records ACKed before startup
+}
+
+const (
+	segmentMagic   uint32 = 0x51444247 // 'GBDQ'
+	segmentVersion uint32 = 1
+
+	segmentHeaderSize = 8
+	recordHeaderSize  = 8
+
+	// upper bound for a single record, used to detect garbage length fields
+	// when recovering from a crash.
+	maxRecordSize = 1 << 30
+
+	segmentExt = ".seg"
+)
+
+var (
+	errInvalidHeader   = errors.New("invalid segment header")
+	errInvalidChecksum = errors.New("record checksum mismatch")
+	errInvalidLength   = errors.New("invalid record length")
+)
+
+var castagnoli = crc32.MakeTable(crc32.Castagnoli)
+
+func segmentPath(dir string, id segmentID) string {
+	return filepath.Join(dir, fmt.Sprintf("%020d%v", uint64(id), segmentExt))
+}
+
+// listSegments returns all segment IDs found in dir in ascending order.
+func listSegments(dir string) ([]segmentID, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []segmentID
+	for _, info := range files {
+		name := info.Name()
+		if !info.Mode().IsRegular() || !strings.HasSuffix(name, segmentExt) {
+			continue
+		}
+
+		id, err := strconv.ParseUint(strings.TrimSuffix(name, segmentExt), 10, 64)
+		if err != nil {
+			continue
+		}
+		ids = append(ids, segmentID(id))
+	}
+
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids, nil
+}
+
+func createSegment(dir string, id segmentID, mode os.FileMode) (*os.File, error) {
+	f, err := os.OpenFile(segmentPath(dir, id), os.O_CREATE|os.O_EXCL|os.O_WRONLY, mode)
+	if err != nil {
+		return nil, err
+	}
+
+	var hdr [segmentHeaderSize]byte
+	binary.LittleEndian.PutUint32(hdr[0:], segmentMagic)
+	binary.LittleEndian.PutUint32(hdr[4:], segmentVersion)
+	if _, err := f.Write(hdr[:]); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return f, nil
+}
+
+func checkSegmentHeader(r io.Reader) error {
+	var hdr [segmentHeaderSize]byte
+	if _, err := io.ReadFull(r, hdr[:]); err != nil {
+		return errInvalidHeader
+	}
+
+	if binary.LittleEndian.Uint32(hdr[0:]) != segmentMagic {
+		return errInvalidHeader
+	}
+	if v := binary.LittleEndian.Uint32(hdr[4:]); v != segmentVersion {
+		return fmt.Errorf("unsupported segment format version %v", v)
+	}
+	return nil
+}
+
+// scanSegment validates all records in a segment file. It returns the size of
+// the valid prefix of the file and the number of valid records stored at or
+// after the offset 'from'. A record being corrupted or partially written ends
+// the scan without error, such that the caller can truncate the segment.
+func scanSegment(path string, from int64) (size int64, events int, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer f.Close()
+
+	rd := bufio.NewReader(f)
+	if err := checkSegmentHeader(rd); err != nil {
+		return 0, 0, err
+	}
+
+	size = segmentHeaderSize
+	var buf []byte
+	for {
+		_, n, err := readRecord(rd, &buf)
+		if err != nil {
+			return size, events, nil
+		}
+
+		if size >= from {
+			events++
+		}
+		size += int64(n)
+	}
+}
+
+func writeRecord(w io.Writer, buf []byte, payload []byte) ([]byte, error) {
+	sz := recordHeaderSize + len(payload)
+	if cap(buf) < sz {
+		buf = make([]byte, sz)
+	}
+	buf = buf[:sz]
+
+	binary.LittleEndian.PutUint32(buf[0:], uint32(len(payload)))
+	binary.LittleEndian.PutUint32(buf[4:], crc32.Checksum(payload, castagnoli))
+	copy(buf[recordHeaderSize:], payload)
+
+	// write header and payload in one call, so to minimize the chance of
+	// partially written records
+	_, err := w.Write(buf)
+	return buf, err
+}
+
+// readRecord reads the next record into buf. It returns the payload and the
+// number of bytes consumed. io.EOF is returned if no more record is available.
+// If the checksum does not match, errInvalidChecksum is returned together with
+// the size of the record, such that the caller can skip the record.
+func readRecord(r io.Reader, buf *[]byte) ([]byte, int, error) {
+	var hdr [recordHeaderSize]byte
+	if _, err := io.ReadFull(r, hdr[:]); err != nil {
+		return nil, 0, err
+	}
+
+	length := binary.LittleEndian.Uint32(hdr[0:])
+	checksum := binary.LittleEndian.Uint32(hdr[4:])
+	if length == 0 || length > maxRecordSize {
+		return nil, 0, errInvalidLength
+	}
+
+	if uint32(cap(*buf)) < length {
+		*buf = make([]byte, length)
+	}
+	payload := (*buf)[:length]
+	if _, err := io.ReadFull(r, payload); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, 0, err
+	}
+
+	if crc32.Checksum(payload, castagnoli) != checksum {
+		return nil, recordHeaderSize + int(length), errInvalidChecksum
+	}
+	return payload, recordHeaderSize + int(length), nil
+}
+
+// segmentReader sequentially reads records starting at a given position.
+// The reader advances to the next segment once the current segment
+// has been read completely.
+type segmentReader struct {
+	dir  string
+	pos  position
+	next func(segmentID) (segmentID, bool)
+
+	// number of records read from the current segment, starting at the
+	// position the reader has been positioned at.
+	records uint64
+
+	file *os.File
+	rd   *bufio.Reader
+	buf  []byte
+}
+
+func newSegmentReader(dir string, pos position, next func(segmentID) (segmentID, bool)) *segmentReader {
+	return &segmentReader{dir: dir, pos: pos, next: next}
+}
+
+// Read returns the payload of the next record. The payload is only valid
+// until the next call to Read. A record with an invalid checksum is skipped
+// and errInvalidChecksum is returned. On any other error the reader must be
+// repositioned using Seek.
+func (r *segmentReader) Read() ([]byte, error) {
+	for {
+		if r.file == nil {
+			if err := r.open(); err != nil {
+				return nil, err
+			}
+		}
+
+		payload, n, err := readRecord(r.rd, &r.buf)
+		if err == nil || err == errInvalidChecksum {
+			r.pos.offset += int64(n)
+			r.records++
+			return payload, err
+		}
+		if err != io.EOF {
+			return nil, err
+		}
+
+		id, ok := r.next(r.pos.segment)
+		if !ok {
+			return nil, io.EOF
+		}
+		r.Seek(position{segment: id, offset: segmentHeaderSize}, 0)
+	}
+}
+
+// Seek moves the reader to pos. records is the number of records in the
+// segment before pos.
+func (r *segmentReader) Seek(pos position, records uint64) {
+	r.close()
+	r.pos = pos
+	r.records = records
+}
+
+// Position returns the position of the next record to be read.
+func (r *segmentReader) Position() position {
+	return r.pos
+}
+
+func (r *segmentReader) open() error {
+	f, err := os.Open(segmentPath(r.dir, r.pos.segment))
+	if err != nil {
+		return err
+	}
+
+	if _, err := f.Seek(r.pos.offset, io.SeekStart); err != nil {
+		f.Close()
+		return err
+	}
+
+	r.file = f
+	if r.rd == nil {
+		r.rd = bufio.NewReaderSize(f, 64*1024)
+	} else {
+		r.rd.Reset(f)
+	}
+	return nil
+}
+
+func (r *segmentReader) close() {
+	if r.file != nil {
+		r.file.Close()
+		r.file = nil
+	}
+}
+
+func (r *segmentReader) Close() {
+	r.close()
+}
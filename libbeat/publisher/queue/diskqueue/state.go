@@ -0,0 +1,97 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package diskqueue
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// The state file stores the position of the oldest not yet ACKed event.
+// The file content is replaced atomically via rename on every update.
+//
+//   state: | segment (8) | offset (8) | checksum (4) |
+
+const (
+	stateFileName = "queue.state"
+	stateFileSize = 20
+)
+
+var errInvalidState = errors.New("invalid queue state file")
+
+func statePath(dir string) string {
+	return filepath.Join(dir, stateFileName)
+}
+
+// readState loads the ACK position. The zero position is returned if no state
+// file exists yet.
+func readState(dir string) (position, error) {
+	contents, err := ioutil.ReadFile(statePath(dir))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return position{}, nil
+		}
+		return position{}, err
+	}
+
+	if len(contents) != stateFileSize {
+		return position{}, errInvalidState
+	}
+
+	checksum := binary.LittleEndian.Uint32(contents[16:])
+	if crc32.Checksum(contents[:16], castagnoli) != checksum {
+		return position{}, errInvalidState
+	}
+
+	return position{
+		segment: segmentID(binary.LittleEndian.Uint64(contents[0:])),
+		offset:  int64(binary.LittleEndian.Uint64(contents[8:])),
+	}, nil
+}
+
+func writeState(dir string, pos position, mode os.FileMode) error {
+	var buf [stateFileSize]byte
+	binary.LittleEndian.PutUint64(buf[0:], uint64(pos.segment))
+	binary.LittleEndian.PutUint64(buf[8:], uint64(pos.offset))
+	binary.LittleEndian.PutUint32(buf[16:], crc32.Checksum(buf[:16], castagnoli))
+
+	path := statePath(dir)
+	tmp := path + ".new"
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, mode)
+	if err != nil {
+		return err
+	}
+
+	if _, err := f.Write(buf[:]); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, path)
+}
@@ -31,7 +31,7 @@ import (
 // MultiLine reader combining multiple line events into one multi-line event.
 //
 // Lines to be combined are matched by some configurable predicate using
-// regular expression.
+// regular expression, or a fixed number of lines is combined into one event.
 //
 // The maximum number of bytes and lines to be returned is fully configurable.
 // Even if limits are reached subsequent lines are matched, until event is
@@ -45,9 +45,11 @@ type Reader struct {
 	flushMatcher *match.Matcher
 	maxBytes     int // bytes stored in content
 	maxLines     int
+	linesCount   int // number of lines per event in count mode
 	separator    []byte
 	last         []byte
-	numLines     int
+	numLines     int // lines stored in content
+	readLines    int // lines read for the current event, including skipped lines
 	truncated    int
 	err          error // last seen error
 	state        func(*Reader) (reader.Message, error)
@@ -78,27 +80,43 @@ func New(
 	maxBytes int,
 	config *Config,
 ) (*Reader, error) {
-	types := map[string]func(match.Matcher) (matcher, error){
-		"before": beforeMatcher,
-		"after":  afterMatcher,
-	}
+	var pred matcher
+	var linesCount int
+	switch config.Type {
+	case patternMode:
+		types := map[string]func(match.Matcher) (matcher, error){
+			"before": beforeMatcher,
+			"after":  afterMatcher,
+		}
 
-	matcherType, ok := types[config.Match]
-	if !ok {
-		return nil, fmt.Errorf("unknown matcher type: %s", config.Match)
-	}
+		matcherType, ok := types[config.Match]
+		if !ok {
+			return nil, fmt.Errorf("unknown matcher type: %s", config.Match)
+		}
 
-	matcher, err := matcherType(*config.Pattern)
-	if err != nil {
-		return nil, err
+		var err error
+		pred, err = matcherType(*config.Pattern)
+		if err != nil {
+			return nil, err
+		}
+
+		if config.Negate {
+			pred = negatedMatcher(pred)
+		}
+	case countMode:
+		if config.LinesCount <= 0 {
+			return nil, fmt.Errorf("count_lines %v must be positive", config.LinesCount)
+		}
+		pred = countMatcher()
+		linesCount = config.LinesCount
+	case whilePatternMode:
+		pred = whilePatternMatcher(*config.Pattern, config.Negate)
+	default:
+		return nil, fmt.Errorf("unknown multiline type %d", config.Type)
 	}
 
 	flushMatcher := config.FlushPattern
 
-	if config.Negate {
-		matcher = negatedMatcher(matcher)
-	}
-
 	maxLines := defaultMaxLines
 	if config.MaxLines != nil {
 		maxLines = *config.MaxLines
@@ -118,11 +136,12 @@ func New(
 
 	mlr := &Reader{
 		reader:       r,
-		pred:         matcher,
+		pred:         pred,
 		flushMatcher: flushMatcher,
 		state:        (*Reader).readFirst,
 		maxBytes:     maxBytes,
 		maxLines:     maxLines,
+		linesCount:   linesCount,
 		separator:    []byte(separator),
 		message:      reader.Message{},
 	}
@@ -156,6 +175,10 @@ func (mlr *Reader) readFirst() (reader.Message, error) {
 		// Start new multiline event
 		mlr.clear()
 		mlr.load(message)
+		if mlr.complete() {
+			msg := mlr.finalize()
+			return msg, nil
+		}
 		mlr.setState((*Reader).readNext)
 		return mlr.readNext()
 	}
@@ -238,9 +261,22 @@ func (mlr *Reader) readNext() (reader.Message, error) {
 
 		// add line to current multiline event
 		mlr.addLine(message)
+
+		// return multiline event once the configured number of lines is reached
+		if mlr.complete() {
+			msg := mlr.finalize()
+			mlr.resetState()
+			return msg, nil
+		}
 	}
 }
 
+// complete returns true if the current multiline event has the number of
+// lines configured in count mode.
+func (mlr *Reader) complete() bool {
+	return mlr.linesCount > 0 && mlr.readLines >= mlr.linesCount
+}
+
 // readFailed returns empty message and error and resets line reader
 func (mlr *Reader) readFailed() (reader.Message, error) {
 	err := mlr.err
@@ -263,6 +299,7 @@ func (mlr *Reader) clear() {
 	mlr.message = reader.Message{}
 	mlr.last = nil
 	mlr.numLines = 0
+	mlr.readLines = 0
 	mlr.truncated = 0
 	mlr.err = nil
 }
@@ -292,6 +329,8 @@ func (mlr *Reader) addLine(m reader.Message) {
 		return
 	}
 
+	mlr.readLines++
+
 	sz := len(mlr.message.Content)
 	addSeparator := len(mlr.message.Content) > 0 && len(mlr.separator) > 0
 	if addSeparator {
@@ -355,6 +394,24 @@ func beforeMatcher(pat match.Matcher) (matcher, error) {
 	})
 }
 
+// countMatcher combines all lines, events are split by the line count.
+func countMatcher() matcher {
+	return func(last, current []byte) bool {
+		return true
+	}
+}
+
+// whilePatternMatcher combines consecutive lines matching the pattern.
+// Lines not matching the pattern are not combined with any other line.
+func whilePatternMatcher(pat match.Matcher, negate bool) matcher {
+	matches := func(line []byte) bool {
+		return pat.Match(line) != negate
+	}
+	return func(last, current []byte) bool {
+		return matches(last) && matches(current)
+	}
+}
+
 func negatedMatcher(m matcher) matcher {
 	return func(last, current []byte) bool {
 		return !m(last, current)
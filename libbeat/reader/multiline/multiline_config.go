@@ -24,20 +24,68 @@ import (
 	"github.com/elastic/beats/libbeat/common/match"
 )
 
+type multilineType uint8
+
+const (
+	patternMode multilineType = iota
+	countMode
+	whilePatternMode
+
+	patternStr      = "pattern"
+	countStr        = "count"
+	whilePatternStr = "while_pattern"
+)
+
+var (
+	multilineTypes = map[string]multilineType{
+		patternStr:      patternMode,
+		countStr:        countMode,
+		whilePatternStr: whilePatternMode,
+	}
+)
+
 // Config holds the options of multiline readers.
 type Config struct {
+	Type         multilineType  `config:"type"`
 	Negate       bool           `config:"negate"`
-	Match        string         `config:"match" validate:"required"`
+	Match        string         `config:"match"`
 	MaxLines     *int           `config:"max_lines"`
-	Pattern      *match.Matcher `config:"pattern" validate:"required"`
+	Pattern      *match.Matcher `config:"pattern"`
 	Timeout      *time.Duration `config:"timeout" validate:"positive"`
 	FlushPattern *match.Matcher `config:"flush_pattern"`
+	LinesCount   int            `config:"count_lines" validate:"min=0"`
 }
 
 // Validate validates the Config option for multiline reader.
 func (c *Config) Validate() error {
-	if c.Match != "after" && c.Match != "before" {
-		return fmt.Errorf("unknown matcher type: %s", c.Match)
+	switch c.Type {
+	case patternMode:
+		if c.Match != "after" && c.Match != "before" {
+			return fmt.Errorf("unknown matcher type: %s", c.Match)
+		}
+		if c.Pattern == nil {
+			return fmt.Errorf("multiline.pattern cannot be empty when pattern based matching is selected")
+		}
+	case countMode:
+		if c.LinesCount == 0 {
+			return fmt.Errorf("multiline.count_lines cannot be zero when count based aggregation is selected")
+		}
+	case whilePatternMode:
+		if c.Pattern == nil {
+			return fmt.Errorf("multiline.pattern cannot be empty when while_pattern based matching is selected")
+		}
+	default:
+		return fmt.Errorf("unknown multiline type %d", c.Type)
+	}
+	return nil
+}
+
+// Unpack selects the approriate aggregation method for creating multiline events.
+func (t *multilineType) Unpack(value string) error {
+	mlType, ok := multilineTypes[value]
+	if !ok {
+		return fmt.Errorf("unknown multiline type: %s", value)
 	}
+	*t = mlType
 	return nil
 }
@@ -29,6 +29,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/elastic/beats/libbeat/common"
 	"github.com/elastic/beats/libbeat/common/match"
 	"github.com/elastic/beats/libbeat/reader"
 	"github.com/elastic/beats/libbeat/reader/readfile"
@@ -185,6 +186,157 @@ func TestMultilineAfterTruncated(t *testing.T) {
 	)
 }
 
+func TestMultilineCount(t *testing.T) {
+	testMultilineOK(t,
+		Config{
+			Type:       countMode,
+			LinesCount: 2,
+		},
+		2,
+		"line1\n line1.1\n",
+		"line2\n line2.1\n",
+	)
+
+	// Remaining lines are returned as a shorter event
+	testMultilineOK(t,
+		Config{
+			Type:       countMode,
+			LinesCount: 3,
+		},
+		2,
+		"line1\nline1.1\nline1.2\n",
+		"line2\n",
+	)
+
+	testMultilineOK(t,
+		Config{
+			Type:       countMode,
+			LinesCount: 1,
+		},
+		3,
+		"line1\n",
+		"line2\n",
+		"line3\n",
+	)
+}
+
+func TestMultilineCountTruncated(t *testing.T) {
+	maxLines := 2
+	testMultilineTruncated(t,
+		Config{
+			Type:       countMode,
+			MaxLines:   &maxLines,
+			LinesCount: 3,
+		},
+		2,
+		true,
+		[]string{
+			"line1\nline1.1\nline1.2\n",
+			"line2\nline2.1\nline2.2\n"},
+		[]string{
+			"line1\nline1.1",
+			"line2\nline2.1"},
+	)
+}
+
+func TestMultilineWhilePattern(t *testing.T) {
+	pattern := match.MustCompile(`^{`)
+	testMultilineOK(t,
+		Config{
+			Type:    whilePatternMode,
+			Pattern: &pattern,
+		},
+		4,
+		"{line1\n{line1.1\n",
+		"not matched line\n",
+		"another not matched line\n",
+		"{line2\n{line2.1\n{line2.2\n",
+	)
+}
+
+func TestMultilineWhilePatternNegate(t *testing.T) {
+	pattern := match.MustCompile(`^{`)
+	testMultilineOK(t,
+		Config{
+			Type:    whilePatternMode,
+			Pattern: &pattern,
+			Negate:  true,
+		},
+		3,
+		"{matched line\n",
+		"line1\nline1.1\n",
+		"{another matched line\n",
+	)
+}
+
+func TestMultilineWhilePatternTruncated(t *testing.T) {
+	pattern := match.MustCompile(`^{`)
+	maxLines := 2
+	testMultilineTruncated(t,
+		Config{
+			Type:     whilePatternMode,
+			Pattern:  &pattern,
+			MaxLines: &maxLines,
+		},
+		1,
+		true,
+		[]string{"{line1\n{line1.1\n{line1.2\n"},
+		[]string{"{line1\n{line1.1"},
+	)
+}
+
+func TestMultilineConfig(t *testing.T) {
+	tests := map[string]struct {
+		config map[string]interface{}
+		valid  bool
+	}{
+		"default pattern type": {
+			config: map[string]interface{}{"pattern": "^ ", "match": "after"},
+			valid:  true,
+		},
+		"pattern type without pattern": {
+			config: map[string]interface{}{"type": "pattern", "match": "after"},
+		},
+		"pattern type without match": {
+			config: map[string]interface{}{"type": "pattern", "pattern": "^ "},
+		},
+		"count type": {
+			config: map[string]interface{}{"type": "count", "count_lines": 3},
+			valid:  true,
+		},
+		"count type without count_lines": {
+			config: map[string]interface{}{"type": "count"},
+		},
+		"while_pattern type": {
+			config: map[string]interface{}{"type": "while_pattern", "pattern": "^ "},
+			valid:  true,
+		},
+		"while_pattern type without pattern": {
+			config: map[string]interface{}{"type": "while_pattern"},
+		},
+		"unknown type": {
+			config: map[string]interface{}{"type": "unknown", "pattern": "^ ", "match": "after"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			c, err := common.NewConfigFrom(test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var config Config
+			err = c.Unpack(&config)
+			if test.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
 func testMultilineOK(t *testing.T, cfg Config, events int, expected ...string) {
 	_, buf := createLineBuffer(expected...)
 	r := createMultilineTestReader(t, buf, cfg)
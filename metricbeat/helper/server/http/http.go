@@ -53,6 +53,28 @@ func (h *HttpEvent) GetMeta() server.Meta {
 }
 
 func NewHttpServer(mb mb.BaseMetricSet) (server.Server, error) {
+	h, err := newHttpServer(mb)
+	if err != nil {
+		return nil, err
+	}
+	h.server.Handler = http.HandlerFunc(h.handleFunc)
+
+	return h, nil
+}
+
+// NewHttpServerWithHandler creates a new HTTP server that handles requests with
+// the given handler, instead of publishing the raw request bodies as events.
+func NewHttpServerWithHandler(mb mb.BaseMetricSet, handler http.Handler) (server.Server, error) {
+	h, err := newHttpServer(mb)
+	if err != nil {
+		return nil, err
+	}
+	h.server.Handler = handler
+
+	return h, nil
+}
+
+func newHttpServer(mb mb.BaseMetricSet) (*HttpServer, error) {
 	config := defaultHttpConfig()
 	err := mb.Module().UnpackConfig(&config)
 	if err != nil {
@@ -73,8 +95,7 @@ func NewHttpServer(mb mb.BaseMetricSet) (server.Server, error) {
 	}
 
 	httpServer := &http.Server{
-		Addr:    net.JoinHostPort(config.Host, strconv.Itoa(int(config.Port))),
-		Handler: http.HandlerFunc(h.handleFunc),
+		Addr: net.JoinHostPort(config.Host, strconv.Itoa(int(config.Port))),
 	}
 	if tlsConfig != nil {
 		httpServer.TLSConfig = tlsConfig.BuildModuleConfig(config.Host)
@@ -109,6 +109,28 @@ func TestCPUService_TotalUsage(t *testing.T) {
 	}
 }
 
+func TestCPUService_TotalUsageOnlineCPUs(t *testing.T) {
+	// Hosts using cgroup v2 don't report per CPU usage.
+	var stats types.StatsJSON
+	stats.PreCPUStats.CPUUsage.TotalUsage = 50
+	stats.CPUStats.CPUUsage.TotalUsage = 500000050
+	stats.CPUStats.OnlineCPUs = 4
+
+	usage := cpuUsage{
+		Stat:        &docker.Stat{Stats: stats},
+		systemDelta: 1000000000,
+	}
+	if cpus := usage.CPUs(); cpus != 4 {
+		t.Errorf("CPUs() => %v, want 4", cpus)
+	}
+	if total := usage.Total(); total != 2.0 {
+		t.Errorf("Total() => %v, want 2", total)
+	}
+	if perCPU := usage.PerCPU(); len(perCPU) != 0 {
+		t.Errorf("PerCPU() => %v, want empty", perCPU)
+	}
+}
+
 func TestCPUService_UsageInKernelmode(t *testing.T) {
 	usageOldValuesTest := []uint64{100, 10, 500000050}
 	usageValuesTest := []uint64{3, 500000010, 500000050}
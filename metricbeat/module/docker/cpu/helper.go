@@ -91,6 +91,10 @@ type cpuUsage struct {
 func (u *cpuUsage) CPUs() int {
 	if u.cpus == 0 {
 		u.cpus = len(u.Stats.CPUStats.CPUUsage.PercpuUsage)
+		// Per CPU usage is not reported by hosts using cgroup v2.
+		if u.cpus == 0 {
+			u.cpus = int(u.Stats.CPUStats.OnlineCPUs)
+		}
 	}
 	return u.cpus
 }
@@ -273,6 +273,52 @@ func TestGetBlkioStatsList(t *testing.T) {
 		stats.servicedBytes)
 }
 
+func TestGetBlkioStatsListCgroupV2(t *testing.T) {
+	start := time.Now()
+	later := start.Add(10 * time.Second)
+
+	blkioService := BlkioService{
+		map[string]BlkioRaw{
+			"cebada": {Time: start, reads: 100, writes: 200, totals: 300},
+		},
+	}
+
+	// Hosts using cgroup v2 report lowercase operations and no total.
+	dockerStats := []docker.Stat{{
+		Container: &types.Container{
+			ID:    "cebada",
+			Names: []string{"test"},
+		},
+		Stats: types.StatsJSON{Stats: types.Stats{
+			Read: later,
+			BlkioStats: types.BlkioStats{
+				IoServicedRecursive: []types.BlkioStatEntry{
+					{Major: 1, Minor: 1, Op: "read", Value: 100},
+					{Major: 1, Minor: 1, Op: "write", Value: 200},
+					{Major: 1, Minor: 2, Op: "read", Value: 50},
+					{Major: 1, Minor: 2, Op: "write", Value: 100},
+				},
+				IoServiceBytesRecursive: []types.BlkioStatEntry{
+					{Major: 1, Minor: 1, Op: "read", Value: 1000},
+					{Major: 1, Minor: 1, Op: "write", Value: 2000},
+				},
+			},
+		}},
+	}}
+
+	statsList := blkioService.getBlkioStatsList(dockerStats, true)
+	stats := statsList[0]
+	assert.Equal(t, float64(5), stats.reads)
+	assert.Equal(t, float64(10), stats.writes)
+	assert.Equal(t, float64(15), stats.totals)
+	assert.Equal(t,
+		BlkioRaw{Time: later, reads: 150, writes: 300, totals: 450},
+		stats.serviced)
+	assert.Equal(t,
+		BlkioRaw{Time: later, reads: 1000, writes: 2000, totals: 3000},
+		stats.servicedBytes)
+}
+
 func TestGetBlkioStatsListWindows(t *testing.T) {
 	start := time.Now()
 	later := start.Add(10 * time.Second)
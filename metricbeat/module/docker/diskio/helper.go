@@ -18,6 +18,7 @@
 package diskio
 
 import (
+	"strings"
 	"time"
 
 	"github.com/docker/docker/api/types"
@@ -141,16 +142,23 @@ func (io *BlkioService) getNewStats(time time.Time, blkioEntry []types.BlkioStat
 		totals: 0,
 	}
 
+	// Hosts using cgroup v2 report lowercase "read" and "write" operations
+	// and no total.
+	hasTotal := false
 	for _, myEntry := range blkioEntry {
-		switch myEntry.Op {
-		case "Write":
+		switch strings.ToLower(myEntry.Op) {
+		case "write":
 			stats.writes += myEntry.Value
-		case "Read":
+		case "read":
 			stats.reads += myEntry.Value
-		case "Total":
+		case "total":
 			stats.totals += myEntry.Value
+			hasTotal = true
 		}
 	}
+	if !hasTotal {
+		stats.totals = stats.reads + stats.writes
+	}
 	return stats
 }
 
@@ -109,6 +109,21 @@ func TestMemoryService_GetMemoryStats(t *testing.T) {
 	assert.Equal(t, expectedFields, event.MetricSetFields)
 }
 
+func TestMemoryService_GetMemoryStatsCgroupV2(t *testing.T) {
+	memorystats := getMemoryStats(time.Now(), 1)
+	delete(memorystats.MemoryStats.Stats, "total_rss")
+	memorystats.MemoryStats.Stats["anon"] = 2
+
+	memoryRawStats := docker.Stat{
+		Container: &types.Container{ID: "containerID", Names: []string{"/name1"}},
+		Stats:     memorystats,
+	}
+
+	rawStats := (&MemoryService{}).getMemoryStats(memoryRawStats, false)
+	assert.Equal(t, uint64(2), rawStats.TotalRss)
+	assert.Equal(t, 0.5, rawStats.TotalRssP)
+}
+
 func getMemoryStats(read time.Time, number uint64) types.StatsJSON {
 
 	myMemoryStats := types.StatsJSON{
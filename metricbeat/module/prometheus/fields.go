@@ -32,5 +32,5 @@ func init() {
 // AssetPrometheus returns asset data.
 // This is the base64 encoded gzipped contents of ../metricbeat/module/prometheus.
 func AssetPrometheus() string {
-	return "eJyUkc1qwzAQhO9+ikG9hSQPoENfoYUeSwmKtba30R+7G0LeviQ2aQIttLppv9FoNNrgQGePJjWTTXTUDjC2RB7u9TZ0HRBJe+FmXIvHcwcAbxZMob2ERhGD1IyA71OgElvlYtsO0KmK7fpaBh49hpCUOkAoUVDyGMNFQ2ZcRvV4d6rJreEms+Y+OmBgSlH99d4nvEgkASs4tyoWimEioTVS2FNSnDgl5GD9hIFFbQ2bCEJqCEKI9bhPdPXaoIRM9w1sZ4/t6soBOzfyqPtP6m0ZzZvdTA50PlWJC/qhpsu6ayWTCfdL0t8yzKK/h7h70QPZ5dAal3GRuZX7Z84b2Tx81lcAAAD//3hXtDU="
+	return "eJyUkUtqMzEQhPc6RaF/Z2wfQIv/CglkGYLRjHpmFOtFdxvj2wd7BmfyggS0UX0ldam0w5EuDo1rJp3oJAbQqIkc7ONdtAYIJD3HprEWh/8GAJ7Uq0B69o0CBq4ZHu+nQCW0GovuDSBTZT30tQxxdBh8EjIAUyIv5DD6q4dUYxnF4dmKJLuFnVSbfTHAECkFcbe5//DAgRhREHOrrL4oJmLaIvmOkuAcU0L22k8YIotuoROBSRSeCaGeunSdD+xQfKZ1A/v5jv3mxgG9NHKo3Sv1ukjz5jCTI13OlcOCvqnpulatZFKO/ZL0pwyz6fchVi/6QA7ZtxbLuNjsxv4x553sPn3WF7Uj9eZtAJ87umM="
 }
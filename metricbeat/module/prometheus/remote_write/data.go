@@ -0,0 +1,92 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package remote_write
+
+import (
+	"math"
+	"time"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/metricbeat/mb"
+)
+
+// metricNameLabel is the label holding the metric name of a time series.
+const metricNameLabel = "__name__"
+
+// samplesToEvents converts the samples of a write request to events. Samples
+// with the same labels and timestamp are grouped in a single event, the same
+// way the collector metricset groups scraped metrics.
+func samplesToEvents(req *WriteRequest) []mb.Event {
+	type eventKey struct {
+		labels    string
+		timestamp int64
+	}
+
+	var keys []eventKey
+	eventList := map[eventKey]common.MapStr{}
+
+	for _, ts := range req.Timeseries {
+		name := ""
+		labels := common.MapStr{}
+		for _, label := range ts.Labels {
+			if label.Name == metricNameLabel {
+				name = label.Value
+				continue
+			}
+			if label.Name != "" && label.Value != "" {
+				labels[label.Name] = label.Value
+			}
+		}
+
+		if name == "" {
+			continue
+		}
+
+		for _, sample := range ts.Samples {
+			if math.IsNaN(sample.Value) || math.IsInf(sample.Value, 0) {
+				continue
+			}
+
+			key := eventKey{labels: labels.String(), timestamp: sample.Timestamp}
+			if _, ok := eventList[key]; !ok {
+				eventList[key] = common.MapStr{
+					"metrics": common.MapStr{},
+				}
+
+				// Add labels
+				if len(labels) > 0 {
+					eventList[key]["labels"] = labels
+				}
+				keys = append(keys, key)
+			}
+
+			// Not checking anything here because we create these maps some lines before
+			metrics := eventList[key]["metrics"].(common.MapStr)
+			metrics[name] = sample.Value
+		}
+	}
+
+	events := make([]mb.Event, 0, len(keys))
+	for _, key := range keys {
+		events = append(events, mb.Event{
+			RootFields: common.MapStr{"prometheus": eventList[key]},
+			Timestamp:  time.Unix(0, key.timestamp*int64(time.Millisecond)).UTC(),
+		})
+	}
+	return events
+}
@@ -0,0 +1,127 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package remote_write
+
+import (
+	"io/ioutil"
+	"net/http"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/libbeat/logp"
+	serverhelper "github.com/elastic/beats/metricbeat/helper/server"
+	httpserver "github.com/elastic/beats/metricbeat/helper/server/http"
+	"github.com/elastic/beats/metricbeat/mb"
+)
+
+func init() {
+	mb.Registry.MustAddMetricSet("prometheus", "remote_write", New)
+}
+
+// MetricSet receives samples pushed by Prometheus servers using the
+// remote write protocol.
+type MetricSet struct {
+	mb.BaseMetricSet
+	server serverhelper.Server
+	events chan mb.Event
+	log    *logp.Logger
+}
+
+// New creates a new remote_write MetricSet.
+func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
+	cfgwarn.Beta("The prometheus remote_write metricset is beta.")
+
+	m := &MetricSet{
+		BaseMetricSet: base,
+		events:        make(chan mb.Event),
+		log:           logp.NewLogger("prometheus.remote_write"),
+	}
+
+	svc, err := httpserver.NewHttpServerWithHandler(base, http.HandlerFunc(m.handleFunc))
+	if err != nil {
+		return nil, err
+	}
+	m.server = svc
+
+	return m, nil
+}
+
+// Run starts the HTTP server and reports the received samples until the
+// reporter is closed.
+func (m *MetricSet) Run(reporter mb.PushReporterV2) {
+	m.server.Start()
+	defer m.server.Stop()
+
+	for {
+		select {
+		case <-reporter.Done():
+			return
+		case e := <-m.events:
+			reporter.Event(e)
+		}
+	}
+}
+
+func (m *MetricSet) handleFunc(writer http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		writer.Header().Set("Allow", http.MethodPost)
+		http.Error(writer, "Prometheus remote write accepts data via POST", http.StatusMethodNotAllowed)
+		return
+	}
+
+	writeReq, err := decodeWriteRequest(req)
+	if err != nil {
+		m.log.Debugw("Failed to decode remote write request", "error", err)
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	for _, e := range samplesToEvents(writeReq) {
+		select {
+		case <-req.Context().Done():
+			return
+		case m.events <- e:
+		}
+	}
+
+	writer.WriteHeader(http.StatusAccepted)
+}
+
+// decodeWriteRequest reads a snappy compressed, protobuf encoded WriteRequest
+// from the request body.
+func decodeWriteRequest(req *http.Request) (*WriteRequest, error) {
+	compressed, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "error reading request body")
+	}
+
+	buf, err := snappy.Decode(nil, compressed)
+	if err != nil {
+		return nil, errors.Wrap(err, "error decompressing request body")
+	}
+
+	var writeReq WriteRequest
+	if err := proto.Unmarshal(buf, &writeReq); err != nil {
+		return nil, errors.Wrap(err, "error unmarshalling write request")
+	}
+
+	return &writeReq, nil
+}
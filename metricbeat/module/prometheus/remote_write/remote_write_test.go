@@ -0,0 +1,169 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package remote_write
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/snappy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/metricbeat/mb"
+)
+
+func TestSamplesToEvents(t *testing.T) {
+	req := &WriteRequest{
+		Timeseries: []*TimeSeries{
+			{
+				Labels: []*Label{
+					{Name: "__name__", Value: "up"},
+					{Name: "job", Value: "node"},
+				},
+				Samples: []*Sample{{Value: 1, Timestamp: 1000}, {Value: 0, Timestamp: 2000}},
+			},
+			{
+				Labels: []*Label{
+					{Name: "__name__", Value: "scrape_duration_seconds"},
+					{Name: "job", Value: "node"},
+				},
+				Samples: []*Sample{{Value: 0.5, Timestamp: 1000}},
+			},
+			{
+				Labels: []*Label{
+					{Name: "__name__", Value: "go_goroutines"},
+					{Name: "empty", Value: ""},
+				},
+				Samples: []*Sample{{Value: 10, Timestamp: 1000}, {Value: math.NaN(), Timestamp: 2000}},
+			},
+			{
+				// series without metric name are ignored
+				Labels:  []*Label{{Name: "job", Value: "node"}},
+				Samples: []*Sample{{Value: 1, Timestamp: 1000}},
+			},
+		},
+	}
+
+	events := samplesToEvents(req)
+	require.Len(t, events, 3)
+
+	assert.Equal(t, time.Unix(1, 0).UTC(), events[0].Timestamp)
+	assert.Equal(t, common.MapStr{
+		"prometheus": common.MapStr{
+			"labels":  common.MapStr{"job": "node"},
+			"metrics": common.MapStr{"up": float64(1), "scrape_duration_seconds": 0.5},
+		},
+	}, events[0].RootFields)
+
+	assert.Equal(t, time.Unix(2, 0).UTC(), events[1].Timestamp)
+	assert.Equal(t, common.MapStr{
+		"prometheus": common.MapStr{
+			"labels":  common.MapStr{"job": "node"},
+			"metrics": common.MapStr{"up": float64(0)},
+		},
+	}, events[1].RootFields)
+
+	assert.Equal(t, common.MapStr{
+		"prometheus": common.MapStr{
+			"metrics": common.MapStr{"go_goroutines": float64(10)},
+		},
+	}, events[2].RootFields)
+}
+
+// TestDecodeWireFormat checks the message definitions against a hand encoded
+// WriteRequest.
+func TestDecodeWireFormat(t *testing.T) {
+	field := func(num, wireType int, data []byte) []byte {
+		buf := proto.EncodeVarint(uint64(num<<3 | wireType))
+		if wireType == 2 {
+			buf = append(buf, proto.EncodeVarint(uint64(len(data)))...)
+		}
+		return append(buf, data...)
+	}
+	value := make([]byte, 8)
+	binary.LittleEndian.PutUint64(value, math.Float64bits(42.5))
+
+	label := append(field(1, 2, []byte("__name__")), field(2, 2, []byte("requests_total"))...)
+	sample := append(field(1, 1, value), field(2, 0, proto.EncodeVarint(1500))...)
+	series := append(field(1, 2, label), field(2, 2, sample)...)
+	raw := field(1, 2, series)
+
+	var req WriteRequest
+	require.NoError(t, proto.Unmarshal(raw, &req))
+	require.Len(t, req.Timeseries, 1)
+	assert.Equal(t, []*Label{{Name: "__name__", Value: "requests_total"}}, req.Timeseries[0].Labels)
+	assert.Equal(t, []*Sample{{Value: 42.5, Timestamp: 1500}}, req.Timeseries[0].Samples)
+}
+
+func TestHandleFunc(t *testing.T) {
+	m := &MetricSet{
+		events: make(chan mb.Event, 10),
+		log:    logp.NewLogger("prometheus.remote_write"),
+	}
+
+	writeReq := &WriteRequest{
+		Timeseries: []*TimeSeries{
+			{
+				Labels:  []*Label{{Name: "__name__", Value: "up"}, {Name: "instance", Value: "localhost:9100"}},
+				Samples: []*Sample{{Value: 1, Timestamp: 1000}},
+			},
+		},
+	}
+	data, err := proto.Marshal(writeReq)
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		method string
+		body   []byte
+		status int
+		events int
+	}{
+		"valid":        {method: "POST", body: snappy.Encode(nil, data), status: http.StatusAccepted, events: 1},
+		"uncompressed": {method: "POST", body: []byte("not snappy"), status: http.StatusBadRequest},
+		"bad protobuf": {method: "POST", body: snappy.Encode(nil, []byte{0xff, 0xff}), status: http.StatusBadRequest},
+		"get":          {method: "GET", status: http.StatusMethodNotAllowed},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := httptest.NewRequest(test.method, "/write", bytes.NewReader(test.body))
+			rec := httptest.NewRecorder()
+			m.handleFunc(rec, req)
+
+			assert.Equal(t, test.status, rec.Code)
+			assert.Len(t, m.events, test.events)
+			for len(m.events) > 0 {
+				e := <-m.events
+				labels, err := e.RootFields.GetValue("prometheus.labels.instance")
+				require.NoError(t, err)
+				assert.Equal(t, "localhost:9100", labels)
+			}
+		})
+	}
+}
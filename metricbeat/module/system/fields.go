@@ -30,7 +30,7 @@ func init() {
 }
 
 // AssetSystem returns asset data.
-// This is the base64 encoded gzipped contents of ../metricbeat/module/system.
+// This is the base64 encoded gzipped contents of module/system.
 func AssetSystem() string {
-	return "eJzsfVuPGzfy77s/RWEOFhnvmZE93iSbnYcDOPYGGCBZDzwOdoGDP2SquyRxh012SLZk5dP/wUvf2VK31Lo4iB6cjNRN/qpYLFYVi8VbeMbNPaiN0pi8ANBUM7yHqyf7xdULgBhVJGmqqeD38P9eAAC4H0FpojMFCWpJI3UDjD4jvHv8FQiPIcFEyA1kiizwBvSSaCASIRKMYaQxhrkUCeglgkhREk35wqOYvABQSyH1NBJ8Thf3oGWGLwAkMiQK72FBXgDMKbJY3VtAt8BJghUyzEdvUvOsFFnqvwmQYj6f3WufIRJcE8oVMBER5lvL6Zv456v9VvuOhMTiy1DvWxBUUNyadipQDD89ApgLCQQU5QuGtj8QcyCQZExT+16Fg/mnzrT80ySiSgiNa1/npDDBF40ftlBjPgb6O4OKZ8kMZYmq9uT/gUeUEXJNFqiCgDKFcpJGOghLRYRhPJ0zQZoPzIVMiL6H1LU/DPynJeYvkoVltCFH0wRBpcg1UG6BgUpJhB201SjQNHpW47DWgCOJyLg+EJiXl0tk7jNKjmwIFSMyeCeHB6DjNMLL47DgwMT6NpVUSKo3kEoRoVKo+lBzMk7vi5LG7AJ5blH1AH46Qe4BSKwJ1RfISw4GGFwLDjFVzy/70XFKHTEMn/zt8pisUK5oZEwzY9ItCY+Z+WNJZLw21hzlGqXMUr1zPsrfTsf60VArMddf07gYvPtReO6x2QO5RsIub2QoB8pXgmVcE7lxKmC2sX7OikqdEWbfWC8pQ/vtcpMalighW52tiarxS+glynwJFHLSeuHtilBGZgxBcLYxi+evnH7pxchT6sXLZVDhy6XZQa5clGYtb9JQZTxmdZh3Zty8MQfK+Wb5QNnWIZWovPVlR0AoPXEPC37Lzfxh9HdsuolQmRkK1pQxWJIVGgeVfKFJlsCKsMxOms93r1//Bf7quvts2241VvZTa5cwiSTegCbPRj6o8q1SrgWQKLJi53TLqt1oAIuB8od2TeEDb4cI1E2r2Y3IICLcDVqV5UXwZiGRaJTmC+74Bj8JCfiFJCnDG6Bz+FurWSdS5nWi4fvXfzHQboxcOeHyYY9JlGaTnJufnfTMEO5+6BycP5YL+8dyEr9e9+uP4u18RVbrn3Z5gMI/rdtxrFst9IUy0tiCqMCRbVfUh5ihFZyHD/82WqjLKPlXaRn1sk+MJXWRLBgapr5YQoYu9JdJyEGr/WWS1H/Jv1D8e6z7l0nJ6Iv/V0XmvhbAZRL5tZoBl8bNPlbATR4IURjnTC5jNta5DtDesBg+taJ7X8vO9CXv6X4du6AXuJl40Ztw594K2X9FPDfyfRe5P/ceqjwxckrFiyYrhmw/mCYq+w/mT3j4UKSR9czByz/D9yjMv8HxfMbNWsjmxoGPH9+Disnd8OG25JkudwkbSkrY1C2eA+D1hPCN8j3k6W7waUkVJGQDXGiYoRGOFY3dMk4YK5neatPH6HcQJJHEE7vhMeLksZZSxcIwnRiRMSNkREZlkZHwecbYZge+taQajw7Q9rInQsvB2Ub331HLTcHQS3uAt81YGHXY8IHDz5RnX9wWF212BQ07UGGkhfQt2c2elFEvaRyIUlliOGOfAkV/t3bod3dveo3g+RlkcGjk4/Aob6wnm1qt7mabFSuz7hxR7BPKjE8QCR4rv7x5tWJnbK+BPRtEN2d3GovHBhjGGAuzDj68+rAboPHeJna0Jf6WodKTBOUC1TRFOVUYBbGHPMwd4Jtb9Xaa+y4V2D7tLjk4StyO7Rolwm8ZZhiDFnYyxLiiO30bT5YTkdPSZfs8NmG18TrpQJXoqVIt9BU69xig047MuJTYEfEEbFltRiDjx3K9LWzfFuam7R5e0nYSRIx/MS4hZIWSLLDq08yFbEhZcES0MBaocVgwHjL/TzgqTsSOOSyOpNONS2PSjDQw+Ywnq8XU2CjHIcVaP9eU52bTSzNQBndPHdCPFqvFj0yJ7QMY8oVeHoWIU070cUXJBTBw2mlnHS5GrgdHiBGnqsH10hL18OrDuOMxy9RmPGoew7H7OJPGTFwvabSsk9C9LF7PCI/XNNZLyDRl9HdiurVMKJ96OYH37nFFdCbdIyKKMuO6uKy5MulRQcSEskNfz2PMWYJcS5FuDgknlYErfyCy3ebwEBHJG53OqB41+FegNQ2bIWvDLWGcfzOoxOtx3hhuEk1XmEtPKgQrnPZvX//j+9YozynD2tlX2CtuWDbTyl4ufxojibkg+kRRBRsitPs6FX5rYZz+jKeSrihD42nY3SnKXTeTIHQ3SacDQ5yDwpjVpNp7+PwqxtUr8+vd5yAi0+8RoJg2mlDwi/42DMKG3KepoB2xvr2x2IaNprVtt3gTRmOl9YiBA9M+cBGjMtJi5qj9ph07r0CSeFZp3y7VBt10bK5V+CUR92Ga5fuJuObGuMK77RzLFJ42dGw6HAjv/KtbA/S2TIVCFJVZYA5ax7xIuZYqS1llEcv3wshiIXFBis0wwphTOY3jLeWrB5/f2Xc75F919ePRwFxkTd+4Nn0OmNafAmqvQ95cVwEnbpvUh0e2TTgqOz4l1RCLSLXiAQGuw3YNvJUVu9C3cIa8B/BMtBqwMQeaAM1kORtAO1N3AAyp49MhdGrv2gJNWaYsT1+2XR4mSHyI+jAunmkj92EPnPBXd1dDlbD5ifLFdE4iLeS9ce2GKeKfK/AL95IRpSGhPNMYnsNX310S0u881g6Fc3V3UWjvAnDDuG0S4rlkIiALENMiK6FfcmGbnHMNRVhgxqDobNLVIVUH0mQfClN03GPDLe3s6oIdZN65JlohCl9xbITwxMncDruuOdy7K0idzt341SyxvWCd0Kt1/lmZ22ctKj/mhS/kcq5ccDQWqGzqFeURy+Li4Uhwl+cx2+TmZESiJSogvG1/zbL5HKWCa4WFr+pZQyKdETZpmCEX7471GlhH2372ehvJW9taWRMQY5s5aji3y4rfai0HZwScwCL1BFX4WZHBBw0SvTJULrJPjRAhjxBmqNfoz757kbZ5DdVYjR+hYFkE82k+CTGmyGOVa94PTy5OlgiJEKMmlKkbSK0ahGiJ0XPhI1dk+HOHSMD5fSjP7vCUf9B2H4SwKGPWkZ8RMywVXhSJYlTb2U+V2xmwMaiyzWDX1tMo9UOuD2yjH57+Y5qkCgioLGlqpXxgKSeRjefn4/qBw78pj8Va3fj38bf2bPOsFcVY+df7jlWHzoE+egd26p6eIxfY8GhNnV15u2vSVDfdiiiVOKdf7uHq/1uy/qdp89TjG2axsK2UtoQxH6jSNFJuH6bcxDM4amVNcxELRTB3hyPO7EyXxPQVpXPpWmuNDMN7LjVV7pQOZ+/lzdSsH+NzKpbZAtPWSfQzTFYDBCySs8/T4GGDFmnhASnjvRWC/M5JKgS79Hn7S8XaoxwIM26aGaKSnDHmxg4CDpoT9dyPmlx1ptjASHpoHNEpTcW9hcgYsnK1f2h6HEJyFDDLtPXqQvI0kDKVSWPenZcwsUIZiSShg6dGjHOSMR3adelNwwHz+73r3mWmzIUMgi+OhKFeC/n8oglvSNzHt1EJ/PhvqofbajXS89/tgcR5Y2/jdOfaUC8HhgJtiK0Jvs8hN5Hpk+5KN0/qGJ93V5YePytCiRHS3RmShpEpiZ5x1Oy0EoxvuyfDjodEFkh6MobyCUop5HHY4pr2R3AdIsoXPcbqVJgU8ng3IsonsRRp2lo1x0FEeSQSmxTlx65Mm/Xd9uDYMQGKTC/EdoDVUC1VQNiabNor0WtjvL8ncm0sSB7Dj0/vYYYRyRT60ImxBSSmQupyd6T7OHOR+OkOah+0Hvk2KuuR/8YsRiQmmtxUr++4qd6L0rhUBMZdjwijpMnLlOhlQfck8GpCFy7buLhwpd2jLVcyYAnsE6H2PLNNN/L7rmTGOeWLq/AecNpxVclu8ttv9qE+PaDDPXtc7N9j+9U+PUZJzCgfeYznGWNgjFzC41vTvPMKtTCjLrWz2R3uG79dYyavDsS/iVxkiQ2sK0yJJH7WBzNX6IILiVMyEyu8hzevv/0hSHKmUO4xlVxtvf3mUbTed1iNSUj5YhpTaQ/mNKPWfXpHvgr2Lmb/xZan7b6cHigByFdUCm5GDlZEUuOSqm4pcOWmjQoNnesmlVIa8JNE/PHp/Y0L8Tsl++EJ/hNWGfXK3jBeeOrd46+3KsWIzmlUjUulZVWQoZGnztpMsMu76xnuCxRK0VWNvK1oUxOsq7Bll/MjoS0qdhuwLrCnKI/QSY/XF1283l0RFM4cbW3UqvGWTDEWltIiMSRLY7taPuiKCaVoQhmRfr8i2O1fTC8FI6sdxFSljGxKG0qLNFfZebGadl2SMHM76qx9VRzGVc0xq7dcNVwrdepbuTllbozhItUgCW8HyD3RUiTwun2Sq8niLYXR4MR6IVwwrQnYycQx8bp99K3Du4WfRnuETkCW6OK20TsEncG0zuvd50y09aNM19iRvB3ck+6ZgeFC70PXo13r3a716kxxyFIC8iJe3seqsntJtoiAVOpsuyQG/UdUNDYy+4QanujvOGlMwwBBIoqylLqtlYSYf9wz1x/f/vJyO6mXp5nHo08tiTyXENq+4xAxmeo8eBX2A/bIsfqJMiyeEdJbSHmcwS1aCr04uVg5VRVTeh4ocueyZsza5a3ssVWGSJEftCg0zpnVeaBs+71XAkYTqidKzAdvNvYVEDHXrpd8S3oH9MKmCDZZ85UqbUeEwwwhWhpjI27aOUQD4Ru7Ku1ixZK0XL2xWGGaPhYrKm0bVthyizMESfIaulII3eEehibe3lPyl/yQGfd4VFnexPVkS+vYEgLW5Cbq2WWTJVi/MTf/+LeK02sSy9hny8RYEuUbUkua2j34VoNc8FvDDt+yZaDCWgeWfzWX26qFod5sKxoFO+JKPRgMXpoe3ltXxUiSsEf4HDUKiFIiojZItKZ66WonGDaHLfsH6xPZ8g38Gw0kb/XhvQtV+PJleeu2NUt3fmFRsFUy27LJU2VRSvTyeEwyree5bF6OmpUG/Ncqmzkv4xvlDkO6s9eDWGZ7OwXT2hEd2DpnB3AsSrOSF6CiJcYZQ3cnN7GVCN1xDaKei9QDP4+Cbb517+T6WXAtBWNes61FEdEsupLqBt799GQVyMdP4UbN70oTHjsweR1MtoE5obJsyuuZVAqjL6jghLFwyqs7auIylwunKs9bzoexSLJdI10s9QQ+fqrACLYrkTDvoTVAKdSqcjdb0P8M2qNQ1sKuD4Blss/0z2u1EFjQFXJje1KxLX2nW5nBLoUGPeYrNCXw4X0ejWlKz1YAHepiLwjhSWA+j/uojc7WQupkK5HRXE38gAUzdWCXQTKEVNuPHQtfnj+hkRR5eUib4yLWIHGRMSLNqtjZlGPJNyrXE1pYWZaoRCYjVKCWImOxtUuwSGUawJPfMqHJ8VnyqXHqppMxbiITFs5it5ByNUmqc1RmPJ+fgqOfm3BNFMQ4p87s6+ZyVTi6zuCEuGddtWPz7i23uTsLlD5aaDfBfVAGjcIrJpLFU1V4nY3W6tfkRmONrZNKtDzvLPbasZuTaeaZ4szvJFO2ZvUbMEJPF8uqNbqVvVJf8Hwt5mU3fzvmK1V7TFSpJzLj1tW6BGbY4LbgC1T2HIymPBOZ8nOus2HKGy5KfRK7O17DXOvJJndk08E4NpvKxEuvamx63YowZZVObcKYSVFXMd3KzUxtywpkJN2eHdwmXS+l0JphfHImGFlRXaM6cwfVPDa4tkTSwNWx+SfPy127bV2j2/NkHb3EjWfQlyXJbEUPWwR/vlUvVdSdkeraCLl4AJVg18K+6r/JcX70JbSIT+eFA11Nv2vKgRMuasUQ/UwrxmOHgREap34+E4m2RIF7+U3eC8rrcwUymvLPn9Z08TmzNe33Z09jNVbvuaoIeu0AtlEBVf95h7wPmuMuQWccWgtaquAL4DZBIhFxV3Z2GF9+q+XJEF67DduXQ6CmKMMRFtieNpR/aulDh0tWQWVLexZkCw5IoqV9tCFhW5ZvqnaL2NatWRimPf3pKB8WdpmhfyrQIynQ4YoywWRid9A6N4ahzwzdtbM4gPBqsRC/uTfbdIa/rvPz8X3NopLghHy5HKKXWEQFq9Ujxqbc7XddItVl6MUtMvXqB4baPFvW+O3dyyeJlvjSuSmBeLXd6KlY7pnquz4Y7s0JZdnx4yn1vV7vuViClkXNBbftd90Y05ewbqXVlh9ploveHluCiVpfmm5YYl6AIsqkRK7risIWsbAnuV0RPT+HOtsbc24VzLpUvdJKszGLcZtZNaZsMSQOZdbFq6I8kuQFrsk0O9jb4yRjKyC1viQV1JxsdkA7W7xujbpVVgOV0vOl2is+RfRoZsvz5dstTRbsNF86Wx3OmYtXJmLe4M82BdEdJdxHcTxfpunSHLcxbRfT9lRH6UWqipqOMIvMp3ePRfm9drXzIYReqmqo6oQmxQEdsSM4tp/2tGz6GvSEZ1aTTy2FsYtLe1saBbcuU2k0B7J7s2q4feEClq6M3ZRwES7h0JsBI8rKWy74JhGZKi1Q6+uC4ODL7jEkSt9KjJBrtrm1s+3654+/djOIUaVrB1GTdK7gWi0TTF7eDFVGNeYZL/3EzPuJMrydkei5TE4vmfPzx18LcvegyvL6xPQ8mgXCdjz2GC0pSiKjJY0ImzpWTS9LNVbDxoUnlsP21lNRjqCiJ5zu6965HYVdan2Z3Co9st5862yyzs/9+JYXBP16NGlRwrSqLmozr9vBbc7IvTh1BrXZzamwQg3yaA/pSEiaYnxZFD/52+cctbcOIvj/2GthulXxuDonJQuc2sJjJ0+SMTqCFKcr6t6plnSxQImxeWJbAMxCHygP/xVy+hXQbYHuIByufjFPXbk/FSyNCPHy7IoPBrgiwWxjz7Bosc39dTWWbakIe7gmptXTHT0lSk07wy5HSDyzpQrNvzb7TFTqfVN3KM/dEtU75bJKh8i6Yo3HJURkFSftUFK2HcjtRcoplkW/9WZmiCRcpcTuuxRVcF/eABfdcd9xDVep1NT0fDFc+1ej6p6YAykYGeTXsNSZNUkvhtanYttjz9HLOK5opMnsglb8Xyrh2IhwLrQ7qxAxQhOMe1GaUzljzzSkwweky/zIRFSt8/lnlszYWTJ7JMm4bMJLkdjmbYVO8VhdM0cpXbTPXTviL3qcWaFyN/p2OzDdmzWD2ETFabIuSwY8vPqQV0IU3Kb5G267DDlD/v6E2zxsLI/W+9xjd00vo9Gms+DiRGVJQuoXQFHN8B4evX351H5gaGVG30StJnDlYDQq8HUFw1dVHf3KqF7XQlVKxRawhb/9vYm3cuLEETYSkkr5bc+wIVho3P+C795ATKODUCiGmB6DJXnDw9DoMYuuVsC4dgdh+V0kMzr+CLlmByGJkYzPEtNoFwp40N8oWKHcQMYZfUbmTR2q3al045YSaYvNUw5KJP4sHWGgqM68SqUaErLxTmyYtIw/c7FuOpeHU1cSVjk2skRXRtU4XSzm33ibTUuKK6P3pXHJPKK2ipaEHnRxauP9o9dX38UrkhRV7txS1zUliW6dzjugXxsFpHpz64aiBwKGKwwvHnvX2zRj4dqtAwhzYMOjqYEtwnK6F4p3PhHRNA6u8RugDsvHtw/vgUhJNu5cZZzxmHAdrlUdU/Wcb5+NNI2qF2S4mK3rZEv/x1zgbQ+VQapeUrYNk/Whx2eJbTbezZI5oWy0pax6vbltd3f/dn6pyV+DCI5UyxYSYov2SLL299pbEEGU1r0YV3IqYRXbeFVoloLFNgwPd6/ffHtr3J8cwjZ4Zn4ewSDx+LyB7SG6ULK0J8JMvzvQFvpJRM+oD1maPr179K2oEoSb+ofZ/a4Mc5fi7C7n7C/3mHS931XGOQhiThLaquXSF4F57pDO7eW7ExoumtX6uijtfvePN5PXkzeTO2OVvHn9+u7+9fsff7h/++M/39//8N3fvr+/vxsmej/bS4AfHoHEsfS1wGhRbIdweHhcfWs6e3hcfV881Ie2VMjwxa2B+VHQ9+bNPvBNVzswSUyExgtg+EcLZGSOe+pOwnJPQH+eG9dhgIFUAPv797dv7u5u7+7+fvu37yd8PfG/TCLRuo5uB+bHTx9BYiRkHKhthh4oPDzm16KKmSa2isqKEpC4Qqnae5MPj8CEeO4MaDXYgJrF05RlaioGXRRQ3oe0L/m2kvx8jpEPZKa3zsSNhS0Reo2ffn7/Mjd4PS/MoLkMEMHRXonbbJWRGbLazRM3tgHT2v+9s/7V1VyIyYzIyUIwwhcTIReTK8Pfq+oXraB0UcTetBGjRplQnlcqN81DJBL0VQEJB0xmGMcYQyTSTWG4E90qA2BfWGqd3r96lWYzRiOVzef0i8XRW5an9mab8Uz+f5rm/EOznExX/qEYEyuBXtzAJ1LuQNx9acf4131sBeDLoe8JYsBVEdtRjH1Dx0+V2zmg1vRWHPhl38tn8AtGmd3uOoQf9nj/YJEIvzW8430vDJlnjE0HiELdBu4Onz/Z3yHw+6HRczF3ZXRz+5mWMXNfjP4gC7pdMmzveqtvrRxz7izq5iDsKlBqExXaDl9uWLlKXoHfe+zOGGCWh93oqrV3lcZAEH9ELEUX1vgJe686Gq0OrunSeGD7j82OigvdDBn5HtvZpuZK5nvgN2U5zDKqU15Q6vJkbPqIKyWaustLfscJvBNSokptYRQt8noQCm3c+ZXRmK/URr3iqF/RdPXtKx2l0wSTCXzoKMvbvQ0fLs53cKXU3aMLPTfnhUyXZHseVvdI90RrEbu57gfJd4uxEfl8aLv5u5WCLh0yNgG5PtnN93565Qj4DLRteqYJD5WxCKhaBm8aHhlgGaeqdDuImxETCqdr0nm09yhoGwiNjpiWSKbB+/nquDVNLgN2ASSEujC14lEXoV/f/0EWIUPIGRehLL7ERWj76ELPRejUKrwL9Zb/KWZH2igVO9jW/+ya+Fw/M9S8PtV35D2CAwPmvvzhJBl8AXT+auNnytNMT/OHEsoY9UXBhimIT0uED085rbZ+atnU5MX/BgAA//+AupAR"
+	return "eJzsfXtvIzey7//+FIQXi9jn2j32JJmT4z8uMBlvAAPJ2hjPYBe4uJCpbkriupvskGxplE9/UHz0S+yX1JJlJ7CReKTu4q+qyGKxWCxeomeyvkFyLRVJThBSVMXkBp0+6g9OTxCKiAwFTRXl7Ab93xOEEDJfIqmwyiRKiBI0lBcops8EfXr4ijCLUEISLtYok3hOLpBaYIWwICjkcUxCRSI0EzxBakEQT4nAirK5RRGcICQXXKhJyNmMzm+QEhk5QUiQmGBJbtAcnyA0oySO5I0GdIkYTkiJDfhQrVN4VvAstZ94WIHfJ/PaEwo5U5gyiWIe4thSc/wF9vlyu+W2Qy5I/qGv9RYEJRSXQKcEBeRpEaAZFwgjSdk8BkkKgvgMYZRksaL6PQvZQUWoLjSE/EyUGaFR5WPHSszZvPZFCzfwC9A/ASqWJVMiClSVJ/+GHogICVN4TqQXUCaJCNJQeWHJEMckmsxijusPzLhIsLpBqaE/DPyXBXEv4rkWNLCjaEKQTAlTiDINDMkUh6SBtwoHiobP0svDYNECOJzwjKkdgdn+cozCfSaCkXgIFyMKuFPCA9AxGpLj676coZivLlNBuaBqjVLBQyIlkX24OZikt0VJo/gIZa5R5a81Az9cR+4BiK8wVUcoS4YAGDrjDEVUPp/34+Nwoh2KT/x+fEKWRCxpCK4ZuHQLzKIY/rHAIlqBN0eZIkJkqeocj+L3w4l+NNSSz9Rr0gvg3Y7Dl9bNFsgVwfHxaYYyRNmSxxlTWKyNCZiu9TpnSYXKcKzfWC1oTPSni3UKIpFcbDS2wrIiL64WRLgpkItg44WPS0xjPI0J4ixeI87QV0a/9RLkwTrAUQvIySRMs52WcmGabawmQQ6wYpa7rc5gmTemoszazClKU0epINJ6X7qLcqkC3fUZZ5cMLFtM/yD1ZSIqjQyJVjSO0QIvCSxQ8TeaZAla4jjTg+bp+urq7+i/9BpWPmnaG8SKdip0cSwIjtZI4WcYQFRaqpQpjnAY6m5n7P6yvB43Px4sAKVQSeWNt7E0RfdsM0QgLzbIrnmGQsyM0gr6sgjezAXBigj4gBm5oV+4QOQbTtKYXCA6Q99vkNU61rEfrNCHq78DNAgIEQb/cWGPIEyzwEnzyfSeKUHXPzUqp7b4e+VL2Le1SHy9y6+3stp506uJP4Ff/pd3O453q7g6UkGCL0gkMmzrGfUuionuOHf3/wIrlJOt0P8b+mfhGfXyT8CTOnYnJX/fy4ad44+WkaET/XEystNsf6S66T3lHyn+Leb94+Rk9Mn/VbG5rQdwnEy+Vjfg2KTZxwu4cIEQSSIn5CJmoxfXHt7zP+D3b+jLRnTvtexMHzIuOXQWPxi2nSbmw0mw91x7OEhbTJ8HAzf6jPjSyLed5A6G+6jnLScT2MymfKftByBR2n+Af6K7+zyNrGcO3vZ7FPBfrz6fyXrFRX3jwMaPb5CM8PVwdWv2oMkCtBeVJILieGImzwHwekL4TvcHimM7PcOuBpUowWvEuEJTAjt3SxqZaRzHcSH0DZo2Rt/BEGyEBHrDw8vNdoNHe0olDwMakSjkEOGHLiOzELYfZ1kcrzvwrQRVZO8AdStbIgTmgulaEdkXoHMFfS9tAV6T0TCqsGHP5lfKsm9mi4vWm0I1P1CSUHFhKenNnjSmtqcxhKXMEtCdfgpJ+of2Q3+8ft9Lgy8vINCxImwcGTliPcW0QbVbbKCFAOadvkLbQjAJjWMqSchZJO30Zs0KtN418YIMyMtB1M13YaR83wD9GCMOM/rdu/tugBDDDUDegSC/Z0SqICFiTuQkJWIiSejF7lthdoCvb9VDk8g2CQn4Ym52yaHrchbBBq1CKyII+j0jGYmQ4tpgRGRJQ9KPLa2jA/Ol29w3YxV9HVRRBXoq5Qb6Ep853TY+qgo6rGbG5URrxDLQMh2PwMbPxXyb+74bmINeU1onQxiWnuMygpdEQBCptKaBMyHVXubViOLggcKChUT9honpXgfUim5wr2rRLRxQL7VBM5JiLL0AL+cT8FH2wwpQRmcQodTekDyHeUctSuy024B+vGgrvmdOdBsoJmyuFnth4pAD3cIeqSuBPaAhmTT6WTszYFswjEB3Kjtc5zCG0d27+3H1Mc3kejxuii32SkQpygS4iasFDRdVFhrRo7MpZtGKRmqBMkVj+geGZrUQiqfOA3RrHpdYZRAg4AzxMMyERKsFYZWkR4nCmEvwbGt5jE4khCnB0/Uu4aQicGUPRG7SHB4iwo7oZEqVHNHHzwkjIAwq24RbwHj5zaACr8V5AUnBWNElcb0n5TzOF+0/XP3Ph5M6GzMak8rZ160U/VSQ2cheLr4aI4k5Z9orfI/i/XN9b5HrEKFONCnJG/KFGcpYKuiSxgSWUHp3ijLjUgRe6GaQTgaGOPtiBLKVpNob9PQuIst3wMH1kxcR6HkPUIBsHQr5pn7wg9BncSYpp0yNi0UTBkuraW/Ixo9G99a+fWuLwAHQR4xHRMIWE9hu/clm7LwESRDSF9E+ent7r54JQiZjS60kL0HINkLTAZu+iHaUmm6rLLt2iWWSHDZ0DA0OhPfys1sNdIF18w+HfCZhgjmpYx4yj9kuZSiVprLSJOb2wvB8Lsgc55thOI6Nyakdbyle3XHq23475J9V82PRoBnP6mtj15bu0jsM6y8es9fQ30xTnkVcW6/3a3aTcSK1fgquUcSLghxtUi9D9FjgVlF0oe/ohe7HCBEar4+BOkAYLC8GEBrvAugzx4dDqMGhMw00jTOpZVrK6HAoY46jk65O1tIqLPGAhlvD7jjgT69PT3ziajHC8BVl88kMQ+jlBpZ2J4OE9msJfr68jLFUKKEsUyTwI/3xmJD+aLHKBrDXR4X22gPXjxvS+YKX6hMVzAYwimieldAvuXCTnR+PgZ1cA2NwdH0ULF2PxZN+6PSkp9ke5Nu3Hxs+qUMxdcF2sc9PhsRGiMJWHBshPHGwZQe0YyulFYi9kA663PgKU2wvWB6far/rsyK3D5q2IIu1kMm5MsHRiBOpU68oC+Msyh8OOTPpMNO1cydDHC7gbDmLNpqeZrMZERKdSeK8z8CKBoeQMhjU3BCvnI5pOdZLsYY3L9z6UO2B5KOm5hQAwgBZawcuqHNcG5e1r2si9fWl1k7Y1RF7MFNiqCTPUh+8U0gQawxhRwMCatCJCAsJmhK1Ivbsu+3SOmGjHKuxGvKWRYDf+pMoIimBBBZree8fTZwsgfP+EVGYxvICpTpKi8IFCZ/zNXKpDz8F3UJ/oTWUFbd/yN8pRCUKcRxmsV7ITzGopSSLPFGMKpg0JIVtGrsTWKLpbVqvNAr74OyBzj67f/w3orp1jGSW1K2SUyxlONTxfKfXe4b+RVnEV/LCvk9+3xxtVrQ815V9va+uGmxOL7vTbXt6am7TBuGNodPAi+NDrnDa2xClgszotxt0+v+0Of3/pyctkPVkoakUvgS4D1QqiA3pfZhiEw9wONXqsqaui1n11FryORhdTsYhxpJdTBfM9O1KTW3uG7D2RobhfSkzldvlYXCPdKRm/QTvuFhkc5JunER/gcEKQJBG8uLj1HvYoKdCinhviSG7cwJbwg3qOJpx+1vJ26MM8n55qOfngp0xxkYHAzuNiWruR0kNwNSe7dA4XadwFbfuRODIQtrgyzLiUKBppvSqztefBnImMwHu3csyxpdEhDxJ6OChEZEZzmLl23U5xPi+Nc2bzBSIxPnAO6yMqBUXzydd00JLu0+WRinwYz8pH26r1Eh33+ujlLPa3sbwuNDApI88TYGoxcBQ4JeFB3yfQ248U16D39gZ2jpCH5B5P9YEENQN7IBI2YsiFCQktDtDEgSZ4vCZjJqdVoCxtHsKbH9IRI6kp2AoC4gQXOxHLIa0PYJrEFE274AEujoUJklY1I2IsiASPE1JtBdElIU80UlRVndF2qxttofE9gmQZ2rO2wGWQ7UQQYlXeF3XH0JX4LzfYrECD5JF6OfHWzQlIc4ksaET8AUESblQxe5I83FmJwAIz8lstxs7zOFDRwkWI3GMKDP2ChJ7zx4e784D9CR5Qp4gTAS+iVzA4X4+y0uA6BTiCmGsEExNsJNCkMLy2Zw2B/pFYEsQyTMRkgv0BOchn7zEK2R1Q+CH6zquFOqMAHGbsezIY2Uo4cScM/FOmVOi+k6a1Vq6zYLu0ccemkVto3mwGeUEUwbeBLIMFLQERxGur158Lf7o7SPd/eLTw9cLtz8dac+ytJd4hWzafdApgg9vUwQfBojg+6u3KYPvr/oKQQc+guHLox6MmRik4yqhoeAW0zAumzkAk3jkg7nDDnfo8bqHGp0QPrxVIXwYIITvr96qFHoNaS2Glx7SvfgMTnz4vVt0e/Qk7EbPn9iZMBLYyQC9AX+iQwp/FpeiQwxvx6uob3XVmXgTjsUIQ/tN+BYjDO634V6MMbxfiYfhG+COhUrdvT07GHf3f+ZIxd39TtbnDTgWd/c72Z234FTc3e9mcV6FQ3F33z15vGpnYseh/CYciR0H89twInYdzq/EgSgPaAfd1v096fIcWlA+WRql9Ab7CRTuxRFW+KJ8G/xF+Zp9+5l3r2b39AYcU1xXSIrVIuc78Lya0LkpXpPf37/ZIiTbDsmo6FAy7EE6mWnStXIRpyJjjLL5aeBFk9JoS/Y33+zDfbpDg1u2ON++xflWLYYJ1AYn3ka31jEYCij4m2AWXQJ5nSUIedpSYWH2Ly3uC3v6B/aClec4BRbzLNHnNCRJscB2E9l7EJrOGRdkgqd8SW7Q+6sffvKyDEVkthhK8Nq24yhcRQNbc2qFpCw4hxlRoeu8rbdonbBl7S3TOp/+h2xMZubDyY49gLAlFZyB5tASCwpJ17K5FwT6JTChvjLBRY4kZ+gXQcjPj7cX5sSIMbL3j+jfwc6b24OynT89fL2UKQnpjIblNOe0KDI/dNXYeNXHKHOrv+5+SQftd4DUwZowoc4O2RPa/AJYmFNNnrikcEJL2xBrL5pkXQd6fMn7uQrywssVXWhOc/csSyM9W96pUkaOpAmNsbDHX7zN/h1ayQVZbiCiMo3xukjJUTx1JtvdfWCTczqF23Btz6uSMFlW8vzKP9U8qNK1x5ai76g1SJEqJDDbPG9hmYY6qlebhQHrIrYJUsdgF/z379QBmwG3T7y6hXb1tsgTrIevoGaBLtp0eoegA0wrd32yE6INOkASXHDiw7XL/qk5yTF0Puqa77rmqxdKay96gLsTxq6xyuJe4JYuIKT0ZjgfCv1nImkEffaRKPRI/yBBbRh6GIICoyncGAE7Z5i6MDk6+/zxt9Lhdh+rx2eZx+NPJ2u+kBp125GPmaxy9VcZ7yzaboRvovgFkp3dM1xYD8nFGUzyriS2O+kPYfYqXOmZ584k7VJrj9p62WObDJ4SNlRbFTlUs5hnVRlIuLGH9Z4JYppQFcClWDtBaukgfKZMKy4nuAN67lN4STqG6rRDzKB2fLgAZyOqsQ9BfszWG5nCPlEssIj2JAogvS9RlGiDKPTtXVOCBHZXMgrOa66d4zv0Dbyth+RvrmYhs3hkUS3ftATsmjgmiACitHpQooSAMDb1Y99yAxgCv3kq/YaLscDSEpILmoLDVg4smh8InYI4LGUtQJmbDd2All9lya3NQuDY26DJZ2UOl+/RghKBRbhYVwHbs3/GRrj1SIJVuPBdseXIXSOZTeunBPpZG9rcm/2BjQEd+u5Wr5ZgXHMdezd4JVxSxEOq41QrqkDRcK4Ay+dN7cLPnRaDLkjOvlMIO6p3tyZaMl1XqGtqmm+0onGMpv7xgactx5bKIoJg0/6EBNRddQarz3rtbPtxruPvpCnvaaoJDxKZbu0QQtsMKrWbjQESC9Os6O9IhgsSZRA5g8UO1temgU9mNz7sgLJD2Uvzo3nHTREcSpjr7SAwriueB1XzpoS8QJ9+edQ27PMXvwLge6kw5G0BGHezW7xGM0xFQcqaulRwMFmUMxzH9RWOlY4unibzIyIwW+WVeJwa87IxK0LnCxWgz19KMLx0BXEbSnVQEs72YZTgbzTJEv8SGKu2yac4zWz7MAjZ1q5ytw9gNKdLwsB/przp4F67Mes0aH3G60YPvLt1Brjee1oBNJiLrSD4BwH8PGxjNhqp+cxJK5PhTAZWYZls5bbBJxrCqm6nvJtb2faE4bXgKyTIPIuxgIm5kZQRyXfS2QnFK6etJJILnsURuIqC5IfzB8jk94wrvH+RfKnVkWsUTL4h3EgqN5PYdRgYoyJjbnxC4otRNTrDEkVkRo3n2Uiy0jlKC9NO6enV4r5l9xEu0FVkToQNWIIRQzYuRMDg5QMpP4noDF4j0cIXtINvQ6xBKWDvGnP+XiPZMM0CDULqutUoyaS+hfU9nHle0Pmi7BC3ileoIx6vVkQtBqppvFK5xUAVKhBwIUNCjkIYYKuhISJ1ZTdFWcYzacdcI2HKaquk6iBe4CVpsnI9xQQRUjeQ9y2mopSINTUwRMUSx1IbncqAgUFRNTGNZPXQ1qIgMU5l7x5iWFcLwZWKSXRwIUBfkU1anZrSixYbOtNMUnnRSNdVmlmZOnBg293xc7Uga0OVfFvgTNeoh2UBn7XapZK5g5mnoiHwmheECqTnwvMtJc72LewiRO6uwrLn3SlDDDM3Qs9L02ihj0aqzXpqkYOTQZhmOAzVbusmuwpyN87YqEVwUnvnL2/6iLxpu0W89y5f3ROsdnS3LMsjA3lvb3VQhujSjHHInBiL15yXMvgcOLSEEh6Vgro98Nk42uEQnpk94/MhUGGvKM1aEXozl7wZTLv3rJzLDeuZs80ZIjhcaIHUelgjWR2U6jQXrbvDA62nrfdnI9MQ4PnLgO7NgA43lAlJAr2J17g33WuEdm1uDmC8XP7e7i9O143hrzNX8fl8MMMJ/nY8TC9IHhXMWSfR6JzrYXiUXBehFzPJVOt5o7MiYRfW7Y0kdU3uc1NYKp8USlKD8EPJc89k3/kB+s0M0zjbfzylut1sVy4LW4HJCkYrEp3VdHoOZ6ka6QqYLnqv2BKSyNWx2QbYgzYl1cNMCMhmsfLQOBHU7TS1iXWqthtDjfTGHFtydeR2pRhhVmYwGW8Kq2JwGgnvLqyjN0UukmQ7XF1ousc10tuLAZKrYzJB9cGmFdpI8WxD69pYDTRKz8fqr9gs1b25Lc/H77fURdDpvjRSHS6ZozcmfFbrIm0GopHwVobj+Thdl+c9+i5Ae6LC9ChNhRWDhga9Dn359OAukCkusMmJDGH0WE1DzjKJNjj22IhGmrtYT90fXoOdsMKqy2nDYHRJaWtPI5fWcRqNuiKbN6uG+xcmoGouZppgxv1FyXsLYMS+8pFxtk5gGzP3QPVaFzJb7UVScLJbXUJ5bKbi9aWegc9+/fy1WUAxlapyFjZJZ3Cp3SIhyfnFUGNUER6s0g8sPEhOv5xCaek8P74Qzq+fv+bsbsGVlvWB+XmACUI3PLaOXK4sDXE8MaKaHJdpLIeN8z39IsVXG8O8IkLJThjb17xzO4q45Oo4pVWsyHrLrZFkVZ7byY2y12ZJKfOYi8rIayS7MSLzJ4dI6gXMZrOk/AbVK6MtekeCoeL/cXEM5+IKH+zSQET2f4BUNpviRqJbSQduKpvoq3S2lsu2STJgW7Fzyq2z6ZxKJeh8TgQktehLfRqpaugD+8N/uJi8Ar4T/B8uOhhHp7/BU6fmn3A0NIVTYvnxGRsMMNdexpAxBFlljUQFwebWUF2tQp/viWj5dEcP+YJk5YSyg4lVN6h7CWSZKW5HlT0mqI8ghbDoIWILPnimXoQRnpUWabuy0nYm+NCmr3FatFtvYBkEZjLFet8lv9fx/AIuXmsk22Qtt5szhJQTaPlopFZ0Ek0M/sC5IL3yGsQvqOFoeH3Mtz221F7GyJKGCsryHJvrrI1/iBlkduqzCmGMaUKiXpw6Lqfx80at2IHpMj/HPCzfXBec1J7+K0tmxywZ/1G/Vl5MNuGx9Fiz4VQEzDQuY5tnRIBrpri9SJ8nsNeIprpTRTD4GhtHLZs1g8RE+dZC2lIAd+/u4fS2STfWaf5gH0yGHLC/PeM6D5sUp/tt7jHMZimPaVgqGO2EYCkFMksSXEmeU1TF5AY9WP/ycfMBr5loEYol4WxFvt4vuLGlDd3x9wWXardqkL57jxv12qHPuh4L2BoulRt4CxyWsZGQFLO4E9gQLDSKyehAgOggFDImJN2HSBzhYWjUmNcIlsAYuoOw/MGTKR1fQ4bsICQRweOLBIg2oUB36juJlgQ231hMn0lsXR2qzKl0iGxgoa9PpkzfxWEOnuAYSaoya1KpQgle20Wsn7WMPTO+YqNzVzBWOjYCaWBQyRWFcLgUzuNrn00JSpZg9wUsySyi4KQOVWAa7WJ2a++PWFLX7xN1yQruZLQumpnq/BqCFVQmx2tXRwGpWl8C4V4IYrIk8XgAYPsUdGHoVgF425drFk4ANmfjofhkExGBODLELxA1WD5/vLtFWAi8hg4pSJSxCDOFvOggoOO2z0YaRqVxZGO2ppGW9vc5wevGS0qCnQhJpZKIz9ow6TX0SJhKItFkI9tGS/OwDU6i8ds3dLvb1+NLBv910vtQyu7ldCHmDRgFXmmAxt5KL0q9vBi35xRCMsTLnWbB40iH4dH11fsfLmH54yC0wYPxSaJ94eOsDFFPsZB/AXPqmoUdaB1SyeHe5l2mJkgYMVRcPBuSQPTQD3aaskwl6CbD2VxR2l5XHzS931RJ2gtihhMar7dEAEh3aRxOxMcBTb3N07Tplv/r/3kfXAXvg2vwSt5fXV3fXN3+/NPNx5//cXvz04/ff7i5ua692qJe+P0VcKC7B4SjSNhyZDQvtoMZuntY/gCN3T0sP+QP5WRaeIN6OV7uPOMj5+/9+23gQ1NFh/RiEiThihyBwD9rICNL3HJ3EJFbBvrLHJa6XlT+qSQH9t8fLt9fX19eX//35fcfArYK7DdByJNgGOaHL58hCMtF5KltRixQdPcQoDsFvhWfQkoDidCSQrmRJRGyPtoRqDDm/DlL+4mBqDiaQE7FhDOyjTy2Zh88WzKbkdAGMtNL4+JGXFcpPSNffr09dw6vlQUozRyAgfoyCd/wmFCMpySuXH5xoYUJ1P7PtY4enc44D6ZYBHMeYzYPuJgHpyDf0/IHdWaKOvpAIyKKiIQyVywdyEOBOWILE2KGoPBgFJEIhTxd5447rtweX9SrXiiV3rx7l2bTmIYym83oN40jf7hNiSCWCRGCiwEa7Oic/wByVoVTx6Yp/5DrRPdA292QTaQs5OZFbJe6weYtHl1zXPObg6Y4R8ZWZN8SxIDbKtpRjH1JyC+lC0JQhXQrDvKNbCkJ8o2Emd7u2kUecB4yGNwl/G8Nb7gxVtHRNNzGMhnQFVyjxnttDp8/6u+R5/tdo+d8BrsILPef7U4AGBBbD38nD3qzZJgfcY+O/FH3Y8ZgfuAbZRV8IMpAdKLCxrcOjq3k5fm+A5QDpmXYjK7AAdm4xBPEHxFL3oR2fuSJD4YK0zH1Aiuw7XXTUXGhWSBd+4s9BPZb9eBWeSnp9sAvinKYRVQnLxZm82TAutpSovCBrtgfoE9cCCJTXRhFcVepGGqFU4begcV8J9fyHSPqHU2XP7xTYQqZyQG6b6oM3ChEf3G+Zq32lE+3dts0XAbIRbrA9ZVwX033RAu/HyE+bg6V6BJUulnYHw5Tp9pm+bZy0GRDxmbA2ZNuufezK3vAB9Da7EwdHpHgEVC52AhG7QFgEacqNTtImmHMJZmsMFWHRFtDCOGzSYFk4r0isIobcjqPAnYOxIfaoc2i9KSvuepABWbq620Fx0k/E3WEk9DX2xedhLLoGCehr7djTEKHNuFNqFv+cFCztFYq1i/GFkRPhkTpFlVYstukITZ3XcU8ZVcEwU7uvit/GCSyb4DPDR/3au1rytJMTdxDCY1jaouCnQzSDARr7h8dr5RVSAUn/zsAPlrbeg=="
 }
@@ -0,0 +1,145 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build linux
+
+package pressure
+
+import (
+	"bufio"
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/metricbeat/mb"
+	"github.com/elastic/beats/metricbeat/module/system"
+)
+
+// resources are the files found in /proc/pressure.
+var resources = []string{"cpu", "memory", "io"}
+
+// init registers the MetricSet with the central registry as soon as the program
+// starts. The New function will be called later to instantiate an instance of
+// the MetricSet for each host defined in the module's configuration. After the
+// MetricSet has been created then Fetch will begin to be called periodically.
+func init() {
+	mb.Registry.MustAddMetricSet("system", "pressure", New)
+}
+
+// MetricSet reads the pressure stall information (PSI) of the host.
+type MetricSet struct {
+	mb.BaseMetricSet
+	pressurePath string
+}
+
+// New creates a new instance of the MetricSet.
+func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
+	cfgwarn.Beta("The system pressure metricset is beta.")
+
+	systemModule, ok := base.Module().(*system.Module)
+	if !ok {
+		return nil, errors.New("unexpected module type")
+	}
+
+	return &MetricSet{
+		BaseMetricSet: base,
+		pressurePath:  filepath.Join(systemModule.HostFS, "/proc/pressure"),
+	}, nil
+}
+
+// Fetch reads the pressure of each resource and reports them in a single
+// event.
+func (m *MetricSet) Fetch(report mb.ReporterV2) error {
+	fields := common.MapStr{}
+	for _, resource := range resources {
+		pressure, err := getPressureData(filepath.Join(m.pressurePath, resource))
+		if err != nil {
+			if os.IsNotExist(errors.Cause(err)) {
+				// Each resource can be missing in older kernels.
+				continue
+			}
+			return errors.Wrapf(err, "error getting %v pressure", resource)
+		}
+		fields[resource] = pressure
+	}
+
+	if len(fields) == 0 {
+		return errors.Errorf("no pressure stall information found in %v, "+
+			"it requires Linux 4.20 or newer with CONFIG_PSI enabled", m.pressurePath)
+	}
+
+	report.Event(mb.Event{
+		MetricSetFields: fields,
+	})
+
+	return nil
+}
+
+// getPressureData parses a PSI file. Each line reports the share of time in
+// which some or all tasks were stalled on the resource.
+// Format:
+// some avg10=0.00 avg60=0.00 avg300=0.00 total=0
+// full avg10=0.00 avg60=0.00 avg300=0.00 total=0
+func getPressureData(path string) (common.MapStr, error) {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "error reading pressure file")
+	}
+
+	pressure := common.MapStr{}
+	sc := bufio.NewScanner(bytes.NewReader(raw))
+	for sc.Scan() {
+		fields := strings.Fields(sc.Text())
+		if len(fields) == 0 {
+			continue
+		}
+
+		line := common.MapStr{}
+		for _, field := range fields[1:] {
+			parts := strings.SplitN(field, "=", 2)
+			if len(parts) != 2 {
+				return nil, errors.Errorf("invalid pressure field '%v'", field)
+			}
+
+			switch key, value := parts[0], parts[1]; key {
+			case "avg10", "avg60", "avg300":
+				avg, err := strconv.ParseFloat(value, 64)
+				if err != nil {
+					return nil, errors.Wrapf(err, "error parsing %v", key)
+				}
+				// The kernel reports percentages from 0 to 100.
+				line.Put(key+".pct", common.Round(avg/100, common.DefaultDecimalPlacesCount))
+			case "total":
+				total, err := strconv.ParseUint(value, 10, 64)
+				if err != nil {
+					return nil, errors.Wrapf(err, "error parsing %v", key)
+				}
+				line.Put("total.us", total)
+			}
+		}
+		pressure[fields[0]] = line
+	}
+
+	return pressure, sc.Err()
+}
@@ -0,0 +1,88 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build linux
+
+package pressure
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/common"
+	mbtest "github.com/elastic/beats/metricbeat/mb/testing"
+	"github.com/elastic/beats/metricbeat/module/system"
+)
+
+func TestData(t *testing.T) {
+	testdata := "./_meta/testdata"
+	system.HostFS = &testdata
+	f := mbtest.NewReportingMetricSetV2Error(t, getConfig())
+	err := mbtest.WriteEventsReporterV2Error(f, t, ".")
+	if err != nil {
+		t.Fatal("write", err)
+	}
+}
+
+func TestFetch(t *testing.T) {
+	testdata := "./_meta/testdata"
+	system.HostFS = &testdata
+	f := mbtest.NewReportingMetricSetV2Error(t, getConfig())
+	events, errs := mbtest.ReportingFetchV2Error(f)
+
+	assert.Empty(t, errs)
+	if !assert.Len(t, events, 1) {
+		t.FailNow()
+	}
+
+	fields := events[0].MetricSetFields
+	expected := map[string]interface{}{
+		"cpu.some.avg10.pct":   0.0153,
+		"cpu.some.total.us":    uint64(4912323),
+		"memory.full.total.us": uint64(95112),
+		"io.some.avg300.pct":   0.0176,
+		"io.full.avg60.pct":    0.0449,
+	}
+	for field, value := range expected {
+		actual, err := fields.GetValue(field)
+		if assert.NoError(t, err, field) {
+			assert.InDelta(t, value, actual, 1e-9, field)
+		}
+	}
+
+	// The cpu file of the fixture has no full line, as in kernels before 5.13.
+	_, err := fields.GetValue("cpu.full")
+	assert.Equal(t, common.ErrKeyNotFound, err)
+}
+
+func TestFetchNotSupported(t *testing.T) {
+	testdata := "./_meta/testdata/missing"
+	system.HostFS = &testdata
+	f := mbtest.NewReportingMetricSetV2Error(t, getConfig())
+	events, errs := mbtest.ReportingFetchV2Error(f)
+
+	assert.Empty(t, events)
+	assert.NotEmpty(t, errs)
+}
+
+func getConfig() map[string]interface{} {
+	return map[string]interface{}{
+		"module":     "system",
+		"metricsets": []string{"pressure"},
+	}
+}
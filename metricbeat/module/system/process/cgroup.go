@@ -21,6 +21,7 @@ import (
 	"strconv"
 
 	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/metric/system/cgroupv2"
 	"github.com/elastic/gosigar/cgroup"
 )
 
@@ -221,3 +222,131 @@ func cgroupBlockIOToMapStr(blockIO *cgroup.BlockIOSubsystem) common.MapStr {
 		},
 	}
 }
+
+// cgroupV2StatsToMap returns a MapStr containing the data from cgroup v2
+// stats. The v1 field names are reused where the values have the same meaning.
+// If stats is nil then nil is returned.
+func cgroupV2StatsToMap(stats *cgroupv2.Stats) common.MapStr {
+	if stats == nil {
+		return nil
+	}
+
+	cgroup := common.MapStr{
+		"id":   stats.ID,
+		"path": stats.Path,
+	}
+
+	if stats.CPU != nil {
+		cgroup["cpu"] = cgroupV2CPUToMapStr(stats.Metadata, stats.CPU)
+		cgroup["cpuacct"] = cgroupV2CPUAccountingToMapStr(stats.Metadata, stats.CPU)
+	}
+	if stats.Memory != nil {
+		cgroup["memory"] = cgroupV2MemoryToMapStr(stats.Metadata, stats.Memory)
+	}
+	if stats.IO != nil {
+		cgroup["blkio"] = common.MapStr{
+			"id":   stats.ID,
+			"path": stats.Path,
+			"total": common.MapStr{
+				"bytes": stats.IO.Total.ReadBytes + stats.IO.Total.WriteBytes,
+				"ios":   stats.IO.Total.ReadIOs + stats.IO.Total.WriteIOs,
+			},
+		}
+	}
+
+	return cgroup
+}
+
+// cgroupV2CPUToMapStr returns a MapStr containing the bandwidth limits and
+// throttling data of the cgroup v2 cpu controller.
+func cgroupV2CPUToMapStr(meta cgroupv2.Metadata, cpu *cgroupv2.CPUStats) common.MapStr {
+	event := common.MapStr{
+		"id":   meta.ID,
+		"path": meta.Path,
+		"stats": common.MapStr{
+			"periods": cpu.Periods,
+			"throttled": common.MapStr{
+				"periods": cpu.ThrottledPeriods,
+				"ns":      cpu.ThrottledMicros * 1000,
+			},
+		},
+	}
+
+	if cpu.PeriodMicros > 0 {
+		event["cfs"] = common.MapStr{
+			"period": common.MapStr{
+				"us": cpu.PeriodMicros,
+			},
+			"quota": common.MapStr{
+				"us": cpu.QuotaMicros,
+			},
+		}
+	}
+
+	return event
+}
+
+// cgroupV2CPUAccountingToMapStr returns a MapStr containing the CPU usage of
+// the cgroup v2 cpu controller. Per CPU usage is not available in cgroup v2.
+func cgroupV2CPUAccountingToMapStr(meta cgroupv2.Metadata, cpu *cgroupv2.CPUStats) common.MapStr {
+	return common.MapStr{
+		"id":   meta.ID,
+		"path": meta.Path,
+		"total": common.MapStr{
+			"ns": cpu.UsageMicros * 1000,
+		},
+		"stats": common.MapStr{
+			"system": common.MapStr{
+				"ns": cpu.SystemMicros * 1000,
+			},
+			"user": common.MapStr{
+				"ns": cpu.UserMicros * 1000,
+			},
+		},
+	}
+}
+
+// cgroupV2MemoryStats maps memory.stat keys to the v1 memory stats fields.
+var cgroupV2MemoryStats = map[string]string{
+	"active_anon":   "active_anon.bytes",
+	"active_file":   "active_file.bytes",
+	"anon":          "rss.bytes",
+	"anon_thp":      "rss_huge.bytes",
+	"file":          "cache.bytes",
+	"file_mapped":   "mapped_file.bytes",
+	"inactive_anon": "inactive_anon.bytes",
+	"inactive_file": "inactive_file.bytes",
+	"pgfault":       "page_faults",
+	"pgmajfault":    "major_page_faults",
+	"unevictable":   "unevictable.bytes",
+}
+
+// cgroupV2MemoryToMapStr returns a MapStr containing the data of the cgroup
+// v2 memory controller.
+func cgroupV2MemoryToMapStr(meta cgroupv2.Metadata, memory *cgroupv2.MemoryStats) common.MapStr {
+	mem := common.MapStr{
+		"usage": common.MapStr{
+			"bytes": memory.Current,
+		},
+	}
+	if memory.Max > 0 {
+		mem.Put("limit.bytes", memory.Max)
+	}
+	if failures, found := memory.Events["max"]; found {
+		mem["failures"] = failures
+	}
+
+	stats := common.MapStr{}
+	for key, field := range cgroupV2MemoryStats {
+		if value, found := memory.Stats[key]; found {
+			stats.Put(field, value)
+		}
+	}
+
+	return common.MapStr{
+		"id":    meta.ID,
+		"path":  meta.Path,
+		"mem":   mem,
+		"stats": stats,
+	}
+}
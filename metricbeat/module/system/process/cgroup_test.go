@@ -0,0 +1,104 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build darwin freebsd linux windows
+
+package process
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/metric/system/cgroupv2"
+)
+
+func TestCgroupV2StatsToMap(t *testing.T) {
+	stats := &cgroupv2.Stats{
+		Metadata: cgroupv2.Metadata{
+			ID:   "docker-b29faf21b7ef.scope",
+			Path: "/system.slice/docker-b29faf21b7ef.scope",
+		},
+		CPU: &cgroupv2.CPUStats{
+			UsageMicros:      3553484,
+			UserMicros:       2112047,
+			SystemMicros:     1441437,
+			Periods:          120,
+			ThrottledPeriods: 12,
+			ThrottledMicros:  96044,
+			QuotaMicros:      50000,
+			PeriodMicros:     100000,
+		},
+		Memory: &cgroupv2.MemoryStats{
+			Current: 32911360,
+			Stats: map[string]uint64{
+				"anon":       20172800,
+				"file":       11407360,
+				"pgmajfault": 66,
+				"sock":       0,
+			},
+			Events: map[string]uint64{"max": 3, "oom": 1},
+		},
+		IO: &cgroupv2.IOStats{
+			Total: cgroupv2.IOCounters{
+				ReadBytes:  12455936,
+				WriteBytes: 12288,
+				ReadIOs:    320,
+				WriteIOs:   3,
+			},
+		},
+	}
+
+	event := cgroupV2StatsToMap(stats)
+
+	fields := map[string]interface{}{
+		"id":                             "docker-b29faf21b7ef.scope",
+		"cpu.cfs.quota.us":               uint64(50000),
+		"cpu.cfs.period.us":              uint64(100000),
+		"cpu.stats.periods":              uint64(120),
+		"cpu.stats.throttled.periods":    uint64(12),
+		"cpu.stats.throttled.ns":         uint64(96044000),
+		"cpuacct.total.ns":               uint64(3553484000),
+		"cpuacct.stats.user.ns":          uint64(2112047000),
+		"cpuacct.stats.system.ns":        uint64(1441437000),
+		"memory.mem.usage.bytes":         uint64(32911360),
+		"memory.mem.failures":            uint64(3),
+		"memory.stats.rss.bytes":         uint64(20172800),
+		"memory.stats.cache.bytes":       uint64(11407360),
+		"memory.stats.major_page_faults": uint64(66),
+		"blkio.total.bytes":              uint64(12468224),
+		"blkio.total.ios":                uint64(323),
+		"blkio.path":                     "/system.slice/docker-b29faf21b7ef.scope",
+		"cpuacct.path":                   "/system.slice/docker-b29faf21b7ef.scope",
+		"memory.id":                      "docker-b29faf21b7ef.scope",
+	}
+	for field, expected := range fields {
+		value, err := event.GetValue(field)
+		if assert.NoError(t, err, field) {
+			assert.Equal(t, expected, value, field)
+		}
+	}
+
+	// Unlimited memory and unmapped stats are not reported.
+	for _, field := range []string{"memory.mem.limit", "memory.stats.sock", "cpuacct.percpu"} {
+		_, err := event.GetValue(field)
+		assert.Equal(t, common.ErrKeyNotFound, err, field)
+	}
+
+	assert.Nil(t, cgroupV2StatsToMap(nil))
+}
@@ -27,6 +27,7 @@ import (
 
 	"github.com/elastic/beats/libbeat/common"
 	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/libbeat/metric/system/cgroupv2"
 	"github.com/elastic/beats/libbeat/metric/system/process"
 	"github.com/elastic/beats/metricbeat/mb"
 	"github.com/elastic/beats/metricbeat/mb/parse"
@@ -46,9 +47,10 @@ func init() {
 // MetricSet that fetches process metrics.
 type MetricSet struct {
 	mb.BaseMetricSet
-	stats  *process.Stats
-	cgroup *cgroup.Reader
-	perCPU bool
+	stats    *process.Stats
+	cgroup   *cgroup.Reader
+	cgroupV2 *cgroupv2.Reader
+	perCPU   bool
 }
 
 // New creates and returns a new MetricSet.
@@ -83,12 +85,18 @@ func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
 		if config.Cgroups == nil || *config.Cgroups {
 			debugf("process cgroup data collection is enabled, using hostfs='%v'", systemModule.HostFS)
 			m.cgroup, err = cgroup.NewReader(systemModule.HostFS, true)
-			if err != nil {
-				if err == cgroup.ErrCgroupsMissing {
-					logp.Warn("cgroup data collection will be disabled: %v", err)
-				} else {
-					return nil, errors.Wrap(err, "error initializing cgroup reader")
-				}
+			if err != nil && err != cgroup.ErrCgroupsMissing {
+				return nil, errors.Wrap(err, "error initializing cgroup reader")
+			}
+
+			// Hosts can mount the unified hierarchy alone or next to v1.
+			m.cgroupV2, err = cgroupv2.NewReader(systemModule.HostFS, true)
+			if err != nil && err != cgroupv2.ErrUnifiedMissing {
+				return nil, errors.Wrap(err, "error initializing cgroup v2 reader")
+			}
+
+			if m.cgroup == nil && m.cgroupV2 == nil {
+				logp.Warn("cgroup data collection will be disabled: %v", cgroup.ErrCgroupsMissing)
 			}
 		}
 	}
@@ -104,20 +112,15 @@ func (m *MetricSet) Fetch(r mb.ReporterV2) error {
 		return errors.Wrap(err, "process stats")
 	}
 
-	if m.cgroup != nil {
+	if m.cgroup != nil || m.cgroupV2 != nil {
 		for _, proc := range procs {
 			pid, ok := proc["pid"].(int)
 			if !ok {
 				debugf("error converting pid to int for proc %+v", proc)
 				continue
 			}
-			stats, err := m.cgroup.GetStatsForProcess(pid)
-			if err != nil {
-				debugf("error getting cgroups stats for pid=%d, %v", pid, err)
-				continue
-			}
 
-			if statsMap := cgroupStatsToMap(stats, m.perCPU); statsMap != nil {
+			if statsMap := m.cgroupStats(pid); statsMap != nil {
 				proc["cgroup"] = statsMap
 			}
 		}
@@ -161,6 +164,32 @@ func (m *MetricSet) Fetch(r mb.ReporterV2) error {
 	return nil
 }
 
+// cgroupStats returns the cgroup metrics of a process. The v1 hierarchy is
+// preferred, cgroup v2 metrics are reported for processes without v1 data.
+func (m *MetricSet) cgroupStats(pid int) common.MapStr {
+	if m.cgroup != nil {
+		stats, err := m.cgroup.GetStatsForProcess(pid)
+		if err != nil {
+			debugf("error getting cgroups stats for pid=%d, %v", pid, err)
+			return nil
+		}
+		if stats != nil {
+			return cgroupStatsToMap(stats, m.perCPU)
+		}
+	}
+
+	if m.cgroupV2 != nil {
+		stats, err := m.cgroupV2.GetStatsForProcess(pid)
+		if err != nil {
+			debugf("error getting cgroup v2 stats for pid=%d, %v", pid, err)
+			return nil
+		}
+		return cgroupV2StatsToMap(stats)
+	}
+
+	return nil
+}
+
 func getAndRemove(from common.MapStr, field string) interface{} {
 	if v, ok := from[field]; ok {
 		delete(from, field)
@@ -64,6 +64,7 @@ type flags struct {
 	loop       *int
 	oneAtAtime *bool
 	topSpeed   *bool
+	speed      *float64
 	dumpfile   *string
 }
 
@@ -75,6 +76,7 @@ func init() {
 		loop:       flag.Int("l", 1, "Loop file. 0 - loop forever"),
 		oneAtAtime: flag.Bool("O", false, "Read packets one at a time (press Enter)"),
 		topSpeed:   flag.Bool("t", false, "Read packets as fast as possible, without sleeping"),
+		speed:      flag.Float64("speed", 1, "Replay speed factor when reading packets from file (e.g. 2 replays twice as fast)"),
 		dumpfile:   flag.String("dump", "", "Write all captured packets to this libpcap file"),
 	}
 }
@@ -85,6 +87,7 @@ func New(b *beat.Beat, rawConfig *common.Config) (beat.Beater, error) {
 			File:       *cmdLineArgs.file,
 			Loop:       *cmdLineArgs.loop,
 			TopSpeed:   *cmdLineArgs.topSpeed,
+			Speed:      *cmdLineArgs.speed,
 			OneAtATime: *cmdLineArgs.oneAtAtime,
 			Dumpfile:   *cmdLineArgs.dumpfile,
 		},
@@ -40,6 +40,7 @@ func init() {
 	var runFlags = pflag.NewFlagSet(Name, pflag.ExitOnError)
 	runFlags.AddGoFlag(flag.CommandLine.Lookup("I"))
 	runFlags.AddGoFlag(flag.CommandLine.Lookup("t"))
+	runFlags.AddGoFlag(flag.CommandLine.Lookup("speed"))
 	runFlags.AddGoFlag(flag.CommandLine.Lookup("O"))
 	runFlags.AddGoFlag(flag.CommandLine.Lookup("l"))
 	runFlags.AddGoFlag(flag.CommandLine.Lookup("dump"))
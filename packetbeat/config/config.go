@@ -44,6 +44,7 @@ type InterfacesConfig struct {
 	Snaplen      int    `config:"snaplen"`
 	BufferSizeMb int    `config:"buffer_size_mb"`
 	TopSpeed     bool
+	Speed        float64
 	Dumpfile     string
 	OneAtATime   bool
 	Loop         int
@@ -0,0 +1,224 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package http2
+
+import (
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/elastic/ecs/code/go/ecs"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/packetbeat/pb"
+	"github.com/elastic/beats/packetbeat/protos/tcp"
+)
+
+// ProtocolFields contains the HTTP fields reported for an HTTP/2 stream.
+type ProtocolFields struct {
+	// Http request method, normalized to lowercase.
+	RequestMethod string `ecs:"request.method"`
+
+	// Http response status code.
+	ResponseStatusCode int64 `ecs:"response.status_code"`
+
+	// Http version.
+	Version string `ecs:"version"`
+
+	// Total size in bytes of the request frames (body and headers).
+	RequestBytes int64 `ecs:"request.bytes"`
+
+	// Size in bytes of the request body.
+	RequestBodyBytes int64 `ecs:"request.body.bytes"`
+
+	// Total size in bytes of the response frames (body and headers).
+	ResponseBytes int64 `ecs:"response.bytes"`
+
+	// Size in bytes of the response body.
+	ResponseBodyBytes int64 `ecs:"response.body.bytes"`
+
+	// HTTP request headers.
+	RequestHeaders common.MapStr `packetbeat:"request.headers"`
+
+	// HTTP response headers.
+	ResponseHeaders common.MapStr `packetbeat:"response.headers"`
+}
+
+func (h2 *http2Plugin) newTransaction(tx *transaction) beat.Event {
+	requ, resp := tx.request, tx.response
+
+	source, destination := common.MakeEndpointPair(tx.tuple.BaseTuple, tx.cmdlineTuple)
+	src, dst := &source, &destination
+	if tx.requestDir == tcp.TCPDirectionReverse {
+		src, dst = dst, src
+	}
+
+	evt, pbf := pb.NewBeatEvent(requ.ts)
+	pbf.SetSource(src)
+	pbf.SetDestination(dst)
+	pbf.Source.Bytes = int64(requ.size)
+	pbf.Event.Dataset = "http2"
+	pbf.Event.Start = requ.ts
+	pbf.Event.End = tx.endTs
+	pbf.Network.Transport = "tcp"
+	pbf.Network.Protocol = pbf.Event.Dataset
+	pbf.Error.Message = tx.notes
+
+	host := hostname(requ.authority)
+	if host != "" && net.ParseIP(host) == nil {
+		pbf.Destination.Domain = host
+	}
+
+	status := common.OK_STATUS
+	if tx.reset || resp == nil || resp.statusCode >= 400 {
+		status = common.ERROR_STATUS
+	}
+
+	fields := evt.Fields
+	fields["type"] = pbf.Event.Dataset
+	fields["method"] = strings.ToLower(requ.method)
+	fields["query"] = requ.method + " " + requ.path
+	if h2.sendRequest {
+		fields["request"] = string(requ.raw)
+	}
+
+	httpFields := ProtocolFields{
+		Version:          "2",
+		RequestMethod:    strings.ToLower(requ.method),
+		RequestBytes:     int64(requ.size),
+		RequestBodyBytes: int64(requ.bodySize),
+		RequestHeaders:   h2.collectHeaders(requ),
+	}
+
+	// url
+	pb.MarshalStruct(evt.Fields, "url", newURL(requ))
+
+	// user-agent
+	userAgent := ecs.UserAgent{Original: requ.headers["user-agent"]}
+	pb.MarshalStruct(evt.Fields, "user_agent", userAgent)
+
+	if resp != nil {
+		pbf.Destination.Bytes = int64(resp.size)
+
+		httpFields.ResponseStatusCode = int64(resp.statusCode)
+		httpFields.ResponseBytes = int64(resp.size)
+		httpFields.ResponseBodyBytes = int64(resp.bodySize)
+		httpFields.ResponseHeaders = h2.collectHeaders(resp)
+
+		if h2.sendResponse {
+			fields["response"] = string(resp.raw)
+		}
+	}
+	pb.MarshalStruct(evt.Fields, "http", httpFields)
+
+	evt.PutValue("http2.stream_id", tx.streamID)
+	if tx.reset {
+		evt.PutValue("http2.error_code", errorCodeName(tx.resetCode))
+	}
+
+	if isGRPC(requ.contentType()) {
+		if !h2.addGRPCFields(evt, requ, resp) {
+			status = common.ERROR_STATUS
+		}
+	}
+
+	fields["status"] = status
+	return evt
+}
+
+// addGRPCFields adds the gRPC service, method and status to the event.
+// It returns false if the call did not complete successfully.
+func (h2 *http2Plugin) addGRPCFields(evt beat.Event, requ, resp *message) bool {
+	if service, method, ok := parseGRPCPath(requ.path); ok {
+		evt.PutValue("grpc.service", service)
+		evt.PutValue("grpc.method", method)
+	}
+
+	if resp == nil {
+		return false
+	}
+	value, found := resp.headers["grpc-status"]
+	if !found {
+		return false
+	}
+	code, ok := parseGRPCStatus(value)
+	if !ok {
+		return false
+	}
+	evt.PutValue("grpc.status_code", code)
+	evt.PutValue("grpc.status", grpcStatusName(code))
+	if msg := resp.headers["grpc-message"]; msg != "" {
+		evt.PutValue("grpc.message", decodeGRPCMessage(msg))
+	}
+	return code == 0
+}
+
+func (h2 *http2Plugin) collectHeaders(m *message) common.MapStr {
+	hdrs := common.MapStr{}
+	if contentType := m.contentType(); contentType != "" {
+		hdrs["content-type"] = contentType
+	}
+
+	if h2.sendAllHeaders || h2.headersWhitelist != nil {
+		for name, value := range m.headers {
+			if h2.sendAllHeaders || h2.headersWhitelist[name] {
+				hdrs[name] = value
+			}
+		}
+	}
+	return hdrs
+}
+
+// newURL returns a new ecs.Url object with data from the request pseudo
+// headers.
+func newURL(requ *message) *ecs.Url {
+	path, query := requ.path, ""
+	if idx := strings.IndexByte(path, '?'); idx >= 0 {
+		path, query = path[:idx], path[idx+1:]
+	}
+
+	u := &ecs.Url{
+		Scheme: requ.scheme,
+		Domain: hostname(requ.authority),
+		Path:   path,
+		Query:  query,
+	}
+	if _, port, err := net.SplitHostPort(requ.authority); err == nil {
+		u.Port, _ = strconv.ParseInt(port, 10, 64)
+	}
+	if u.Domain != "" && u.Scheme != "" {
+		full := url.URL{
+			Scheme:   u.Scheme,
+			Host:     requ.authority,
+			Path:     path,
+			RawQuery: query,
+		}
+		u.Full = full.String()
+	}
+	return u
+}
+
+// hostname strips the port from an :authority pseudo header value.
+func hostname(authority string) string {
+	if host, _, err := net.SplitHostPort(authority); err == nil {
+		return host
+	}
+	return authority
+}
@@ -0,0 +1,36 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Code generated by beats/dev-tools/cmd/asset/asset.go - DO NOT EDIT.
+
+package http2
+
+import (
+	"github.com/elastic/beats/libbeat/asset"
+)
+
+func init() {
+	if err := asset.SetFields("packetbeat", "http2", asset.ModuleFieldsPri, AssetHttp2); err != nil {
+		panic(err)
+	}
+}
+
+// AssetHttp2 returns asset data.
+// This is the base64 encoded gzipped contents of protos/http2.
+func AssetHttp2() string {
+	return "eJyclMtu2zwQhfd6ioOsfytAll78QJA4TdE2Dmxl7bDiSCZKkcxwFFdvX1CXxIkdNC60I+fynZlDzfCLujm2IuEiA8SIpTnObovi/vziLAM0xZJNEOPdHP9nADBcQjmNenV/hRioNJUpQc/kBJUhq2OOYkt9KJieWorSJzDF4F2kvtAQCcUEpuBZSKN1mhiPCegxz6aYeR8/g1MNvdKmT7pAc9Ts2zCe7GfsZ0VhUs3G6JebKdt6V+8dHpE8fUmT0eTEVIYYvoKMKs8vxgYoFXNnXN1f7Wl/U2iaQ54dcBKz503pNX2eqc9BypmYVutisy5Wi8sfqFg1BDOcj5A7FcEUSf57U4vyOsfV5d3V4js8Y7W4eVgvrsdCI+vEWXMoP1zCB7xfXeW5UclNUD99K4ODSmXt4JhI48Z7V3hnO4TE6WQU8FJqmmzpnZCTWVolTIQKwZqyb3GeEPO/2YL42ZQnzLpqre3w1CqbTKD7aUxTT0JITzWn1umj36oJ6W1tyVq/82x1/oWJhPjQAg3J1uvPIx1BGEocJVir7jZBHPaNoqSN7733L4/EtQ2xKccfRF92sCeTtOxIw7jej2lFs6EvhJWxxEfexBBwQvu9gbxHODqTu2WxuVk+3F0f9m4oRlWfYBBNqY0epI3Zr7p3RrZQcN7Nlt8QRUkb8+zPAJ0TmdE="
+}
@@ -0,0 +1,192 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package http2
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// clientPreface is the connection preface every HTTP/2 client sends before
+// its first frame (RFC 7540, section 3.5).
+const clientPreface = "PRI * HTTP/2.0\r\n\r\nSM\r\n\r\n"
+
+// frameHeaderLen is the size of the fixed frame header.
+const frameHeaderLen = 9
+
+type frameType uint8
+
+// Frame types defined in RFC 7540, section 6.
+const (
+	frameData         frameType = 0x0
+	frameHeaders      frameType = 0x1
+	framePriority     frameType = 0x2
+	frameRSTStream    frameType = 0x3
+	frameSettings     frameType = 0x4
+	framePushPromise  frameType = 0x5
+	framePing         frameType = 0x6
+	frameGoAway       frameType = 0x7
+	frameWindowUpdate frameType = 0x8
+	frameContinuation frameType = 0x9
+)
+
+var frameTypeNames = map[frameType]string{
+	frameData:         "DATA",
+	frameHeaders:      "HEADERS",
+	framePriority:     "PRIORITY",
+	frameRSTStream:    "RST_STREAM",
+	frameSettings:     "SETTINGS",
+	framePushPromise:  "PUSH_PROMISE",
+	framePing:         "PING",
+	frameGoAway:       "GOAWAY",
+	frameWindowUpdate: "WINDOW_UPDATE",
+	frameContinuation: "CONTINUATION",
+}
+
+func (t frameType) String() string {
+	if name, ok := frameTypeNames[t]; ok {
+		return name
+	}
+	return fmt.Sprintf("UNKNOWN_FRAME_TYPE_%d", uint8(t))
+}
+
+// Frame flags. Not all flags are valid for all frame types.
+const (
+	flagEndStream  uint8 = 0x1
+	flagAck        uint8 = 0x1
+	flagEndHeaders uint8 = 0x4
+	flagPadded     uint8 = 0x8
+	flagPriority   uint8 = 0x20
+)
+
+// settingHeaderTableSize is the SETTINGS_HEADER_TABLE_SIZE identifier.
+const settingHeaderTableSize uint16 = 0x1
+
+// Error codes used in RST_STREAM and GOAWAY frames (RFC 7540, section 7).
+var errorCodeNames = []string{
+	"NO_ERROR",
+	"PROTOCOL_ERROR",
+	"INTERNAL_ERROR",
+	"FLOW_CONTROL_ERROR",
+	"SETTINGS_TIMEOUT",
+	"STREAM_CLOSED",
+	"FRAME_SIZE_ERROR",
+	"REFUSED_STREAM",
+	"CANCEL",
+	"COMPRESSION_ERROR",
+	"CONNECT_ERROR",
+	"ENHANCE_YOUR_CALM",
+	"INADEQUATE_SECURITY",
+	"HTTP_1_1_REQUIRED",
+}
+
+func errorCodeName(code uint32) string {
+	if int(code) < len(errorCodeNames) {
+		return errorCodeNames[code]
+	}
+	return fmt.Sprintf("UNKNOWN_ERROR_%d", code)
+}
+
+var (
+	errFramePadding = errors.New("invalid frame padding")
+	errFrameSize    = errors.New("invalid frame size")
+)
+
+type frameHeader struct {
+	length   uint32
+	typ      frameType
+	flags    uint8
+	streamID uint32
+}
+
+func (h *frameHeader) has(flag uint8) bool {
+	return h.flags&flag != 0
+}
+
+// parseFrameHeader decodes the fixed 9 byte frame header at the start of buf.
+func parseFrameHeader(buf []byte) frameHeader {
+	return frameHeader{
+		length:   uint32(buf[0])<<16 | uint32(buf[1])<<8 | uint32(buf[2]),
+		typ:      frameType(buf[3]),
+		flags:    buf[4],
+		streamID: binary.BigEndian.Uint32(buf[5:9]) & 0x7fffffff,
+	}
+}
+
+// stripPadding removes the pad length field and trailing padding from the
+// payload of DATA, HEADERS and PUSH_PROMISE frames.
+func stripPadding(h *frameHeader, payload []byte) ([]byte, error) {
+	if !h.has(flagPadded) {
+		return payload, nil
+	}
+	if len(payload) < 1 {
+		return nil, errFramePadding
+	}
+	padLen := int(payload[0])
+	payload = payload[1:]
+	if padLen > len(payload) {
+		return nil, errFramePadding
+	}
+	return payload[:len(payload)-padLen], nil
+}
+
+// headerBlockFragment returns the header block fragment of a HEADERS frame.
+func headerBlockFragment(h *frameHeader, payload []byte) ([]byte, error) {
+	payload, err := stripPadding(h, payload)
+	if err != nil {
+		return nil, err
+	}
+	if h.has(flagPriority) {
+		if len(payload) < 5 {
+			return nil, errFrameSize
+		}
+		payload = payload[5:]
+	}
+	return payload, nil
+}
+
+// pushPromiseFragment returns the header block fragment of a PUSH_PROMISE
+// frame, skipping the promised stream ID.
+func pushPromiseFragment(h *frameHeader, payload []byte) ([]byte, error) {
+	payload, err := stripPadding(h, payload)
+	if err != nil {
+		return nil, err
+	}
+	if len(payload) < 4 {
+		return nil, errFrameSize
+	}
+	return payload[4:], nil
+}
+
+// settingsHeaderTableSize returns the last SETTINGS_HEADER_TABLE_SIZE value
+// found in the payload of a SETTINGS frame.
+func settingsHeaderTableSize(payload []byte) (uint32, bool, error) {
+	if len(payload)%6 != 0 {
+		return 0, false, errFrameSize
+	}
+	var size uint32
+	found := false
+	for ; len(payload) > 0; payload = payload[6:] {
+		if binary.BigEndian.Uint16(payload) == settingHeaderTableSize {
+			size = binary.BigEndian.Uint32(payload[2:])
+			found = true
+		}
+	}
+	return size, found, nil
+}
@@ -0,0 +1,92 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package http2
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const grpcContentType = "application/grpc"
+
+// gRPC status codes as defined in
+// https://github.com/grpc/grpc/blob/master/doc/statuscodes.md
+var grpcStatusNames = []string{
+	"OK",
+	"CANCELLED",
+	"UNKNOWN",
+	"INVALID_ARGUMENT",
+	"DEADLINE_EXCEEDED",
+	"NOT_FOUND",
+	"ALREADY_EXISTS",
+	"PERMISSION_DENIED",
+	"RESOURCE_EXHAUSTED",
+	"FAILED_PRECONDITION",
+	"ABORTED",
+	"OUT_OF_RANGE",
+	"UNIMPLEMENTED",
+	"INTERNAL",
+	"UNAVAILABLE",
+	"DATA_LOSS",
+	"UNAUTHENTICATED",
+}
+
+func grpcStatusName(code int) string {
+	if code >= 0 && code < len(grpcStatusNames) {
+		return grpcStatusNames[code]
+	}
+	return "UNKNOWN"
+}
+
+// isGRPC returns true if the content-type denotes a gRPC message. The
+// content-type can carry a message encoding suffix like "+proto" or "+json".
+func isGRPC(contentType string) bool {
+	return strings.HasPrefix(contentType, grpcContentType)
+}
+
+// parseGRPCPath splits a gRPC request path of the form
+// "/package.Service/Method" into the service and method names.
+func parseGRPCPath(path string) (service, method string, ok bool) {
+	if !strings.HasPrefix(path, "/") {
+		return "", "", false
+	}
+	parts := strings.Split(path[1:], "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", false
+	}
+	return parts[0], parts[1], true
+}
+
+// parseGRPCStatus parses the grpc-status header value.
+func parseGRPCStatus(value string) (int, bool) {
+	code, err := strconv.Atoi(value)
+	if err != nil || code < 0 {
+		return 0, false
+	}
+	return code, true
+}
+
+// decodeGRPCMessage decodes the percent-encoded grpc-message header value.
+func decodeGRPCMessage(value string) string {
+	msg, err := url.PathUnescape(value)
+	if err != nil {
+		return value
+	}
+	return msg
+}
@@ -0,0 +1,556 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package http2
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"strings"
+	"time"
+
+	"golang.org/x/net/http2/hpack"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/libbeat/monitoring"
+
+	"github.com/elastic/beats/packetbeat/procs"
+	"github.com/elastic/beats/packetbeat/protos"
+	"github.com/elastic/beats/packetbeat/protos/applayer"
+	"github.com/elastic/beats/packetbeat/protos/tcp"
+)
+
+// initialHeaderTableSize is the HPACK dynamic table size used until a
+// SETTINGS_HEADER_TABLE_SIZE parameter is seen.
+const initialHeaderTableSize = 4096
+
+var (
+	debugf  = logp.MakeDebug("http2")
+	isDebug = false
+)
+
+var (
+	unmatchedResponses = monitoring.NewInt(nil, "http2.unmatched_responses")
+	unmatchedRequests  = monitoring.NewInt(nil, "http2.unmatched_requests")
+	droppedStreams     = monitoring.NewInt(nil, "http2.dropped_streams")
+)
+
+var (
+	errUnexpectedContinuation = errors.New("unexpected CONTINUATION frame")
+	errExpectedContinuation   = errors.New("expected CONTINUATION frame")
+)
+
+// stream buffers the frames sent in one direction of a TCP connection.
+type stream struct {
+	applayer.Stream
+
+	// prefaceChecked is set once the start of the stream has been checked
+	// for the client connection preface.
+	prefaceChecked bool
+
+	// header block being assembled from a HEADERS or PUSH_PROMISE frame and
+	// its CONTINUATION frames.
+	headerBlock     []byte
+	headerStreamID  uint32
+	headerSize      int
+	headerEndStream bool
+	headerPush      bool
+}
+
+type connection struct {
+	streams [2]*stream
+
+	// decoders holds the HPACK decoder for header blocks sent in each
+	// direction. The decoder state must be kept for the whole connection.
+	decoders [2]*hpack.Decoder
+
+	transactions map[uint32]*transaction
+
+	// broken is set if the connection can no longer be decoded, e.g. the
+	// HPACK state was lost or an invalid frame was seen.
+	broken bool
+}
+
+// HTTP/2 protocol plugin
+type http2Plugin struct {
+	// config
+	ports              []int
+	sendRequest        bool
+	sendResponse       bool
+	sendAllHeaders     bool
+	headersWhitelist   map[string]bool
+	maxStreams         int
+	transactionTimeout time.Duration
+
+	results protos.Reporter
+}
+
+func init() {
+	protos.Register("http2", New)
+}
+
+// New creates and initializes a new HTTP/2 protocol analyzer instance.
+func New(
+	testMode bool,
+	results protos.Reporter,
+	cfg *common.Config,
+) (protos.Plugin, error) {
+	p := &http2Plugin{}
+	config := defaultConfig
+	if !testMode {
+		if err := cfg.Unpack(&config); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.init(results, &config); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (h2 *http2Plugin) init(results protos.Reporter, config *http2Config) error {
+	h2.setFromConfig(config)
+
+	h2.results = results
+	isDebug = logp.IsDebug("http2")
+
+	return nil
+}
+
+func (h2 *http2Plugin) setFromConfig(config *http2Config) {
+	h2.ports = config.Ports
+	h2.sendRequest = config.SendRequest
+	h2.sendResponse = config.SendResponse
+	h2.sendAllHeaders = config.SendAllHeaders
+	h2.maxStreams = config.MaxStreams
+	h2.transactionTimeout = config.TransactionTimeout
+
+	h2.headersWhitelist = nil
+	if !config.SendAllHeaders && len(config.SendHeaders) > 0 {
+		h2.headersWhitelist = map[string]bool{}
+		for _, hdr := range config.SendHeaders {
+			h2.headersWhitelist[strings.ToLower(hdr)] = true
+		}
+	}
+}
+
+// GetPorts returns the ports numbers the HTTP/2 analyzer is configured for.
+func (h2 *http2Plugin) GetPorts() []int {
+	return h2.ports
+}
+
+// ConnectionTimeout returns the configured HTTP/2 transaction timeout.
+func (h2 *http2Plugin) ConnectionTimeout() time.Duration {
+	return h2.transactionTimeout
+}
+
+func newConnection() *connection {
+	conn := &connection{
+		transactions: map[uint32]*transaction{},
+	}
+	for i := range conn.decoders {
+		conn.decoders[i] = hpack.NewDecoder(initialHeaderTableSize, nil)
+		conn.decoders[i].SetMaxStringLength(tcp.TCPMaxDataInStream)
+	}
+	return conn
+}
+
+func getConnection(private protos.ProtocolData) *connection {
+	if private == nil {
+		return newConnection()
+	}
+
+	priv, ok := private.(*connection)
+	if !ok {
+		logp.Warn("http2 connection data type error, create new one")
+		return newConnection()
+	}
+	if priv == nil {
+		logp.Warn("Unexpected: http2 connection data not set, create new one")
+		return newConnection()
+	}
+
+	return priv
+}
+
+// Parse function is used to process TCP payloads.
+func (h2 *http2Plugin) Parse(
+	pkt *protos.Packet,
+	tcptuple *common.TCPTuple,
+	dir uint8,
+	private protos.ProtocolData,
+) protos.ProtocolData {
+	defer logp.Recover("ParseHTTP2 exception")
+
+	conn := getConnection(private)
+	if conn.broken {
+		return conn
+	}
+
+	st := conn.streams[dir]
+	if st == nil {
+		st = &stream{}
+		st.Stream.Init(tcp.TCPMaxDataInStream)
+		conn.streams[dir] = st
+		if isDebug {
+			debugf("new stream: %p (dir=%v, len=%v)", st, dir, len(pkt.Payload))
+		}
+	}
+
+	if err := st.Append(pkt.Payload); err != nil {
+		h2.dropConnection(conn, tcptuple, err)
+		return conn
+	}
+
+	if err := h2.parseFrames(conn, st, pkt.Ts, tcptuple, dir); err != nil {
+		h2.dropConnection(conn, tcptuple, err)
+	}
+	return conn
+}
+
+// dropConnection stops decoding a connection. Once a frame could not be
+// decoded the HPACK state is unknown and no further headers can be read.
+func (h2 *http2Plugin) dropConnection(conn *connection, tcptuple *common.TCPTuple, err error) {
+	if isDebug {
+		debugf("%v, dropping HTTP/2 connection %s", err, tcptuple)
+	}
+	droppedStreams.Add(int64(len(conn.transactions)))
+	conn.broken = true
+	conn.streams = [2]*stream{}
+	conn.transactions = nil
+}
+
+func (h2 *http2Plugin) parseFrames(
+	conn *connection,
+	st *stream,
+	ts time.Time,
+	tcptuple *common.TCPTuple,
+	dir uint8,
+) error {
+	defer st.Reset()
+
+	if !st.prefaceChecked {
+		buf := st.Buf.Bytes()
+		if len(buf) < len(clientPreface) && strings.HasPrefix(clientPreface, string(buf)) {
+			// wait for more data
+			return nil
+		}
+		if bytes.HasPrefix(buf, []byte(clientPreface)) {
+			st.Buf.Advance(len(clientPreface))
+		}
+		st.prefaceChecked = true
+	}
+
+	for st.Buf.Len() >= frameHeaderLen {
+		buf := st.Buf.Bytes()
+		hdr := parseFrameHeader(buf)
+		frameLen := frameHeaderLen + int(hdr.length)
+		if len(buf) < frameLen {
+			// wait for more data
+			return nil
+		}
+
+		if isDebug {
+			debugf("frame %v (dir=%v, stream=%v, len=%v, flags=0x%x)",
+				hdr.typ, dir, hdr.streamID, hdr.length, hdr.flags)
+		}
+
+		payload := buf[frameHeaderLen:frameLen]
+		if err := h2.handleFrame(conn, st, &hdr, payload, ts, tcptuple, dir); err != nil {
+			return err
+		}
+		st.Buf.Advance(frameLen)
+	}
+	return nil
+}
+
+func (h2 *http2Plugin) handleFrame(
+	conn *connection,
+	st *stream,
+	hdr *frameHeader,
+	payload []byte,
+	ts time.Time,
+	tcptuple *common.TCPTuple,
+	dir uint8,
+) error {
+	frameLen := frameHeaderLen + len(payload)
+
+	if st.headerBlock != nil && hdr.typ != frameContinuation {
+		return errExpectedContinuation
+	}
+
+	switch hdr.typ {
+	case frameData:
+		data, err := stripPadding(hdr, payload)
+		if err != nil {
+			return err
+		}
+		h2.onData(conn, hdr, frameLen, len(data), ts, dir)
+
+	case frameHeaders:
+		fragment, err := headerBlockFragment(hdr, payload)
+		if err != nil {
+			return err
+		}
+		st.startHeaderBlock(hdr, fragment, frameLen, false)
+		if hdr.has(flagEndHeaders) {
+			return h2.onHeaderBlock(conn, st, ts, tcptuple, dir)
+		}
+
+	case framePushPromise:
+		fragment, err := pushPromiseFragment(hdr, payload)
+		if err != nil {
+			return err
+		}
+		st.startHeaderBlock(hdr, fragment, frameLen, true)
+		if hdr.has(flagEndHeaders) {
+			return h2.onHeaderBlock(conn, st, ts, tcptuple, dir)
+		}
+
+	case frameContinuation:
+		if st.headerBlock == nil || st.headerStreamID != hdr.streamID {
+			return errUnexpectedContinuation
+		}
+		st.headerBlock = append(st.headerBlock, payload...)
+		st.headerSize += frameLen
+		if hdr.has(flagEndHeaders) {
+			return h2.onHeaderBlock(conn, st, ts, tcptuple, dir)
+		}
+
+	case frameRSTStream:
+		if len(payload) != 4 {
+			return errFrameSize
+		}
+		h2.onReset(conn, hdr.streamID, binary.BigEndian.Uint32(payload), ts)
+
+	case frameSettings:
+		if hdr.has(flagAck) {
+			return nil
+		}
+		size, found, err := settingsHeaderTableSize(payload)
+		if err != nil {
+			return err
+		}
+		if found {
+			// The setting limits the table used by the peer's encoder, which
+			// is decoded by the decoder for the opposite direction.
+			conn.decoders[1-dir].SetAllowedMaxDynamicTableSize(size)
+		}
+	}
+
+	// PRIORITY, PING, GOAWAY, WINDOW_UPDATE and unknown frame types do not
+	// affect transactions.
+	return nil
+}
+
+func (st *stream) startHeaderBlock(hdr *frameHeader, fragment []byte, frameLen int, push bool) {
+	st.headerBlock = append(make([]byte, 0, len(fragment)), fragment...)
+	st.headerStreamID = hdr.streamID
+	st.headerSize = frameLen
+	st.headerEndStream = hdr.has(flagEndStream)
+	st.headerPush = push
+}
+
+func (h2 *http2Plugin) onHeaderBlock(
+	conn *connection,
+	st *stream,
+	ts time.Time,
+	tcptuple *common.TCPTuple,
+	dir uint8,
+) error {
+	block, streamID, size, endStream, push := st.headerBlock, st.headerStreamID,
+		st.headerSize, st.headerEndStream, st.headerPush
+	st.headerBlock = nil
+
+	// Header blocks must always be decoded to keep the HPACK state in sync,
+	// even if the stream is not tracked.
+	fields, err := conn.decoders[dir].DecodeFull(block)
+	if err != nil {
+		return err
+	}
+	if push {
+		return nil
+	}
+
+	tx := conn.transactions[streamID]
+	if tx == nil {
+		if !hasHeader(fields, ":method") {
+			// Server initiated (pushed) streams have even IDs and are not
+			// correlated with a request on this stream.
+			if streamID%2 == 1 {
+				unmatchedResponses.Add(1)
+			}
+			return nil
+		}
+		if len(conn.transactions) >= h2.maxStreams {
+			if isDebug {
+				debugf("too many concurrent streams, ignoring stream %v", streamID)
+			}
+			droppedStreams.Add(1)
+			return nil
+		}
+
+		tx = &transaction{
+			streamID:     streamID,
+			tuple:        *tcptuple,
+			cmdlineTuple: procs.ProcWatcher.FindProcessesTupleTCP(tcptuple.IPPort()),
+			requestDir:   dir,
+		}
+		conn.transactions[streamID] = tx
+	}
+
+	m := tx.message(dir, ts)
+	m.addHeaders(fields)
+	if h2.sendRaw(tx, dir) {
+		m.addRawHeaders(fields)
+	}
+	m.size += size
+	if endStream {
+		h2.endMessage(conn, tx, dir, ts)
+	}
+	return nil
+}
+
+// sendRaw reports if the raw message received in the given direction is
+// reported.
+func (h2 *http2Plugin) sendRaw(tx *transaction, dir uint8) bool {
+	if dir == tx.requestDir {
+		return h2.sendRequest
+	}
+	return h2.sendResponse
+}
+
+func (h2 *http2Plugin) onData(
+	conn *connection,
+	hdr *frameHeader,
+	frameLen, dataLen int,
+	ts time.Time,
+	dir uint8,
+) {
+	tx := conn.transactions[hdr.streamID]
+	if tx == nil {
+		return
+	}
+
+	m := tx.message(dir, ts)
+	m.size += frameLen
+	m.bodySize += dataLen
+	if hdr.has(flagEndStream) {
+		h2.endMessage(conn, tx, dir, ts)
+	}
+}
+
+func (h2 *http2Plugin) onReset(conn *connection, streamID uint32, code uint32, ts time.Time) {
+	tx := conn.transactions[streamID]
+	if tx == nil {
+		return
+	}
+
+	tx.reset = true
+	tx.resetCode = code
+	tx.endTs = ts
+	tx.notes = append(tx.notes, "Stream reset: "+errorCodeName(code))
+	h2.publishTransaction(conn, tx)
+}
+
+// message returns the request or response of the transaction, depending on
+// the direction data was received in.
+func (tx *transaction) message(dir uint8, ts time.Time) *message {
+	if dir == tx.requestDir {
+		if tx.request == nil {
+			tx.request = newMessage(ts)
+		}
+		return tx.request
+	}
+	if tx.response == nil {
+		tx.response = newMessage(ts)
+	}
+	return tx.response
+}
+
+func (h2 *http2Plugin) endMessage(conn *connection, tx *transaction, dir uint8, ts time.Time) {
+	m := tx.message(dir, ts)
+	m.complete = true
+	tx.endTs = ts
+
+	// The transaction is complete once the server closes its side of the
+	// stream. Requests can still be streaming when the response ends.
+	if dir != tx.requestDir {
+		h2.publishTransaction(conn, tx)
+	}
+}
+
+func (h2 *http2Plugin) publishTransaction(conn *connection, tx *transaction) {
+	delete(conn.transactions, tx.streamID)
+	if h2.results != nil {
+		h2.results(h2.newTransaction(tx))
+	}
+}
+
+// flushTransactions publishes all streams that have received a response and
+// drops streams waiting for one.
+func (h2 *http2Plugin) flushTransactions(conn *connection) {
+	for _, tx := range conn.transactions {
+		if tx.response == nil {
+			unmatchedRequests.Add(1)
+			delete(conn.transactions, tx.streamID)
+			continue
+		}
+		tx.notes = append(tx.notes, "Incomplete response")
+		h2.publishTransaction(conn, tx)
+	}
+}
+
+// GapInStream is called when a gap of nbytes bytes is found in the stream (due
+// to packet loss).
+func (h2 *http2Plugin) GapInStream(tcptuple *common.TCPTuple, dir uint8,
+	nbytes int, private protos.ProtocolData) (priv protos.ProtocolData, drop bool) {
+
+	// Frames following the gap can not be decoded without the HPACK state
+	// of the lost header blocks.
+	conn := getConnection(private)
+	if !conn.broken {
+		h2.dropConnection(conn, tcptuple, errors.New("packet loss"))
+	}
+	return conn, true
+}
+
+// ReceivedFin is called when a FIN is received. Streams still open on the
+// connection are flushed.
+func (h2 *http2Plugin) ReceivedFin(tcptuple *common.TCPTuple, dir uint8,
+	private protos.ProtocolData) protos.ProtocolData {
+
+	conn := getConnection(private)
+	if !conn.broken {
+		h2.flushTransactions(conn)
+	}
+	return conn
+}
+
+// Expired is called when the TCP stream is expired due to connection timeout.
+func (h2 *http2Plugin) Expired(tuple *common.TCPTuple, private protos.ProtocolData) {
+	conn := getConnection(private)
+	if isDebug {
+		debugf("expired connection %s", tuple)
+	}
+	if !conn.broken {
+		h2.flushTransactions(conn)
+	}
+}
@@ -0,0 +1,471 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package http2
+
+import (
+	"bytes"
+	"encoding/binary"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/http2/hpack"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/packetbeat/protos"
+	"github.com/elastic/beats/packetbeat/protos/tcp"
+	"github.com/elastic/beats/packetbeat/publish"
+)
+
+type eventStore struct {
+	events []beat.Event
+}
+
+func (e *eventStore) publish(event beat.Event) {
+	publish.MarshalPacketbeatFields(&event, nil)
+	e.events = append(e.events, event)
+}
+
+func newTestPlugin(t *testing.T, store *eventStore, settings map[string]interface{}) *http2Plugin {
+	config := defaultConfig
+	if settings != nil {
+		err := common.MustNewConfigFrom(settings).Unpack(&config)
+		require.NoError(t, err)
+	}
+	p := &http2Plugin{}
+	require.NoError(t, p.init(store.publish, &config))
+	return p
+}
+
+func testCreateTCPTuple() *common.TCPTuple {
+	t := &common.TCPTuple{
+		IPLength: 4,
+		BaseTuple: common.BaseTuple{
+			SrcIP: net.IPv4(192, 168, 0, 1), DstIP: net.IPv4(192, 168, 0, 2),
+			SrcPort: 6512, DstPort: 50051,
+		},
+	}
+	t.ComputeHashables()
+	return t
+}
+
+// endpoint encodes the frames sent by one side of a connection. The HPACK
+// encoder is shared by all header blocks, like in a real connection.
+type endpoint struct {
+	hbuf bytes.Buffer
+	enc  *hpack.Encoder
+}
+
+func newEndpoint() *endpoint {
+	e := &endpoint{}
+	e.enc = hpack.NewEncoder(&e.hbuf)
+	return e
+}
+
+func (e *endpoint) headerBlock(fields ...string) []byte {
+	e.hbuf.Reset()
+	for i := 0; i+1 < len(fields); i += 2 {
+		e.enc.WriteField(hpack.HeaderField{Name: fields[i], Value: fields[i+1]})
+	}
+	return append([]byte(nil), e.hbuf.Bytes()...)
+}
+
+func (e *endpoint) headers(streamID uint32, flags uint8, fields ...string) []byte {
+	return frame(frameHeaders, flags|flagEndHeaders, streamID, e.headerBlock(fields...))
+}
+
+func frame(typ frameType, flags uint8, streamID uint32, payload []byte) []byte {
+	buf := make([]byte, frameHeaderLen, frameHeaderLen+len(payload))
+	buf[0] = byte(len(payload) >> 16)
+	buf[1] = byte(len(payload) >> 8)
+	buf[2] = byte(len(payload))
+	buf[3] = byte(typ)
+	buf[4] = flags
+	binary.BigEndian.PutUint32(buf[5:], streamID)
+	return append(buf, payload...)
+}
+
+func settings() []byte {
+	return frame(frameSettings, 0, 0, nil)
+}
+
+func data(streamID uint32, flags uint8, payload string) []byte {
+	return frame(frameData, flags, streamID, []byte(payload))
+}
+
+func rstStream(streamID uint32, code uint32) []byte {
+	payload := make([]byte, 4)
+	binary.BigEndian.PutUint32(payload, code)
+	return frame(frameRSTStream, 0, streamID, payload)
+}
+
+func concat(chunks ...[]byte) []byte {
+	return bytes.Join(chunks, nil)
+}
+
+type testConn struct {
+	plugin  *http2Plugin
+	tuple   *common.TCPTuple
+	private protos.ProtocolData
+}
+
+func (c *testConn) send(dir uint8, payload []byte) {
+	pkt := &protos.Packet{Ts: time.Now(), Payload: payload}
+	c.private = c.plugin.Parse(pkt, c.tuple, dir, c.private)
+}
+
+func (c *testConn) client(payload []byte) { c.send(tcp.TCPDirectionOriginal, payload) }
+func (c *testConn) server(payload []byte) { c.send(tcp.TCPDirectionReverse, payload) }
+
+func newTestConn(plugin *http2Plugin) *testConn {
+	return &testConn{plugin: plugin, tuple: testCreateTCPTuple()}
+}
+
+func grpcRequest(e *endpoint, streamID uint32, path string) []byte {
+	return concat(
+		e.headers(streamID, 0,
+			":method", "POST",
+			":scheme", "http",
+			":path", path,
+			":authority", "localhost:50051",
+			"content-type", "application/grpc",
+			"user-agent", "grpc-go/1.23.0",
+			"te", "trailers"),
+		data(streamID, flagEndStream, "\x00\x00\x00\x00\x03abc"),
+	)
+}
+
+func grpcResponse(e *endpoint, streamID uint32, status, message string) []byte {
+	return concat(
+		e.headers(streamID, 0,
+			":status", "200",
+			"content-type", "application/grpc"),
+		data(streamID, 0, "\x00\x00\x00\x00\x02ok"),
+		e.headers(streamID, flagEndStream,
+			"grpc-status", status,
+			"grpc-message", message),
+	)
+}
+
+func TestHTTP2_GRPCUnaryCall(t *testing.T) {
+	store := &eventStore{}
+	conn := newTestConn(newTestPlugin(t, store, nil))
+	cli, srv := newEndpoint(), newEndpoint()
+
+	conn.client(concat([]byte(clientPreface), settings(), grpcRequest(cli, 1, "/helloworld.Greeter/SayHello")))
+	conn.server(concat(settings(), grpcResponse(srv, 1, "0", "")))
+
+	require.Len(t, store.events, 1)
+	fields := store.events[0].Fields
+
+	assert.Equal(t, "http2", fields["type"])
+	assert.Equal(t, common.OK_STATUS, fields["status"])
+	assert.Equal(t, "post", fields["method"])
+	assert.Equal(t, "POST /helloworld.Greeter/SayHello", fields["query"])
+
+	expected := common.MapStr{
+		"http.version":                      "2",
+		"http.request.method":               "post",
+		"http.request.body.bytes":           int64(8),
+		"http.response.status_code":         int64(200),
+		"http.response.body.bytes":          int64(7),
+		"http2.stream_id":                   uint32(1),
+		"grpc.service":                      "helloworld.Greeter",
+		"grpc.method":                       "SayHello",
+		"grpc.status_code":                  0,
+		"grpc.status":                       "OK",
+		"url.scheme":                        "http",
+		"url.domain":                        "localhost",
+		"url.port":                          int64(50051),
+		"url.path":                          "/helloworld.Greeter/SayHello",
+		"url.full":                          "http://localhost:50051/helloworld.Greeter/SayHello",
+		"user_agent.original":               "grpc-go/1.23.0",
+		"http.request.headers":              common.MapStr{"content-type": "application/grpc"},
+		"http.response.headers":             common.MapStr{"content-type": "application/grpc"},
+		"source.ip":                         "192.168.0.1",
+		"destination.port":                  int64(50051),
+		"network.protocol":                  "http2",
+		"event.dataset":                     "http2",
+		"destination.domain":                "localhost",
+		"network.transport":                 "tcp",
+		"source.port":                       int64(6512),
+		"destination.ip":                    "192.168.0.2",
+		"grpc.message":                      nil,
+		"http2.error_code":                  nil,
+		"http.response.headers.grpc-status": nil,
+		"request":                           nil,
+		"response":                          nil,
+	}
+	for key, value := range expected {
+		actual, _ := fields.GetValue(key)
+		assert.Equal(t, value, actual, key)
+	}
+}
+
+func TestHTTP2_GRPCError(t *testing.T) {
+	store := &eventStore{}
+	conn := newTestConn(newTestPlugin(t, store, map[string]interface{}{
+		"send_headers": []string{"grpc-status", "TE"},
+	}))
+	cli, srv := newEndpoint(), newEndpoint()
+
+	conn.client(concat([]byte(clientPreface), grpcRequest(cli, 1, "/pkg.Store/Get")))
+	conn.server(grpcResponse(srv, 1, "5", "key%20not%20found"))
+
+	require.Len(t, store.events, 1)
+	fields := store.events[0].Fields
+	assert.Equal(t, common.ERROR_STATUS, fields["status"])
+
+	expected := common.MapStr{
+		"grpc.status_code":                  5,
+		"grpc.status":                       "NOT_FOUND",
+		"grpc.message":                      "key not found",
+		"http.request.headers.te":           "trailers",
+		"http.request.headers.user-agent":   nil,
+		"http.response.headers.grpc-status": "5",
+	}
+	for key, value := range expected {
+		actual, _ := fields.GetValue(key)
+		assert.Equal(t, value, actual, key)
+	}
+}
+
+func TestHTTP2_SendRequestResponse(t *testing.T) {
+	store := &eventStore{}
+	conn := newTestConn(newTestPlugin(t, store, map[string]interface{}{
+		"send_request":  true,
+		"send_response": true,
+	}))
+	cli, srv := newEndpoint(), newEndpoint()
+
+	conn.client(concat([]byte(clientPreface), grpcRequest(cli, 1, "/pkg.Store/Get")))
+	conn.server(grpcResponse(srv, 1, "0", ""))
+
+	require.Len(t, store.events, 1)
+	fields := store.events[0].Fields
+
+	assert.Equal(t, ":method: POST\r\n"+
+		":scheme: http\r\n"+
+		":path: /pkg.Store/Get\r\n"+
+		":authority: localhost:50051\r\n"+
+		"content-type: application/grpc\r\n"+
+		"user-agent: grpc-go/1.23.0\r\n"+
+		"te: trailers\r\n", fields["request"])
+	assert.Equal(t, ":status: 200\r\n"+
+		"content-type: application/grpc\r\n"+
+		"\r\n"+
+		"grpc-status: 0\r\n"+
+		"grpc-message: \r\n", fields["response"])
+}
+
+func TestHTTP2_ContinuationAndSplitSegments(t *testing.T) {
+	store := &eventStore{}
+	conn := newTestConn(newTestPlugin(t, store, nil))
+	cli, srv := newEndpoint(), newEndpoint()
+
+	block := cli.headerBlock(
+		":method", "GET",
+		":scheme", "https",
+		":path", "/index.html?lang=en",
+		":authority", "www.example.com")
+	request := concat(
+		[]byte(clientPreface),
+		frame(frameHeaders, flagEndStream, 3, block[:4]),
+		frame(frameContinuation, 0, 3, block[4:6]),
+		frame(frameContinuation, flagEndHeaders, 3, block[6:]),
+	)
+	// deliver the request one byte at a time
+	for i := range request {
+		conn.client(request[i : i+1])
+	}
+	assert.Empty(t, store.events)
+
+	conn.server(srv.headers(3, 0, ":status", "404"))
+	assert.Empty(t, store.events)
+	conn.server(data(3, flagEndStream, "not found"))
+
+	require.Len(t, store.events, 1)
+	fields := store.events[0].Fields
+	assert.Equal(t, common.ERROR_STATUS, fields["status"])
+	assert.Equal(t, "GET /index.html?lang=en", fields["query"])
+
+	expected := common.MapStr{
+		"http.response.status_code": int64(404),
+		"http.response.body.bytes":  int64(9),
+		"http2.stream_id":           uint32(3),
+		"url.path":                  "/index.html",
+		"url.query":                 "lang=en",
+		"url.full":                  "https://www.example.com/index.html?lang=en",
+		"grpc":                      nil,
+	}
+	for key, value := range expected {
+		actual, _ := fields.GetValue(key)
+		assert.Equal(t, value, actual, key)
+	}
+	assert.Equal(t, int64(len(request)-len(clientPreface)), fields["source"].(common.MapStr)["bytes"])
+}
+
+func TestHTTP2_ConcurrentStreams(t *testing.T) {
+	store := &eventStore{}
+	conn := newTestConn(newTestPlugin(t, store, nil))
+	cli, srv := newEndpoint(), newEndpoint()
+
+	// Same encoder for all requests, so the later header blocks use
+	// indexed fields from the dynamic table.
+	conn.client(concat(
+		[]byte(clientPreface),
+		grpcRequest(cli, 1, "/pkg.Svc/First"),
+		grpcRequest(cli, 3, "/pkg.Svc/Second"),
+		grpcRequest(cli, 5, "/pkg.Svc/Third"),
+	))
+	conn.server(concat(
+		grpcResponse(srv, 5, "0", ""),
+		grpcResponse(srv, 1, "14", ""),
+	))
+	conn.server(grpcResponse(srv, 3, "0", ""))
+
+	require.Len(t, store.events, 3)
+	var methods []interface{}
+	var codes []interface{}
+	for _, evt := range store.events {
+		method, _ := evt.Fields.GetValue("grpc.method")
+		code, _ := evt.Fields.GetValue("grpc.status")
+		methods = append(methods, method)
+		codes = append(codes, code)
+	}
+	assert.Equal(t, []interface{}{"Third", "First", "Second"}, methods)
+	assert.Equal(t, []interface{}{"OK", "UNAVAILABLE", "OK"}, codes)
+}
+
+func TestHTTP2_StreamReset(t *testing.T) {
+	store := &eventStore{}
+	conn := newTestConn(newTestPlugin(t, store, nil))
+	cli := newEndpoint()
+
+	conn.client(concat([]byte(clientPreface), grpcRequest(cli, 1, "/pkg.Svc/Slow")))
+	conn.client(rstStream(1, 8))
+
+	require.Len(t, store.events, 1)
+	fields := store.events[0].Fields
+	assert.Equal(t, common.ERROR_STATUS, fields["status"])
+	code, _ := fields.GetValue("http2.error_code")
+	assert.Equal(t, "CANCEL", code)
+	msg, _ := fields.GetValue("error.message")
+	assert.Equal(t, "Stream reset: CANCEL", msg)
+}
+
+func TestHTTP2_Expired(t *testing.T) {
+	store := &eventStore{}
+	plugin := newTestPlugin(t, store, nil)
+	conn := newTestConn(plugin)
+	cli, srv := newEndpoint(), newEndpoint()
+
+	conn.client(concat(
+		[]byte(clientPreface),
+		grpcRequest(cli, 1, "/pkg.Svc/Stream"),
+		grpcRequest(cli, 3, "/pkg.Svc/Lost"),
+	))
+	conn.server(srv.headers(1, 0, ":status", "200", "content-type", "application/grpc"))
+
+	plugin.Expired(conn.tuple, conn.private)
+
+	require.Len(t, store.events, 1)
+	fields := store.events[0].Fields
+	method, _ := fields.GetValue("grpc.method")
+	assert.Equal(t, "Stream", method)
+	assert.Equal(t, common.ERROR_STATUS, fields["status"])
+	msg, _ := fields.GetValue("error.message")
+	assert.Equal(t, "Incomplete response", msg)
+}
+
+func TestHTTP2_HeaderTableSize(t *testing.T) {
+	store := &eventStore{}
+	conn := newTestConn(newTestPlugin(t, store, nil))
+	cli, srv := newEndpoint(), newEndpoint()
+
+	// The client allows the server encoder a bigger dynamic table.
+	setting := make([]byte, 6)
+	binary.BigEndian.PutUint16(setting, settingHeaderTableSize)
+	binary.BigEndian.PutUint32(setting[2:], 65536)
+	conn.client(concat([]byte(clientPreface), frame(frameSettings, 0, 0, setting)))
+
+	srv.enc.SetMaxDynamicTableSizeLimit(65536)
+	srv.enc.SetMaxDynamicTableSize(65536)
+
+	conn.client(grpcRequest(cli, 1, "/pkg.Svc/Call"))
+	conn.server(grpcResponse(srv, 1, "0", ""))
+
+	require.Len(t, store.events, 1)
+	assert.Equal(t, common.OK_STATUS, store.events[0].Fields["status"])
+}
+
+func TestHTTP2_InvalidHeaderBlock(t *testing.T) {
+	store := &eventStore{}
+	conn := newTestConn(newTestPlugin(t, store, nil))
+	cli := newEndpoint()
+
+	// Indexed field referencing an unknown dynamic table entry.
+	conn.client(concat([]byte(clientPreface), frame(frameHeaders, flagEndHeaders, 1, []byte{0xff, 0x7f})))
+	assert.True(t, conn.private.(*connection).broken)
+
+	// Further frames on the connection are ignored.
+	conn.client(grpcRequest(cli, 3, "/pkg.Svc/Call"))
+	assert.Empty(t, store.events)
+}
+
+func TestParseGRPCPath(t *testing.T) {
+	tests := []struct {
+		path, service, method string
+		ok                    bool
+	}{
+		{"/helloworld.Greeter/SayHello", "helloworld.Greeter", "SayHello", true},
+		{"/Service/Method", "Service", "Method", true},
+		{"helloworld.Greeter/SayHello", "", "", false},
+		{"/helloworld.Greeter", "", "", false},
+		{"/a/b/c", "", "", false},
+		{"/a/", "", "", false},
+	}
+
+	for _, test := range tests {
+		service, method, ok := parseGRPCPath(test.path)
+		assert.Equal(t, test.ok, ok, test.path)
+		assert.Equal(t, test.service, service, test.path)
+		assert.Equal(t, test.method, method, test.path)
+	}
+}
+
+func TestStripPadding(t *testing.T) {
+	hdr := &frameHeader{typ: frameData, flags: flagPadded}
+
+	payload, err := stripPadding(hdr, []byte("\x02data\x00\x00"))
+	assert.NoError(t, err)
+	assert.Equal(t, "data", string(payload))
+
+	_, err = stripPadding(hdr, []byte("\x05abc"))
+	assert.Equal(t, errFramePadding, err)
+
+	hdr.flags = 0
+	payload, err = stripPadding(hdr, []byte("\x02data"))
+	assert.NoError(t, err)
+	assert.Equal(t, "\x02data", string(payload))
+}
@@ -0,0 +1,145 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package http2
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/net/http2/hpack"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+// message is a request or response exchanged on a single HTTP/2 stream.
+type message struct {
+	ts time.Time
+
+	// size of all frames belonging to the message, including frame headers.
+	size int
+	// bodySize is the number of DATA payload bytes, excluding padding.
+	bodySize int
+
+	method     string
+	scheme     string
+	authority  string
+	path       string
+	statusCode int
+
+	// headers holds all regular (non pseudo) header fields, including
+	// trailers. Repeated fields are joined with ", ".
+	headers map[string]string
+
+	// raw holds the text rendering of all header blocks, if the raw message
+	// is reported.
+	raw []byte
+
+	complete bool
+}
+
+// transaction correlates the request and response sent on the same stream.
+type transaction struct {
+	streamID     uint32
+	tuple        common.TCPTuple
+	cmdlineTuple *common.ProcessTuple
+
+	// requestDir is the direction the request was received in.
+	requestDir uint8
+
+	request  *message
+	response *message
+
+	reset     bool
+	resetCode uint32
+
+	endTs time.Time
+	notes []string
+}
+
+func newMessage(ts time.Time) *message {
+	return &message{ts: ts, headers: map[string]string{}}
+}
+
+// addHeaders merges the decoded fields of a header block into the message.
+// Header blocks following the initial one carry trailers or, for responses,
+// the final status after informational (1xx) responses.
+func (m *message) addHeaders(fields []hpack.HeaderField) {
+	for _, f := range fields {
+		if f.IsPseudo() {
+			switch f.Name {
+			case ":method":
+				m.method = f.Value
+			case ":scheme":
+				m.scheme = f.Value
+			case ":authority":
+				m.authority = f.Value
+			case ":path":
+				m.path = f.Value
+			case ":status":
+				m.statusCode = parseStatusCode(f.Value)
+			}
+			continue
+		}
+
+		name := strings.ToLower(f.Name)
+		if prev, exists := m.headers[name]; exists {
+			m.headers[name] = prev + ", " + f.Value
+		} else {
+			m.headers[name] = f.Value
+		}
+	}
+}
+
+// addRawHeaders renders the decoded fields of a header block as text, one
+// "name: value" line per field. Header blocks are separated by an empty line.
+// HTTP/2 header blocks are binary encoded, so the raw message is built from
+// the decoded fields.
+func (m *message) addRawHeaders(fields []hpack.HeaderField) {
+	if len(m.raw) > 0 {
+		m.raw = append(m.raw, "\r\n"...)
+	}
+	for _, f := range fields {
+		m.raw = append(m.raw, f.Name...)
+		m.raw = append(m.raw, ": "...)
+		m.raw = append(m.raw, f.Value...)
+		m.raw = append(m.raw, "\r\n"...)
+	}
+}
+
+func (m *message) contentType() string {
+	return m.headers["content-type"]
+}
+
+func parseStatusCode(value string) int {
+	code, err := strconv.Atoi(value)
+	if err != nil {
+		return 0
+	}
+	return code
+}
+
+// hasHeader reports if a header block contains the given pseudo header.
+func hasHeader(fields []hpack.HeaderField, name string) bool {
+	for _, f := range fields {
+		if f.Name == name {
+			return true
+		}
+	}
+	return false
+}
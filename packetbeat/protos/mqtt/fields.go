@@ -0,0 +1,36 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Code generated by beats/dev-tools/cmd/asset/asset.go - DO NOT EDIT.
+
+package mqtt
+
+import (
+	"github.com/elastic/beats/libbeat/asset"
+)
+
+func init() {
+	if err := asset.SetFields("packetbeat", "mqtt", asset.ModuleFieldsPri, AssetMqtt); err != nil {
+		panic(err)
+	}
+}
+
+// AssetMqtt returns asset data.
+// This is the base64 encoded gzipped contents of protos/mqtt.
+func AssetMqtt() string {
+	return "eJysVU1v2zgQvftXDHLaBRJhg0UvPhRI3AAN0ny0ds4KTT45hGmSJim36q8vSEmOnMqJXBe6iB/z5s2b4cwZLVGNabUOYUQUZFAY08nt19nsZEQk4LmTNkijx/RxREQUj868BZeF5IQNdKBCQgmfjaj5G6ebZ6TZClvsuBUqizEtnClts9M16BpxJaFDLsX2pJdN+82e0ZiQFNBBFhKOfFprCs+gyf3d3dVkRpbxJQKZIu1yozV4jC8b/cbBOhMMNyrfwHlp9HAqUaStOTXmfT5bIyL8YCsbxf8/O8/Oe8gk3ruC1HIqoxfDqTXxd1QqPQQFQ9w4B8UCkjKML7X5riAWWEGHHnmCsZIPd5yuJ9MoBKOHx8sv19PPDaF9+P5QB4VUAc7XPqaPl9PJt+vLKzKOHu9elnudro0/UuB1yZQMVSTg4TaSgxQ2UAOjdghMdkut5jA3RoEdUII1DhWKLQZ6FqU93q0orZI8FpGAkhu46hAKllXKMJF7+RPvpaEwbsXCmOZVwAFVEqFJ6tqsfZLM1qzjK13Be7ZAP+MWKX73WlVkHeouU9CT1FyVAnk3iieSnqDZXEH0BLwEbM6iUO+F+05YEYgSEEkd4DZMxSg9uNHCk8O6hA8QNK/S866bZQ8jHvOde/hXHe8P6yHBUQNH/zTLwFxqzKlPfsj++3dbJJ1WvYO1t2Qa6LxJxPGMW65tZl8zu5jc7C9gB2+N9sijXMN9xtutj6ZBcxPHQZB6kfIVHNOepUl1SsgWWXxMF5ObHSjj4u7k/vahlxrzRufciGNrzSGUTlNEin21Bm6WRd/wION2QD5dT5scv6VkRB1OqnPcsugQe1sP//cE8a8UeRlEsW7aKfRSQ6c7YEaDLNzOKMtGvwYA4H22EQ=="
+}
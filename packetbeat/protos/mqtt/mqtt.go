@@ -0,0 +1,549 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package mqtt
+
+import (
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/libbeat/monitoring"
+
+	"github.com/elastic/beats/packetbeat/pb"
+	"github.com/elastic/beats/packetbeat/procs"
+	"github.com/elastic/beats/packetbeat/protos"
+	"github.com/elastic/beats/packetbeat/protos/applayer"
+	"github.com/elastic/beats/packetbeat/protos/tcp"
+)
+
+type stream struct {
+	applayer.Stream
+
+	// skip is the number of payload bytes of a large PUBLISH packet that are
+	// still to be received. The payload is never buffered.
+	skip int
+}
+
+// transaction is a request and the acknowledgement completing it. For QoS 2
+// publishing the transaction completes with PUBCOMP.
+type transaction struct {
+	tuple        common.TCPTuple
+	cmdlineTuple *common.ProcessTuple
+	requestDir   uint8
+
+	request  *message
+	response *message
+
+	// bytes of intermediate packets (PUBREC and PUBREL) exchanged during QoS 2
+	// publishing.
+	requestBytes  int
+	responseBytes int
+
+	notes []string
+}
+
+type connection struct {
+	streams [2]*stream
+
+	// protocolLevel of the connection as announced in CONNECT. MQTT 3.1.1 is
+	// assumed if the CONNECT packet was not seen.
+	protocolLevel uint8
+	clientID      string
+
+	connect *transaction
+	ping    *transaction
+
+	// pending holds the transactions waiting for an acknowledgement, indexed
+	// by the direction of the request. Each side has its own packet
+	// identifier space.
+	pending [2]map[uint16]*transaction
+
+	lastExpire time.Time
+}
+
+// MQTT protocol plugin
+type mqttPlugin struct {
+	// config
+	ports              []int
+	includePayloadSize bool
+	includePing        bool
+	transactionTimeout time.Duration
+
+	results protos.Reporter
+}
+
+var (
+	debugf  = logp.MakeDebug("mqtt")
+	isDebug = false
+)
+
+var (
+	unmatchedResponses = monitoring.NewInt(nil, "mqtt.unmatched_responses")
+	unmatchedRequests  = monitoring.NewInt(nil, "mqtt.unmatched_requests")
+)
+
+func init() {
+	protos.Register("mqtt", New)
+}
+
+// New creates and initializes a new MQTT protocol analyzer instance.
+func New(
+	testMode bool,
+	results protos.Reporter,
+	cfg *common.Config,
+) (protos.Plugin, error) {
+	p := &mqttPlugin{}
+	config := defaultConfig
+	if !testMode {
+		if err := cfg.Unpack(&config); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.init(results, &config); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (mqtt *mqttPlugin) init(results protos.Reporter, config *mqttConfig) error {
+	mqtt.setFromConfig(config)
+
+	mqtt.results = results
+	isDebug = logp.IsDebug("mqtt")
+
+	return nil
+}
+
+func (mqtt *mqttPlugin) setFromConfig(config *mqttConfig) {
+	mqtt.ports = config.Ports
+	mqtt.includePayloadSize = config.IncludePayloadSize
+	mqtt.includePing = config.IncludePingRequests
+	mqtt.transactionTimeout = config.TransactionTimeout
+}
+
+// GetPorts returns the ports numbers the MQTT analyzer is configured for.
+func (mqtt *mqttPlugin) GetPorts() []int {
+	return mqtt.ports
+}
+
+// ConnectionTimeout returns the configured MQTT transaction timeout.
+func (mqtt *mqttPlugin) ConnectionTimeout() time.Duration {
+	return mqtt.transactionTimeout
+}
+
+func newConnection() *connection {
+	return &connection{
+		protocolLevel: protocolLevel311,
+		pending: [2]map[uint16]*transaction{
+			map[uint16]*transaction{},
+			map[uint16]*transaction{},
+		},
+	}
+}
+
+func getConnection(private protos.ProtocolData) *connection {
+	if private == nil {
+		return newConnection()
+	}
+
+	priv, ok := private.(*connection)
+	if !ok {
+		logp.Warn("mqtt connection data type error, create new one")
+		return newConnection()
+	}
+	if priv == nil {
+		logp.Warn("Unexpected: mqtt connection data not set, create new one")
+		return newConnection()
+	}
+
+	return priv
+}
+
+// Parse function is used to process TCP payloads.
+func (mqtt *mqttPlugin) Parse(
+	pkt *protos.Packet,
+	tcptuple *common.TCPTuple,
+	dir uint8,
+	private protos.ProtocolData,
+) protos.ProtocolData {
+	defer logp.Recover("ParseMQTT exception")
+
+	conn := getConnection(private)
+	st := conn.streams[dir]
+	if st == nil {
+		st = &stream{}
+		st.Stream.Init(tcp.TCPMaxDataInStream)
+		conn.streams[dir] = st
+		if isDebug {
+			debugf("new stream: %p (dir=%v, len=%v)", st, dir, len(pkt.Payload))
+		}
+	}
+
+	payload := pkt.Payload
+	if st.skip > 0 {
+		n := st.skip
+		if n > len(payload) {
+			n = len(payload)
+		}
+		st.skip -= n
+		payload = payload[n:]
+	}
+
+	if err := st.Append(payload); err != nil {
+		if isDebug {
+			debugf("%v, dropping TCP stream", err)
+		}
+		conn.streams[dir] = nil
+		return conn
+	}
+
+	if err := mqtt.parsePackets(conn, st, pkt.Ts, tcptuple, dir); err != nil {
+		// drop this tcp stream. Will retry parsing with the next
+		// segment in it
+		if isDebug {
+			debugf("Ignore MQTT message: %v. Drop tcp stream. Try parsing with the next segment", err)
+		}
+		conn.streams[dir] = nil
+	}
+	return conn
+}
+
+func (mqtt *mqttPlugin) parsePackets(
+	conn *connection,
+	st *stream,
+	ts time.Time,
+	tcptuple *common.TCPTuple,
+	dir uint8,
+) error {
+	defer st.Reset()
+
+	for st.skip == 0 && st.Buf.Len() > 0 {
+		buf := st.Buf.Bytes()
+		typ, flags, headerLen, remaining, ok, err := parseFixedHeader(buf)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			// wait for more data
+			return nil
+		}
+
+		msg := &message{
+			ts:    ts,
+			typ:   typ,
+			flags: flags,
+			size:  headerLen + remaining,
+		}
+
+		body := buf[headerLen:]
+		if len(body) >= remaining {
+			if err := parseMessage(msg, body[:remaining], conn.protocolLevel); err != nil {
+				return err
+			}
+			st.Buf.Advance(msg.size)
+		} else {
+			if typ != packetPublish {
+				// wait for more data
+				return nil
+			}
+
+			// Decode the topic and headers of large PUBLISH packets as soon
+			// as they are available and skip the payload.
+			if err := parseMessage(msg, body, conn.protocolLevel); err != nil {
+				if err == errPacketTooShort {
+					return nil
+				}
+				return err
+			}
+			msg.payloadSize += remaining - len(body)
+			st.skip = remaining - len(body)
+			st.Buf.Advance(len(buf))
+		}
+
+		if isDebug {
+			debugf("MQTT (%p) %v packet (dir=%v, size=%v)", conn, msg.typ, dir, msg.size)
+		}
+		mqtt.handleMessage(conn, msg, tcptuple, dir)
+	}
+	return nil
+}
+
+func (mqtt *mqttPlugin) newTransaction(msg *message, tcptuple *common.TCPTuple, dir uint8) *transaction {
+	return &transaction{
+		tuple:        *tcptuple,
+		cmdlineTuple: procs.ProcWatcher.FindProcessesTupleTCP(tcptuple.IPPort()),
+		requestDir:   dir,
+		request:      msg,
+	}
+}
+
+func (mqtt *mqttPlugin) handleMessage(
+	conn *connection,
+	msg *message,
+	tcptuple *common.TCPTuple,
+	dir uint8,
+) {
+	mqtt.expireTransactions(conn, msg.ts)
+
+	switch msg.typ {
+	case packetConnect:
+		conn.protocolLevel = msg.protocolLevel
+		conn.clientID = msg.clientID
+		conn.connect = mqtt.newTransaction(msg, tcptuple, dir)
+
+	case packetConnAck:
+		trans := conn.connect
+		conn.connect = nil
+		mqtt.complete(conn, trans, msg)
+
+	case packetPublish:
+		trans := mqtt.newTransaction(msg, tcptuple, dir)
+		if msg.qos == 0 {
+			// QoS 0 messages are not acknowledged.
+			mqtt.publishTransaction(conn, trans)
+			return
+		}
+		if prev := conn.pending[dir][msg.packetID]; prev != nil && msg.dup {
+			// retransmission of an unacknowledged message
+			prev.requestBytes += msg.size
+			return
+		}
+		mqtt.addPending(conn, trans, dir, msg.packetID)
+
+	case packetSubscribe, packetUnsubscribe:
+		mqtt.addPending(conn, mqtt.newTransaction(msg, tcptuple, dir), dir, msg.packetID)
+
+	case packetPubAck, packetPubComp, packetSubAck, packetUnsubAck:
+		trans := conn.pending[1-dir][msg.packetID]
+		delete(conn.pending[1-dir], msg.packetID)
+		mqtt.complete(conn, trans, msg)
+
+	case packetPubRec:
+		trans := conn.pending[1-dir][msg.packetID]
+		if trans == nil {
+			unmatchedResponses.Add(1)
+			return
+		}
+		if msg.hasReasonCode && isFailure(msg.typ, conn.protocolLevel, msg.reasonCode) {
+			// A failed PUBREC ends the QoS 2 flow.
+			delete(conn.pending[1-dir], msg.packetID)
+			mqtt.complete(conn, trans, msg)
+			return
+		}
+		trans.responseBytes += msg.size
+
+	case packetPubRel:
+		if trans := conn.pending[dir][msg.packetID]; trans != nil {
+			trans.requestBytes += msg.size
+		}
+
+	case packetPingReq:
+		if mqtt.includePing {
+			conn.ping = mqtt.newTransaction(msg, tcptuple, dir)
+		}
+
+	case packetPingResp:
+		if mqtt.includePing {
+			trans := conn.ping
+			conn.ping = nil
+			mqtt.complete(conn, trans, msg)
+		}
+
+	case packetDisconnect:
+		mqtt.publishTransaction(conn, mqtt.newTransaction(msg, tcptuple, dir))
+	}
+}
+
+func (mqtt *mqttPlugin) addPending(conn *connection, trans *transaction, dir uint8, id uint16) {
+	if prev := conn.pending[dir][id]; prev != nil {
+		// packet identifier reused without acknowledgement
+		unmatchedRequests.Add(1)
+	}
+	conn.pending[dir][id] = trans
+}
+
+func (mqtt *mqttPlugin) complete(conn *connection, trans *transaction, resp *message) {
+	if trans == nil {
+		if isDebug {
+			debugf("%v from unknown transaction. Ignoring", resp.typ)
+		}
+		unmatchedResponses.Add(1)
+		return
+	}
+	trans.response = resp
+	mqtt.publishTransaction(conn, trans)
+}
+
+// expireTransactions drops transactions that did not receive their
+// acknowledgement within the transaction timeout. MQTT connections are long
+// lived, so pending transactions can't wait for the connection to end.
+func (mqtt *mqttPlugin) expireTransactions(conn *connection, now time.Time) {
+	if now.Sub(conn.lastExpire) < mqtt.transactionTimeout {
+		return
+	}
+	conn.lastExpire = now
+
+	for _, pending := range conn.pending {
+		for id, trans := range pending {
+			if now.Sub(trans.request.ts) > mqtt.transactionTimeout {
+				unmatchedRequests.Add(1)
+				delete(pending, id)
+			}
+		}
+	}
+}
+
+func (mqtt *mqttPlugin) publishTransaction(conn *connection, trans *transaction) {
+	if mqtt.results != nil {
+		mqtt.results(mqtt.newEvent(conn, trans))
+	}
+}
+
+func (mqtt *mqttPlugin) newEvent(conn *connection, trans *transaction) beat.Event {
+	requ, resp := trans.request, trans.response
+
+	source, destination := common.MakeEndpointPair(trans.tuple.BaseTuple, trans.cmdlineTuple)
+	src, dst := &source, &destination
+	if trans.requestDir == tcp.TCPDirectionReverse {
+		src, dst = dst, src
+	}
+
+	evt, pbf := pb.NewBeatEvent(requ.ts)
+	pbf.SetSource(src)
+	pbf.SetDestination(dst)
+	pbf.Source.Bytes = int64(requ.size + trans.requestBytes)
+	pbf.Event.Dataset = "mqtt"
+	pbf.Event.Start = requ.ts
+	pbf.Network.Transport = "tcp"
+	pbf.Network.Protocol = pbf.Event.Dataset
+	pbf.Error.Message = trans.notes
+
+	status := common.OK_STATUS
+	fields := evt.Fields
+	fields["type"] = pbf.Event.Dataset
+	fields["method"] = requ.typ.String()
+	fields["query"] = query(requ)
+
+	mqttFields := common.MapStr{
+		"protocol_version": protocolVersion(conn.protocolLevel),
+	}
+	if conn.clientID != "" {
+		mqttFields["client_id"] = conn.clientID
+	}
+	if requ.hasPacketID {
+		mqttFields["packet_id"] = requ.packetID
+	}
+
+	switch requ.typ {
+	case packetConnect:
+		mqttFields["keep_alive"] = requ.keepAlive
+		mqttFields["clean_session"] = requ.cleanSession
+	case packetPublish:
+		mqttFields["topic"] = requ.topic
+		mqttFields["qos"] = requ.qos
+		mqttFields["retain"] = requ.retain
+		mqttFields["dup"] = requ.dup
+		if mqtt.includePayloadSize {
+			mqttFields["payload_size"] = requ.payloadSize
+		}
+	case packetSubscribe, packetUnsubscribe:
+		mqttFields["topics"] = requ.topics
+	case packetDisconnect:
+		if requ.hasReasonCode {
+			mqttFields["reason_code"] = requ.reasonCode
+			mqttFields["reason"] = reasonName(requ.typ, conn.protocolLevel, requ.reasonCode)
+		}
+	}
+
+	if resp != nil {
+		pbf.Destination.Bytes = int64(resp.size + trans.responseBytes)
+		pbf.Event.End = resp.ts
+		mqttFields["response_type"] = resp.typ.String()
+
+		if resp.typ == packetConnAck {
+			mqttFields["session_present"] = resp.sessionPresent
+		}
+		if resp.hasReasonCode {
+			mqttFields["reason_code"] = resp.reasonCode
+			if name := reasonName(resp.typ, conn.protocolLevel, resp.reasonCode); name != "" {
+				mqttFields["reason"] = name
+			}
+			if isFailure(resp.typ, conn.protocolLevel, resp.reasonCode) {
+				status = common.ERROR_STATUS
+			}
+		}
+		if len(resp.reasonCodes) > 0 {
+			codes := make([]int, len(resp.reasonCodes))
+			for i, code := range resp.reasonCodes {
+				codes[i] = int(code)
+				if isFailure(resp.typ, conn.protocolLevel, code) {
+					status = common.ERROR_STATUS
+				}
+			}
+			mqttFields["reason_codes"] = codes
+		}
+	}
+
+	fields["status"] = status
+	fields["mqtt"] = mqttFields
+	return evt
+}
+
+func query(msg *message) string {
+	switch msg.typ {
+	case packetPublish:
+		return msg.typ.String() + " " + msg.topic
+	case packetSubscribe, packetUnsubscribe:
+		return msg.typ.String() + " " + strings.Join(msg.topics, ", ")
+	}
+	return msg.typ.String()
+}
+
+// GapInStream is called when a gap of nbytes bytes is found in the stream (due
+// to packet loss).
+func (mqtt *mqttPlugin) GapInStream(tcptuple *common.TCPTuple, dir uint8,
+	nbytes int, private protos.ProtocolData) (priv protos.ProtocolData, drop bool) {
+
+	conn := getConnection(private)
+	if st := conn.streams[dir]; st != nil && st.skip >= nbytes && st.Buf.Len() == 0 {
+		// The gap is within the payload of a PUBLISH packet that is skipped
+		// anyway.
+		st.skip -= nbytes
+		return conn, false
+	}
+	conn.streams[dir] = nil
+	return conn, true
+}
+
+// ReceivedFin is called when a FIN is received.
+func (mqtt *mqttPlugin) ReceivedFin(tcptuple *common.TCPTuple, dir uint8,
+	private protos.ProtocolData) protos.ProtocolData {
+
+	return private
+}
+
+// Expired is called when the TCP stream is expired due to connection timeout.
+func (mqtt *mqttPlugin) Expired(tuple *common.TCPTuple, private protos.ProtocolData) {
+	conn := getConnection(private)
+	for _, pending := range conn.pending {
+		unmatchedRequests.Add(int64(len(pending)))
+	}
+}
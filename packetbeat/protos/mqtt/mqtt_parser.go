@@ -0,0 +1,308 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package mqtt
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+)
+
+type packetType uint8
+
+// MQTT control packet types.
+const (
+	packetConnect     packetType = 1
+	packetConnAck     packetType = 2
+	packetPublish     packetType = 3
+	packetPubAck      packetType = 4
+	packetPubRec      packetType = 5
+	packetPubRel      packetType = 6
+	packetPubComp     packetType = 7
+	packetSubscribe   packetType = 8
+	packetSubAck      packetType = 9
+	packetUnsubscribe packetType = 10
+	packetUnsubAck    packetType = 11
+	packetPingReq     packetType = 12
+	packetPingResp    packetType = 13
+	packetDisconnect  packetType = 14
+	packetAuth        packetType = 15
+)
+
+var packetTypeNames = []string{
+	"RESERVED",
+	"CONNECT",
+	"CONNACK",
+	"PUBLISH",
+	"PUBACK",
+	"PUBREC",
+	"PUBREL",
+	"PUBCOMP",
+	"SUBSCRIBE",
+	"SUBACK",
+	"UNSUBSCRIBE",
+	"UNSUBACK",
+	"PINGREQ",
+	"PINGRESP",
+	"DISCONNECT",
+	"AUTH",
+}
+
+func (t packetType) String() string {
+	if int(t) < len(packetTypeNames) {
+		return packetTypeNames[t]
+	}
+	return fmt.Sprintf("UNKNOWN_%d", uint8(t))
+}
+
+// Protocol levels sent in the CONNECT packet.
+const (
+	protocolLevel31  uint8 = 3
+	protocolLevel311 uint8 = 4
+	protocolLevel5   uint8 = 5
+)
+
+func protocolVersion(level uint8) string {
+	switch level {
+	case protocolLevel31:
+		return "3.1"
+	case protocolLevel311:
+		return "3.1.1"
+	case protocolLevel5:
+		return "5.0"
+	}
+	return fmt.Sprintf("unknown (%d)", level)
+}
+
+// maxFixedHeaderLen is the size of the packet type byte plus the longest
+// remaining length encoding.
+const maxFixedHeaderLen = 5
+
+var (
+	errMalformedLength = errors.New("malformed remaining length")
+	errPacketTooShort  = errors.New("packet too short")
+	errUnknownPacket   = errors.New("unknown packet type")
+)
+
+type message struct {
+	ts time.Time
+
+	typ   packetType
+	flags uint8
+	// size is the total size of the packet including the fixed header.
+	size int
+
+	packetID    uint16
+	hasPacketID bool
+
+	// CONNECT
+	protocolLevel uint8
+	clientID      string
+	keepAlive     uint16
+	cleanSession  bool
+
+	// CONNACK
+	sessionPresent bool
+
+	// Reason code of CONNACK, PUBACK, PUBREC, PUBREL, PUBCOMP, DISCONNECT
+	reasonCode    uint8
+	hasReasonCode bool
+
+	// PUBLISH
+	topic       string
+	qos         uint8
+	retain      bool
+	dup         bool
+	payloadSize int
+
+	// SUBSCRIBE, UNSUBSCRIBE
+	topics []string
+
+	// SUBACK, UNSUBACK
+	reasonCodes []uint8
+}
+
+// parseFixedHeader decodes the packet type, flags and remaining length at
+// the start of buf. ok is false if more data is needed.
+func parseFixedHeader(buf []byte) (typ packetType, flags uint8, headerLen, remaining int, ok bool, err error) {
+	if len(buf) < 2 {
+		return 0, 0, 0, 0, false, nil
+	}
+
+	typ = packetType(buf[0] >> 4)
+	flags = buf[0] & 0x0f
+	if typ == 0 {
+		return 0, 0, 0, 0, false, errUnknownPacket
+	}
+
+	multiplier := 1
+	for i := 1; i < maxFixedHeaderLen; i++ {
+		if i >= len(buf) {
+			return 0, 0, 0, 0, false, nil
+		}
+		b := buf[i]
+		remaining += int(b&0x7f) * multiplier
+		if b&0x80 == 0 {
+			return typ, flags, i + 1, remaining, true, nil
+		}
+		multiplier *= 128
+	}
+	return 0, 0, 0, 0, false, errMalformedLength
+}
+
+// decoder reads the fields of the variable header and payload of a packet.
+// The first failing read sets err and all further reads return zero values.
+type decoder struct {
+	buf []byte
+	err error
+}
+
+func (d *decoder) remaining() int {
+	return len(d.buf)
+}
+
+func (d *decoder) read(n int) []byte {
+	if d.err != nil {
+		return nil
+	}
+	if n > len(d.buf) {
+		d.err = errPacketTooShort
+		return nil
+	}
+	b := d.buf[:n]
+	d.buf = d.buf[n:]
+	return b
+}
+
+func (d *decoder) uint8() uint8 {
+	if b := d.read(1); b != nil {
+		return b[0]
+	}
+	return 0
+}
+
+func (d *decoder) uint16() uint16 {
+	if b := d.read(2); b != nil {
+		return binary.BigEndian.Uint16(b)
+	}
+	return 0
+}
+
+func (d *decoder) binary() []byte {
+	n := d.uint16()
+	return d.read(int(n))
+}
+
+func (d *decoder) string() string {
+	return string(d.binary())
+}
+
+func (d *decoder) varint() int {
+	value, multiplier := 0, 1
+	for i := 0; i < 4; i++ {
+		b := d.uint8()
+		if d.err != nil {
+			return 0
+		}
+		value += int(b&0x7f) * multiplier
+		if b&0x80 == 0 {
+			return value
+		}
+		multiplier *= 128
+	}
+	d.err = errMalformedLength
+	return 0
+}
+
+// skipProperties skips the properties of MQTT 5.0 packets.
+func (d *decoder) skipProperties(level uint8) {
+	if level == protocolLevel5 {
+		d.read(d.varint())
+	}
+}
+
+// parseMessage decodes the variable header and payload of a packet. level is
+// the protocol level of the connection, which determines if MQTT 5.0
+// properties are present.
+func parseMessage(msg *message, body []byte, level uint8) error {
+	d := &decoder{buf: body}
+
+	switch msg.typ {
+	case packetConnect:
+		d.string() // protocol name
+		msg.protocolLevel = d.uint8()
+		connectFlags := d.uint8()
+		msg.cleanSession = connectFlags&0x02 != 0
+		msg.keepAlive = d.uint16()
+		d.skipProperties(msg.protocolLevel)
+		msg.clientID = d.string()
+
+	case packetConnAck:
+		msg.sessionPresent = d.uint8()&0x01 != 0
+		msg.reasonCode = d.uint8()
+		msg.hasReasonCode = true
+
+	case packetPublish:
+		msg.dup = msg.flags&0x08 != 0
+		msg.qos = (msg.flags >> 1) & 0x03
+		msg.retain = msg.flags&0x01 != 0
+		msg.topic = d.string()
+		if msg.qos > 0 {
+			msg.packetID = d.uint16()
+			msg.hasPacketID = true
+		}
+		d.skipProperties(level)
+		msg.payloadSize = d.remaining()
+
+	case packetPubAck, packetPubRec, packetPubRel, packetPubComp:
+		msg.packetID = d.uint16()
+		msg.hasPacketID = true
+		if d.remaining() > 0 {
+			msg.reasonCode = d.uint8()
+			msg.hasReasonCode = true
+		}
+
+	case packetSubscribe, packetUnsubscribe:
+		msg.packetID = d.uint16()
+		msg.hasPacketID = true
+		d.skipProperties(level)
+		for d.err == nil && d.remaining() > 0 {
+			msg.topics = append(msg.topics, d.string())
+			if msg.typ == packetSubscribe {
+				d.uint8() // subscription options
+			}
+		}
+
+	case packetSubAck, packetUnsubAck:
+		msg.packetID = d.uint16()
+		msg.hasPacketID = true
+		d.skipProperties(level)
+		for d.err == nil && d.remaining() > 0 {
+			msg.reasonCodes = append(msg.reasonCodes, d.uint8())
+		}
+
+	case packetDisconnect:
+		if d.remaining() > 0 {
+			msg.reasonCode = d.uint8()
+			msg.hasReasonCode = true
+		}
+	}
+
+	return d.err
+}
@@ -0,0 +1,153 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package mqtt
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func packet(typ packetType, flags uint8, body ...[]byte) []byte {
+	payload := bytes.Join(body, nil)
+	buf := []byte{byte(typ)<<4 | flags}
+	n := len(payload)
+	for {
+		b := byte(n % 128)
+		n /= 128
+		if n > 0 {
+			b |= 0x80
+		}
+		buf = append(buf, b)
+		if n == 0 {
+			break
+		}
+	}
+	return append(buf, payload...)
+}
+
+func str(s string) []byte {
+	return append(u16(uint16(len(s))), s...)
+}
+
+func u16(v uint16) []byte {
+	buf := make([]byte, 2)
+	binary.BigEndian.PutUint16(buf, v)
+	return buf
+}
+
+func connectPacket(level uint8, clientID string) []byte {
+	name := "MQTT"
+	if level == protocolLevel31 {
+		name = "MQIsdp"
+	}
+	props := []byte{}
+	if level == protocolLevel5 {
+		// property length 5: session expiry interval
+		props = []byte{5, 0x11, 0, 0, 0, 60}
+	}
+	return packet(packetConnect, 0,
+		str(name), []byte{level, 0x02}, u16(30), props, str(clientID))
+}
+
+func TestParseFixedHeader(t *testing.T) {
+	tests := []struct {
+		input     []byte
+		typ       packetType
+		headerLen int
+		remaining int
+		ok        bool
+		err       error
+	}{
+		{[]byte{0x30}, 0, 0, 0, false, nil},
+		{[]byte{0x30, 0x05}, packetPublish, 2, 5, true, nil},
+		{[]byte{0x30, 0xc1}, 0, 0, 0, false, nil},
+		{[]byte{0x30, 0xc1, 0x02}, packetPublish, 3, 321, true, nil},
+		{[]byte{0x30, 0xff, 0xff, 0xff, 0x7f}, packetPublish, 5, 268435455, true, nil},
+		{[]byte{0x30, 0xff, 0xff, 0xff, 0xff, 0x01}, 0, 0, 0, false, errMalformedLength},
+		{[]byte{0x00, 0x00}, 0, 0, 0, false, errUnknownPacket},
+	}
+
+	for _, test := range tests {
+		typ, _, headerLen, remaining, ok, err := parseFixedHeader(test.input)
+		assert.Equal(t, test.err, err, "%x", test.input)
+		assert.Equal(t, test.ok, ok, "%x", test.input)
+		assert.Equal(t, test.typ, typ, "%x", test.input)
+		assert.Equal(t, test.headerLen, headerLen, "%x", test.input)
+		assert.Equal(t, test.remaining, remaining, "%x", test.input)
+	}
+}
+
+func parseTestPacket(t *testing.T, data []byte, level uint8) *message {
+	typ, flags, headerLen, remaining, ok, err := parseFixedHeader(data)
+	if !assert.NoError(t, err) || !assert.True(t, ok) {
+		t.FailNow()
+	}
+	msg := &message{typ: typ, flags: flags, size: headerLen + remaining}
+	assert.NoError(t, parseMessage(msg, data[headerLen:], level))
+	return msg
+}
+
+func TestParseConnect(t *testing.T) {
+	for _, level := range []uint8{protocolLevel31, protocolLevel311, protocolLevel5} {
+		msg := parseTestPacket(t, connectPacket(level, "sensor-42"), protocolLevel311)
+		assert.Equal(t, level, msg.protocolLevel)
+		assert.Equal(t, "sensor-42", msg.clientID)
+		assert.Equal(t, uint16(30), msg.keepAlive)
+		assert.True(t, msg.cleanSession)
+	}
+}
+
+func TestParsePublish(t *testing.T) {
+	data := packet(packetPublish, 0x0b, str("a/b"), u16(7), []byte("hello"))
+	msg := parseTestPacket(t, data, protocolLevel311)
+	assert.Equal(t, "a/b", msg.topic)
+	assert.Equal(t, uint8(1), msg.qos)
+	assert.True(t, msg.dup)
+	assert.True(t, msg.retain)
+	assert.Equal(t, uint16(7), msg.packetID)
+	assert.Equal(t, 5, msg.payloadSize)
+
+	// MQTT 5.0 with properties
+	data = packet(packetPublish, 0x04, str("a/b"), u16(8), []byte{2, 0x01, 0x01}, []byte("hi"))
+	msg = parseTestPacket(t, data, protocolLevel5)
+	assert.Equal(t, uint8(2), msg.qos)
+	assert.Equal(t, uint16(8), msg.packetID)
+	assert.Equal(t, 2, msg.payloadSize)
+}
+
+func TestParseSubscribe(t *testing.T) {
+	data := packet(packetSubscribe, 0x02, u16(3), str("a/#"), []byte{1}, str("b/+"), []byte{0})
+	msg := parseTestPacket(t, data, protocolLevel311)
+	assert.Equal(t, uint16(3), msg.packetID)
+	assert.Equal(t, []string{"a/#", "b/+"}, msg.topics)
+
+	data = packet(packetSubAck, 0, u16(3), []byte{0}, []byte{1, 0x80})
+	msg = parseTestPacket(t, data, protocolLevel5)
+	assert.Equal(t, []uint8{1, 0x80}, msg.reasonCodes)
+}
+
+func TestParseTruncated(t *testing.T) {
+	data := packet(packetPublish, 0x02, str("a/b"))
+	msg := &message{typ: packetPublish, flags: 0x02}
+	assert.Equal(t, errPacketTooShort, parseMessage(msg, data[2:], protocolLevel311))
+}
@@ -0,0 +1,309 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package mqtt
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/packetbeat/protos"
+	"github.com/elastic/beats/packetbeat/protos/tcp"
+	"github.com/elastic/beats/packetbeat/publish"
+)
+
+type eventStore struct {
+	events []beat.Event
+}
+
+func (e *eventStore) publish(event beat.Event) {
+	publish.MarshalPacketbeatFields(&event, nil)
+	e.events = append(e.events, event)
+}
+
+func newTestPlugin(t *testing.T, store *eventStore, config mqttConfig) *mqttPlugin {
+	p := &mqttPlugin{}
+	require.NoError(t, p.init(store.publish, &config))
+	return p
+}
+
+func testCreateTCPTuple() *common.TCPTuple {
+	t := &common.TCPTuple{
+		IPLength: 4,
+		BaseTuple: common.BaseTuple{
+			SrcIP: net.IPv4(192, 168, 0, 1), DstIP: net.IPv4(192, 168, 0, 2),
+			SrcPort: 6512, DstPort: 1883,
+		},
+	}
+	t.ComputeHashables()
+	return t
+}
+
+type testConn struct {
+	plugin  *mqttPlugin
+	tuple   *common.TCPTuple
+	private protos.ProtocolData
+	ts      time.Time
+}
+
+func newTestConn(plugin *mqttPlugin) *testConn {
+	return &testConn{
+		plugin: plugin,
+		tuple:  testCreateTCPTuple(),
+		ts:     time.Date(2019, 9, 1, 12, 0, 0, 0, time.UTC),
+	}
+}
+
+func (c *testConn) send(dir uint8, payload []byte) {
+	c.ts = c.ts.Add(10 * time.Millisecond)
+	pkt := &protos.Packet{Ts: c.ts, Payload: payload}
+	c.private = c.plugin.Parse(pkt, c.tuple, dir, c.private)
+}
+
+func (c *testConn) client(payload ...[]byte) {
+	for _, p := range payload {
+		c.send(tcp.TCPDirectionOriginal, p)
+	}
+}
+
+func (c *testConn) server(payload ...[]byte) {
+	for _, p := range payload {
+		c.send(tcp.TCPDirectionReverse, p)
+	}
+}
+
+func getValue(evt beat.Event, key string) interface{} {
+	v, _ := evt.Fields.GetValue(key)
+	return v
+}
+
+func TestMQTT_Connect(t *testing.T) {
+	store := &eventStore{}
+	conn := newTestConn(newTestPlugin(t, store, defaultConfig))
+
+	conn.client(connectPacket(protocolLevel311, "sensor-42"))
+	conn.server(packet(packetConnAck, 0, []byte{0x01, 0x00}))
+
+	require.Len(t, store.events, 1)
+	evt := store.events[0]
+	assert.Equal(t, "mqtt", evt.Fields["type"])
+	assert.Equal(t, "CONNECT", evt.Fields["method"])
+	assert.Equal(t, common.OK_STATUS, evt.Fields["status"])
+
+	expected := map[string]interface{}{
+		"mqtt.client_id":        "sensor-42",
+		"mqtt.protocol_version": "3.1.1",
+		"mqtt.keep_alive":       uint16(30),
+		"mqtt.clean_session":    true,
+		"mqtt.session_present":  true,
+		"mqtt.reason_code":      uint8(0),
+		"mqtt.reason":           "Connection Accepted",
+		"mqtt.response_type":    "CONNACK",
+		"event.duration":        10 * time.Millisecond,
+		"source.port":           int64(6512),
+		"destination.port":      int64(1883),
+	}
+	for key, value := range expected {
+		assert.Equal(t, value, getValue(evt, key), key)
+	}
+}
+
+func TestMQTT_ConnectRefused(t *testing.T) {
+	store := &eventStore{}
+	conn := newTestConn(newTestPlugin(t, store, defaultConfig))
+
+	conn.client(connectPacket(protocolLevel5, "sensor-42"))
+	conn.server(packet(packetConnAck, 0, []byte{0x00, 0x86, 0x00}))
+
+	require.Len(t, store.events, 1)
+	evt := store.events[0]
+	assert.Equal(t, common.ERROR_STATUS, evt.Fields["status"])
+	assert.Equal(t, "5.0", getValue(evt, "mqtt.protocol_version"))
+	assert.Equal(t, "Bad User Name or Password", getValue(evt, "mqtt.reason"))
+}
+
+func TestMQTT_PublishQoS0(t *testing.T) {
+	store := &eventStore{}
+	conn := newTestConn(newTestPlugin(t, store, defaultConfig))
+
+	conn.client(connectPacket(protocolLevel311, "sensor-42"))
+	conn.server(packet(packetConnAck, 0, []byte{0x00, 0x00}))
+	conn.client(packet(packetPublish, 0, str("sensors/temp"), []byte("21.5")))
+
+	require.Len(t, store.events, 2)
+	evt := store.events[1]
+	assert.Equal(t, "PUBLISH", evt.Fields["method"])
+	assert.Equal(t, "PUBLISH sensors/temp", evt.Fields["query"])
+	assert.Equal(t, "sensors/temp", getValue(evt, "mqtt.topic"))
+	assert.Equal(t, uint8(0), getValue(evt, "mqtt.qos"))
+	assert.Equal(t, "sensor-42", getValue(evt, "mqtt.client_id"))
+	assert.Nil(t, getValue(evt, "mqtt.payload_size"))
+	assert.Nil(t, getValue(evt, "mqtt.response_type"))
+}
+
+func TestMQTT_PublishQoS1(t *testing.T) {
+	store := &eventStore{}
+	config := defaultConfig
+	config.IncludePayloadSize = true
+	conn := newTestConn(newTestPlugin(t, store, config))
+
+	// The server delivers to the client, the client acknowledges.
+	conn.server(packet(packetPublish, 0x02, str("cmd/reboot"), u16(10), []byte("now")))
+	conn.client(packet(packetPubAck, 0, u16(10)))
+
+	require.Len(t, store.events, 1)
+	evt := store.events[0]
+	assert.Equal(t, common.OK_STATUS, evt.Fields["status"])
+	assert.Equal(t, uint16(10), getValue(evt, "mqtt.packet_id"))
+	assert.Equal(t, uint8(1), getValue(evt, "mqtt.qos"))
+	assert.Equal(t, 3, getValue(evt, "mqtt.payload_size"))
+	assert.Equal(t, "PUBACK", getValue(evt, "mqtt.response_type"))
+	assert.Equal(t, "192.168.0.2", getValue(evt, "source.ip"))
+	assert.Equal(t, "192.168.0.1", getValue(evt, "destination.ip"))
+}
+
+func TestMQTT_PublishQoS2(t *testing.T) {
+	store := &eventStore{}
+	conn := newTestConn(newTestPlugin(t, store, defaultConfig))
+
+	publish := packet(packetPublish, 0x04, str("a/b"), u16(1), []byte("x"))
+	pubrec := packet(packetPubRec, 0, u16(1))
+	pubrel := packet(packetPubRel, 0x02, u16(1))
+	pubcomp := packet(packetPubComp, 0, u16(1))
+
+	conn.client(publish)
+	conn.server(pubrec)
+	conn.client(pubrel)
+	assert.Empty(t, store.events)
+	conn.server(pubcomp)
+
+	require.Len(t, store.events, 1)
+	evt := store.events[0]
+	assert.Equal(t, "PUBCOMP", getValue(evt, "mqtt.response_type"))
+	assert.Equal(t, 30*time.Millisecond, getValue(evt, "event.duration"))
+	assert.Equal(t, int64(len(publish)+len(pubrel)), getValue(evt, "source.bytes"))
+	assert.Equal(t, int64(len(pubrec)+len(pubcomp)), getValue(evt, "destination.bytes"))
+}
+
+func TestMQTT_Subscribe(t *testing.T) {
+	store := &eventStore{}
+	conn := newTestConn(newTestPlugin(t, store, defaultConfig))
+
+	conn.client(packet(packetSubscribe, 0x02, u16(3), str("a/#"), []byte{1}, str("b/+"), []byte{2}))
+	conn.client(packet(packetUnsubscribe, 0x02, u16(4), str("c")))
+	conn.server(packet(packetSubAck, 0, u16(3), []byte{1, 0x80}))
+	conn.server(packet(packetUnsubAck, 0, u16(4)))
+
+	require.Len(t, store.events, 2)
+	sub, unsub := store.events[0], store.events[1]
+	assert.Equal(t, "SUBSCRIBE a/#, b/+", sub.Fields["query"])
+	assert.Equal(t, []string{"a/#", "b/+"}, getValue(sub, "mqtt.topics"))
+	assert.Equal(t, []int{1, 0x80}, getValue(sub, "mqtt.reason_codes"))
+	assert.Equal(t, common.ERROR_STATUS, sub.Fields["status"])
+
+	assert.Equal(t, "UNSUBSCRIBE", unsub.Fields["method"])
+	assert.Equal(t, common.OK_STATUS, unsub.Fields["status"])
+}
+
+func TestMQTT_LargePublishSplit(t *testing.T) {
+	store := &eventStore{}
+	config := defaultConfig
+	config.IncludePayloadSize = true
+	conn := newTestConn(newTestPlugin(t, store, config))
+
+	payload := make([]byte, 100000)
+	publish := packet(packetPublish, 0x02, str("firmware/update"), u16(5), payload)
+	puback := packet(packetPubAck, 0, u16(5))
+
+	// deliver in TCP sized segments, the ack arrives in the last segment
+	// of the client stream.
+	for len(publish) > 0 {
+		n := 1400
+		if n > len(publish) {
+			n = len(publish)
+		}
+		conn.client(publish[:n])
+		publish = publish[n:]
+	}
+	conn.server(puback)
+
+	require.Len(t, store.events, 1)
+	evt := store.events[0]
+	assert.Equal(t, 100000, getValue(evt, "mqtt.payload_size"))
+	assert.Equal(t, "firmware/update", getValue(evt, "mqtt.topic"))
+
+	// The stream continues to be decoded after the skipped payload.
+	conn.client(packet(packetPublish, 0, str("next"), []byte("x")))
+	require.Len(t, store.events, 2)
+	assert.Equal(t, "next", getValue(store.events[1], "mqtt.topic"))
+}
+
+func TestMQTT_Ping(t *testing.T) {
+	for _, include := range []bool{false, true} {
+		store := &eventStore{}
+		config := defaultConfig
+		config.IncludePingRequests = include
+		conn := newTestConn(newTestPlugin(t, store, config))
+
+		conn.client(packet(packetPingReq, 0))
+		conn.server(packet(packetPingResp, 0))
+
+		if include {
+			require.Len(t, store.events, 1)
+			assert.Equal(t, "PINGREQ", store.events[0].Fields["method"])
+		} else {
+			assert.Empty(t, store.events)
+		}
+	}
+}
+
+func TestMQTT_ExpirePending(t *testing.T) {
+	store := &eventStore{}
+	plugin := newTestPlugin(t, store, defaultConfig)
+	conn := newTestConn(plugin)
+
+	conn.client(packet(packetPublish, 0x02, str("a"), u16(1), []byte("x")))
+	conn.ts = conn.ts.Add(2 * defaultConfig.TransactionTimeout)
+	conn.client(packet(packetPublish, 0x02, str("b"), u16(2), []byte("x")))
+
+	// the ack for the expired message is not correlated anymore
+	conn.server(packet(packetPubAck, 0, u16(1)))
+	conn.server(packet(packetPubAck, 0, u16(2)))
+
+	require.Len(t, store.events, 1)
+	assert.Equal(t, "b", getValue(store.events[0], "mqtt.topic"))
+}
+
+func TestMQTT_InvalidData(t *testing.T) {
+	store := &eventStore{}
+	conn := newTestConn(newTestPlugin(t, store, defaultConfig))
+
+	conn.client([]byte{0x00, 0x01, 0x02})
+	assert.Nil(t, conn.private.(*connection).streams[tcp.TCPDirectionOriginal])
+
+	// parsing resumes with the next segment
+	conn.client(packet(packetPublish, 0, str("a"), []byte("x")))
+	require.Len(t, store.events, 1)
+}
@@ -0,0 +1,93 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package mqtt
+
+// connectReturnCodes are the CONNACK return codes of MQTT 3.1 and 3.1.1.
+var connectReturnCodes = map[uint8]string{
+	0: "Connection Accepted",
+	1: "Unacceptable protocol version",
+	2: "Identifier rejected",
+	3: "Server unavailable",
+	4: "Bad user name or password",
+	5: "Not authorized",
+}
+
+// reasonCodes are the MQTT 5.0 reason codes. Codes below 0x80 indicate
+// success, 0x80 and above indicate failure. In MQTT 3.1.1 only the SUBACK
+// return code 0x80 (Failure) is defined in this range.
+var reasonCodes = map[uint8]string{
+	0x00: "Success",
+	0x01: "Granted QoS 1",
+	0x02: "Granted QoS 2",
+	0x04: "Disconnect with Will Message",
+	0x10: "No matching subscribers",
+	0x11: "No subscription existed",
+	0x18: "Continue authentication",
+	0x19: "Re-authenticate",
+	0x80: "Unspecified error",
+	0x81: "Malformed Packet",
+	0x82: "Protocol Error",
+	0x83: "Implementation specific error",
+	0x84: "Unsupported Protocol Version",
+	0x85: "Client Identifier not valid",
+	0x86: "Bad User Name or Password",
+	0x87: "Not authorized",
+	0x88: "Server unavailable",
+	0x89: "Server busy",
+	0x8A: "Banned",
+	0x8B: "Server shutting down",
+	0x8C: "Bad authentication method",
+	0x8D: "Keep Alive timeout",
+	0x8E: "Session taken over",
+	0x8F: "Topic Filter invalid",
+	0x90: "Topic Name invalid",
+	0x91: "Packet Identifier in use",
+	0x92: "Packet Identifier not found",
+	0x93: "Receive Maximum exceeded",
+	0x94: "Topic Alias invalid",
+	0x95: "Packet too large",
+	0x96: "Message rate too high",
+	0x97: "Quota exceeded",
+	0x98: "Administrative action",
+	0x99: "Payload format invalid",
+	0x9A: "Retain not supported",
+	0x9B: "QoS not supported",
+	0x9C: "Use another server",
+	0x9D: "Server moved",
+	0x9E: "Shared Subscriptions not supported",
+	0x9F: "Connection rate exceeded",
+	0xA0: "Maximum connect time",
+	0xA1: "Subscription Identifiers not supported",
+	0xA2: "Wildcard Subscriptions not supported",
+}
+
+// reasonName returns the description of the reason code of a packet.
+func reasonName(typ packetType, level uint8, code uint8) string {
+	if typ == packetConnAck && level != protocolLevel5 {
+		return connectReturnCodes[code]
+	}
+	return reasonCodes[code]
+}
+
+// isFailure reports if a reason code signals an error.
+func isFailure(typ packetType, level uint8, code uint8) bool {
+	if typ == packetConnAck && level != protocolLevel5 {
+		return code != 0
+	}
+	return code >= 0x80
+}
@@ -18,8 +18,10 @@
 package sniffer
 
 import (
+	"bufio"
 	"fmt"
 	"io"
+	"os"
 	"time"
 
 	"github.com/tsg/gopacket"
@@ -30,20 +32,49 @@ import (
 )
 
 type fileHandler struct {
-	pcapHandle *pcap.Handle
-	file       string
+	source packetSource
+	file   string
 
 	loopCount, maxLoopCount int
 
 	topSpeed bool
-	lastTS   time.Time
+	speed    float64
+
+	// replay timing: packets are published at replayStart plus the time
+	// passed since firstTS in the file, divided by speed.
+	firstTS     time.Time
+	lastTS      time.Time
+	replayStart time.Time
+	sleep       func(time.Duration)
+	now         func() time.Time
 }
 
-func newFileHandler(file string, topSpeed bool, maxLoopCount int) (*fileHandler, error) {
+// packetSource is a file based packet reader, either libpcap for classic
+// pcap files or the pcapng reader.
+type packetSource interface {
+	gopacket.PacketDataSource
+	LinkType() layers.LinkType
+	Close()
+}
+
+// pcapngFile wraps the pcapng reader with the file it is reading from.
+type pcapngFile struct {
+	*pcapngReader
+	file *os.File
+}
+
+func newFileHandler(file string, topSpeed bool, speed float64, maxLoopCount int) (*fileHandler, error) {
+	if speed <= 0 {
+		speed = 1
+	}
+
 	h := &fileHandler{
 		file:         file,
 		topSpeed:     topSpeed,
+		speed:        speed,
 		maxLoopCount: maxLoopCount,
+		sleep:        time.Sleep,
+		now:          time.Now,
 	}
 	if err := h.open(); err != nil {
 		return nil, err
@@ -53,24 +84,53 @@ func newFileHandler(file string, topSpeed bool, maxLoopCount int) (*fileHandler,
 }
 
 func (h *fileHandler) open() error {
-	tmp, err := pcap.OpenOffline(h.file)
+	tmp, err := openPacketSource(h.file)
 	if err != nil {
 		return err
 	}
 
-	h.pcapHandle = tmp
+	h.source = tmp
+	h.firstTS = time.Time{}
+	h.lastTS = time.Time{}
 	return nil
 }
 
+// openPacketSource opens the file with the pcapng reader if the file starts
+// with a pcapng section header, else libpcap is used.
+func openPacketSource(file string) (packetSource, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+
+	r := bufio.NewReader(f)
+	ng, err := isPcapng(r)
+	if err != nil || !ng {
+		f.Close()
+		return pcap.OpenOffline(file)
+	}
+
+	reader, err := newPcapngReader(r)
+	if err != nil {
+		f.Close()
+		return nil, fmt.Errorf("Error reading pcapng file %s: %v", file, err)
+	}
+	return &pcapngFile{pcapngReader: reader, file: f}, nil
+}
+
+func (f *pcapngFile) Close() {
+	f.file.Close()
+}
+
 func (h *fileHandler) ReadPacketData() ([]byte, gopacket.CaptureInfo, error) {
-	data, ci, err := h.pcapHandle.ReadPacketData()
+	data, ci, err := h.source.ReadPacketData()
 	if err != nil {
 		if err != io.EOF {
 			return data, ci, err
 		}
 
-		h.pcapHandle.Close()
-		h.pcapHandle = nil
+		h.source.Close()
+		h.source = nil
 
 		h.loopCount++
 		if h.loopCount >= h.maxLoopCount {
@@ -82,36 +142,60 @@ func (h *fileHandler) ReadPacketData() ([]byte, gopacket.CaptureInfo, error) {
 			return nil, ci, fmt.Errorf("Error reopening file: %s", err)
 		}
 
-		data, ci, err = h.pcapHandle.ReadPacketData()
-		h.lastTS = ci.Timestamp
-		return data, ci, err
+		data, ci, err = h.source.ReadPacketData()
+		if err != nil {
+			return data, ci, err
+		}
 	}
 
 	if h.topSpeed {
 		return data, ci, nil
 	}
 
-	if !h.lastTS.IsZero() {
-		sleep := ci.Timestamp.Sub(h.lastTS)
-		if sleep > 0 {
-			time.Sleep(sleep)
-		} else {
-			logp.Warn("Time in pcap went backwards: %d", sleep)
-		}
+	h.wait(ci.Timestamp)
+	ci.Timestamp = h.now()
+	return data, ci, nil
+}
+
+// wait blocks until the packet with timestamp ts is due, based on the
+// timestamp of the first packet and the replay speed. Waiting for the
+// absolute point in time, instead of the gap to the previous packet, keeps
+// processing delays from accumulating.
+func (h *fileHandler) wait(ts time.Time) {
+	if !h.lastTS.IsZero() && ts.Before(h.lastTS) {
+		logp.Warn("Time in pcap went backwards: %v", ts.Sub(h.lastTS))
+		h.firstTS = time.Time{}
 	}
+	h.lastTS = ts
 
-	h.lastTS = ci.Timestamp
-	ci.Timestamp = time.Now()
-	return data, ci, nil
+	if h.firstTS.IsZero() {
+		h.firstTS = ts
+		h.replayStart = h.now()
+		return
+	}
+
+	offset := time.Duration(float64(ts.Sub(h.firstTS)) / h.speed)
+	if d := h.replayStart.Add(offset).Sub(h.now()); d > 0 {
+		h.sleep(d)
+	}
 }
 
 func (h *fileHandler) LinkType() layers.LinkType {
-	return h.pcapHandle.LinkType()
+	return h.source.LinkType()
+}
+
+// InterfaceLinkType returns the link type of the interface the last packet
+// has been captured on. Only pcapng files can contain multiple interfaces.
+func (h *fileHandler) InterfaceLinkType() layers.LinkType {
+	if ng, ok := h.source.(*pcapngFile); ok {
+		return ng.InterfaceLinkType()
+	}
+	return h.source.LinkType()
 }
 
 func (h *fileHandler) Close() {
-	if h.pcapHandle != nil {
-		h.pcapHandle.Close()
-		h.pcapHandle = nil
+	if h.source != nil {
+		h.source.Close()
+		h.source = nil
 	}
 }
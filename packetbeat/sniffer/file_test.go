@@ -0,0 +1,71 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package sniffer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileHandlerReplaySpeed(t *testing.T) {
+	for _, speed := range []float64{1, 2, 0.5} {
+		now := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+		h := &fileHandler{
+			speed: speed,
+			now:   func() time.Time { return now },
+			sleep: func(d time.Duration) { now = now.Add(d) },
+		}
+		start := now
+
+		ts := time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)
+		h.wait(ts)
+		assert.Equal(t, start, now)
+
+		// Processing time is not added to the gap between packets.
+		now = now.Add(100 * time.Millisecond)
+		h.wait(ts.Add(time.Second))
+		assert.Equal(t, start.Add(time.Duration(float64(time.Second)/speed)), now, "speed %v", speed)
+
+		h.wait(ts.Add(3 * time.Second))
+		assert.Equal(t, start.Add(time.Duration(float64(3*time.Second)/speed)), now, "speed %v", speed)
+	}
+}
+
+func TestFileHandlerTimeGoingBackwards(t *testing.T) {
+	now := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	h := &fileHandler{
+		speed: 1,
+		now:   func() time.Time { return now },
+		sleep: func(d time.Duration) { now = now.Add(d) },
+	}
+
+	ts := time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)
+	h.wait(ts)
+	h.wait(ts.Add(time.Second))
+
+	// Replay continues immediately and restarts timing from the new packet.
+	before := now
+	h.wait(ts.Add(-time.Hour))
+	assert.Equal(t, before, now)
+	h.wait(ts.Add(-time.Hour + time.Second))
+	assert.Equal(t, before.Add(time.Second), now)
+}
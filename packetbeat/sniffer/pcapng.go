@@ -0,0 +1,374 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sniffer
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/bits"
+	"time"
+
+	"github.com/tsg/gopacket"
+	"github.com/tsg/gopacket/layers"
+)
+
+// pcapng block types, see
+// https://github.com/pcapng/pcapng/blob/master/draft-tuexen-opsawg-pcapng.xml
+const (
+	pcapngSectionHeader        uint32 = 0x0A0D0D0A
+	pcapngInterfaceDescription uint32 = 0x00000001
+	pcapngObsoletePacket       uint32 = 0x00000002
+	pcapngSimplePacket         uint32 = 0x00000003
+	pcapngEnhancedPacket       uint32 = 0x00000006
+
+	pcapngByteOrderMagic uint32 = 0x1A2B3C4D
+
+	pcapngOptionEnd      = 0
+	pcapngOptionTSResol  = 9
+	pcapngOptionTSOffset = 14
+
+	// pcapngMaxBlockSize limits the memory allocated for a single block.
+	pcapngMaxBlockSize = 16 * 1024 * 1024
+)
+
+var (
+	errPcapngInvalidMagic = errors.New("pcapng: invalid byte order magic")
+	errPcapngNoInterface  = errors.New("pcapng: no interface description found")
+)
+
+// pcapngReader reads packets from a pcapng file. Files can contain multiple
+// sections and interfaces with different link types. The interface of the
+// packet read last is available via InterfaceLinkType.
+type pcapngReader struct {
+	r     *bufio.Reader
+	order binary.ByteOrder
+
+	interfaces []pcapngInterface
+	current    int // interface of the last packet read
+
+	buf []byte
+}
+
+type pcapngInterface struct {
+	linkType layers.LinkType
+	snaplen  uint32
+
+	// resolution of the timestamps in units per second
+	tsResolution uint64
+	tsOffset     int64
+}
+
+// isPcapng reports whether the file starts with a pcapng section header.
+func isPcapng(r *bufio.Reader) (bool, error) {
+	magic, err := r.Peek(4)
+	if err != nil {
+		return false, err
+	}
+	return binary.LittleEndian.Uint32(magic) == pcapngSectionHeader, nil
+}
+
+func newPcapngReader(r io.Reader) (*pcapngReader, error) {
+	br, ok := r.(*bufio.Reader)
+	if !ok {
+		br = bufio.NewReader(r)
+	}
+
+	p := &pcapngReader{r: br}
+
+	// Read blocks up to the first interface description, so the link type
+	// is known before the first packet is read.
+	for len(p.interfaces) == 0 {
+		blockType, body, err := p.readBlock()
+		if err != nil {
+			if err == io.EOF {
+				return nil, errPcapngNoInterface
+			}
+			return nil, err
+		}
+		if err := p.handleBlock(blockType, body); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// LinkType returns the link type of the first interface in the file.
+func (p *pcapngReader) LinkType() layers.LinkType {
+	return p.interfaces[0].linkType
+}
+
+// InterfaceLinkType returns the link type of the interface the last packet
+// was captured on.
+func (p *pcapngReader) InterfaceLinkType() layers.LinkType {
+	return p.interfaces[p.current].linkType
+}
+
+// ReadPacketData returns the next packet in the file, skipping all non
+// packet blocks.
+func (p *pcapngReader) ReadPacketData() ([]byte, gopacket.CaptureInfo, error) {
+	for {
+		blockType, body, err := p.readBlock()
+		if err != nil {
+			return nil, gopacket.CaptureInfo{}, err
+		}
+
+		switch blockType {
+		case pcapngEnhancedPacket:
+			return p.parseEnhancedPacket(body)
+		case pcapngSimplePacket:
+			return p.parseSimplePacket(body)
+		case pcapngObsoletePacket:
+			return p.parseObsoletePacket(body)
+		default:
+			if err := p.handleBlock(blockType, body); err != nil {
+				return nil, gopacket.CaptureInfo{}, err
+			}
+		}
+	}
+}
+
+func (p *pcapngReader) handleBlock(blockType uint32, body []byte) error {
+	switch blockType {
+	case pcapngSectionHeader:
+		// A new section starts, interface IDs are local to a section.
+		p.interfaces = p.interfaces[:0]
+		p.current = 0
+		return nil
+	case pcapngInterfaceDescription:
+		return p.parseInterface(body)
+	default:
+		// Ignore unsupported blocks like statistics or name resolution.
+		return nil
+	}
+}
+
+// readBlock reads the next block and returns its type and body. The body
+// buffer is reused by the next call.
+func (p *pcapngReader) readBlock() (uint32, []byte, error) {
+	var hdr [12]byte
+	if _, err := io.ReadFull(p.r, hdr[:8]); err != nil {
+		if err == io.ErrUnexpectedEOF {
+			return 0, nil, io.EOF
+		}
+		return 0, nil, err
+	}
+
+	// The byte order of a section is only known once the byte order magic
+	// of the section header has been read.
+	if binary.LittleEndian.Uint32(hdr[:4]) == pcapngSectionHeader {
+		if _, err := io.ReadFull(p.r, hdr[8:12]); err != nil {
+			return 0, nil, err
+		}
+		switch pcapngByteOrderMagic {
+		case binary.LittleEndian.Uint32(hdr[8:12]):
+			p.order = binary.LittleEndian
+		case binary.BigEndian.Uint32(hdr[8:12]):
+			p.order = binary.BigEndian
+		default:
+			return 0, nil, errPcapngInvalidMagic
+		}
+		body, err := p.readBody(p.order.Uint32(hdr[4:8]), 12)
+		return pcapngSectionHeader, body, err
+	}
+
+	if p.order == nil {
+		return 0, nil, errors.New("pcapng: file does not start with a section header")
+	}
+
+	blockType := p.order.Uint32(hdr[:4])
+	body, err := p.readBody(p.order.Uint32(hdr[4:8]), 8)
+	return blockType, body, err
+}
+
+// readBody reads the remaining block body and the trailing length field.
+// consumed is the number of bytes of the block already read.
+func (p *pcapngReader) readBody(length uint32, consumed int) ([]byte, error) {
+	if length < 12 || length%4 != 0 || length > pcapngMaxBlockSize {
+		return nil, fmt.Errorf("pcapng: invalid block length %d", length)
+	}
+
+	n := int(length) - consumed
+	if cap(p.buf) < n {
+		p.buf = make([]byte, n)
+	}
+	buf := p.buf[:n]
+	if _, err := io.ReadFull(p.r, buf); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+
+	if trailer := p.order.Uint32(buf[n-4:]); trailer != length {
+		return nil, fmt.Errorf("pcapng: block length mismatch (%d != %d)", length, trailer)
+	}
+	return buf[:n-4], nil
+}
+
+func (p *pcapngReader) parseInterface(body []byte) error {
+	if len(body) < 8 {
+		return errors.New("pcapng: interface description block too short")
+	}
+
+	iface := pcapngInterface{
+		linkType:     layers.LinkType(p.order.Uint16(body[0:2])),
+		snaplen:      p.order.Uint32(body[4:8]),
+		tsResolution: 1000000,
+	}
+
+	err := p.parseOptions(body[8:], func(code uint16, value []byte) error {
+		switch code {
+		case pcapngOptionTSResol:
+			if len(value) != 1 {
+				return errors.New("pcapng: invalid if_tsresol option")
+			}
+			exp := uint(value[0] & 0x7f)
+			if value[0]&0x80 == 0 {
+				if exp > 19 {
+					return fmt.Errorf("pcapng: unsupported timestamp resolution 10^-%d", exp)
+				}
+				iface.tsResolution = uint64(math.Pow10(int(exp)))
+			} else {
+				if exp > 63 {
+					return fmt.Errorf("pcapng: unsupported timestamp resolution 2^-%d", exp)
+				}
+				iface.tsResolution = 1 << exp
+			}
+		case pcapngOptionTSOffset:
+			if len(value) != 8 {
+				return errors.New("pcapng: invalid if_tsoffset option")
+			}
+			iface.tsOffset = int64(p.order.Uint64(value))
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	p.interfaces = append(p.interfaces, iface)
+	return nil
+}
+
+// parseOptions calls fn for every option in the options list.
+func (p *pcapngReader) parseOptions(opts []byte, fn func(code uint16, value []byte) error) error {
+	for len(opts) >= 4 {
+		code := p.order.Uint16(opts[0:2])
+		length := int(p.order.Uint16(opts[2:4]))
+		if code == pcapngOptionEnd {
+			return nil
+		}
+
+		padded := (length + 3) &^ 3
+		if len(opts) < 4+padded {
+			return errors.New("pcapng: option exceeds block")
+		}
+		if err := fn(code, opts[4:4+length]); err != nil {
+			return err
+		}
+		opts = opts[4+padded:]
+	}
+	return nil
+}
+
+func (p *pcapngReader) parseEnhancedPacket(body []byte) ([]byte, gopacket.CaptureInfo, error) {
+	if len(body) < 20 {
+		return nil, gopacket.CaptureInfo{}, errors.New("pcapng: enhanced packet block too short")
+	}
+
+	ifaceID := p.order.Uint32(body[0:4])
+	ts := uint64(p.order.Uint32(body[4:8]))<<32 | uint64(p.order.Uint32(body[8:12]))
+	capLen := p.order.Uint32(body[12:16])
+	origLen := p.order.Uint32(body[16:20])
+	return p.packet(ifaceID, ts, capLen, origLen, body[20:])
+}
+
+func (p *pcapngReader) parseObsoletePacket(body []byte) ([]byte, gopacket.CaptureInfo, error) {
+	if len(body) < 20 {
+		return nil, gopacket.CaptureInfo{}, errors.New("pcapng: packet block too short")
+	}
+
+	ifaceID := uint32(p.order.Uint16(body[0:2]))
+	ts := uint64(p.order.Uint32(body[4:8]))<<32 | uint64(p.order.Uint32(body[8:12]))
+	capLen := p.order.Uint32(body[12:16])
+	origLen := p.order.Uint32(body[16:20])
+	return p.packet(ifaceID, ts, capLen, origLen, body[20:])
+}
+
+// parseSimplePacket parses a simple packet block. Simple packets have no
+// timestamp and are always captured on the first interface.
+func (p *pcapngReader) parseSimplePacket(body []byte) ([]byte, gopacket.CaptureInfo, error) {
+	if len(body) < 4 {
+		return nil, gopacket.CaptureInfo{}, errors.New("pcapng: simple packet block too short")
+	}
+	if len(p.interfaces) == 0 {
+		return nil, gopacket.CaptureInfo{}, errPcapngNoInterface
+	}
+
+	origLen := p.order.Uint32(body[0:4])
+	capLen := origLen
+	if snaplen := p.interfaces[0].snaplen; snaplen > 0 && capLen > snaplen {
+		capLen = snaplen
+	}
+	if int(capLen) > len(body)-4 {
+		capLen = uint32(len(body) - 4)
+	}
+
+	p.current = 0
+	data := make([]byte, capLen)
+	copy(data, body[4:])
+	return data, gopacket.CaptureInfo{CaptureLength: int(capLen), Length: int(origLen)}, nil
+}
+
+func (p *pcapngReader) packet(ifaceID uint32, ts uint64, capLen, origLen uint32, payload []byte) ([]byte, gopacket.CaptureInfo, error) {
+	if int(ifaceID) >= len(p.interfaces) {
+		return nil, gopacket.CaptureInfo{}, fmt.Errorf("pcapng: packet references unknown interface %d", ifaceID)
+	}
+	if int(capLen) > len(payload) {
+		return nil, gopacket.CaptureInfo{}, errors.New("pcapng: captured length exceeds block")
+	}
+
+	iface := &p.interfaces[ifaceID]
+	p.current = int(ifaceID)
+
+	// The packet data must not be shared with the reused block buffer.
+	data := make([]byte, capLen)
+	copy(data, payload)
+
+	ci := gopacket.CaptureInfo{
+		Timestamp:     iface.timestamp(ts),
+		CaptureLength: int(capLen),
+		Length:        int(origLen),
+	}
+	return data, ci, nil
+}
+
+func (i *pcapngInterface) timestamp(ts uint64) time.Time {
+	secs := ts / i.tsResolution
+	frac := ts % i.tsResolution
+
+	// frac * 10^9 can overflow for fine grained resolutions, use 128bit
+	// arithmetic. frac < tsResolution guarantees the quotient fits.
+	hi, lo := bits.Mul64(frac, uint64(time.Second))
+	nsecs, _ := bits.Div64(hi, lo, i.tsResolution)
+	return time.Unix(int64(secs)+i.tsOffset, int64(nsecs)).UTC()
+}
@@ -0,0 +1,185 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package sniffer
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tsg/gopacket/layers"
+)
+
+// pcapngWriter builds pcapng test files.
+type pcapngWriter struct {
+	buf   bytes.Buffer
+	order binary.ByteOrder
+}
+
+func (w *pcapngWriter) block(blockType uint32, body []byte) {
+	for len(body)%4 != 0 {
+		body = append(body, 0)
+	}
+	length := uint32(len(body) + 12)
+	binary.Write(&w.buf, w.order, blockType)
+	binary.Write(&w.buf, w.order, length)
+	w.buf.Write(body)
+	binary.Write(&w.buf, w.order, length)
+}
+
+func (w *pcapngWriter) sectionHeader() {
+	body := make([]byte, 16)
+	w.order.PutUint32(body[0:], pcapngByteOrderMagic)
+	w.order.PutUint16(body[4:], 1)
+	w.order.PutUint64(body[8:], 0xffffffffffffffff)
+	w.block(pcapngSectionHeader, body)
+}
+
+func (w *pcapngWriter) interfaceDescription(linkType layers.LinkType, tsresol byte) {
+	body := make([]byte, 8)
+	w.order.PutUint16(body[0:], uint16(linkType))
+	w.order.PutUint32(body[4:], 65535)
+	if tsresol != 0 {
+		opt := make([]byte, 8)
+		w.order.PutUint16(opt[0:], pcapngOptionTSResol)
+		w.order.PutUint16(opt[2:], 1)
+		opt[4] = tsresol
+		body = append(body, opt...)
+		body = append(body, 0, 0, 0, 0) // opt_endofopt
+	}
+	w.block(pcapngInterfaceDescription, body)
+}
+
+func (w *pcapngWriter) enhancedPacket(iface uint32, ts uint64, data []byte) {
+	body := make([]byte, 20)
+	w.order.PutUint32(body[0:], iface)
+	w.order.PutUint32(body[4:], uint32(ts>>32))
+	w.order.PutUint32(body[8:], uint32(ts))
+	w.order.PutUint32(body[12:], uint32(len(data)))
+	w.order.PutUint32(body[16:], uint32(len(data)))
+	w.block(pcapngEnhancedPacket, append(body, data...))
+}
+
+func (w *pcapngWriter) simplePacket(data []byte) {
+	body := make([]byte, 4)
+	w.order.PutUint32(body[0:], uint32(len(data)))
+	w.block(pcapngSimplePacket, append(body, data...))
+}
+
+func TestPcapngMultipleInterfaces(t *testing.T) {
+	w := &pcapngWriter{order: binary.LittleEndian}
+	w.sectionHeader()
+	w.interfaceDescription(layers.LinkTypeEthernet, 0)
+	w.interfaceDescription(layers.LinkTypeLinuxSLL, 9) // nanoseconds
+	w.block(0x00000005, make([]byte, 12))               // interface statistics, ignored
+	w.enhancedPacket(0, 1500000000123456, []byte{1, 2, 3})
+	w.enhancedPacket(1, 1500000000123456789, []byte{4, 5, 6, 7, 8})
+	w.simplePacket([]byte{9})
+
+	r, err := newPcapngReader(bytes.NewReader(w.buf.Bytes()))
+	require.NoError(t, err)
+	assert.Equal(t, layers.LinkTypeEthernet, r.LinkType())
+
+	data, ci, err := r.ReadPacketData()
+	require.NoError(t, err)
+	assert.Equal(t, []byte{1, 2, 3}, data)
+	assert.Equal(t, 3, ci.CaptureLength)
+	assert.Equal(t, time.Unix(1500000000, 123456000).UTC(), ci.Timestamp)
+	assert.Equal(t, layers.LinkTypeEthernet, r.InterfaceLinkType())
+
+	data, ci, err = r.ReadPacketData()
+	require.NoError(t, err)
+	assert.Equal(t, []byte{4, 5, 6, 7, 8}, data)
+	assert.Equal(t, time.Unix(1500000000, 123456789).UTC(), ci.Timestamp)
+	assert.Equal(t, layers.LinkTypeLinuxSLL, r.InterfaceLinkType())
+
+	data, _, err = r.ReadPacketData()
+	require.NoError(t, err)
+	assert.Equal(t, []byte{9}, data)
+	assert.Equal(t, layers.LinkTypeEthernet, r.InterfaceLinkType())
+
+	_, _, err = r.ReadPacketData()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestPcapngMultipleSections(t *testing.T) {
+	w := &pcapngWriter{order: binary.LittleEndian}
+	w.sectionHeader()
+	w.interfaceDescription(layers.LinkTypeEthernet, 0)
+	w.enhancedPacket(0, 1000000, []byte{1})
+
+	// Interface IDs are local to a section, the byte order can change.
+	w.order = binary.BigEndian
+	w.sectionHeader()
+	w.interfaceDescription(layers.LinkTypeRaw, 0x80|10) // 2^-10 seconds
+	w.enhancedPacket(0, 3*1024+512, []byte{2})
+
+	r, err := newPcapngReader(bytes.NewReader(w.buf.Bytes()))
+	require.NoError(t, err)
+
+	data, ci, err := r.ReadPacketData()
+	require.NoError(t, err)
+	assert.Equal(t, []byte{1}, data)
+	assert.Equal(t, time.Unix(1, 0).UTC(), ci.Timestamp)
+
+	data, ci, err = r.ReadPacketData()
+	require.NoError(t, err)
+	assert.Equal(t, []byte{2}, data)
+	assert.Equal(t, time.Unix(3, int64(500*time.Millisecond)).UTC(), ci.Timestamp)
+	assert.Equal(t, layers.LinkTypeRaw, r.InterfaceLinkType())
+}
+
+func TestPcapngErrors(t *testing.T) {
+	t.Run("no interface", func(t *testing.T) {
+		w := &pcapngWriter{order: binary.LittleEndian}
+		w.sectionHeader()
+		_, err := newPcapngReader(bytes.NewReader(w.buf.Bytes()))
+		assert.Equal(t, errPcapngNoInterface, err)
+	})
+
+	t.Run("unknown interface", func(t *testing.T) {
+		w := &pcapngWriter{order: binary.LittleEndian}
+		w.sectionHeader()
+		w.interfaceDescription(layers.LinkTypeEthernet, 0)
+		w.enhancedPacket(1, 0, []byte{1})
+
+		r, err := newPcapngReader(bytes.NewReader(w.buf.Bytes()))
+		require.NoError(t, err)
+		_, _, err = r.ReadPacketData()
+		assert.Error(t, err)
+	})
+
+	t.Run("truncated block", func(t *testing.T) {
+		w := &pcapngWriter{order: binary.LittleEndian}
+		w.sectionHeader()
+		w.interfaceDescription(layers.LinkTypeEthernet, 0)
+		w.enhancedPacket(0, 0, []byte{1, 2, 3, 4})
+
+		raw := w.buf.Bytes()
+		r, err := newPcapngReader(bytes.NewReader(raw[:len(raw)-6]))
+		require.NoError(t, err)
+		_, _, err = r.ReadPacketData()
+		assert.Equal(t, io.ErrUnexpectedEOF, err)
+	})
+}
@@ -65,6 +65,13 @@ type snifferHandle interface {
 	Close()
 }
 
+// multiLinkHandle is implemented by handles reading packets from multiple
+// interfaces with potentially different link types, like pcapng files.
+type multiLinkHandle interface {
+	// InterfaceLinkType returns the link type of the last packet read.
+	InterfaceLinkType() layers.LinkType
+}
+
 // sniffer state values
 const (
 	snifferInactive = 0
@@ -161,11 +168,17 @@ func (s *Sniffer) Run() error {
 		defer dumper.Close()
 	}
 
-	worker, err := s.factory(handle.LinkType())
+	linkType := handle.LinkType()
+	worker, err := s.factory(linkType)
 	if err != nil {
 		return err
 	}
 
+	// Packets of interfaces with another link type than the first one are
+	// forwarded to a separate worker.
+	multiLink, _ := handle.(multiLinkHandle)
+	workers := map[layers.LinkType]Worker{linkType: worker}
+
 	// Mark inactive sniffer as active. In case of the sniffer/packetbeat closing
 	// before/while Run is executed, the state will be snifferClosing.
 	// => return if state is already snifferClosing.
@@ -201,13 +214,32 @@ func (s *Sniffer) Run() error {
 			continue
 		}
 
+		packetLinkType := linkType
+		if multiLink != nil {
+			packetLinkType = multiLink.InterfaceLinkType()
+		}
+
 		if dumper != nil {
-			dumper.WritePacketData(data, ci)
+			if packetLinkType == linkType {
+				dumper.WritePacketData(data, ci)
+			} else {
+				logp.Debug("sniffer", "Not dumping packet with link type %v", packetLinkType)
+			}
+		}
+
+		w := workers[packetLinkType]
+		if w == nil {
+			w, err = s.factory(packetLinkType)
+			if err != nil {
+				s.state.Store(snifferInactive)
+				return err
+			}
+			workers[packetLinkType] = w
 		}
 
 		counter++
 		logp.Debug("sniffer", "Packet number: %d", counter)
-		worker.OnPacket(data, &ci)
+		w.OnPacket(data, &ci)
 	}
 
 	return nil
@@ -215,7 +247,7 @@ func (s *Sniffer) Run() error {
 
 func (s *Sniffer) open() (snifferHandle, error) {
 	if s.config.File != "" {
-		return newFileHandler(s.config.File, s.config.TopSpeed, s.config.Loop)
+		return newFileHandler(s.config.File, s.config.TopSpeed, s.config.Speed, s.config.Loop)
 	}
 
 	switch s.config.Type {
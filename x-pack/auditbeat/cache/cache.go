@@ -26,6 +26,16 @@ func (cache *Cache) IsEmpty() bool {
 	return len(cache.hashMap) == 0
 }
 
+// Put adds an item to the cache, replacing an item with the same hash.
+func (cache *Cache) Put(item Cacheable) {
+	cache.hashMap[item.Hash()] = item
+}
+
+// Remove removes an item from the cache.
+func (cache *Cache) Remove(item Cacheable) {
+	delete(cache.hashMap, item.Hash())
+}
+
 // DiffAndUpdateCache takes a list of new items to cache, compares them to the current
 // cache contents, and returns both items new to the cache and items that are in the cache
 // but missing in the new data.
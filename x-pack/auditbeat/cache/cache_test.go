@@ -53,3 +53,19 @@ func TestCache(t *testing.T) {
 	assert.Equal(t, 2, len(missing))
 	assert.True(t, c.IsEmpty())
 }
+
+func TestCachePutRemove(t *testing.T) {
+	c := New()
+
+	c.Put(CacheTestItem{"item1"})
+	c.Put(CacheTestItem{"item2"})
+	c.Remove(CacheTestItem{"item1"})
+	assert.False(t, c.IsEmpty())
+
+	new, missing := c.DiffAndUpdateCache([]Cacheable{
+		CacheTestItem{"item2"},
+		CacheTestItem{"item3"},
+	})
+	assert.Equal(t, []interface{}{CacheTestItem{"item3"}}, new)
+	assert.Empty(t, missing)
+}
@@ -19,5 +19,5 @@ func init() {
 // AssetSystem returns asset data.
 // This is the base64 encoded gzipped contents of module/system.
 func AssetSystem() string {
-	return "eJzEWl1v2zgWffevuOhLE8BVELcJCj8skG4Wm2DbbTBOgb7ZlHgtcUKRGpJKov76ASnKlmzKthwXIyBATJPnnPtFXUr+AE9YTUFX2mA+AjDMcJzCu5kbeDcCoKgTxQrDpJjCv0YAAI8ZagSiEEyGsGTIqYYUBSpikEJcufEaE3JJS47RCEAhR6JxCjEaMgK/cDoaAXwAQXKcAj6jMI7DVAVOIVWyLNznZrL9v5ktFUuZcEPNgiesXqSifiyg3V7f3TqQS6fTcUbwmDENCREQIxBYMo5QEJPBGUZpBIuLZ6IuuEztX3S5OB+v0KRyMFZSA+lNT2ReSIHCgMmIAV0WBWdI3RRKDGmwBRrOxNPiPGr7otSoDnYFCsNMNWd0uDfub6EU7K8SeQWMWqBlxUTqVFoNIAUQyKQ2EdwbsF6SeVHaSBMNBGZ3Nx8mV9eQEZ2tnVI7wq6C+9txDWT/IYLWH6zuqGODQZUzQfhwEx79yobWEnR8WSiZoNb/tDu9jP1+9IasQFd+fGh86KFAG6IMGLbpzFY0Ng3ulX5HdIZ6VRevmJSGxBxtcaC1XruiJzyVipksd1TaybELngkv0U1ZIbocwFdAkUiKFChLURs/00VoMwJrC2JOnnASzydX12u8QBw2zPny9eZ//5nEm65smzPqYfr4+dMxTB8/fxrKdHU5OYbp6nJyKFNOr4YwfLu9OhRZZ+RyCPTs7uZyAPZkMigIs7ubyeRg/1v8Yelk8Q/PJJ2RgUk0u7sZkD8Wfz7cQ27NMI7BXnJrBnEc4an5UF8NLDTHMaDKdEaGMwzGPyLiV5eTi2ExdzyDo+54Do/762t2PciUnz+vdxqxMkAmT3h45/ib7u+1ijfc3msAYEJSHAOXCeFw/9D8V0hlxqAwlwbdsL3z+o/2u65HXA8akZKysF8C9nVvxK1mQmqzGgw1FDv8Za+FBVhAIoUhTDRnBV7bzcRSqpzYdVFr1eZpobk2NbYa5sK2QR3iWimXIu0M14RToKVyvJ0vmShKM2+mCCKkxkQKqjuzZGna04i+JVVwRqEwYdo55bLz/Q5/2euHswaYaEuIAmbHUpoewykxOITzi5TtXrLL46OHiv1CGiCLpeRIxBC+mc31pU8DWyQrjpAAK+yXFBjZjwEBmxvJAQL+3zq0NfDts8sY3Anty+xxpyC5XGo0kcbkkOzbo+lxrcOi2gzYEX2r8nT+uPNoISYWCvqRHHB/G6IgKsmYwcSU6oQGdWD9mfv18/X8+tN5SEROQlE8gvvbzb+BUKpQawzGjhUBoo3BPRz3D7sppA5QbO7ce1gWUrf27tZ2DSSWpXHFIgu0W6lIm/tOd7/d2rPb2wrFrQTe5fW9Pvk+W4GO7fZCROWjro1Ck2TnUVBJwYmxtp1USQPqFSQojNRjKONSmHIML0xQ+aJ7FJ3cL+4hVa3kG0nsyM8e6iXJGa9OSl5DenqFNCNmDBRjRsQYlgox1nSfR55R6c0b9lt1ecww4RMqgfx0fI+BYnmvPc22lFVukuSJpPimJsxj7KxlIoAJbQjnSEEq110+I23439agbXbd+5y505U7n7N5tYMb8eZaNeQeCerNxD148yOBrOkt2yNNfGiRh3hCxfBGqh1W+Xifks1D9rUCp6Rq9wAhPs4SFKe1zkMG7/x1jZ2kbW/oPGZv/67Zr4MORweRWbAgSZnnRFVHANYLQ5il4qcMy48/vm7vr6uXLW2KIZurBdjbJdlJun6fst0mHb6f/q4GAeBH983MlpfYJuLb2bongTVXelqu/9pY9pJRpk5t2HsNmczRQmNiZDe120/ekJ+wvQB4UDJVJAcjQZUCiAEuU9bT3diEnLdy9aQe9w953Nu+9kMe+C7gKxPl6xhMxrS9Q9viSDGRus72nozYOrY0CmX8JyZmmMCFg9vTDFU1qV6/DGUaCqKMbRzOYqykf/dV1hEvFLO7WL1qo4UNVzLsruZ9UTgoErDK/+3Shp0lt6ZnwmCKm1UykL6v/AqidcC4vtPq/tg2gLvDu4qanw1nQhrfQPoRZjTy5eBIWuW/K5I3W7ItbAQPUmsW8/Z7WFjojFD5Ml/5owfzrGO064xtYYr6qbbDcD+JOB+vfTunTJOYI12Me1AXQq6ZLUdd7JSIFJUstevHRSUFuh9ecJkCE+euze5DTFRVmDboS4aiGzIXG6v9Ak1y4YYpaMRc94Aa2WSJPf6gcBzuzFMjbkW/1TUSbeZJZg3qL52tdq6+Dgr2o/upSNXZYxpDX4h2AsALiEZ/BwAA//9zu6mM"
+	return "eJzEWl9v2zj2ffenuJiXJoCrIG4TFHn4Aekvs5Ng222wSYG+2bR4LXEjkVqSSqJ++sWlKFm2adlKBMyMZxBL1Dnn/qMuSX+EJ6yuwFTGYj4BsMJmeAV/PLgLf0wAOJpYi8IKJa/g/yYAAI8pGgSmEWyKsBKYcQMJStTMIodl5a7XmJArXmYYTQA0ZsgMXsESLZuAf/BqMgH4CJLleAX4jNI6DlsVeAWJVmXhvjeDAdajlRaJkO5288ATVi9Kc38toJ0+P9xzoFZOp+OM4DEVBmImYYnAYCUyhILZFE4wSiJYnD0zfZaphP6Lzhen0xZNaQdDkhpIb3qs8kJJlBZsyiyYsigygdwN58yyBluizYR8WpxGXV+UBvXRrkBpha3mgg/3xt0NlFL8t8SsAsEJaFUJmTiVpAGUBAapMjaCOwvkJZUXJUWaGWDwcHv9cXZxCSkzaQvqHUFPwd3NtAaiP5jk9RcyMtqwwaLOhWTZcBMe/ZON/4lgw5eFVjEa83e708s47EdvSAva+vG+8aGHAmOZtmDFtjOZjNFYpc1wC/50xsPdjWn82YI1Fzz7tKYn816ETUFYAwXTVE+b7nwVdh4rjp6jFpMpmRxQ8ios0HPEy8BYVRTY2h7BP5RuvqBp8sdPQQyMSCgnhG3xhIHz2RcostK49PQjZJkvUUfwQ2YVaCyUJoyXFGWDXk9Mhua8FkxjjOIZOay0yluvQKykxNgqvemC2i3+4e0U3EzC/WkYDuNe93VC2QbOyWjMirb46P9vpbr3nurOg/1s+IpxadkyezPn9dKorLR+prbKRWEN2yejEdGZtXajsof4lpkU2+JY89FLBMljLlGAZYnSwqa5ozKubEnOM8tK3MwlupziK6CkbOfARYLG+pHRpDdJlhl7wtlyPru4HOLHr9+u//nnbLk95XSiEk32MH368vktTJ++fB7KdHE+ewvTxfnsWKacXwxh+H5zcSyySdn5EOiH2+vzAdiz2aAgPNxez2ZH+5/wh6UT4R+fSSZlA5Po4fZ6QP4Q/ny4hz7Nh/no09CiIyvmA/00f4On5kN9NbDQnB0DqsykbDjDYPw3RPzifHY2LOaOZ3DUHc/xcX99TS8HmfLr12WvEa0BKn5C+3f3wbWKd7TBNQAIqThOIVMxy+DuvvmLGrgpaMyVRXeZ3rz+K93b9Ihbq0Ws5CLsl4B9my/iNRbpay/uIvX6iz4LAlhQC2mZkM2aOnOLLxBypXTOKKWaTgpgd1Xd/LOtca2yLGi50LkRaMg9hiO8Al5qx7txU8iitPNmiGRSGYyV5M2Kwy8ES9sdxswNq4IjCo2xMM4p69fmAX/R56ezBoTsSogCZi+VsnsM58ziEM6vSnXXXJs8PnqoxW/kAbKlUhkyOYTvgXJ95dOAiqTlCAkgYb+VxEiyoLXbE8kRAv7Vaeob+OY7qZqC28n4+vDYK0itVgZtZDA+JvsOaHpc6yBUyoCe6JPK8fxx69FCTIKPxwF3NyEKpuNUWIxtqXFEsi6s35t6/XI5v/x8GhKRs3gc7u/X/w+Mc+1W8SEmUQSIRDGE4+6+n0JtzknhmfsAy0KZztzdma6BLVVJm4AIqqBNUtow8e+dDYzdOXutkBaGOwnc5/WDPvnx0IJOaXphsvJRN1ajjdPTKKikyJgl20ZV0oB6BTFKq8wUymUpbTmFFyG5ejF7FI3uFwL0Sr6zGH48wK891CuWi6walbyG9PQaecrsFDguBZNTWGnEpeGHPPKM2my/sN+ry2OGCZ9QS2y2bkfgewwUywfjaXalNDIKFj+xBCeHSrmHfeExemuZSRDSWJZlyEFp12zSXqB/9n0N2u62X78ze13Zux/t1Q5uxJt/24bc2+2i4Deo/ZVA1uwt2zeaeN8hD/F4CWNS9Vjl4z0mm4cMsdE7e0yqbg8Q4stEjHJc6zxkiM3X2Chte0PnMff270b8xne3pw0ZgQVJyjxnunoDYP1gCLPU2Zhh+fnvb9Fkh6M5lHzr5EpHcwe7JBpk6nPH3Tbp+Pm0Z6bp884B/9Dn5+YJ5jZdKfjobHc3Ya5kXK6/aN9jLxkXekwyMuyDgVTlCFxod2xWhZlNitmI7YU7r0o0y8Eq0KUEZiFTiZBhdkrIeSdXxxTyl9/kIY5uPdB5JHwTsnydgqUfJ4j60DLBWJn6LCgsdrsc1wrV8j8Y22ECFw7uQDNU1ftlpq1e2tcr6GRareBkiZXyZ19074OBQguaxeqntlrYcCUfqOZDUTgqEuv83y3t/pJb0wtpMUEduD+Afl/5FcyYgHHhWfgIzkUD2B/eNmp+NJxI2v9KcX1FWIPZanAkSfleV74zktc7soktgntljFhm3XNYWJiUcfUyb4Yu9mCebBjttqipMKVzRo3hfjp0Ol37ds6FoX14vphOgqCwkGrNDCdNsXMmE9SqNG5jXFa010U/UMpUAkKeujZ7H2Ksq8J2Qd2vGTbUuyoj7Wdo4zN3mYNBzM0kgEhRUU2WAJN0VE0cbs1TI55Ge+OcMWPncUoGheK5p50bEGxaLnJWbcwxjaEvzDgBEKdMJsijyf8GAN6Gn8g="
 }
@@ -5,21 +5,44 @@
 package process
 
 import (
+	"strings"
 	"time"
 
+	"github.com/pkg/errors"
+
 	"github.com/elastic/beats/auditbeat/helper/hasher"
 )
 
+// Sources of process events.
+const (
+	// backendAuto uses the proc connector when available and falls back to
+	// polling otherwise.
+	backendAuto = "auto"
+	// backendProcConnector receives process events from the Linux netlink
+	// proc connector.
+	backendProcConnector = "proc_connector"
+	// backendPolling periodically diffs the process table.
+	backendPolling = "polling"
+)
+
 // Config defines the host metricset's configuration options.
 type Config struct {
 	StatePeriod        time.Duration `config:"state.period"`
 	ProcessStatePeriod time.Duration `config:"process.state.period"`
 
 	HasherConfig hasher.Config `config:"process.hash"`
+	Backend      string        `config:"process.backend"`
 }
 
 // Validate validates the config.
 func (c *Config) Validate() error {
+	c.Backend = strings.ToLower(c.Backend)
+	switch c.Backend {
+	case backendAuto, backendProcConnector, backendPolling:
+	default:
+		return errors.Errorf("invalid process.backend value '%v'", c.Backend)
+	}
+
 	return c.HasherConfig.Validate()
 }
 
@@ -40,4 +63,5 @@ var defaultConfig = Config{
 		ScanRatePerSec:      "50 MiB",
 		ScanRateBytesPerSec: 50 * 1024 * 1024,
 	},
+	Backend: backendAuto,
 }
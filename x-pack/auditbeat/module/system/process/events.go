@@ -0,0 +1,177 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+package process
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/metricbeat/mb"
+	"github.com/elastic/beats/x-pack/auditbeat/module/system"
+	"github.com/elastic/go-sysinfo"
+)
+
+// errProcEventsLost is returned by procConnector.Receive when events were
+// dropped because the socket buffer overflowed.
+var errProcEventsLost = errors.New("process events lost")
+
+// procEvent is a process event received from the proc connector.
+type procEvent struct {
+	action   eventAction // eventActionProcessStarted on exec, eventActionProcessStopped on exit.
+	pid      int
+	exitCode int // Only for eventActionProcessStopped.
+}
+
+// eventMetricSet reports processes as they start and stop, based on the
+// events received from the proc connector, instead of periodically diffing
+// the process table. This way short-lived processes are reported too.
+//
+// It wraps the polling MetricSet, which can't be embedded because a MetricSet
+// must implement either Fetch or Run.
+type eventMetricSet struct {
+	system.SystemMetricSet
+	metricSet *MetricSet
+	conn      *procConnector
+}
+
+func newEventMetricSet(ms *MetricSet, conn *procConnector) *eventMetricSet {
+	return &eventMetricSet{
+		SystemMetricSet: ms.SystemMetricSet,
+		metricSet:       ms,
+		conn:            conn,
+	}
+}
+
+// Close cleans up the MetricSet when it finishes.
+func (ms *eventMetricSet) Close() error {
+	err := ms.conn.Close()
+	if msErr := ms.metricSet.Close(); msErr != nil {
+		return msErr
+	}
+	return err
+}
+
+// Run reports process events until the reporter is done. The state of all
+// processes is still reported periodically. If receiving events fails, it falls
+// back to polling.
+func (ms *eventMetricSet) Run(report mb.PushReporterV2) {
+	eventsC := make(chan []procEvent)
+	lostC := make(chan struct{}, 1)
+	go ms.receiveEvents(report.Done(), eventsC, lostC)
+
+	ticker := time.NewTicker(ms.Module().Config().Period)
+	defer ticker.Stop()
+
+	ms.metricSet.reportStateIfNeeded(report)
+	for {
+		select {
+		case <-report.Done():
+			return
+		case <-ticker.C:
+			if eventsC == nil {
+				ms.metricSet.Fetch(report)
+			} else {
+				ms.metricSet.reportStateIfNeeded(report)
+			}
+		case <-lostC:
+			// Processes might have started or stopped without us noticing.
+			// Diff the process table against the last known processes.
+			if err := ms.metricSet.reportChanges(report); err != nil {
+				ms.metricSet.log.Error(err)
+				report.Error(err)
+			}
+		case events, ok := <-eventsC:
+			if !ok {
+				ms.metricSet.log.Warn("Stopped receiving process events, falling back to polling")
+				eventsC = nil
+				continue
+			}
+			for _, event := range events {
+				ms.metricSet.reportProcEvent(report, event)
+			}
+		}
+	}
+}
+
+// receiveEvents passes the received events to eventsC. lostC is signaled if
+// events were lost.
+func (ms *eventMetricSet) receiveEvents(done <-chan struct{}, eventsC chan<- []procEvent, lostC chan<- struct{}) {
+	defer close(eventsC)
+
+	for {
+		events, err := ms.conn.Receive()
+		if err == errProcEventsLost {
+			ms.metricSet.log.Warn("Process events were lost because the socket buffer overflowed, resyncing")
+			select {
+			case lostC <- struct{}{}:
+			default:
+			}
+			continue
+		}
+		if err != nil {
+			select {
+			case <-done:
+			default:
+				ms.metricSet.log.Errorw("Failed to receive process events", "error", err)
+			}
+			return
+		}
+
+		select {
+		case eventsC <- events:
+		case <-done:
+			return
+		}
+	}
+}
+
+// reportProcEvent reports a process event received from the proc connector.
+func (ms *MetricSet) reportProcEvent(report mb.ReporterV2, event procEvent) {
+	switch event.action {
+	case eventActionProcessStarted:
+		p := ms.getProcess(event.pid)
+		if p == nil {
+			return
+		}
+		ms.processes[event.pid] = p
+		ms.cache.Put(p)
+		ms.enrichProcess(p)
+		ms.addAncestry(p)
+
+		if p.Error == nil {
+			report.Event(ms.processEvent(p, eventTypeEvent, eventActionProcessStarted))
+		} else {
+			ms.log.Warn(p.Error)
+			report.Event(ms.processEvent(p, eventTypeError, eventActionProcessError))
+		}
+
+	case eventActionProcessStopped:
+		// Processes that were not reported as started (e.g. forked processes
+		// that didn't call exec) are not reported either when they stop.
+		p, found := ms.processes[event.pid]
+		if !found {
+			return
+		}
+		delete(ms.processes, event.pid)
+		ms.cache.Remove(p)
+
+		if p.Error == nil {
+			e := ms.processEvent(p, eventTypeEvent, eventActionProcessStopped)
+			e.RootFields.Put("process.exit_code", event.exitCode)
+			report.Event(e)
+		}
+	}
+}
+
+// getProcess collects the information about a process that was just started.
+// It returns nil if the process already exited or must not be reported.
+func (ms *MetricSet) getProcess(pid int) *Process {
+	sysinfoProc, err := sysinfo.Process(pid)
+	if err != nil {
+		ms.log.Debugw("Failed to get started process", "pid", pid, "error", err)
+		return nil
+	}
+	return ms.newProcess(sysinfoProc)
+}
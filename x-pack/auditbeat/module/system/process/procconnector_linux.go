@@ -0,0 +1,169 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+// +build linux
+
+package process
+
+import (
+	"os"
+	"syscall"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/gosigar/sys"
+)
+
+// Proc connector definitions from linux/connector.h and linux/cn_proc.h.
+const (
+	cnIdxProc = 0x1
+	cnValProc = 0x1
+
+	procCnMcastListen = 1
+	procCnMcastIgnore = 2
+
+	procEventExec = 0x00000002
+	procEventExit = 0x80000000
+
+	cnMsgLen           = 20 // struct cn_msg without data.
+	procEventHeaderLen = 16 // struct proc_event without event_data.
+	procEventExecLen   = 8  // struct exec_proc_event.
+	procEventExitLen   = 16 // struct exit_proc_event without the parent fields.
+)
+
+// Receive buffer size requested for the netlink socket. Events are lost when
+// the buffer overflows during bursts of process creation.
+const procConnectorRcvBufSize = 4 * 1024 * 1024
+
+var byteOrder = sys.GetEndian()
+
+// procConnector receives process events from the kernel's netlink proc
+// connector. It requires the CAP_NET_ADMIN capability.
+type procConnector struct {
+	file *os.File
+	buf  []byte
+}
+
+// newProcConnector opens a netlink connector socket and subscribes to
+// process events.
+func newProcConnector() (*procConnector, error) {
+	fd, err := syscall.Socket(syscall.AF_NETLINK,
+		syscall.SOCK_DGRAM|syscall.SOCK_CLOEXEC|syscall.SOCK_NONBLOCK,
+		syscall.NETLINK_CONNECTOR)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create netlink connector socket")
+	}
+
+	if err = syscall.Bind(fd, &syscall.SockaddrNetlink{
+		Family: syscall.AF_NETLINK,
+		Groups: cnIdxProc,
+	}); err != nil {
+		syscall.Close(fd)
+		return nil, errors.Wrap(err, "failed to bind to the proc connector")
+	}
+
+	// Best effort, the default buffer is likely to overflow on busy hosts.
+	syscall.SetsockoptInt(fd, syscall.SOL_SOCKET, syscall.SO_RCVBUF, procConnectorRcvBufSize)
+
+	c := &procConnector{
+		// Reads on the non-blocking file are handled by the runtime poller
+		// and are interrupted by Close.
+		file: os.NewFile(uintptr(fd), "proc_connector"),
+		buf:  make([]byte, os.Getpagesize()),
+	}
+	if err = c.setMulticast(procCnMcastListen); err != nil {
+		c.file.Close()
+		return nil, errors.Wrap(err, "failed to subscribe to process events")
+	}
+	return c, nil
+}
+
+// Close unsubscribes from process events and closes the socket.
+func (c *procConnector) Close() error {
+	c.setMulticast(procCnMcastIgnore)
+	return c.file.Close()
+}
+
+// Receive blocks until process events are received and returns them.
+func (c *procConnector) Receive() ([]procEvent, error) {
+	n, err := c.file.Read(c.buf)
+	if err != nil {
+		if pathErr, ok := err.(*os.PathError); ok && pathErr.Err == syscall.ENOBUFS {
+			return nil, errProcEventsLost
+		}
+		return nil, err
+	}
+
+	msgs, err := syscall.ParseNetlinkMessage(c.buf[:n])
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse netlink message")
+	}
+
+	var events []procEvent
+	for _, msg := range msgs {
+		if event, ok := parseProcEvent(msg.Data); ok {
+			events = append(events, event)
+		}
+	}
+	return events, nil
+}
+
+// setMulticast sends a PROC_CN_MCAST_LISTEN or PROC_CN_MCAST_IGNORE operation
+// to the proc connector.
+func (c *procConnector) setMulticast(op uint32) error {
+	msg := make([]byte, syscall.NLMSG_HDRLEN+cnMsgLen+4)
+	byteOrder.PutUint32(msg[0:], uint32(len(msg)))     // nlmsg_len
+	byteOrder.PutUint16(msg[4:], syscall.NLMSG_DONE)   // nlmsg_type
+	byteOrder.PutUint32(msg[12:], uint32(os.Getpid())) // nlmsg_pid
+	data := msg[syscall.NLMSG_HDRLEN:]
+	byteOrder.PutUint32(data[0:], cnIdxProc) // id.idx
+	byteOrder.PutUint32(data[4:], cnValProc) // id.val
+	byteOrder.PutUint16(data[16:], 4)        // len
+	byteOrder.PutUint32(data[cnMsgLen:], op)
+
+	_, err := c.file.Write(msg)
+	return err
+}
+
+// parseProcEvent decodes the struct cn_msg payload of a proc connector
+// message. Only exec and exit events of processes (not threads) are returned.
+func parseProcEvent(data []byte) (procEvent, bool) {
+	if len(data) < cnMsgLen+procEventHeaderLen+procEventExecLen ||
+		byteOrder.Uint32(data[0:]) != cnIdxProc || byteOrder.Uint32(data[4:]) != cnValProc {
+		return procEvent{}, false
+	}
+	data = data[cnMsgLen:]
+
+	what := byteOrder.Uint32(data[0:])
+	data = data[procEventHeaderLen:]
+	pid := int(byteOrder.Uint32(data[0:]))
+	tgid := int(byteOrder.Uint32(data[4:]))
+	if pid != tgid {
+		return procEvent{}, false
+	}
+
+	switch what {
+	case procEventExec:
+		return procEvent{action: eventActionProcessStarted, pid: tgid}, true
+	case procEventExit:
+		if len(data) < procEventExitLen {
+			return procEvent{}, false
+		}
+		return procEvent{
+			action:   eventActionProcessStopped,
+			pid:      tgid,
+			exitCode: exitCode(syscall.WaitStatus(byteOrder.Uint32(data[8:]))),
+		}, true
+	default:
+		return procEvent{}, false
+	}
+}
+
+// exitCode returns the exit code of a process given its wait status. Like in
+// shells, it's 128 plus the signal number for processes killed by a signal.
+func exitCode(status syscall.WaitStatus) int {
+	if status.Signaled() {
+		return 128 + int(status.Signal())
+	}
+	return status.ExitStatus()
+}
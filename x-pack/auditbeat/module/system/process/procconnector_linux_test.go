@@ -0,0 +1,118 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+// +build linux
+
+package process
+
+import (
+	"os"
+	"os/exec"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	abtest "github.com/elastic/beats/auditbeat/testing"
+	mbtest "github.com/elastic/beats/metricbeat/mb/testing"
+)
+
+func TestParseProcEvent(t *testing.T) {
+	exec := testProcConnectorMsg(procEventExec, 1234, 1234, 0)
+	event, ok := parseProcEvent(exec)
+	if assert.True(t, ok) {
+		assert.Equal(t, procEvent{action: eventActionProcessStarted, pid: 1234}, event)
+	}
+
+	exit := testProcConnectorMsg(procEventExit, 1234, 1234, 3<<8)
+	event, ok = parseProcEvent(exit)
+	if assert.True(t, ok) {
+		assert.Equal(t, procEvent{action: eventActionProcessStopped, pid: 1234, exitCode: 3}, event)
+	}
+
+	killed := testProcConnectorMsg(procEventExit, 1234, 1234, uint32(syscall.SIGKILL))
+	event, ok = parseProcEvent(killed)
+	if assert.True(t, ok) {
+		assert.Equal(t, 128+int(syscall.SIGKILL), event.exitCode)
+	}
+
+	// Thread exit.
+	_, ok = parseProcEvent(testProcConnectorMsg(procEventExit, 1235, 1234, 0))
+	assert.False(t, ok)
+
+	// Fork event.
+	_, ok = parseProcEvent(testProcConnectorMsg(0x1, 1234, 1234, 0))
+	assert.False(t, ok)
+
+	// Truncated message.
+	_, ok = parseProcEvent(exit[:cnMsgLen+procEventHeaderLen+4])
+	assert.False(t, ok)
+}
+
+func TestProcConnector(t *testing.T) {
+	conn, err := newProcConnector()
+	if err != nil {
+		t.Skip("proc connector not available:", err)
+	}
+	conn.Close()
+
+	defer abtest.SetupDataDir(t)()
+
+	config := getConfig()
+	config["process.backend"] = "proc_connector"
+	config["process.hash.max_file_size"] = "100 MiB" // Otherwise hashing fails.
+	ms := mbtest.NewPushMetricSetV2(t, config).(*eventMetricSet)
+	defer ms.Close()
+
+	// The process events are queued until Run reads them.
+	cmd := exec.Command("/bin/sh", "-c", "sleep 1; exit 3")
+	if err := cmd.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer cmd.Wait()
+
+	events := mbtest.RunPushMetricSetV2(3*time.Second, 0, ms)
+
+	var started, stopped bool
+	for _, e := range events {
+		if pid, _ := e.RootFields.GetValue("process.pid"); pid != cmd.Process.Pid {
+			continue
+		}
+
+		action, _ := e.RootFields.GetValue("event.action")
+		switch action {
+		case eventActionProcessStarted.String():
+			started = true
+			args, _ := e.RootFields.GetValue("process.args")
+			assert.Equal(t, []string{"/bin/sh", "-c", "sleep 1; exit 3"}, args)
+
+			self, err := os.Executable()
+			if err != nil {
+				t.Fatal(err)
+			}
+			parent, _ := e.RootFields.GetValue("process.parent.executable")
+			assert.Equal(t, self, parent)
+		case eventActionProcessStopped.String():
+			stopped = true
+			exitCode, _ := e.RootFields.GetValue("process.exit_code")
+			assert.Equal(t, 3, exitCode)
+		}
+	}
+	assert.True(t, started, "process_started event not found")
+	assert.True(t, stopped, "process_stopped event not found")
+}
+
+func testProcConnectorMsg(what uint32, pid, tgid int, exitCode uint32) []byte {
+	msg := make([]byte, cnMsgLen+procEventHeaderLen+24)
+	byteOrder.PutUint32(msg[0:], cnIdxProc)
+	byteOrder.PutUint32(msg[4:], cnValProc)
+	byteOrder.PutUint16(msg[16:], uint16(len(msg)-cnMsgLen))
+
+	event := msg[cnMsgLen:]
+	byteOrder.PutUint32(event[0:], what)
+	byteOrder.PutUint32(event[procEventHeaderLen:], uint32(pid))
+	byteOrder.PutUint32(event[procEventHeaderLen+4:], uint32(tgid))
+	byteOrder.PutUint32(event[procEventHeaderLen+8:], exitCode)
+	return msg
+}
@@ -0,0 +1,27 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+// +build !linux
+
+package process
+
+import (
+	"github.com/pkg/errors"
+)
+
+// procConnector is only available on Linux.
+type procConnector struct{}
+
+func newProcConnector() (*procConnector, error) {
+	return nil, errors.New("the proc connector is only supported on Linux")
+}
+
+// Close is a no-op.
+func (c *procConnector) Close() error {
+	return nil
+}
+
+// Receive returns an error.
+func (c *procConnector) Receive() ([]procEvent, error) {
+	return nil, errors.New("the proc connector is only supported on Linux")
+}
@@ -40,6 +40,9 @@ const (
 	eventTypeState = "state"
 	eventTypeEvent = "event"
 	eventTypeError = "error"
+
+	// maxAncestors limits the length of the reported process ancestry.
+	maxAncestors = 32
 )
 
 type eventAction uint8
@@ -82,6 +85,8 @@ type MetricSet struct {
 	bucket    datastore.Bucket
 	lastState time.Time
 	hasher    *hasher.FileHasher
+	hashes    map[string]executableHashes // Hashes of executables by path.
+	processes map[int]*Process            // Last known processes by PID.
 
 	suppressPermissionWarnings bool
 }
@@ -94,6 +99,17 @@ type Process struct {
 	Group    *user.Group
 	Hashes   map[hasher.HashType]hasher.Digest
 	Error    error
+
+	// Ancestors of the process, starting with its parent.
+	Ancestors []types.ProcessInfo
+}
+
+// executableHashes are the hashes of an executable file. They are valid as
+// long as the modification time and size of the file don't change.
+type executableHashes struct {
+	modTime time.Time
+	size    int64
+	hashes  map[hasher.HashType]hasher.Digest
 }
 
 // Hash creates a hash for Process.
@@ -119,10 +135,14 @@ func (p Process) toMapStr() common.MapStr {
 
 // entityID creates an ID that uniquely identifies this process across machines.
 func (p Process) entityID(hostID string) string {
+	return processEntityID(hostID, p.Info)
+}
+
+func processEntityID(hostID string, info types.ProcessInfo) string {
 	h := system.NewEntityHash()
 	h.Write([]byte(hostID))
-	binary.Write(h, binary.LittleEndian, int64(p.Info.PID))
-	binary.Write(h, binary.LittleEndian, int64(p.Info.StartTime.Nanosecond()))
+	binary.Write(h, binary.LittleEndian, int64(info.PID))
+	binary.Write(h, binary.LittleEndian, int64(info.StartTime.Nanosecond()))
 	return h.Sum()
 }
 
@@ -152,6 +172,8 @@ func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
 		cache:           cache.New(),
 		bucket:          bucket,
 		hasher:          hasher,
+		hashes:          map[string]executableHashes{},
+		processes:       map[int]*Process{},
 	}
 
 	// Load from disk: Time when state was last sent
@@ -174,6 +196,19 @@ func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
 		ms.log.Warn("Running as non-root user, will likely not report all processes.")
 	}
 
+	if config.Backend == backendProcConnector || (config.Backend == backendAuto && runtime.GOOS == "linux") {
+		conn, err := newProcConnector()
+		if err == nil {
+			ms.log.Info("Receiving process events from the proc connector")
+			return newEventMetricSet(ms, conn), nil
+		}
+		if config.Backend == backendProcConnector {
+			ms.Close()
+			return nil, err
+		}
+		ms.log.Warnw("Failed to use the proc connector, falling back to polling", "error", err)
+	}
+
 	return ms, nil
 }
 
@@ -187,6 +222,18 @@ func (ms *MetricSet) Close() error {
 
 // Fetch collects process information. It is invoked periodically.
 func (ms *MetricSet) Fetch(report mb.ReporterV2) {
+	ms.reportStateIfNeeded(report)
+
+	err := ms.reportChanges(report)
+	if err != nil {
+		ms.log.Error(err)
+		report.Error(err)
+	}
+}
+
+// reportStateIfNeeded reports all running processes if the state period has
+// elapsed or nothing was reported yet.
+func (ms *MetricSet) reportStateIfNeeded(report mb.ReporterV2) {
 	needsStateUpdate := time.Since(ms.lastState) > ms.config.effectiveStatePeriod()
 	if needsStateUpdate || ms.cache.IsEmpty() {
 		ms.log.Debugf("State update needed (needsStateUpdate=%v, cache.IsEmpty()=%v)", needsStateUpdate, ms.cache.IsEmpty())
@@ -197,12 +244,6 @@ func (ms *MetricSet) Fetch(report mb.ReporterV2) {
 		}
 		ms.log.Debugf("Next state update by %v", ms.lastState.Add(ms.config.effectiveStatePeriod()))
 	}
-
-	err := ms.reportChanges(report)
-	if err != nil {
-		ms.log.Error(err)
-		report.Error(err)
-	}
 }
 
 // reportState reports all running processes on the system.
@@ -218,6 +259,7 @@ func (ms *MetricSet) reportState(report mb.ReporterV2) error {
 		return errors.Wrap(err, "failed to get process infos")
 	}
 	ms.log.Debugf("Found %v processes", len(processes))
+	ms.updateProcesses(processes)
 
 	stateID, err := uuid.NewV4()
 	if err != nil {
@@ -225,6 +267,7 @@ func (ms *MetricSet) reportState(report mb.ReporterV2) error {
 	}
 	for _, p := range processes {
 		ms.enrichProcess(p)
+		ms.addAncestry(p)
 
 		if p.Error == nil {
 			event := ms.processEvent(p, eventTypeState, eventActionExistingProcess)
@@ -261,12 +304,14 @@ func (ms *MetricSet) reportChanges(report mb.ReporterV2) error {
 		return errors.Wrap(err, "failed to get processes")
 	}
 	ms.log.Debugf("Found %v processes", len(processes))
+	ms.updateProcesses(processes)
 
 	started, stopped := ms.cache.DiffAndUpdateCache(convertToCacheable(processes))
 
 	for _, cacheValue := range started {
 		p := cacheValue.(*Process)
 		ms.enrichProcess(p)
+		ms.addAncestry(p)
 
 		if p.Error == nil {
 			report.Event(ms.processEvent(p, eventTypeEvent, eventActionProcessStarted))
@@ -303,7 +348,7 @@ func (ms *MetricSet) enrichProcess(process *Process) {
 	}
 
 	if process.Info.Exe != "" {
-		hashes, err := ms.hasher.HashFile(process.Info.Exe)
+		hashes, err := ms.hashExecutable(process.Info.Exe)
 		if err != nil {
 			if process.Error == nil {
 				process.Error = errors.Wrapf(err, "failed to hash executable %v for PID %v", process.Info.Exe,
@@ -315,6 +360,72 @@ func (ms *MetricSet) enrichProcess(process *Process) {
 	}
 }
 
+// hashExecutable returns the hashes of an executable. Hashing is rate limited,
+// so the hashes are cached until the file is modified.
+func (ms *MetricSet) hashExecutable(path string) (map[hasher.HashType]hasher.Digest, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	cached, found := ms.hashes[path]
+	if found && cached.modTime.Equal(info.ModTime()) && cached.size == info.Size() {
+		return cached.hashes, nil
+	}
+
+	hashes, err := ms.hasher.HashFile(path)
+	if err != nil {
+		return nil, err
+	}
+	ms.hashes[path] = executableHashes{
+		modTime: info.ModTime(),
+		size:    info.Size(),
+		hashes:  hashes,
+	}
+	return hashes, nil
+}
+
+// updateProcesses replaces the last known processes. Cached hashes of
+// executables not used by any of the processes are dropped.
+func (ms *MetricSet) updateProcesses(processes []*Process) {
+	ms.processes = make(map[int]*Process, len(processes))
+	executables := make(map[string]struct{}, len(processes))
+	for _, p := range processes {
+		ms.processes[p.Info.PID] = p
+		executables[p.Info.Exe] = struct{}{}
+	}
+
+	for path := range ms.hashes {
+		if _, found := executables[path]; !found {
+			delete(ms.hashes, path)
+		}
+	}
+}
+
+// addAncestry sets the ancestors of a process. The ancestors that are not
+// among the last known processes are read from the system.
+func (ms *MetricSet) addAncestry(process *Process) {
+	process.Ancestors = nil
+	for ppid := process.Info.PPID; ppid > 0 && len(process.Ancestors) < maxAncestors; {
+		var info types.ProcessInfo
+		if parent, found := ms.processes[ppid]; found {
+			info = parent.Info
+		} else {
+			sysinfoProc, err := sysinfo.Process(ppid)
+			if err == nil {
+				info, err = sysinfoProc.Info()
+			}
+			if err != nil {
+				ms.log.Debugw("Failed to get process ancestor", "pid", process.Info.PID, "ancestor", ppid, "error", err)
+				return
+			}
+		}
+
+		process.Ancestors = append(process.Ancestors, info)
+		ppid = info.PPID
+	}
+}
+
 func (ms *MetricSet) processEvent(process *Process, eventType string, action eventAction) mb.Event {
 	event := mb.Event{
 		RootFields: common.MapStr{
@@ -361,8 +472,23 @@ func (ms *MetricSet) processEvent(process *Process, eventType string, action eve
 		event.RootFields.Put("error.message", process.Error.Error())
 	}
 
+	if len(process.Ancestors) > 0 {
+		parent := process.Ancestors[0]
+		putIfNotEmpty(&event.RootFields, "process.parent.name", parent.Name)
+		putIfNotEmpty(&event.RootFields, "process.parent.executable", parent.Exe)
+	}
+
 	if ms.HostID() != "" {
 		event.RootFields.Put("process.entity_id", process.entityID(ms.HostID()))
+
+		if len(process.Ancestors) > 0 {
+			ancestors := make([]string, 0, len(process.Ancestors))
+			for _, info := range process.Ancestors {
+				ancestors = append(ancestors, processEntityID(ms.HostID(), info))
+			}
+			event.RootFields.Put("process.parent.entity_id", ancestors[0])
+			event.RootFields.Put("process.ancestors", ancestors)
+		}
 	}
 
 	return event
@@ -417,58 +543,66 @@ func (ms *MetricSet) getProcesses() ([]*Process, error) {
 	}
 
 	for _, sysinfoProc := range sysinfoProcs {
-		var process *Process
+		if process := ms.newProcess(sysinfoProc); process != nil {
+			processes = append(processes, process)
+		}
+	}
 
-		pInfo, err := sysinfoProc.Info()
-		if err != nil {
-			if os.IsNotExist(err) {
-				// Skip - process probably just terminated since our call to Processes().
-				continue
-			}
+	return processes, nil
+}
 
-			if os.Geteuid() != 0 && os.IsPermission(err) {
-				// Running as non-root, permission issues when trying to access
-				// other user's private process information are expected.
+// newProcess collects the information about a process. It returns nil if the
+// process must not be reported.
+func (ms *MetricSet) newProcess(sysinfoProc types.Process) *Process {
+	var process *Process
 
-				if !ms.suppressPermissionWarnings {
-					ms.log.Warnf("Failed to load process information for PID %d as non-root user. "+
-						"Will suppress further errors of this kind. Error: %v", sysinfoProc.PID(), err)
+	pInfo, err := sysinfoProc.Info()
+	if err != nil {
+		if os.IsNotExist(err) {
+			// Skip - process probably just terminated since our call to Processes().
+			return nil
+		}
 
-					// Only warn once at the start of Auditbeat.
-					ms.suppressPermissionWarnings = true
-				}
+		if os.Geteuid() != 0 && os.IsPermission(err) {
+			// Running as non-root, permission issues when trying to access
+			// other user's private process information are expected.
 
-				continue
-			}
+			if !ms.suppressPermissionWarnings {
+				ms.log.Warnf("Failed to load process information for PID %d as non-root user. "+
+					"Will suppress further errors of this kind. Error: %v", sysinfoProc.PID(), err)
 
-			// Record what we can and continue
-			process = &Process{
-				Info:  pInfo,
-				Error: errors.Wrapf(err, "failed to load process information for PID %d", sysinfoProc.PID()),
-			}
-			process.Info.PID = sysinfoProc.PID() // in case pInfo did not contain it
-		} else {
-			process = &Process{
-				Info: pInfo,
+				// Only warn once at the start of Auditbeat.
+				ms.suppressPermissionWarnings = true
 			}
+
+			return nil
 		}
 
-		userInfo, err := sysinfoProc.User()
-		if err != nil {
-			if process.Error == nil {
-				process.Error = errors.Wrapf(err, "failed to load user for PID %d", sysinfoProc.PID())
-			}
-		} else {
-			process.UserInfo = &userInfo
+		// Record what we can and continue
+		process = &Process{
+			Info:  pInfo,
+			Error: errors.Wrapf(err, "failed to load process information for PID %d", sysinfoProc.PID()),
 		}
+		process.Info.PID = sysinfoProc.PID() // in case pInfo did not contain it
+	} else {
+		process = &Process{
+			Info: pInfo,
+		}
+	}
 
-		// Exclude Linux kernel processes, they are not very interesting.
-		if runtime.GOOS == "linux" && userInfo.UID == "0" && process.Info.Exe == "" {
-			continue
+	userInfo, err := sysinfoProc.User()
+	if err != nil {
+		if process.Error == nil {
+			process.Error = errors.Wrapf(err, "failed to load user for PID %d", sysinfoProc.PID())
 		}
+	} else {
+		process.UserInfo = &userInfo
+	}
 
-		processes = append(processes, process)
+	// Exclude Linux kernel processes, they are not very interesting.
+	if runtime.GOOS == "linux" && userInfo.UID == "0" && process.Info.Exe == "" {
+		return nil
 	}
 
-	return processes, nil
+	return process
 }
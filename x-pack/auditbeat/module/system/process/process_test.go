@@ -5,6 +5,9 @@
 package process
 
 import (
+	"io/ioutil"
+	"math"
+	"os"
 	"os/user"
 	"testing"
 	"time"
@@ -50,6 +53,9 @@ func getConfig() map[string]interface{} {
 		// To speed things up during testing, we effectively
 		// disable hashing.
 		"process.hash.max_file_size": 1,
+
+		// The tests use the polling MetricSet unless stated otherwise.
+		"process.backend": "polling",
 	}
 }
 
@@ -102,6 +108,110 @@ func TestProcessEvent(t *testing.T) {
 	}
 }
 
+func TestProcessEventAncestry(t *testing.T) {
+	ms := mbtest.NewReportingMetricSetV2(t, getConfig()).(*MetricSet)
+
+	ms.updateProcesses([]*Process{
+		{Info: types.ProcessInfo{Name: "systemd", PID: 1, Exe: "/lib/systemd/systemd"}},
+		{Info: types.ProcessInfo{Name: "sshd", PID: 9085, PPID: 1, Exe: "/usr/sbin/sshd"}},
+	})
+	p := testProcess()
+	ms.addAncestry(p)
+	if assert.Len(t, p.Ancestors, 2) {
+		assert.Equal(t, 9085, p.Ancestors[0].PID)
+		assert.Equal(t, 1, p.Ancestors[1].PID)
+	}
+
+	event := ms.processEvent(p, eventTypeEvent, eventActionProcessStarted)
+
+	name, err := event.RootFields.GetValue("process.parent.name")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "sshd", name)
+	}
+	executable, err := event.RootFields.GetValue("process.parent.executable")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "/usr/sbin/sshd", executable)
+	}
+
+	if ms.HostID() == "" {
+		t.Skip("entity IDs require a host ID")
+	}
+	ancestors, err := event.RootFields.GetValue("process.ancestors")
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{
+			processEntityID(ms.HostID(), p.Ancestors[0]),
+			processEntityID(ms.HostID(), p.Ancestors[1]),
+		}, ancestors)
+	}
+	parentID, err := event.RootFields.GetValue("process.parent.entity_id")
+	if assert.NoError(t, err) {
+		assert.Equal(t, processEntityID(ms.HostID(), p.Ancestors[0]), parentID)
+	}
+}
+
+func TestReportProcEvent(t *testing.T) {
+	ms := mbtest.NewReportingMetricSetV2(t, getConfig()).(*MetricSet)
+	report := &mbtest.CapturingReporterV2{}
+
+	// Processes that exited before their information could be collected are
+	// not reported.
+	ms.reportProcEvent(report, procEvent{action: eventActionProcessStarted, pid: math.MaxInt32})
+	assert.Empty(t, report.GetEvents())
+	assert.Empty(t, ms.processes)
+
+	// Started and stopped processes are tracked in the cache, such that
+	// polling doesn't report them again.
+	pid := os.Getpid()
+	ms.reportProcEvent(report, procEvent{action: eventActionProcessStarted, pid: pid})
+	assert.Len(t, report.GetEvents(), 1)
+	assert.Contains(t, ms.processes, pid)
+	assert.False(t, ms.cache.IsEmpty())
+
+	ms.reportProcEvent(report, procEvent{action: eventActionProcessStopped, pid: pid, exitCode: 1})
+	assert.NotContains(t, ms.processes, pid)
+	assert.True(t, ms.cache.IsEmpty())
+}
+
+func TestHashExecutable(t *testing.T) {
+	config := getConfig()
+	config["process.hash.max_file_size"] = "1 MiB"
+	ms := mbtest.NewReportingMetricSetV2(t, config).(*MetricSet)
+
+	f, err := ioutil.TempFile("", "process-hash")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	f.WriteString("executable")
+	f.Close()
+
+	hashes, err := ms.hashExecutable(f.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Contains(t, ms.hashes, f.Name())
+
+	// Hashes are taken from the cache as long as the file is not modified.
+	ms.hashes[f.Name()].hashes[hasher.SHA1] = hasher.Digest("cached")
+	cached, err := ms.hashExecutable(f.Name())
+	if assert.NoError(t, err) {
+		assert.Equal(t, hasher.Digest("cached"), cached[hasher.SHA1])
+	}
+
+	if err := ioutil.WriteFile(f.Name(), []byte("modified executable"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	modified, err := ms.hashExecutable(f.Name())
+	if assert.NoError(t, err) {
+		assert.NotEqual(t, hashes[hasher.SHA1], modified[hasher.SHA1])
+		assert.NotEqual(t, hasher.Digest("cached"), modified[hasher.SHA1])
+	}
+
+	// Hashes of executables not used by any process are dropped.
+	ms.updateProcesses(nil)
+	assert.Empty(t, ms.hashes)
+}
+
 func testProcess() *Process {
 	return &Process{
 		Info: types.ProcessInfo{
@@ -9,6 +9,8 @@ package include
 import (
 	// Import packages that need to register themselves.
 	_ "github.com/elastic/beats/x-pack/filebeat/input/googlepubsub"
+	_ "github.com/elastic/beats/x-pack/filebeat/input/http_endpoint"
+	_ "github.com/elastic/beats/x-pack/filebeat/input/httpjson"
 	_ "github.com/elastic/beats/x-pack/filebeat/input/netflow"
 	_ "github.com/elastic/beats/x-pack/filebeat/input/s3"
 	_ "github.com/elastic/beats/x-pack/filebeat/module/aws"
@@ -0,0 +1,73 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package http_endpoint
+
+import (
+	"strings"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/pkg/errors"
+
+	inputhttp "github.com/elastic/beats/filebeat/inputsource/http"
+)
+
+type config struct {
+	inputhttp.Config `config:",inline"`
+
+	// URL path events are accepted on.
+	URL string `config:"url"`
+
+	// Field the decoded JSON object is stored in.
+	Prefix string `config:"prefix"`
+
+	// Shared secret required in a request header.
+	Secret struct {
+		Header string `config:"header"`
+		Value  string `config:"value"`
+	} `config:"secret"`
+
+	// Basic authentication credentials.
+	BasicAuth bool   `config:"basic_auth"`
+	Username  string `config:"username"`
+	Password  string `config:"password"`
+
+	// Maximum time to wait for events to be ACKed by the outputs. If the
+	// timeout expires, 503 Service Unavailable is returned to the client.
+	ACKTimeout time.Duration `config:"ack_timeout" validate:"positive"`
+}
+
+func defaultConfig() config {
+	return config{
+		Config: inputhttp.Config{
+			Host:           "localhost:8080",
+			Timeout:        time.Minute * 5,
+			MaxMessageSize: 20 * humanize.MiByte,
+		},
+		URL:        "/",
+		Prefix:     "json",
+		ACKTimeout: 30 * time.Second,
+	}
+}
+
+func (c *config) Validate() error {
+	if !strings.HasPrefix(c.URL, "/") {
+		return errors.New("url must start with /")
+	}
+
+	if c.Prefix == "" {
+		return errors.New("prefix can not be empty")
+	}
+
+	if (c.Secret.Header == "") != (c.Secret.Value == "") {
+		return errors.New("both secret.header and secret.value must be set")
+	}
+
+	if c.BasicAuth && (c.Username == "" || c.Password == "") {
+		return errors.New("username and password are required when basic_auth is enabled")
+	}
+
+	return nil
+}
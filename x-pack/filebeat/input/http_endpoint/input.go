@@ -0,0 +1,328 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package http_endpoint
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/filebeat/channel"
+	"github.com/elastic/beats/filebeat/input"
+	inputhttp "github.com/elastic/beats/filebeat/inputsource/http"
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/libbeat/logp"
+)
+
+const inputName = "http_endpoint"
+
+var errOutletClosed = errors.New("input outlet closed")
+
+func init() {
+	err := input.Register(inputName, NewInput)
+	if err != nil {
+		panic(errors.Wrapf(err, "failed to register %v input", inputName))
+	}
+}
+
+// Input accepts events pushed via HTTP POST requests.
+type Input struct {
+	sync.Mutex
+	config
+	server  *inputhttp.Server
+	started bool
+	outlet  channel.Outleter
+	log     *logp.Logger
+}
+
+// requestPublisher stops publishing the events of a request once the request
+// has been answered.
+type requestPublisher struct {
+	mu       sync.Mutex
+	canceled bool
+}
+
+// requestACK tracks the events published for a single HTTP request. The
+// request is answered once all events have been ACKed.
+type requestACK struct {
+	mu      sync.Mutex
+	pending int
+	done    chan struct{}
+}
+
+// NewInput creates a new http_endpoint input.
+func NewInput(
+	cfg *common.Config,
+	connector channel.Connector,
+	context input.Context,
+) (input.Input, error) {
+	cfgwarn.Experimental("The %v input is experimental", inputName)
+
+	conf := defaultConfig()
+	if err := cfg.Unpack(&conf); err != nil {
+		return nil, err
+	}
+
+	out, err := connector.ConnectWith(cfg, beat.ClientConfig{
+		Processing: beat.ProcessingConfig{
+			DynamicFields: context.DynamicFields,
+		},
+		ACKEvents: func(privates []interface{}) {
+			for _, private := range privates {
+				if ack, ok := private.(*requestACK); ok {
+					ack.ack()
+				}
+			}
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	in := &Input{
+		config: conf,
+		outlet: out,
+		log:    logp.NewLogger(inputName).With("address", conf.Host),
+	}
+
+	server, err := inputhttp.New(&in.config.Config, in)
+	if err != nil {
+		return nil, err
+	}
+	in.server = server
+
+	return in, nil
+}
+
+// Run starts the HTTP server.
+func (in *Input) Run() {
+	in.Lock()
+	defer in.Unlock()
+
+	if !in.started {
+		in.log.Info("Starting HTTP endpoint input")
+		if err := in.server.Start(); err != nil {
+			in.log.Errorw("Error starting the HTTP server", "error", err)
+		}
+		in.started = true
+	}
+}
+
+// Stop stops the HTTP server.
+func (in *Input) Stop() {
+	defer in.outlet.Close()
+	in.Lock()
+	defer in.Unlock()
+
+	in.log.Info("Stopping HTTP endpoint input")
+	if in.started {
+		in.server.Stop()
+	}
+	in.started = false
+}
+
+// Wait stops the HTTP server.
+func (in *Input) Wait() {
+	in.Stop()
+}
+
+// ServeHTTP handles a single request. The request is answered with 200 OK
+// only after all events in the request have been ACKed by the outputs. On
+// timeout no further events of the request are published.
+func (in *Input) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != in.URL {
+		sendError(w, http.StatusNotFound, "not found")
+		return
+	}
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		sendError(w, http.StatusMethodNotAllowed, "only POST requests are allowed")
+		return
+	}
+	if !in.authorized(r) {
+		if in.BasicAuth {
+			w.Header().Set("WWW-Authenticate", `Basic realm="filebeat"`)
+		}
+		sendError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	objects, err := decodeObjects(r.Body)
+	if err != nil {
+		status := http.StatusBadRequest
+		if strings.Contains(err.Error(), "request body too large") {
+			status = http.StatusRequestEntityTooLarge
+		}
+		sendError(w, status, err.Error())
+		return
+	}
+	if len(objects) == 0 {
+		sendError(w, http.StatusBadRequest, "body contains no JSON objects")
+		return
+	}
+
+	metadata := inputhttp.Metadata(r)
+	remote := ""
+	if metadata.RemoteAddr != nil {
+		remote = metadata.RemoteAddr.String()
+	}
+
+	ack := newRequestACK(len(objects))
+	pub := &requestPublisher{}
+	failed := make(chan struct{})
+	go func() {
+		for _, obj := range objects {
+			if !pub.next() {
+				return
+			}
+			if !in.outlet.OnEvent(in.createEvent(obj, remote, ack)) {
+				close(failed)
+				return
+			}
+		}
+	}()
+
+	timer := time.NewTimer(in.ACKTimeout)
+	defer timer.Stop()
+
+	select {
+	case <-ack.done:
+		sendResponse(w, http.StatusOK, "success")
+	case <-failed:
+		sendError(w, http.StatusServiceUnavailable, errOutletClosed.Error())
+	case <-timer.C:
+		in.log.Debugw("Timeout waiting for events to be published", "remote_address", remote)
+		pub.stop()
+		w.Header().Set("Retry-After", "30")
+		sendError(w, http.StatusServiceUnavailable, "pipeline blocked, events not published in time")
+	case <-r.Context().Done():
+		in.log.Debugw("Client disconnected before events have been published", "remote_address", remote)
+		pub.stop()
+	}
+}
+
+func (in *Input) authorized(r *http.Request) bool {
+	if in.Secret.Header != "" {
+		if !secureEqual(r.Header.Get(in.Secret.Header), in.Secret.Value) {
+			return false
+		}
+	}
+
+	if in.BasicAuth {
+		user, pass, ok := r.BasicAuth()
+		if !ok || !secureEqual(user, in.Username) || !secureEqual(pass, in.Password) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (in *Input) createEvent(obj common.MapStr, remote string, ack *requestACK) beat.Event {
+	fields := common.MapStr{
+		in.Prefix: obj,
+	}
+	if remote != "" {
+		fields["log"] = common.MapStr{
+			"source": common.MapStr{
+				"address": remote,
+			},
+		}
+	}
+
+	return beat.Event{
+		Timestamp: time.Now().UTC(),
+		Fields:    fields,
+		Private:   ack,
+	}
+}
+
+// decodeObjects reads a single JSON object, an array of JSON objects or a
+// stream of newline delimited JSON objects.
+func decodeObjects(body io.Reader) ([]common.MapStr, error) {
+	var objects []common.MapStr
+
+	dec := json.NewDecoder(body)
+	dec.UseNumber()
+	for {
+		var v interface{}
+		err := dec.Decode(&v)
+		if err == io.EOF {
+			return objects, nil
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to decode body")
+		}
+
+		switch value := v.(type) {
+		case map[string]interface{}:
+			objects = append(objects, value)
+		case []interface{}:
+			for _, elem := range value {
+				obj, ok := elem.(map[string]interface{})
+				if !ok {
+					return nil, errors.Errorf("array element is not a JSON object: %v", elem)
+				}
+				objects = append(objects, obj)
+			}
+		default:
+			return nil, errors.Errorf("expected JSON object or array, got %T", v)
+		}
+	}
+}
+
+func secureEqual(a, b string) bool {
+	return subtle.ConstantTimeCompare([]byte(a), []byte(b)) == 1
+}
+
+func sendResponse(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(common.MapStr{"message": message})
+}
+
+func sendError(w http.ResponseWriter, status int, message string) {
+	sendResponse(w, status, message)
+}
+
+// next reports whether the next event may be published. It returns false
+// once the request has been answered.
+func (p *requestPublisher) next() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return !p.canceled
+}
+
+// stop cancels publishing. An event already handed to the outlet is still
+// published, once the outlet unblocks.
+func (p *requestPublisher) stop() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.canceled = true
+}
+
+func newRequestACK(n int) *requestACK {
+	return &requestACK{pending: n, done: make(chan struct{})}
+}
+
+func (a *requestACK) ack() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.pending--
+	if a.pending == 0 {
+		close(a.done)
+	}
+}
@@ -0,0 +1,231 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package http_endpoint
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/logp"
+)
+
+type mockOutlet struct {
+	sync.Mutex
+	events []beat.Event
+	ack    bool
+	closed bool
+	block  chan struct{} // if set, OnEvent blocks after the first event
+}
+
+func (o *mockOutlet) Close() error          { return nil }
+func (o *mockOutlet) Done() <-chan struct{} { return nil }
+func (o *mockOutlet) OnEvent(event beat.Event) bool {
+	o.Lock()
+	if o.block != nil && len(o.events) > 0 {
+		o.Unlock()
+		<-o.block
+		o.Lock()
+	}
+	defer o.Unlock()
+	if o.closed {
+		return false
+	}
+	o.events = append(o.events, event)
+	if o.ack {
+		event.Private.(*requestACK).ack()
+	}
+	return true
+}
+
+func newTestInput(t *testing.T, settings map[string]interface{}, out *mockOutlet) *Input {
+	conf := defaultConfig()
+	cfg := common.MustNewConfigFrom(settings)
+	require.NoError(t, cfg.Unpack(&conf))
+	return &Input{config: conf, outlet: out, log: logp.NewLogger(inputName)}
+}
+
+func TestDecodeObjects(t *testing.T) {
+	tests := map[string]struct {
+		body  string
+		count int
+		err   bool
+	}{
+		"object":        {body: `{"a": 1}`, count: 1},
+		"array":         {body: `[{"a": 1}, {"b": 2}]`, count: 2},
+		"ndjson":        {body: "{\"a\": 1}\n{\"b\": 2}\n{\"c\": 3}\n", count: 3},
+		"empty":         {body: "", count: 0},
+		"invalid":       {body: `{"a":`, err: true},
+		"scalar":        {body: `"hello"`, err: true},
+		"array scalars": {body: `[1, 2]`, err: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			objs, err := decodeObjects(strings.NewReader(test.body))
+			if test.err {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, objs, test.count)
+		})
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	tests := map[string]map[string]interface{}{
+		"url without slash":  {"url": "webhook"},
+		"empty prefix":       {"prefix": ""},
+		"secret header only": {"secret.header": "X-Secret"},
+		"basic auth no user": {"basic_auth": true, "password": "secret"},
+	}
+
+	for name, settings := range tests {
+		t.Run(name, func(t *testing.T) {
+			conf := defaultConfig()
+			err := common.MustNewConfigFrom(settings).Unpack(&conf)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestServeHTTP(t *testing.T) {
+	tests := map[string]struct {
+		settings map[string]interface{}
+		method   string
+		path     string
+		body     string
+		header   map[string]string
+		user     string
+		pass     string
+		ack      bool
+		status   int
+		events   int
+	}{
+		"success": {
+			method: "POST", path: "/", body: `[{"a": 1}, {"b": 2}]`,
+			ack: true, status: http.StatusOK, events: 2,
+		},
+		"wrong method": {
+			method: "GET", path: "/", status: http.StatusMethodNotAllowed,
+		},
+		"wrong path": {
+			method: "POST", path: "/other", body: `{}`, status: http.StatusNotFound,
+		},
+		"bad json": {
+			method: "POST", path: "/", body: `{`, status: http.StatusBadRequest,
+		},
+		"empty body": {
+			method: "POST", path: "/", status: http.StatusBadRequest,
+		},
+		"missing secret": {
+			settings: map[string]interface{}{"secret.header": "X-Secret", "secret.value": "s3cr3t"},
+			method:   "POST", path: "/", body: `{}`, status: http.StatusUnauthorized,
+		},
+		"valid secret": {
+			settings: map[string]interface{}{"secret.header": "X-Secret", "secret.value": "s3cr3t"},
+			method:   "POST", path: "/", body: `{}`, header: map[string]string{"X-Secret": "s3cr3t"},
+			ack: true, status: http.StatusOK, events: 1,
+		},
+		"wrong basic auth": {
+			settings: map[string]interface{}{"basic_auth": true, "username": "u", "password": "p"},
+			method:   "POST", path: "/", body: `{}`, user: "u", pass: "x",
+			status: http.StatusUnauthorized,
+		},
+		"valid basic auth": {
+			settings: map[string]interface{}{"basic_auth": true, "username": "u", "password": "p"},
+			method:   "POST", path: "/", body: `{}`, user: "u", pass: "p",
+			ack: true, status: http.StatusOK, events: 1,
+		},
+		"ack timeout": {
+			settings: map[string]interface{}{"ack_timeout": "50ms"},
+			method:   "POST", path: "/", body: `{}`,
+			status: http.StatusServiceUnavailable, events: 1,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			settings := test.settings
+			if settings == nil {
+				settings = map[string]interface{}{}
+			}
+			out := &mockOutlet{ack: test.ack}
+			in := newTestInput(t, settings, out)
+
+			req := httptest.NewRequest(test.method, test.path, strings.NewReader(test.body))
+			for k, v := range test.header {
+				req.Header.Set(k, v)
+			}
+			if test.user != "" {
+				req.SetBasicAuth(test.user, test.pass)
+			}
+			rec := httptest.NewRecorder()
+			in.ServeHTTP(rec, req)
+
+			assert.Equal(t, test.status, rec.Code)
+
+			// events are published asynchronously
+			deadline := time.Now().Add(time.Second)
+			for {
+				out.Lock()
+				n := len(out.events)
+				out.Unlock()
+				if n >= test.events || time.Now().After(deadline) {
+					break
+				}
+				time.Sleep(10 * time.Millisecond)
+			}
+			out.Lock()
+			defer out.Unlock()
+			assert.Len(t, out.events, test.events)
+		})
+	}
+}
+
+func TestServeHTTPTimeoutStopsPublishing(t *testing.T) {
+	out := &mockOutlet{block: make(chan struct{})}
+	in := newTestInput(t, map[string]interface{}{"ack_timeout": "50ms"}, out)
+
+	body := "{\"a\": 1}\n{\"b\": 2}\n{\"c\": 3}\n{\"d\": 4}\n"
+	req := httptest.NewRequest("POST", "/", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	in.ServeHTTP(rec, req)
+
+	// The events were not ACKed in time. The second event is blocked in the
+	// outlet, it is published once the outlet unblocks. The remaining events
+	// must not be published.
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	assert.Equal(t, "30", rec.Header().Get("Retry-After"))
+
+	close(out.block)
+	time.Sleep(100 * time.Millisecond)
+
+	out.Lock()
+	defer out.Unlock()
+	assert.Len(t, out.events, 2)
+}
+
+func TestCreateEvent(t *testing.T) {
+	in := newTestInput(t, map[string]interface{}{"prefix": "webhook"}, &mockOutlet{})
+	event := in.createEvent(common.MapStr{"id": "1"}, "127.0.0.1:1234", newRequestACK(1))
+
+	id, err := event.GetValue("webhook.id")
+	require.NoError(t, err)
+	assert.Equal(t, "1", id)
+
+	addr, err := event.GetValue("log.source.address")
+	require.NoError(t, err)
+	assert.Equal(t, "127.0.0.1:1234", addr)
+}
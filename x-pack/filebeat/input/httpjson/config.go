@@ -0,0 +1,126 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package httpjson
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/transport/tlscommon"
+)
+
+type config struct {
+	// URL of the API endpoint to poll.
+	URL string `config:"url" validate:"required"`
+
+	// HTTP method used for requests. Either GET or POST.
+	HTTPMethod string `config:"http_method"`
+
+	// Additional HTTP headers sent with every request.
+	HTTPHeaders map[string]string `config:"http_headers"`
+
+	// JSON request body sent with POST requests.
+	HTTPRequestBody common.MapStr `config:"http_request_body"`
+
+	// Value of the Authorization header, e.g. "Bearer <token>".
+	APIKey string `config:"api_key"`
+
+	// Basic authentication credentials.
+	Username string `config:"username"`
+	Password string `config:"password"`
+
+	// Interval between two polls. If set to 0, the API is polled only once.
+	Interval time.Duration `config:"interval" validate:"min=0"`
+
+	// Timeout of a single HTTP request.
+	Timeout time.Duration `config:"timeout" validate:"min=0"`
+
+	// Field in the response holding an array of objects. Each object is
+	// published as a separate event.
+	JSONObjects string `config:"json_objects_array"`
+
+	Pagination *paginationConfig `config:"pagination"`
+	Cursor     *cursorConfig     `config:"cursor"`
+
+	TLS *tlscommon.Config `config:"ssl"`
+}
+
+// paginationConfig configures how the next page of a response is requested.
+type paginationConfig struct {
+	// Field in the response holding the cursor of the next page.
+	CursorField string `config:"cursor_field"`
+
+	// URL query parameter used to send the cursor of the next page.
+	CursorParam string `config:"cursor_param"`
+
+	// Field in the response holding the URL of the next page.
+	NextLinkField string `config:"next_link_field"`
+
+	// Follow the URL in the Link header with rel="next".
+	LinkHeader bool `config:"link_header"`
+
+	// Maximum number of pages requested per poll. 0 means no limit.
+	MaxPages int `config:"max_pages" validate:"min=0"`
+}
+
+// cursorConfig configures the cursor persisted in the registry. The cursor
+// allows the input to resume after a restart.
+type cursorConfig struct {
+	// Event field holding the cursor value. The value of the last published
+	// event is persisted.
+	Field string `config:"field" validate:"required"`
+
+	// URL query parameter used to send the persisted cursor with the first
+	// request of a poll.
+	Param string `config:"param" validate:"required"`
+}
+
+func defaultConfig() config {
+	return config{
+		HTTPMethod: http.MethodGet,
+		Interval:   60 * time.Second,
+		Timeout:    30 * time.Second,
+	}
+}
+
+func (c *config) Validate() error {
+	if _, err := url.Parse(c.URL); err != nil {
+		return errors.Wrapf(err, "invalid url '%v'", c.URL)
+	}
+
+	switch strings.ToUpper(c.HTTPMethod) {
+	case http.MethodGet, http.MethodPost:
+	default:
+		return errors.Errorf("http_method '%v' is not supported, use GET or POST", c.HTTPMethod)
+	}
+
+	if c.HTTPRequestBody != nil && strings.ToUpper(c.HTTPMethod) != http.MethodPost {
+		return errors.New("http_request_body requires http_method to be POST")
+	}
+
+	if c.APIKey != "" && c.Username != "" {
+		return errors.New("api_key and username can not be used together")
+	}
+	if (c.Username == "") != (c.Password == "") {
+		return errors.New("both username and password must be set")
+	}
+
+	return nil
+}
+
+func (c *paginationConfig) Validate() error {
+	if c.CursorField == "" && c.NextLinkField == "" && !c.LinkHeader {
+		return errors.New("pagination requires one of cursor_field, next_link_field or link_header")
+	}
+	if (c.CursorField == "") != (c.CursorParam == "") {
+		return errors.New("pagination cursor_field and cursor_param must be set together")
+	}
+	return nil
+}
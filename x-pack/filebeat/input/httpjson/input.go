@@ -0,0 +1,427 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package httpjson
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/filebeat/channel"
+	"github.com/elastic/beats/filebeat/input"
+	"github.com/elastic/beats/filebeat/input/file"
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/libbeat/common/transport/tlscommon"
+	"github.com/elastic/beats/libbeat/logp"
+)
+
+const (
+	inputName = "httpjson"
+
+	// upper bound for a single response body
+	maxBodySize = 100 * 1024 * 1024
+)
+
+var (
+	errOutletClosed = errors.New("input outlet closed")
+
+	linkNextRegex = regexp.MustCompile(`<([^>]+)>\s*;\s*rel="?next"?`)
+)
+
+func init() {
+	err := input.Register(inputName, NewInput)
+	if err != nil {
+		panic(errors.Wrapf(err, "failed to register %v input", inputName))
+	}
+}
+
+type httpjsonInput struct {
+	config
+
+	log      *logp.Logger
+	outlet   channel.Outleter // Output of received API responses.
+	inputCtx context.Context  // Wraps the Done channel from parent input.Context.
+	client   *http.Client
+
+	workerCtx    context.Context    // Worker goroutine context. It's cancelled when the input stops or the worker exits.
+	workerCancel context.CancelFunc // Used to signal that the worker should stop.
+	workerOnce   sync.Once          // Guarantees that the worker goroutine is only started once.
+	workerWg     sync.WaitGroup     // Waits on the polling worker goroutine.
+
+	// state persisted in the registry. The Cursor field holds the cursor
+	// value of the last published event.
+	state file.State
+}
+
+// NewInput creates a new httpjson input that periodically polls a REST API.
+func NewInput(
+	cfg *common.Config,
+	connector channel.Connector,
+	inputContext input.Context,
+) (input.Input, error) {
+	cfgwarn.Experimental("The %v input is experimental", inputName)
+
+	conf := defaultConfig()
+	if err := cfg.Unpack(&conf); err != nil {
+		return nil, err
+	}
+
+	tlsConfig, err := tlscommon.LoadTLSConfig(conf.TLS)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := url.Parse(conf.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	transport := &http.Transport{
+		Proxy:           http.ProxyFromEnvironment,
+		TLSClientConfig: tlsConfig.BuildModuleConfig(u.Host),
+	}
+
+	// Build outlet for events.
+	out, err := connector.ConnectWith(cfg, beat.ClientConfig{
+		Processing: beat.ProcessingConfig{
+			DynamicFields: inputContext.DynamicFields,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Wrap input.Context's Done channel with a context.Context. This goroutine
+	// stops with the parent closes the Done channel.
+	inputCtx, cancelInputCtx := context.WithCancel(context.Background())
+	go func() {
+		defer cancelInputCtx()
+		select {
+		case <-inputContext.Done:
+		case <-inputCtx.Done():
+		}
+	}()
+
+	workerCtx, workerCancel := context.WithCancel(inputCtx)
+
+	in := &httpjsonInput{
+		config:       conf,
+		log:          logp.NewLogger(inputName).With("url", conf.URL),
+		outlet:       out,
+		inputCtx:     inputCtx,
+		client:       &http.Client{Transport: transport, Timeout: conf.Timeout},
+		workerCtx:    workerCtx,
+		workerCancel: workerCancel,
+		state:        findState(inputContext.States, conf.URL),
+	}
+
+	in.log.Info("Initialized httpjson input.")
+	return in, nil
+}
+
+// findState returns the registry state of the input. If no state is found, a
+// new state is returned.
+func findState(states []file.State, url string) file.State {
+	state := file.State{
+		Type:      inputName,
+		Source:    url,
+		Timestamp: time.Now(),
+		TTL:       -1,
+		Meta:      map[string]string{"url": url},
+	}
+
+	id := state.ID()
+	for _, st := range states {
+		if st.Type == inputName && st.ID() == id {
+			state.Cursor = st.Cursor
+			break
+		}
+	}
+	return state
+}
+
+// Run starts the polling worker then returns. Only the first invocation
+// will ever start the worker.
+func (in *httpjsonInput) Run() {
+	in.workerOnce.Do(func() {
+		in.workerWg.Add(1)
+		go func() {
+			in.log.Info("httpjson input worker has started.")
+			defer in.log.Info("httpjson input worker has stopped.")
+			defer in.workerWg.Done()
+			defer in.workerCancel()
+			if err := in.run(); err != nil && err != context.Canceled {
+				in.log.Error(err)
+			}
+		}()
+	})
+}
+
+func (in *httpjsonInput) run() error {
+	ctx := in.workerCtx
+
+	for {
+		if err := in.poll(ctx); err != nil {
+			if err == errOutletClosed || ctx.Err() != nil {
+				return err
+			}
+			in.log.Errorf("Failed to poll API: %v", err)
+		}
+
+		if in.Interval <= 0 {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(in.Interval):
+		}
+	}
+}
+
+// poll requests all pages available and publishes the contained objects.
+func (in *httpjsonInput) poll(ctx context.Context) error {
+	requestURL, err := url.Parse(in.URL)
+	if err != nil {
+		return err
+	}
+	if in.Cursor != nil && in.state.Cursor != "" {
+		requestURL = withQueryParam(requestURL, in.Cursor.Param, in.state.Cursor)
+	}
+
+	for page := 1; ; page++ {
+		resp, body, err := in.request(ctx, requestURL)
+		if err != nil {
+			return err
+		}
+
+		objects, err := in.splitObjects(body)
+		if err != nil {
+			return err
+		}
+
+		for _, obj := range objects {
+			if err := in.publish(obj); err != nil {
+				return err
+			}
+		}
+
+		if in.Pagination == nil {
+			return nil
+		}
+		if max := in.Pagination.MaxPages; max > 0 && page >= max {
+			return nil
+		}
+
+		next, err := in.nextPage(requestURL, resp, body)
+		if err != nil || next == nil {
+			return err
+		}
+		requestURL = next
+	}
+}
+
+func (in *httpjsonInput) request(ctx context.Context, u *url.URL) (*http.Response, interface{}, error) {
+	var body io.Reader
+	method := strings.ToUpper(in.HTTPMethod)
+	if method == http.MethodPost && in.HTTPRequestBody != nil {
+		contents, err := json.Marshal(in.HTTPRequestBody)
+		if err != nil {
+			return nil, nil, err
+		}
+		body = bytes.NewReader(contents)
+	}
+
+	req, err := http.NewRequest(method, u.String(), body)
+	if err != nil {
+		return nil, nil, err
+	}
+	req = req.WithContext(ctx)
+
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for k, v := range in.HTTPHeaders {
+		req.Header.Set(k, v)
+	}
+	if in.APIKey != "" {
+		req.Header.Set("Authorization", in.APIKey)
+	}
+	if in.Username != "" {
+		req.SetBasicAuth(in.Username, in.Password)
+	}
+
+	in.log.Debugf("Requesting %v %v", method, u)
+	resp, err := in.client.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+
+	contents, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxBodySize))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, nil, errors.Errorf("server responded with status code %v: %s",
+			resp.StatusCode, truncate(contents, 256))
+	}
+
+	var decoded interface{}
+	if err := json.Unmarshal(contents, &decoded); err != nil {
+		return nil, nil, errors.Wrap(err, "failed to decode response body")
+	}
+	return resp, decoded, nil
+}
+
+// splitObjects returns the JSON objects to be published as events.
+func (in *httpjsonInput) splitObjects(body interface{}) ([]common.MapStr, error) {
+	if in.JSONObjects != "" {
+		obj, ok := body.(map[string]interface{})
+		if !ok {
+			return nil, errors.New("response is not a JSON object")
+		}
+
+		v, err := common.MapStr(obj).GetValue(in.JSONObjects)
+		if err != nil {
+			if err == common.ErrKeyNotFound {
+				return nil, nil
+			}
+			return nil, err
+		}
+		body = v
+		if body == nil {
+			return nil, nil
+		}
+	}
+
+	switch v := body.(type) {
+	case map[string]interface{}:
+		return []common.MapStr{v}, nil
+	case []interface{}:
+		objects := make([]common.MapStr, 0, len(v))
+		for _, elem := range v {
+			obj, ok := elem.(map[string]interface{})
+			if !ok {
+				return nil, errors.Errorf("array element is not a JSON object: %v", elem)
+			}
+			objects = append(objects, obj)
+		}
+		return objects, nil
+	default:
+		return nil, errors.Errorf("expected JSON object or array, got %T", body)
+	}
+}
+
+func (in *httpjsonInput) publish(obj common.MapStr) error {
+	message, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+
+	event := beat.Event{
+		Timestamp: time.Now().UTC(),
+		Fields: common.MapStr{
+			"message": string(message),
+		},
+	}
+
+	if in.Cursor != nil {
+		if v, err := obj.GetValue(in.Cursor.Field); err == nil && v != nil {
+			in.state.Cursor = fmt.Sprint(v)
+		}
+		state := in.state
+		state.Timestamp = event.Timestamp
+		event.Private = state
+	}
+
+	if !in.outlet.OnEvent(event) {
+		return errOutletClosed
+	}
+	return nil
+}
+
+// nextPage returns the URL of the next page, or nil if no more pages are
+// available.
+func (in *httpjsonInput) nextPage(current *url.URL, resp *http.Response, body interface{}) (*url.URL, error) {
+	pagination := in.Pagination
+	obj, _ := body.(map[string]interface{})
+
+	if pagination.CursorField != "" && obj != nil {
+		v, err := common.MapStr(obj).GetValue(pagination.CursorField)
+		if err == nil && v != nil && v != "" {
+			return withQueryParam(current, pagination.CursorParam, fmt.Sprint(v)), nil
+		}
+	}
+
+	if pagination.NextLinkField != "" && obj != nil {
+		v, err := common.MapStr(obj).GetValue(pagination.NextLinkField)
+		if err == nil {
+			if link, ok := v.(string); ok && link != "" {
+				return resolveLink(current, link)
+			}
+		}
+	}
+
+	if pagination.LinkHeader {
+		for _, header := range resp.Header["Link"] {
+			if m := linkNextRegex.FindStringSubmatch(header); m != nil {
+				return resolveLink(current, m[1])
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+func resolveLink(current *url.URL, link string) (*url.URL, error) {
+	next, err := url.Parse(link)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid next page link '%v'", link)
+	}
+	return current.ResolveReference(next), nil
+}
+
+func withQueryParam(u *url.URL, name, value string) *url.URL {
+	tmp := *u
+	q := tmp.Query()
+	q.Set(name, value)
+	tmp.RawQuery = q.Encode()
+	return &tmp
+}
+
+func truncate(b []byte, n int) []byte {
+	if len(b) > n {
+		return b[:n]
+	}
+	return b
+}
+
+// Stop stops the input and waits for it to fully stop.
+func (in *httpjsonInput) Stop() {
+	in.workerCancel()
+	in.workerWg.Wait()
+}
+
+// Wait is an alias for Stop.
+func (in *httpjsonInput) Wait() {
+	in.Stop()
+}
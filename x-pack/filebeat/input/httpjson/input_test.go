@@ -0,0 +1,162 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package httpjson
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/filebeat/channel"
+	"github.com/elastic/beats/filebeat/input"
+	"github.com/elastic/beats/filebeat/input/file"
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+)
+
+type eventCaptor struct {
+	mu     sync.Mutex
+	events []beat.Event
+	c      chan struct{}
+}
+
+func newEventCaptor() *eventCaptor {
+	return &eventCaptor{c: make(chan struct{}, 100)}
+}
+
+func (ec *eventCaptor) Close() error                                     { return nil }
+func (ec *eventCaptor) Done() <-chan struct{}                            { return nil }
+func (ec *eventCaptor) Connect(*common.Config) (channel.Outleter, error) { return ec, nil }
+func (ec *eventCaptor) ConnectWith(*common.Config, beat.ClientConfig) (channel.Outleter, error) {
+	return ec, nil
+}
+
+func (ec *eventCaptor) OnEvent(event beat.Event) bool {
+	ec.mu.Lock()
+	ec.events = append(ec.events, event)
+	ec.mu.Unlock()
+	ec.c <- struct{}{}
+	return true
+}
+
+func (ec *eventCaptor) waitEvents(t *testing.T, n int) []beat.Event {
+	for i := 0; i < n; i++ {
+		select {
+		case <-ec.c:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timeout waiting for event %v", i)
+		}
+	}
+
+	ec.mu.Lock()
+	defer ec.mu.Unlock()
+	return ec.events
+}
+
+func runInput(t *testing.T, settings map[string]interface{}, states []file.State, events int) []beat.Event {
+	cfg := common.MustNewConfigFrom(settings)
+	captor := newEventCaptor()
+
+	done := make(chan struct{})
+	defer close(done)
+
+	in, err := NewInput(cfg, captor, input.Context{Done: done, States: states})
+	require.NoError(t, err)
+
+	in.Run()
+	defer in.Stop()
+
+	return captor.waitEvents(t, events)
+}
+
+func decodeMessage(t *testing.T, event beat.Event) map[string]interface{} {
+	var obj map[string]interface{}
+	msg, err := event.Fields.GetValue("message")
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal([]byte(msg.(string)), &obj))
+	return obj
+}
+
+func TestSplitObjectsArray(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "secret", r.Header.Get("Authorization"))
+		fmt.Fprint(w, `{"data": {"items": [{"id": 1}, {"id": 2}]}}`)
+	}))
+	defer server.Close()
+
+	events := runInput(t, map[string]interface{}{
+		"url":                server.URL,
+		"api_key":            "secret",
+		"interval":           0,
+		"json_objects_array": "data.items",
+	}, nil, 2)
+
+	require.Len(t, events, 2)
+	assert.EqualValues(t, 1, decodeMessage(t, events[0])["id"])
+	assert.EqualValues(t, 2, decodeMessage(t, events[1])["id"])
+	assert.Nil(t, events[0].Private)
+}
+
+func TestPaginationAndCursor(t *testing.T) {
+	var mu sync.Mutex
+	var requests []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests = append(requests, r.URL.RawQuery)
+		mu.Unlock()
+
+		switch r.URL.Query().Get("page") {
+		case "":
+			fmt.Fprint(w, `{"items": [{"id": 11}], "next": "p2"}`)
+		case "p2":
+			w.Header().Set("Link", `</?page=p3>; rel="next"`)
+			fmt.Fprint(w, `{"items": [{"id": 12}], "next": null}`)
+		case "p3":
+			fmt.Fprint(w, `{"items": [{"id": 13}]}`)
+		}
+	}))
+	defer server.Close()
+
+	events := runInput(t, map[string]interface{}{
+		"url":                server.URL + "/?since=0",
+		"interval":           0,
+		"json_objects_array": "items",
+		"pagination": map[string]interface{}{
+			"cursor_field": "next",
+			"cursor_param": "page",
+			"link_header":  true,
+		},
+		"cursor": map[string]interface{}{
+			"field": "id",
+			"param": "after",
+		},
+	}, []file.State{{Type: inputName, Meta: map[string]string{"url": server.URL + "/?since=0"}, Cursor: "10"}}, 3)
+
+	require.Len(t, events, 3)
+	for i, event := range events {
+		state, ok := event.Private.(file.State)
+		require.True(t, ok)
+		assert.Equal(t, fmt.Sprint(11+i), state.Cursor)
+		assert.Equal(t, inputName, state.Type)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, []string{"after=10&since=0", "after=10&page=p2&since=0", "page=p3"}, requests)
+}
+
+func TestLinkParsing(t *testing.T) {
+	m := linkNextRegex.FindStringSubmatch(`<https://api.test/items?page=1>; rel="prev", <https://api.test/items?page=3>; rel="next"`)
+	require.NotNil(t, m)
+	assert.Equal(t, "https://api.test/items?page=3", m[1])
+}